@@ -79,22 +79,28 @@ const (
 // Config はアプリケーションの設定を保持する構造体です。
 // データベース接続情報やサーバー設定など、アプリケーション全体で使用される設定値を管理します。
 type Config struct {
-	Port              string        // サーバーのポート番号
-	Env               string        // 実行環境（development, production など）
-	DBHost            string        // データベースホスト名
-	DBPort            string        // データベースポート番号
-	DBUser            string        // データベースユーザー名
-	DBPassword        string        // データベースパスワード
-	DBName            string        // データベース名
-	DBSSLMode         string        // データベースSSLモード
-	DBMaxIdleConns    int           // データベースのアイドル接続の最大数
-	DBMaxOpenConns    int           // データベースの同時接続の最大数
-	DBConnMaxLifetime time.Duration // データベース接続の最大生存時間
-	DBConnMaxIdleTime time.Duration // データベース接続のアイドル最大時間
+	Port                 string        // サーバーのポート番号
+	Env                  string        // 実行環境（development, production など）
+	DBHost               string        // データベースホスト名
+	DBPort               string        // データベースポート番号
+	DBUser               string        // データベースユーザー名
+	DBPassword           string        // データベースパスワード
+	DBName               string        // データベース名
+	DBSSLMode            string        // データベースSSLモード
+	DBMaxIdleConns       int           // データベースのアイドル接続の最大数
+	DBMaxOpenConns       int           // データベースの同時接続の最大数
+	DBConnMaxLifetime    time.Duration // データベース接続の最大生存時間
+	DBConnMaxIdleTime    time.Duration // データベース接続のアイドル最大時間
+	TrashRetention       time.Duration // ゴミ箱の要素を完全削除するまでの保持期間（0以下で自動削除を無効化）
+	TrashPurgeInterval   time.Duration // 保持期間を過ぎたゴミ箱の要素を完全削除する間隔
+	TestTypeRegistryFile string        // 試験種別レジストリの定義ファイル（JSON）のパス（空の場合は既定の定義を使用）
+	ScheduleRegistryFile string        // 日程レジストリの定義ファイル（JSON）のパス（空の場合は既定の定義を使用）
 }
 
 const (
-	defaultPort = "8080" // デフォルトのポート番号
+	defaultPort               = "8080"              // デフォルトのポート番号
+	defaultTrashRetention     = 30 * 24 * time.Hour // ゴミ箱のデフォルトの保持期間
+	defaultTrashPurgeInterval = time.Hour           // ゴミ箱の完全削除のデフォルトの実行間隔
 )
 
 // Validate は設定値の検証を行います。
@@ -133,18 +139,22 @@ func (c *Config) Validate() error {
 // エラーが発生した場合は、エラーメッセージを返します。
 func New() (*Config, error) {
 	config := &Config{
-		Port:              getEnvOrDefault("PORT", defaultPort),
-		Env:               getEnvOrDefault("ENV", "development"),
-		DBHost:            getEnvOrDefault("DB_HOST", ""),
-		DBPort:            getEnvOrDefault("DB_PORT", ""),
-		DBUser:            getEnvOrDefault("DB_USER", ""),
-		DBPassword:        getEnvOrDefault("DB_PASSWORD", ""),
-		DBName:            getEnvOrDefault("DB_NAME", ""),
-		DBSSLMode:         getEnvOrDefault("DB_SSL_MODE", "disable"),
-		DBMaxIdleConns:    getEnvOrDefaultInt("DB_MAX_IDLE_CONNS", 10),
-		DBMaxOpenConns:    getEnvOrDefaultInt("DB_MAX_OPEN_CONNS", 100),
-		DBConnMaxLifetime: getEnvOrDefaultDuration("DB_CONN_MAX_LIFETIME", time.Hour),
-		DBConnMaxIdleTime: getEnvOrDefaultDuration("DB_CONN_MAX_IDLE_TIME", 30*time.Minute),
+		Port:                 getEnvOrDefault("PORT", defaultPort),
+		Env:                  getEnvOrDefault("ENV", "development"),
+		DBHost:               getEnvOrDefault("DB_HOST", ""),
+		DBPort:               getEnvOrDefault("DB_PORT", ""),
+		DBUser:               getEnvOrDefault("DB_USER", ""),
+		DBPassword:           getEnvOrDefault("DB_PASSWORD", ""),
+		DBName:               getEnvOrDefault("DB_NAME", ""),
+		DBSSLMode:            getEnvOrDefault("DB_SSL_MODE", "disable"),
+		DBMaxIdleConns:       getEnvOrDefaultInt("DB_MAX_IDLE_CONNS", 10),
+		DBMaxOpenConns:       getEnvOrDefaultInt("DB_MAX_OPEN_CONNS", 100),
+		DBConnMaxLifetime:    getEnvOrDefaultDuration("DB_CONN_MAX_LIFETIME", time.Hour),
+		DBConnMaxIdleTime:    getEnvOrDefaultDuration("DB_CONN_MAX_IDLE_TIME", 30*time.Minute),
+		TrashRetention:       getEnvOrDefaultDuration("TRASH_RETENTION", defaultTrashRetention),
+		TrashPurgeInterval:   getEnvOrDefaultDuration("TRASH_PURGE_INTERVAL", defaultTrashPurgeInterval),
+		TestTypeRegistryFile: getEnvOrDefault("TEST_TYPE_REGISTRY_FILE", ""),
+		ScheduleRegistryFile: getEnvOrDefault("SCHEDULE_REGISTRY_FILE", ""),
 	}
 
 	if err := config.Validate(); err != nil {
@@ -268,6 +268,36 @@ func TestGetEnvOrDefaultInt(t *testing.T) {
 	}
 }
 
+// TestNewTrashSettings はゴミ箱の保持期間と完全削除の実行間隔の設定をテストします
+func TestNewTrashSettings(t *testing.T) {
+	required := map[string]string{
+		"DB_HOST": "localhost",
+		"DB_PORT": "5432",
+		"DB_USER": "postgres",
+		"DB_NAME": "postgres",
+	}
+
+	t.Run("既定値", func(t *testing.T) {
+		setupTestEnv(t, required)
+		setupTestEnv(t, map[string]string{"TRASH_RETENTION": "", "TRASH_PURGE_INTERVAL": ""})
+
+		cfg, err := New()
+		require.NoError(t, err)
+		assert.Equal(t, 30*24*time.Hour, cfg.TrashRetention)
+		assert.Equal(t, time.Hour, cfg.TrashPurgeInterval)
+	})
+
+	t.Run("環境変数で指定", func(t *testing.T) {
+		setupTestEnv(t, required)
+		setupTestEnv(t, map[string]string{"TRASH_RETENTION": "168h", "TRASH_PURGE_INTERVAL": "30m"})
+
+		cfg, err := New()
+		require.NoError(t, err)
+		assert.Equal(t, 7*24*time.Hour, cfg.TrashRetention)
+		assert.Equal(t, 30*time.Minute, cfg.TrashPurgeInterval)
+	})
+}
+
 // TestGetEnvOrDefaultDuration は時間型の環境変数取得をテストします
 func TestGetEnvOrDefaultDuration(t *testing.T) {
 	tests := []struct {
@@ -519,3 +549,33 @@ func TestErrorMethods(t *testing.T) {
 		})
 	}
 }
+
+// TestNewTestTypeRegistryFile は試験種別レジストリの定義ファイルの設定をテストします
+func TestNewTestTypeRegistryFile(t *testing.T) {
+	setupTestEnv(t, map[string]string{
+		"DB_HOST":                 "localhost",
+		"DB_PORT":                 "5432",
+		"DB_USER":                 "postgres",
+		"DB_NAME":                 "postgres",
+		"TEST_TYPE_REGISTRY_FILE": "/etc/exam/test_types.json",
+	})
+
+	cfg, err := New()
+	require.NoError(t, err)
+	assert.Equal(t, "/etc/exam/test_types.json", cfg.TestTypeRegistryFile)
+}
+
+// TestNewScheduleRegistryFile は日程レジストリの定義ファイルの設定をテストします
+func TestNewScheduleRegistryFile(t *testing.T) {
+	setupTestEnv(t, map[string]string{
+		"DB_HOST":                "localhost",
+		"DB_PORT":                "5432",
+		"DB_USER":                "postgres",
+		"DB_NAME":                "postgres",
+		"SCHEDULE_REGISTRY_FILE": "/etc/exam/schedules.json",
+	})
+
+	cfg, err := New()
+	require.NoError(t, err)
+	assert.Equal(t, "/etc/exam/schedules.json", cfg.ScheduleRegistryFile)
+}
@@ -0,0 +1,70 @@
+package models
+
+const (
+	// MinDeviation は偏差値の下限です
+	MinDeviation = 20.0
+	// MaxDeviation は偏差値の上限です
+	MaxDeviation = 90.0
+	// MaxBorderScore はボーダー得点の上限です
+	MaxBorderScore = 10000.0
+	// MaxDifficultySourceLength は出典の最大文字数です
+	MaxDifficultySourceLength = 50
+)
+
+// AdmissionDifficulty は入試情報（学年度ごと）の合格難易度を表現する構造体です
+// 以下のフィールドを含みます：
+// - BaseModel: 基本フィールド
+// - AdmissionInfoID: 入試情報ID（1つの入試情報に1件）
+// - BorderScore: ボーダー得点（合格可能性50%の合計点）
+// - CommonTestRate: 共通テストのボーダー得点率（%）
+// - DeviationLow / DeviationHigh: 偏差値帯の下限・上限
+// - Source: 出典
+// 各項目は公表されたものだけを登録できるよう省略可能とし、少なくとも1項目が必要です
+type AdmissionDifficulty struct {
+	BaseModel
+	AdmissionInfoID uint          `json:"admission_info_id" gorm:"not null;uniqueIndex:idx_admission_difficulty_info,where:deleted_at IS NULL"`
+	BorderScore     *float64      `json:"border_score,omitempty" gorm:"check:border_score >= 0"`
+	CommonTestRate  *float64      `json:"common_test_rate,omitempty" gorm:"check:common_test_rate >= 0 AND common_test_rate <= 100"`
+	DeviationLow    *float64      `json:"deviation_low,omitempty"`
+	DeviationHigh   *float64      `json:"deviation_high,omitempty" gorm:"check:deviation_high >= deviation_low"`
+	Source          string        `json:"source" gorm:"size:50"`
+	AdmissionInfo   AdmissionInfo `json:"-" gorm:"foreignKey:AdmissionInfoID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// Validate は合格難易度のバリデーションを行います
+// 偏差値帯は下限・上限の両方を指定する必要があり、1つの値の場合は下限と上限に同じ値を指定します
+func (d *AdmissionDifficulty) Validate() error {
+	switch {
+	case d.AdmissionInfoID == 0:
+		return &ValidationError{Field: "AdmissionInfoID", Message: "入試情報IDは必須です", Code: "REQUIRED_ADMISSION_INFO_ID"}
+	case d.BorderScore == nil && d.CommonTestRate == nil && d.DeviationLow == nil && d.DeviationHigh == nil:
+		return &ValidationError{
+			Field:   "AdmissionDifficulty",
+			Message: "ボーダー得点・共通テスト得点率・偏差値のいずれかを指定する必要があります",
+			Code:    "REQUIRED_DIFFICULTY",
+		}
+	case d.BorderScore != nil && (*d.BorderScore < 0 || *d.BorderScore > MaxBorderScore):
+		return &ValidationError{Field: "BorderScore", Message: "ボーダー得点は0-10000の範囲である必要があります", Code: "INVALID_BORDER_SCORE"}
+	case d.CommonTestRate != nil && (*d.CommonTestRate < 0 || *d.CommonTestRate > 100):
+		return &ValidationError{
+			Field:   "CommonTestRate",
+			Message: "共通テスト得点率は0-100の範囲である必要があります",
+			Code:    "INVALID_COMMON_TEST_RATE",
+		}
+	case (d.DeviationLow == nil) != (d.DeviationHigh == nil):
+		return &ValidationError{Field: "Deviation", Message: "偏差値は下限と上限の両方を指定する必要があります", Code: "INVALID_DEVIATION"}
+	case d.DeviationLow != nil && (!inDeviationRange(*d.DeviationLow) || !inDeviationRange(*d.DeviationHigh)):
+		return &ValidationError{Field: "Deviation", Message: "偏差値は20-90の範囲である必要があります", Code: "INVALID_DEVIATION"}
+	case d.DeviationLow != nil && *d.DeviationLow > *d.DeviationHigh:
+		return &ValidationError{Field: "Deviation", Message: "偏差値の下限は上限以下である必要があります", Code: "INVALID_DEVIATION"}
+	case len([]rune(d.Source)) > MaxDifficultySourceLength:
+		return &ValidationError{Field: "Source", Message: "出典は50文字以下である必要があります", Code: "INVALID_SOURCE"}
+	}
+
+	return nil
+}
+
+// inDeviationRange は偏差値が登録できる範囲内かを返します
+func inDeviationRange(v float64) bool {
+	return v >= MinDeviation && v <= MaxDeviation
+}
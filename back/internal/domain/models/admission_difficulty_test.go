@@ -0,0 +1,71 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// float64Ptr はテスト用の数値のポインタを返します
+func float64Ptr(v float64) *float64 {
+	return &v
+}
+
+func TestAdmissionDifficultyValidate(t *testing.T) {
+	valid := func() *AdmissionDifficulty {
+		return &AdmissionDifficulty{
+			AdmissionInfoID: 1,
+			BorderScore:     float64Ptr(720),
+			CommonTestRate:  float64Ptr(80),
+			DeviationLow:    float64Ptr(62.5),
+			DeviationHigh:   float64Ptr(65),
+			Source:          "河合塾",
+		}
+	}
+
+	assert.NoError(t, valid().Validate())
+
+	rateOnly := &AdmissionDifficulty{AdmissionInfoID: 1, CommonTestRate: float64Ptr(75)}
+	assert.NoError(t, rateOnly.Validate(), "公表された項目のみ登録できる")
+
+	single := valid()
+	single.DeviationLow = float64Ptr(65)
+	assert.NoError(t, single.Validate(), "偏差値が1つの値の場合は下限と上限を同じ値にする")
+
+	tests := []struct {
+		name   string
+		modify func(d *AdmissionDifficulty)
+		code   string
+	}{
+		{name: "入試情報IDなし", modify: func(d *AdmissionDifficulty) { d.AdmissionInfoID = 0 }, code: "REQUIRED_ADMISSION_INFO_ID"},
+		{
+			name: "項目なし",
+			modify: func(d *AdmissionDifficulty) {
+				d.BorderScore, d.CommonTestRate, d.DeviationLow, d.DeviationHigh = nil, nil, nil, nil
+			},
+			code: "REQUIRED_DIFFICULTY",
+		},
+		{name: "負のボーダー得点", modify: func(d *AdmissionDifficulty) { d.BorderScore = float64Ptr(-1) }, code: "INVALID_BORDER_SCORE"},
+		{name: "100%を超える得点率", modify: func(d *AdmissionDifficulty) { d.CommonTestRate = float64Ptr(100.5) }, code: "INVALID_COMMON_TEST_RATE"},
+		{name: "偏差値の上限なし", modify: func(d *AdmissionDifficulty) { d.DeviationHigh = nil }, code: "INVALID_DEVIATION"},
+		{name: "範囲外の偏差値", modify: func(d *AdmissionDifficulty) { d.DeviationHigh = float64Ptr(95) }, code: "INVALID_DEVIATION"},
+		{name: "下限が上限より大きい偏差値", modify: func(d *AdmissionDifficulty) { d.DeviationLow = float64Ptr(67.5) }, code: "INVALID_DEVIATION"},
+		{
+			name:   "長すぎる出典",
+			modify: func(d *AdmissionDifficulty) { d.Source = string(make([]rune, MaxDifficultySourceLength+1)) },
+			code:   "INVALID_SOURCE",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := valid()
+			tt.modify(d)
+
+			var validationErr *ValidationError
+			require.ErrorAs(t, d.Validate(), &validationErr)
+			assert.Equal(t, tt.code, validationErr.Code)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package models
+
+// 入試情報のステータス
+const (
+	AdmissionStatusDraft     = "draft"
+	AdmissionStatusPublished = "published"
+	AdmissionStatusArchived  = "archived"
+)
+
+// 入試情報のステータス遷移の操作
+const (
+	AdmissionActionPublish   = "publish"
+	AdmissionActionUnpublish = "unpublish"
+	AdmissionActionArchive   = "archive"
+)
+
+// AdmissionTransition は入試情報のステータス遷移を表現する構造体です
+// 以下のフィールドを含みます：
+// - From: 遷移元として許可されるステータス一覧
+// - To: 遷移先のステータス
+type AdmissionTransition struct {
+	From []string
+	To   string
+}
+
+// AdmissionTransitions は操作ごとの許可されたステータス遷移です
+// アーカイブ済みの入試情報は終端の状態として、いずれの操作も受け付けません
+var AdmissionTransitions = map[string]AdmissionTransition{
+	AdmissionActionPublish:   {From: []string{AdmissionStatusDraft}, To: AdmissionStatusPublished},
+	AdmissionActionUnpublish: {From: []string{AdmissionStatusPublished}, To: AdmissionStatusDraft},
+	AdmissionActionArchive:   {From: []string{AdmissionStatusDraft, AdmissionStatusPublished}, To: AdmissionStatusArchived},
+}
+
+// Allows は指定されたステータスからの遷移が許可されているかを判定します
+func (t AdmissionTransition) Allows(status string) bool {
+	for _, from := range t.From {
+		if from == status {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PublishedUniversities は公開中の入試情報のみを含む大学一覧のコピーを返します
+// キャッシュされた値を共有している場合があるため、元の値は変更しません
+func PublishedUniversities(universities []University) []University {
+	result := make([]University, len(universities))
+	for i := range universities {
+		result[i] = universities[i].PublishedOnly()
+	}
+
+	return result
+}
+
+// PublishedOnly は公開中の入試情報のみを含む大学のコピーを返します
+// 公開中の入試情報を持たない入試日程は、試験種別・科目も非公開として除外します
+func (u University) PublishedOnly() University {
+	if u.Departments == nil {
+		return u
+	}
+
+	departments := make([]Department, len(u.Departments))
+	for i, department := range u.Departments {
+		departments[i] = department.publishedOnly()
+	}
+
+	u.Departments = departments
+
+	return u
+}
+
+// publishedOnly は公開中の入試情報のみを含む学部のコピーを返します
+func (d Department) publishedOnly() Department {
+	if d.Majors == nil {
+		return d
+	}
+
+	majors := make([]Major, len(d.Majors))
+	for i, major := range d.Majors {
+		majors[i] = major.publishedOnly()
+	}
+
+	d.Majors = majors
+
+	return d
+}
+
+// publishedOnly は公開中の入試情報のみを含む学科のコピーを返します
+func (m Major) publishedOnly() Major {
+	if m.AdmissionSchedules == nil {
+		return m
+	}
+
+	schedules := make([]AdmissionSchedule, len(m.AdmissionSchedules))
+	for i, schedule := range m.AdmissionSchedules {
+		schedules[i] = schedule.publishedOnly()
+	}
+
+	m.AdmissionSchedules = schedules
+
+	return m
+}
+
+// publishedOnly は公開中の入試情報のみを含む入試日程のコピーを返します
+func (a AdmissionSchedule) publishedOnly() AdmissionSchedule {
+	infos := make([]AdmissionInfo, 0, len(a.AdmissionInfos))
+	for _, info := range a.AdmissionInfos {
+		if info.Status == AdmissionStatusPublished {
+			infos = append(infos, info)
+		}
+	}
+
+	a.AdmissionInfos = infos
+	if len(infos) == 0 {
+		a.TestTypes = nil
+	}
+
+	return a
+}
@@ -0,0 +1,69 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdmissionTransitions(t *testing.T) {
+	tests := []struct {
+		action  string
+		status  string
+		allowed bool
+	}{
+		{AdmissionActionPublish, AdmissionStatusDraft, true},
+		{AdmissionActionPublish, AdmissionStatusPublished, false},
+		{AdmissionActionPublish, AdmissionStatusArchived, false},
+		{AdmissionActionUnpublish, AdmissionStatusPublished, true},
+		{AdmissionActionUnpublish, AdmissionStatusDraft, false},
+		{AdmissionActionArchive, AdmissionStatusDraft, true},
+		{AdmissionActionArchive, AdmissionStatusPublished, true},
+		{AdmissionActionArchive, AdmissionStatusArchived, false},
+	}
+
+	for _, tt := range tests {
+		transition, ok := AdmissionTransitions[tt.action]
+		require.True(t, ok, tt.action)
+		assert.Equal(t, tt.allowed, transition.Allows(tt.status), "%s from %s", tt.action, tt.status)
+	}
+}
+
+func TestPublishedUniversities(t *testing.T) {
+	universities := []University{{
+		Name: "テスト大学",
+		Departments: []Department{{
+			Name: "工学部",
+			Majors: []Major{{
+				Name: "情報工学科",
+				AdmissionSchedules: []AdmissionSchedule{
+					{
+						Name:           "前",
+						AdmissionInfos: []AdmissionInfo{{Status: AdmissionStatusPublished}, {Status: AdmissionStatusDraft}},
+						TestTypes:      []TestType{{Name: "共通"}},
+					},
+					{
+						Name:           "後",
+						AdmissionInfos: []AdmissionInfo{{Status: AdmissionStatusDraft}},
+						TestTypes:      []TestType{{Name: "共通"}},
+					},
+				},
+			}},
+		}},
+	}}
+
+	published := PublishedUniversities(universities)
+
+	schedules := published[0].Departments[0].Majors[0].AdmissionSchedules
+	require.Len(t, schedules, 2)
+	assert.Len(t, schedules[0].AdmissionInfos, 1)
+	assert.Len(t, schedules[0].TestTypes, 1)
+	assert.Empty(t, schedules[1].AdmissionInfos)
+	assert.Empty(t, schedules[1].TestTypes)
+
+	// 元の値は変更されない
+	original := universities[0].Departments[0].Majors[0].AdmissionSchedules
+	assert.Len(t, original[0].AdmissionInfos, 2)
+	assert.Len(t, original[1].TestTypes, 1)
+}
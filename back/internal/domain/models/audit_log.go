@@ -0,0 +1,57 @@
+// Package models はアプリケーションのドメインモデルを定義します。
+package models
+
+import "time"
+
+// 監査ログの操作種別
+const (
+	AuditActionCreate = "create" // 作成
+	AuditActionUpdate = "update" // 更新
+	AuditActionDelete = "delete" // 削除
+)
+
+// 監査対象のエンティティ種別
+const (
+	AuditEntityUniversity        = "university"         // 大学
+	AuditEntityDepartment        = "department"         // 学部
+	AuditEntityMajor             = "major"              // 学科
+	AuditEntityAdmissionSchedule = "admission_schedule" // 入試日程
+	AuditEntityAdmissionInfo     = "admission_info"     // 入試情報
+	AuditEntityTestType          = "test_type"          // 試験種別
+	AuditEntitySubject           = "subject"            // 科目
+)
+
+// AuditEntities は監査対象のエンティティ種別とテーブル名の対応です
+var AuditEntities = map[string]string{
+	AuditEntityUniversity:        "universities",
+	AuditEntityDepartment:        "departments",
+	AuditEntityMajor:             "majors",
+	AuditEntityAdmissionSchedule: "admission_schedules",
+	AuditEntityAdmissionInfo:     "admission_infos",
+	AuditEntityTestType:          "test_types",
+	AuditEntitySubject:           "subjects",
+}
+
+// FieldChange は1つのカラムの変更前後の値を表現する構造体です。
+// 作成時は Before、削除時は After が nil になります
+type FieldChange struct {
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// AuditLog はエンティティの変更履歴を表現する構造体です
+// 以下のフィールドを含みます：
+// - EntityType: エンティティ種別（university, subject など）
+// - EntityID: エンティティのID
+// - Action: 操作種別（create, update, delete）
+// - Actor: 操作したユーザー（JWTのsubject、特定できない場合は system）
+// - Changes: カラムごとの変更前後の値
+type AuditLog struct {
+	ID         uint                   `json:"id" gorm:"primarykey"`
+	EntityType string                 `json:"entity_type" gorm:"not null;size:30;index:idx_audit_entity,priority:1"`
+	EntityID   uint                   `json:"entity_id" gorm:"not null;index:idx_audit_entity,priority:2"`
+	Action     string                 `json:"action" gorm:"not null;size:10"`
+	Actor      string                 `json:"actor" gorm:"not null;size:100;index:idx_audit_actor"`
+	Changes    map[string]FieldChange `json:"changes" gorm:"serializer:json;type:text"`
+	CreatedAt  time.Time              `json:"created_at" gorm:"not null;index:idx_audit_entity,priority:3"`
+}
@@ -1,6 +1,28 @@
 // Package models はアプリケーションのドメインモデルを定義します。
 package models
 
+import "fmt"
+
+// フィルターオプションのカテゴリ定義
+const (
+	FilterCategoryRegion            = "REGION"             // 地域
+	FilterCategoryPrefecture        = "PREFECTURE"         // 都道府県
+	FilterCategorySchedule          = "SCHEDULE"           // 日程
+	FilterCategoryAcademicField     = "ACADEMIC_FIELD"     // 学問系統
+	FilterCategoryClassification    = "CLASSIFICATION"     // 設置区分
+	FilterCategorySubClassification = "SUB_CLASSIFICATION" // 小分類
+)
+
+// FilterCategories は有効なフィルターカテゴリの一覧です
+var FilterCategories = []string{
+	FilterCategoryRegion,
+	FilterCategoryPrefecture,
+	FilterCategorySchedule,
+	FilterCategoryAcademicField,
+	FilterCategoryClassification,
+	FilterCategorySubClassification,
+}
+
 // FilterOption はフィルターオプションの基本構造体です
 type FilterOption struct {
 	BaseModel
@@ -16,7 +38,7 @@ type FilterOption struct {
 	_ struct{} `gorm:"check:name <> ''"`
 	_ struct{} `gorm:"check:(category = 'REGION' AND length(name) <= 3)"`
 	_ struct{} `gorm:"check:(category = 'PREFECTURE' AND length(name) <= 3)"`
-	_ struct{} `gorm:"check:(category = 'SCHEDULE' AND length(name) = 1)"`
+	_ struct{} `gorm:"check:(category = 'SCHEDULE' AND length(name) <= 20)"`
 	_ struct{} `gorm:"check:(category = 'ACADEMIC_FIELD' AND length(name) <= 50)"`
 	_ struct{} `gorm:"check:(category = 'CLASSIFICATION' AND length(name) <= 10)"`
 	_ struct{} `gorm:"check:(category = 'SUB_CLASSIFICATION' AND length(name) <= 50)"`
@@ -33,19 +55,24 @@ func (f *FilterOption) validateName() error {
 	runeLen := len([]rune(f.Name))
 
 	switch f.Category {
-	case "REGION", "PREFECTURE":
+	case FilterCategoryRegion, FilterCategoryPrefecture:
 		if runeLen == 0 || runeLen > 3 {
 			return &ValidationError{Field: "Name", Message: "名前は1-3文字である必要があります", Code: "INVALID_NAME"}
 		}
-	case "SCHEDULE":
-		if runeLen != 1 {
-			return &ValidationError{Field: "Name", Message: "名前は1文字である必要があります", Code: "INVALID_NAME"}
+	case FilterCategorySchedule:
+		// 日程の絞り込みは入試日程の日程名と一致させるため、日程レジストリに登録された日程名のみを許可します
+		if _, ok := Schedules().Lookup(f.Name); !ok {
+			return &ValidationError{
+				Field:   "Name",
+				Message: fmt.Sprintf("名前は%sのいずれかである必要があります", Schedules().Describe()),
+				Code:    "INVALID_NAME",
+			}
 		}
-	case "ACADEMIC_FIELD", "SUB_CLASSIFICATION":
+	case FilterCategoryAcademicField, FilterCategorySubClassification:
 		if runeLen == 0 || runeLen > 50 {
 			return &ValidationError{Field: "Name", Message: "名前は1-50文字である必要があります", Code: "INVALID_NAME"}
 		}
-	case "CLASSIFICATION":
+	case FilterCategoryClassification:
 		if runeLen == 0 || runeLen > 10 {
 			return &ValidationError{Field: "Name", Message: "名前は1-10文字である必要があります", Code: "INVALID_NAME"}
 		}
@@ -63,10 +90,10 @@ func (f *FilterOption) validateParentCategory() error {
 	valid := false
 
 	switch f.Category {
-	case "PREFECTURE":
-		valid = f.Parent.Category == "REGION"
-	case "SUB_CLASSIFICATION":
-		valid = f.Parent.Category == "CLASSIFICATION"
+	case FilterCategoryPrefecture:
+		valid = f.Parent.Category == FilterCategoryRegion
+	case FilterCategorySubClassification:
+		valid = f.Parent.Category == FilterCategoryClassification
 	default:
 		valid = true // 他は親カテゴリ制約なし
 	}
@@ -96,15 +123,7 @@ func (f *FilterOption) Validate() error {
 				if !ok || category == "" {
 					return false
 				}
-				validCategories := map[string]bool{
-					"REGION": true,
-					"PREFECTURE": true,
-					"SCHEDULE": true,
-					"ACADEMIC_FIELD": true,
-					"CLASSIFICATION": true,
-					"SUB_CLASSIFICATION": true,
-				}
-				return validCategories[category]
+				return IsValidFilterCategory(category)
 			},
 			Message: "カテゴリは有効な値である必要があります",
 			Code:    "INVALID_CATEGORY",
@@ -130,3 +149,14 @@ func (f *FilterOption) Validate() error {
 
 	return f.validateParentCategory()
 }
+
+// IsValidFilterCategory は指定されたカテゴリが有効なフィルターカテゴリかどうかを判定します
+func IsValidFilterCategory(category string) bool {
+	for _, c := range FilterCategories {
+		if c == category {
+			return true
+		}
+	}
+
+	return false
+}
@@ -60,6 +60,16 @@ func TestFilterOptionValidate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "日程カテゴリの私立の方式",
+			option: FilterOption{
+				BaseModel: BaseModel{Version: 1},
+				Category:    "SCHEDULE",
+				Name:       "共通テスト利用（前期）",
+				DisplayOrder: 4,
+			},
+			wantErr: false,
+		},
 		{
 			name: "日程カテゴリの無効な名前",
 			option: FilterOption{
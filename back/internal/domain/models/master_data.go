@@ -0,0 +1,107 @@
+package models
+
+// MasterData は管理者が編集するマスタデータ（地域・都道府県・設置区分・小分類・学問系統）の共通インターフェースです。
+// マスタデータは所属先（大学・地域・設置区分・学科）に紐付き、FilterOption の階層ルールで検証します
+type MasterData interface {
+	Base() *BaseModel
+	OwnerID() uint
+	SetOwnerID(id uint)
+	SetDisplayOrder(order int)
+	ToFilterOption() FilterOption
+}
+
+// MasterDataModel はマスタデータのモデル（構造体のポインタ）の型制約です
+// マスタデータを型によらず扱うリポジトリ・ユースケース・ハンドラーで使用します
+type MasterDataModel[T any] interface {
+	*T
+	MasterData
+}
+
+// OwnerID は地域の所属先（大学）のIDを返します
+func (r *Region) OwnerID() uint { return r.UniversityID }
+
+// SetOwnerID は地域の所属先（大学）を設定します
+func (r *Region) SetOwnerID(id uint) { r.UniversityID = id }
+
+// SetDisplayOrder は地域の表示順を設定します
+func (r *Region) SetDisplayOrder(order int) { r.DisplayOrder = order }
+
+// ToFilterOption は地域をフィルターオプションとして表現します
+func (r *Region) ToFilterOption() FilterOption {
+	return masterFilterOption(r.BaseModel, FilterCategoryRegion, r.Name, r.DisplayOrder, "")
+}
+
+// OwnerID は都道府県の所属先（地域）のIDを返します
+func (p *Prefecture) OwnerID() uint { return p.RegionID }
+
+// SetOwnerID は都道府県の所属先（地域）を設定します
+func (p *Prefecture) SetOwnerID(id uint) { p.RegionID = id }
+
+// SetDisplayOrder は都道府県の表示順を設定します
+func (p *Prefecture) SetDisplayOrder(order int) { p.DisplayOrder = order }
+
+// ToFilterOption は都道府県を、地域を親とするフィルターオプションとして表現します
+func (p *Prefecture) ToFilterOption() FilterOption {
+	return masterFilterOption(p.BaseModel, FilterCategoryPrefecture, p.Name, p.DisplayOrder, FilterCategoryRegion)
+}
+
+// OwnerID は設置区分の所属先（大学）のIDを返します
+func (c *Classification) OwnerID() uint { return c.UniversityID }
+
+// SetOwnerID は設置区分の所属先（大学）を設定します
+func (c *Classification) SetOwnerID(id uint) { c.UniversityID = id }
+
+// SetDisplayOrder は設置区分の表示順を設定します
+func (c *Classification) SetDisplayOrder(order int) { c.DisplayOrder = order }
+
+// ToFilterOption は設置区分をフィルターオプションとして表現します
+func (c *Classification) ToFilterOption() FilterOption {
+	return masterFilterOption(c.BaseModel, FilterCategoryClassification, c.Name, c.DisplayOrder, "")
+}
+
+// OwnerID は小分類の所属先（設置区分）のIDを返します
+func (s *SubClassification) OwnerID() uint { return s.ClassificationID }
+
+// SetOwnerID は小分類の所属先（設置区分）を設定します
+func (s *SubClassification) SetOwnerID(id uint) { s.ClassificationID = id }
+
+// SetDisplayOrder は小分類の表示順を設定します
+func (s *SubClassification) SetDisplayOrder(order int) { s.DisplayOrder = order }
+
+// ToFilterOption は小分類を、設置区分を親とするフィルターオプションとして表現します
+func (s *SubClassification) ToFilterOption() FilterOption {
+	return masterFilterOption(
+		s.BaseModel, FilterCategorySubClassification, s.Name, s.DisplayOrder, FilterCategoryClassification,
+	)
+}
+
+// OwnerID は学問系統の所属先（学科）のIDを返します
+func (a *AcademicField) OwnerID() uint { return a.MajorID }
+
+// SetOwnerID は学問系統の所属先（学科）を設定します
+func (a *AcademicField) SetOwnerID(id uint) { a.MajorID = id }
+
+// SetDisplayOrder は学問系統の表示順を設定します
+func (a *AcademicField) SetDisplayOrder(order int) { a.DisplayOrder = order }
+
+// ToFilterOption は学問系統をフィルターオプションとして表現します
+func (a *AcademicField) ToFilterOption() FilterOption {
+	return masterFilterOption(a.BaseModel, FilterCategoryAcademicField, a.Name, a.DisplayOrder, "")
+}
+
+// masterFilterOption はマスタデータのフィルターオプションとしての表現を生成します
+// parentCategory を指定した場合は、親子カテゴリの検証のためにカテゴリのみを持つ親を設定します
+func masterFilterOption(base BaseModel, category, name string, displayOrder int, parentCategory string) FilterOption {
+	option := FilterOption{
+		BaseModel:    base,
+		Category:     category,
+		Name:         name,
+		DisplayOrder: displayOrder,
+	}
+
+	if parentCategory != "" {
+		option.Parent = &FilterOption{Category: parentCategory}
+	}
+
+	return option
+}
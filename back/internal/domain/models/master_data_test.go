@@ -0,0 +1,70 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMasterDataOwner(t *testing.T) {
+	items := []MasterData{
+		&Region{},
+		&Prefecture{},
+		&Classification{},
+		&SubClassification{},
+		&AcademicField{},
+	}
+
+	for _, item := range items {
+		item.SetOwnerID(7)
+		item.SetDisplayOrder(2)
+
+		assert.Equal(t, uint(7), item.OwnerID())
+		assert.Equal(t, 2, item.ToFilterOption().DisplayOrder)
+	}
+}
+
+func TestMasterDataToFilterOption(t *testing.T) {
+	tests := []struct {
+		name           string
+		item           MasterData
+		category       string
+		parentCategory string
+	}{
+		{"地域", &Region{Name: "関東"}, FilterCategoryRegion, ""},
+		{"都道府県", &Prefecture{Name: "東京"}, FilterCategoryPrefecture, FilterCategoryRegion},
+		{"設置区分", &Classification{Name: "国公立"}, FilterCategoryClassification, ""},
+		{"小分類", &SubClassification{Name: "国立"}, FilterCategorySubClassification, FilterCategoryClassification},
+		{"学問系統", &AcademicField{Name: "工学"}, FilterCategoryAcademicField, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.item.Base().Version = 1
+
+			option := tt.item.ToFilterOption()
+			assert.Equal(t, tt.category, option.Category)
+
+			if tt.parentCategory == "" {
+				assert.Nil(t, option.Parent)
+			} else {
+				require.NotNil(t, option.Parent)
+				assert.Equal(t, tt.parentCategory, option.Parent.Category)
+			}
+
+			assert.NoError(t, option.Validate())
+		})
+	}
+
+	t.Run("名称の規則に違反する場合は検証エラー", func(t *testing.T) {
+		prefecture := &Prefecture{BaseModel: BaseModel{Version: 1}, Name: "東京都特別区"}
+
+		option := prefecture.ToFilterOption()
+		err := option.Validate()
+
+		var validationErr *ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "INVALID_NAME", validationErr.Code)
+	})
+}
@@ -133,13 +133,13 @@ func (e *ValidationError) Error() string {
 // - CreatedBy: 作成者
 // - UpdatedBy: 更新者
 type BaseModel struct {
-	ID        uint       `json:"id" gorm:"primarykey"`                    // 主キー
-	CreatedAt time.Time  `json:"created_at"`                              // 作成日時
-	UpdatedAt time.Time  `json:"updated_at"`                              // 更新日時
-	DeletedAt *time.Time `json:"deleted_at,omitempty" gorm:"index"` // 削除日時（ソフトデリート用）
-	Version   int        `json:"version" gorm:"not null;default:1"`       // 楽観的ロック用バージョン
-	CreatedBy string     `json:"created_by" gorm:"size:100"`              // 作成者
-	UpdatedBy string     `json:"updated_by" gorm:"size:100"`              // 更新者
+	ID        uint           `json:"id" gorm:"primarykey"`              // 主キー
+	CreatedAt time.Time      `json:"created_at"`                        // 作成日時
+	UpdatedAt time.Time      `json:"updated_at"`                        // 更新日時
+	DeletedAt gorm.DeletedAt `json:"deleted_at,omitzero" gorm:"index"`  // 削除日時（ソフトデリート用）
+	Version   int            `json:"version" gorm:"not null;default:1"` // 楽観的ロック用バージョン
+	CreatedBy string         `json:"created_by" gorm:"size:100"`        // 作成者
+	UpdatedBy string         `json:"updated_by" gorm:"size:100"`        // 更新者
 }
 
 // Validate はBaseModelのバリデーションを行う
@@ -165,6 +165,12 @@ func (b *BaseModel) BeforeUpdate(_ *gorm.DB) error {
 	return nil
 }
 
+// Base は埋め込まれたBaseModelを返します
+// リポジトリで楽観的ロックのバージョンをモデルの型によらず扱うために使用します
+func (b *BaseModel) Base() *BaseModel {
+	return b
+}
+
 // University は大学エンティティを表現する構造体です
 // 以下のフィールドを含みます：
 // - BaseModel: 基本フィールド
@@ -392,10 +398,11 @@ func (m *Major) Validate() error {
 // - Major: 所属学科
 // - AdmissionInfos: 入試情報一覧
 // - TestTypes: 試験種別一覧
+// - Events: 年度ごとの日程（出願期間・試験日・合格発表・入学手続締切）一覧
 type AdmissionSchedule struct {
 	BaseModel
 	MajorID       uint           `json:"major_id" gorm:"not null;index:idx_schedule_major_year"` // 学科ID
-	Name          string         `json:"name" gorm:"not null;size:6;check:name in ('前','中','後')"` // 日程名
+	Name          string         `json:"name" gorm:"not null;size:20"` // 日程名（日程レジストリに登録された日程名）
 	DisplayOrder  int `json:"display_order" gorm:"not null;default:0;index:idx_schedule_display_order"` // 表示順
 	_ struct{} `gorm:"check:display_order >= 0 AND display_order <= 3"`
 	Major         Major         `json:"-" gorm:"foreignKey:MajorID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"` // 所属学科
@@ -403,6 +410,7 @@ type AdmissionSchedule struct {
 	_ struct{} `gorm:"foreignKey:AdmissionScheduleID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 	TestTypes     []TestType    `json:"test_types,omitempty"` // 試験種別一覧
 	_ struct{} `gorm:"foreignKey:AdmissionScheduleID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Events        []ScheduleEvent `json:"events,omitempty"` // 年度ごとの日程一覧
 }
 
 // Validate はAdmissionScheduleのバリデーションを行う
@@ -425,9 +433,10 @@ func (a *AdmissionSchedule) Validate() error {
 			Field: "Name",
 			Condition: func(v interface{}) bool {
 				name, ok := v.(string)
-				return ok && (name == "前" || name == "中" || name == "後")
+				_, registered := Schedules().Lookup(name)
+				return ok && registered
 			},
-			Message: "日程名は'前'、'中'、'後'のいずれかである必要があります",
+			Message: fmt.Sprintf("日程名は%sのいずれかである必要があります", Schedules().Describe()),
 			Code:    "INVALID_SCHEDULE_NAME",
 		},
 		{
@@ -453,6 +462,7 @@ func (a *AdmissionSchedule) Validate() error {
 // - Status: ステータス
 // - AdmissionSchedule: 所属入試日程
 // - TestTypes: 試験種別一覧
+// - Difficulty: 合格難易度（ボーダー得点・共通テスト得点率・偏差値帯）
 type AdmissionInfo struct {
 	BaseModel
 	AdmissionScheduleID uint `json:"admission_schedule_id" gorm:"not null;index:idx_info_schedule_year"` // 入試日程ID
@@ -464,6 +474,7 @@ type AdmissionInfo struct {
 	AdmissionSchedule  AdmissionSchedule `json:"-" gorm:"foreignKey:AdmissionScheduleID"` // 所属入試日程
 	TestTypes []TestType `json:"test_types,omitempty" gorm:"many2many:admission_info_test_types"` // 試験種別一覧
 	_ struct{} `gorm:"index:idx_info_test_types"`
+	Difficulty *AdmissionDifficulty `json:"difficulty,omitempty" gorm:"foreignKey:AdmissionInfoID"` // 合格難易度
 }
 
 // Validate はAdmissionInfoのバリデーションを行う
@@ -521,15 +532,17 @@ func (a *AdmissionInfo) Validate() error {
 // - Name: 試験種別名
 // - AdmissionSchedule: 所属入試日程
 // - Subjects: 科目一覧
+// - SubjectGroups: 選択科目群一覧
 type TestType struct {
 	BaseModel
 	AdmissionScheduleID uint      `json:"admission_schedule_id" gorm:"not null;index:idx_test_type_schedule"`
 	_ struct{} `gorm:"index:idx_test_type_name,comment:'試験種別名のインデックス'"`
-	Name               string    `json:"name" gorm:"not null;type:varchar(10);check:name in ('共通','二次')"` // 試験種別名
+	Name               string    `json:"name" gorm:"not null;type:varchar(10)"` // 試験種別名（試験種別レジストリに登録された表示名）
 	AdmissionSchedule  AdmissionSchedule `json:"-"` // 所属入試日程
 	_ struct{} `gorm:"foreignKey:AdmissionScheduleID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 	Subjects           []Subject `json:"subjects,omitempty"` // 科目一覧
 	_ struct{} `gorm:"foreignKey:TestTypeID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	SubjectGroups      []SubjectGroup `json:"subject_groups,omitempty"` // 選択科目群一覧
 }
 
 // Validate はTestTypeのバリデーションを行う
@@ -552,9 +565,10 @@ func (t *TestType) Validate() error {
 			Field: "Name",
 			Condition: func(v interface{}) bool {
 				name, ok := v.(string)
-				return ok && (name == "共通" || name == "二次")
+				_, registered := TestTypes().Lookup(name)
+				return ok && registered
 			},
-			Message: "試験種別名は'共通'または'二次'である必要があります",
+			Message: fmt.Sprintf("試験種別名は%sのいずれかである必要があります", TestTypes().describe()),
 			Code:    "INVALID_TEST_TYPE_NAME",
 		},
 	}
@@ -566,16 +580,24 @@ func (t *TestType) Validate() error {
 // 以下のフィールドを含みます：
 // - BaseModel: 基本フィールド
 // - TestTypeID: 試験種別ID
+// - SubjectGroupID: 選択科目群ID（選択科目群のエンドポイントで設定）
 // - Name: 科目名
-// - Score: 配点
-// - Percentage: 配点比率
+// - Score: 配点（素点の満点）
+// - ConversionRatio: 換算比率（圧縮・傾斜配点。900点を450点に圧縮する場合は0.5）
+// - EffectiveScore: 換算後の配点（Score × ConversionRatio、保存しない）
+// - Percentage: 配点比率（換算後の配点から算出）
 // - DisplayOrder: 表示順
 // - TestType: 所属試験種別
+// 選択科目群に属する科目の配点比率は、その科目が選択された場合の参考値です。
+// 試験種別の配点比率を合計する場合は、所属する科目ではなく選択科目群の Percentage を1回だけ数えます
 type Subject struct {
 	BaseModel
 	TestTypeID   uint     `json:"test_type_id" gorm:"not null;index:idx_subject_test_type"` // 試験種別ID
+	SubjectGroupID *uint  `json:"subject_group_id,omitempty" gorm:"index:idx_subject_group"` // 選択科目群ID（選択科目群に属さない場合は空）
 	Name         string   `json:"name" gorm:"not null;index:idx_subject_name;size:20;check:name <> ''"` // 科目名
-	Score        int      `json:"score" gorm:"not null;check:score >= 0 AND score <= 1000"` // 配点
+	Score        int      `json:"score" gorm:"not null;check:score >= 0 AND score <= 1000"` // 配点（素点の満点）
+	ConversionRatio float64 `json:"conversion_ratio" gorm:"not null;default:1;check:conversion_ratio > 0 AND conversion_ratio <= 10"` // 換算比率
+	EffectiveScore  float64 `json:"effective_score" gorm:"-"` // 換算後の配点
 	Percentage   float64  `json:"percentage"` // 配点比率
 	_ struct{} `gorm:"not null;check:percentage >= 0 AND percentage <= 100 AND ROUND(percentage, 2) = percentage"`
 	DisplayOrder int      `json:"display_order"`
@@ -618,6 +640,16 @@ func (s *Subject) Validate() error {
 			Message: "配点は0-1000の範囲である必要があります",
 			Code:    "INVALID_SCORE",
 		},
+		{
+			Field: "ConversionRatio",
+			Condition: func(v interface{}) bool {
+				// 0は未指定として扱い、保存時に既定値の1を設定する
+				ratio, ok := v.(float64)
+				return ok && ratio >= 0 && ratio <= MaxConversionRatio
+			},
+			Message: "換算比率は0より大きく10以下である必要があります",
+			Code:    "INVALID_CONVERSION_RATIO",
+		},
 		{
 			Field: "Percentage",
 			Condition: func(v interface{}) bool {
@@ -657,12 +689,14 @@ type TestEnv struct {
 // - BaseModel: 基本フィールド
 // - UniversityID: 大学ID
 // - Name: 地域名
+// - DisplayOrder: 表示順
 // - University: 所属大学
 // - Prefectures: 都道府県一覧
 type Region struct {
 	BaseModel
 	UniversityID uint         `json:"university_id" gorm:"not null;index:idx_region_univ"`
 	Name         string       `json:"name" gorm:"not null;index:idx_region_name;size:20;check:name <> ''"`
+	DisplayOrder int          `json:"display_order" gorm:"not null;default:0"`
 	University   University   `json:"-" gorm:"foreignKey:UniversityID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 	Prefectures  []Prefecture `json:"prefectures" gorm:"foreignKey:RegionID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 }
@@ -672,11 +706,13 @@ type Region struct {
 // - BaseModel: 基本フィールド
 // - RegionID: 地域ID
 // - Name: 都道府県名
+// - DisplayOrder: 表示順
 // - Region: 所属地域
 type Prefecture struct {
 	BaseModel
 	RegionID uint   `json:"region_id" gorm:"not null;index:idx_prefecture_region"`
 	Name     string `json:"name" gorm:"not null;index:idx_prefecture_name;size:20;check:name <> ''"`
+	DisplayOrder int `json:"display_order" gorm:"not null;default:0"`
 	Region   Region `json:"-" gorm:"foreignKey:RegionID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 }
 
@@ -685,6 +721,7 @@ type Prefecture struct {
 // - BaseModel: 基本フィールド
 // - UniversityID: 大学ID
 // - Name: 設置区分名（国公立/私立）
+// - DisplayOrder: 表示順
 // - University: 所属大学
 // - SubClassifications: 小分類一覧
 type Classification struct {
@@ -692,6 +729,7 @@ type Classification struct {
 	UniversityID       uint              `json:"university_id" gorm:"not null;index:idx_classification_univ"`
 	Name              string            `json:"name"`
 	_ struct{} `gorm:"not null;index:idx_classification_name;size:20;check:name in ('国公立','私立')"`
+	DisplayOrder       int               `json:"display_order" gorm:"not null;default:0"`
 	University        University        `json:"-"`
 	_ struct{} `gorm:"foreignKey:UniversityID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 	SubClassifications []SubClassification `json:"sub_classifications"`
@@ -703,11 +741,13 @@ type Classification struct {
 // - BaseModel: 基本フィールド
 // - ClassificationID: 設置区分ID
 // - Name: 小分類名
+// - DisplayOrder: 表示順
 // - Classification: 所属設置区分
 type SubClassification struct {
 	BaseModel
 	ClassificationID uint          `json:"classification_id" gorm:"not null;index:idx_sub_classification_class"`
 	Name            string        `json:"name" gorm:"not null;index:idx_sub_classification_name;size:50;check:name <> ''"`
+	DisplayOrder    int           `json:"display_order" gorm:"not null;default:0"`
 	Classification  Classification `json:"-"`
 	_ struct{} `gorm:"foreignKey:ClassificationID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 }
@@ -717,10 +757,12 @@ type SubClassification struct {
 // - BaseModel: 基本フィールド
 // - MajorID: 学科ID
 // - Name: 学問系統名
+// - DisplayOrder: 表示順
 // - Major: 所属学科
 type AcademicField struct {
 	BaseModel
 	MajorID uint   `json:"major_id" gorm:"not null;index:idx_academic_field_major"`
 	Name    string `json:"name" gorm:"not null;index:idx_academic_field_name;size:50;check:name <> ''"`
+	DisplayOrder int `json:"display_order" gorm:"not null;default:0"`
 	Major   Major  `json:"-" gorm:"foreignKey:MajorID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 }
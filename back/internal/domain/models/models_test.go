@@ -417,7 +417,7 @@ func TestMajorValidation(t *testing.T) {
 
 // TestAdmissionScheduleValidation は入試日程モデルのバリデーションテストを実行します。
 // 以下のケースをテストします：
-// 1. 正常な入試日程（前期）
+// 1. 正常な入試日程（前期・私立の方式）
 // 2. 無効な日程名
 // 3. 無効な表示順
 // 4. 無効な学科ID
@@ -436,6 +436,11 @@ func TestAdmissionScheduleValidation(t *testing.T) {
 			admissionSchedule: h.createTestAdmissionSchedule("前", 1, 1),
 			wantErr:          false,
 		},
+		{
+			name:             "正常な入試日程（私立の方式）",
+			admissionSchedule: h.createTestAdmissionSchedule("全学部日程", 1, 6),
+			wantErr:          false,
+		},
 		{
 			name:             "無効な日程名",
 			admissionSchedule: h.createTestAdmissionSchedule("無効", 1, 1),
@@ -524,8 +529,9 @@ func TestAdmissionInfoValidation(t *testing.T) {
 // TestTestTypeValidation は試験種別モデルのバリデーションテストを実行します。
 // 以下のケースをテストします：
 // 1. 正常な試験種別（共通）
-// 2. 無効な試験種別名
-// 3. 無効な入試日程ID
+// 2. 試験種別レジストリに登録された試験種別
+// 3. 無効な試験種別名
+// 4. 無効な入試日程ID
 func TestTestTypeValidation(t *testing.T) {
 	t.Parallel()
 
@@ -541,6 +547,11 @@ func TestTestTypeValidation(t *testing.T) {
 			testType: h.createTestTestType("共通", 1),
 			wantErr:  false,
 		},
+		{
+			name:     "試験種別レジストリに登録された試験種別（総合型選抜）",
+			testType: h.createTestTestType("総合型選抜", 1),
+			wantErr:  false,
+		},
 		{
 			name:     "無効な試験種別名",
 			testType: h.createTestTestType("無効", 1),
@@ -604,6 +615,15 @@ func TestSubjectValidation(t *testing.T) {
 			subject: h.createTestSubject(0, 100, 50.0),
 			wantErr: true,
 		},
+		{
+			name: "無効な換算比率",
+			subject: func() Subject {
+				s := h.createTestSubject(1, 100, 50.0)
+				s.ConversionRatio = MaxConversionRatio + 1
+				return s
+			}(),
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -823,7 +843,7 @@ func TestFilterOptionValidation(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "日程カテゴリの名前が1文字以外",
+			name: "日程カテゴリの名前が日程レジストリに未登録",
 			filterOption: &FilterOption{
 				BaseModel: BaseModel{Version: 1},
 				Category:  "SCHEDULE",
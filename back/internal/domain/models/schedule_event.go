@@ -0,0 +1,202 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// 入試日程のイベント種別
+const (
+	ScheduleEventApplication  = "application"  // 出願期間
+	ScheduleEventExam         = "exam"         // 試験日
+	ScheduleEventAnnouncement = "announcement" // 合格発表
+	ScheduleEventEnrollment   = "enrollment"   // 入学手続締切
+)
+
+// DateLayout は日付（YYYY-MM-DD）の書式です
+const DateLayout = "2006-01-02"
+
+// scheduleEventLabels はイベント種別ごとの表示名です（表示順）
+var scheduleEventLabels = []struct {
+	Type  string
+	Label string
+}{
+	{Type: ScheduleEventApplication, Label: "出願期間"},
+	{Type: ScheduleEventExam, Label: "試験日"},
+	{Type: ScheduleEventAnnouncement, Label: "合格発表"},
+	{Type: ScheduleEventEnrollment, Label: "入学手続締切"},
+}
+
+// ScheduleEventTypes は表示順のイベント種別を返します
+func ScheduleEventTypes() []string {
+	types := make([]string, len(scheduleEventLabels))
+	for i, l := range scheduleEventLabels {
+		types[i] = l.Type
+	}
+
+	return types
+}
+
+// ScheduleEventLabel はイベント種別の表示名を返します
+// 登録されていない種別の場合は空文字を返します
+func ScheduleEventLabel(eventType string) string {
+	for _, l := range scheduleEventLabels {
+		if l.Type == eventType {
+			return l.Label
+		}
+	}
+
+	return ""
+}
+
+// Date は時刻を持たない日付を表現する型です
+// JSONでは "YYYY-MM-DD" 形式の文字列として扱い、データベースでは date 型の列に保存します
+type Date struct {
+	time.Time
+}
+
+// NewDate は年月日から日付を生成します
+func NewDate(year int, month time.Month, day int) Date {
+	return Date{Time: time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
+}
+
+// ParseDate は "YYYY-MM-DD" 形式の文字列から日付を生成します
+func ParseDate(value string) (Date, error) {
+	t, err := time.Parse(DateLayout, value)
+	if err != nil {
+		return Date{}, fmt.Errorf("日付は%s形式で指定してください: %s", DateLayout, value)
+	}
+
+	return Date{Time: t}, nil
+}
+
+// String は日付を "YYYY-MM-DD" 形式で返します
+func (d Date) String() string {
+	return d.Format(DateLayout)
+}
+
+// AddDays は指定した日数後の日付を返します
+func (d Date) AddDays(days int) Date {
+	return Date{Time: d.AddDate(0, 0, days)}
+}
+
+// MarshalJSON は日付を "YYYY-MM-DD" 形式の文字列として出力します
+func (d Date) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON は "YYYY-MM-DD" 形式の文字列から日付を読み込みます
+func (d *Date) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("日付は文字列で指定してください: %w", err)
+	}
+
+	parsed, err := ParseDate(value)
+	if err != nil {
+		return err
+	}
+
+	*d = parsed
+
+	return nil
+}
+
+// Scan はデータベースの値から日付を読み込みます
+func (d *Date) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case time.Time:
+		*d = NewDate(v.Year(), v.Month(), v.Day())
+		return nil
+	case string:
+		return d.scanString(v)
+	case []byte:
+		return d.scanString(string(v))
+	default:
+		return fmt.Errorf("日付に変換できない値です: %v", value)
+	}
+}
+
+// scanString は日付または日時の文字列から日付を読み込みます
+func (d *Date) scanString(value string) error {
+	if len(value) < len(DateLayout) {
+		return fmt.Errorf("日付に変換できない値です: %s", value)
+	}
+
+	parsed, err := ParseDate(value[:len(DateLayout)])
+	if err != nil {
+		return err
+	}
+
+	*d = parsed
+
+	return nil
+}
+
+// Value は日付を "YYYY-MM-DD" 形式の文字列としてデータベースに保存します
+func (d Date) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// GormDataType は日付の列の型を返します
+func (Date) GormDataType() string {
+	return "date"
+}
+
+// ScheduleEvent は入試日程の年度ごとの日程（出願期間・試験日・合格発表・入学手続締切）を表現する構造体です
+// 以下のフィールドを含みます：
+// - BaseModel: 基本フィールド
+// - AdmissionScheduleID: 入試日程ID
+// - AcademicYear: 学年度
+// - Type: イベント種別
+// - StartDate: 開始日
+// - EndDate: 終了日（終了日を含む。1日のみの場合は省略）
+// - Note: 備考
+// 入試日程・学年度・イベント種別の組み合わせごとに1件のみ登録できます
+type ScheduleEvent struct {
+	BaseModel
+	AdmissionScheduleID uint              `json:"admission_schedule_id" gorm:"not null;uniqueIndex:idx_schedule_event_year_type,where:deleted_at IS NULL"`
+	AcademicYear        int               `json:"academic_year" gorm:"not null;uniqueIndex:idx_schedule_event_year_type,where:deleted_at IS NULL"`
+	_                   struct{}          `gorm:"check:academic_year >= 2000 AND academic_year <= 2100"`
+	Type                string            `json:"type" gorm:"not null;type:varchar(20);uniqueIndex:idx_schedule_event_year_type,where:deleted_at IS NULL"`
+	StartDate           Date              `json:"start_date" gorm:"not null;index:idx_schedule_event_start_date"`
+	EndDate             *Date             `json:"end_date,omitempty"`
+	Note                string            `json:"note" gorm:"size:200"`
+	AdmissionSchedule   AdmissionSchedule `json:"-" gorm:"foreignKey:AdmissionScheduleID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// LastDate は日程の最終日（終了日を省略した場合は開始日）を返します
+func (e *ScheduleEvent) LastDate() Date {
+	if e.EndDate != nil {
+		return *e.EndDate
+	}
+
+	return e.StartDate
+}
+
+// Validate はScheduleEventのバリデーションを行う
+// 最初に見つかった不正な項目のエラーを返します
+func (e *ScheduleEvent) Validate() error {
+	switch {
+	case e.AdmissionScheduleID == 0:
+		return &ValidationError{Field: "AdmissionScheduleID", Message: "入試日程IDは必須です", Code: "REQUIRED_ADMISSION_SCHEDULE_ID"}
+	case e.AcademicYear < 2000 || e.AcademicYear > 2100:
+		return &ValidationError{Field: "AcademicYear", Message: "学年度は2000-2100の範囲である必要があります", Code: "INVALID_ACADEMIC_YEAR"}
+	case ScheduleEventLabel(e.Type) == "":
+		return &ValidationError{
+			Field:   "Type",
+			Message: "イベント種別は'application'、'exam'、'announcement'、'enrollment'のいずれかである必要があります",
+			Code:    "INVALID_EVENT_TYPE",
+		}
+	case e.StartDate.IsZero():
+		return &ValidationError{Field: "StartDate", Message: "開始日は必須です", Code: "REQUIRED_START_DATE"}
+	case e.EndDate != nil && e.EndDate.Before(e.StartDate.Time):
+		return &ValidationError{Field: "EndDate", Message: "終了日は開始日以降である必要があります", Code: "INVALID_END_DATE"}
+	case len([]rune(e.Note)) > 200:
+		return &ValidationError{Field: "Note", Message: "備考は200文字以下である必要があります", Code: "INVALID_NOTE"}
+	}
+
+	return nil
+}
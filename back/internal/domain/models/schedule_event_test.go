@@ -0,0 +1,110 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDateJSON(t *testing.T) {
+	var event ScheduleEvent
+	require.NoError(t, json.Unmarshal([]byte(`{"start_date":"2026-01-26","end_date":"2026-02-04"}`), &event))
+	assert.Equal(t, NewDate(2026, time.January, 26), event.StartDate)
+	require.NotNil(t, event.EndDate)
+	assert.Equal(t, "2026-02-04", event.EndDate.String())
+
+	data, err := json.Marshal(event)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"start_date":"2026-01-26"`)
+	assert.Contains(t, string(data), `"end_date":"2026-02-04"`)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"start_date":"2026/01/26"}`), &event))
+	assert.Error(t, json.Unmarshal([]byte(`{"start_date":20260126}`), &event))
+}
+
+func TestDateScan(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+	}{
+		{name: "日時", value: time.Date(2026, 2, 25, 0, 0, 0, 0, time.UTC)},
+		{name: "日付の文字列", value: "2026-02-25"},
+		{name: "日時の文字列", value: []byte("2026-02-25 00:00:00+00:00")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var d Date
+			require.NoError(t, d.Scan(tt.value))
+			assert.Equal(t, "2026-02-25", d.String())
+		})
+	}
+
+	var d Date
+	assert.Error(t, d.Scan(int64(20260225)))
+	assert.Error(t, d.Scan("2026"))
+
+	value, err := NewDate(2026, time.March, 1).Value()
+	require.NoError(t, err)
+	assert.Equal(t, "2026-03-01", value)
+}
+
+func TestScheduleEventValidate(t *testing.T) {
+	valid := func() *ScheduleEvent {
+		return &ScheduleEvent{
+			AdmissionScheduleID: 1,
+			AcademicYear:        2026,
+			Type:                ScheduleEventExam,
+			StartDate:           NewDate(2026, time.February, 25),
+		}
+	}
+
+	assert.NoError(t, valid().Validate())
+
+	sameDay := valid()
+	end := sameDay.StartDate
+	sameDay.EndDate = &end
+	assert.NoError(t, sameDay.Validate(), "開始日と同じ終了日は許可する")
+
+	tests := []struct {
+		name   string
+		modify func(e *ScheduleEvent)
+		code   string
+	}{
+		{name: "入試日程IDなし", modify: func(e *ScheduleEvent) { e.AdmissionScheduleID = 0 }, code: "REQUIRED_ADMISSION_SCHEDULE_ID"},
+		{name: "学年度が範囲外", modify: func(e *ScheduleEvent) { e.AcademicYear = 1999 }, code: "INVALID_ACADEMIC_YEAR"},
+		{name: "未登録のイベント種別", modify: func(e *ScheduleEvent) { e.Type = "orientation" }, code: "INVALID_EVENT_TYPE"},
+		{name: "開始日なし", modify: func(e *ScheduleEvent) { e.StartDate = Date{} }, code: "REQUIRED_START_DATE"},
+		{
+			name: "終了日が開始日より前",
+			modify: func(e *ScheduleEvent) {
+				end := e.StartDate.AddDays(-1)
+				e.EndDate = &end
+			},
+			code: "INVALID_END_DATE",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := valid()
+			tt.modify(event)
+
+			var validationErr *ValidationError
+			require.ErrorAs(t, event.Validate(), &validationErr)
+			assert.Equal(t, tt.code, validationErr.Code)
+		})
+	}
+}
+
+func TestScheduleEventLabel(t *testing.T) {
+	assert.Equal(t, []string{"application", "exam", "announcement", "enrollment"}, ScheduleEventTypes())
+	assert.Equal(t, "合格発表", ScheduleEventLabel(ScheduleEventAnnouncement))
+	assert.Empty(t, ScheduleEventLabel("orientation"))
+
+	event := ScheduleEvent{StartDate: NewDate(2026, time.March, 10)}
+	assert.Equal(t, "2026-03-10", event.LastDate().String(), "終了日を省略した場合は開始日")
+}
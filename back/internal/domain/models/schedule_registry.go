@@ -0,0 +1,212 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"unicode/utf8"
+)
+
+// 日程コード
+const (
+	ScheduleCodeFirst           = "first"
+	ScheduleCodeMiddle          = "middle"
+	ScheduleCodeLate            = "late"
+	ScheduleCodeMethodA         = "method_a"
+	ScheduleCodeMethodB         = "method_b"
+	ScheduleCodeAllFaculties    = "all_faculties"
+	ScheduleCodeCommonTestFirst = "common_test_first"
+	ScheduleCodeCommonTestLate  = "common_test_late"
+)
+
+// 日程の区分
+const (
+	ScheduleKindNational = "national" // 国公立（分離分割方式）
+	ScheduleKindPrivate  = "private"  // 私立
+)
+
+const (
+	// MaxScheduleNameLength は日程名の最大文字数です（admission_schedules.name の列の長さ）
+	MaxScheduleNameLength = 20
+	// MaxScheduleDisplayOrder は入試日程・日程の定義の表示順の最大値です
+	MaxScheduleDisplayOrder = 99
+)
+
+// ScheduleDefinition は日程レジストリに登録する日程の定義です
+// 以下のフィールドを含みます：
+// - Code: 日程コード
+// - Name: 日程名（入試日程の name として保存する値）
+// - Label: 表示用の名称（省略時は日程名）
+// - Kind: 日程の区分（national, private）
+// - DisplayOrder: 表示順（一括取り込みで作成する入試日程の表示順）
+type ScheduleDefinition struct {
+	Code         string `json:"code"`
+	Name         string `json:"name"`
+	Label        string `json:"label"`
+	Kind         string `json:"kind"`
+	DisplayOrder int    `json:"display_order"`
+}
+
+// DefaultScheduleDefinitions は既定の日程の定義を返します
+// 国公立の前期・中期・後期に加えて、私立大学の主な方式・日程を含みます
+func DefaultScheduleDefinitions() []ScheduleDefinition {
+	return []ScheduleDefinition{
+		{Code: ScheduleCodeFirst, Name: "前", Label: "前期", Kind: ScheduleKindNational, DisplayOrder: 1},
+		{Code: ScheduleCodeMiddle, Name: "中", Label: "中期", Kind: ScheduleKindNational, DisplayOrder: 2},
+		{Code: ScheduleCodeLate, Name: "後", Label: "後期", Kind: ScheduleKindNational, DisplayOrder: 3},
+		{Code: ScheduleCodeMethodA, Name: "A方式", Kind: ScheduleKindPrivate, DisplayOrder: 4},
+		{Code: ScheduleCodeMethodB, Name: "B方式", Kind: ScheduleKindPrivate, DisplayOrder: 5},
+		{Code: ScheduleCodeAllFaculties, Name: "全学部日程", Kind: ScheduleKindPrivate, DisplayOrder: 6},
+		{Code: ScheduleCodeCommonTestFirst, Name: "共通テスト利用（前期）", Kind: ScheduleKindPrivate, DisplayOrder: 7},
+		{Code: ScheduleCodeCommonTestLate, Name: "共通テスト利用（後期）", Kind: ScheduleKindPrivate, DisplayOrder: 8},
+	}
+}
+
+// ScheduleRegistry は登録可能な日程の一覧です
+// 日程名の検証・表示用の名称・一括取り込みの表示順・日程のフィルターオプションの検証はこのレジストリに従います
+type ScheduleRegistry struct {
+	definitions []ScheduleDefinition
+	byName      map[string]ScheduleDefinition
+}
+
+// NewScheduleRegistry は日程の定義を検証し、表示順に並べたレジストリを生成します。
+// この関数は以下の検証を行います：
+// - 1件以上の定義があること
+// - コードと日程名が空でなく、重複しないこと
+// - 日程名が列の長さ以下であること
+// - 区分が national または private であること
+// - 表示順が0から MaxScheduleDisplayOrder の範囲であること
+func NewScheduleRegistry(definitions []ScheduleDefinition) (*ScheduleRegistry, error) {
+	if len(definitions) == 0 {
+		return nil, fmt.Errorf("日程の定義が1件もありません")
+	}
+
+	sorted := make([]ScheduleDefinition, len(definitions))
+	copy(sorted, definitions)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].DisplayOrder < sorted[j].DisplayOrder
+	})
+
+	registry := &ScheduleRegistry{
+		definitions: sorted,
+		byName:      make(map[string]ScheduleDefinition, len(sorted)),
+	}
+	codes := make(map[string]bool, len(sorted))
+
+	for i, d := range sorted {
+		if strings.TrimSpace(d.Code) == "" || strings.TrimSpace(d.Name) == "" {
+			return nil, fmt.Errorf("日程のコードと日程名は必須です")
+		}
+
+		if utf8.RuneCountInString(d.Name) > MaxScheduleNameLength {
+			return nil, fmt.Errorf("日程名「%s」は%d文字以下である必要があります", d.Name, MaxScheduleNameLength)
+		}
+
+		if d.Kind != ScheduleKindNational && d.Kind != ScheduleKindPrivate {
+			return nil, fmt.Errorf("日程「%s」の区分は%sまたは%sである必要があります",
+				d.Name, ScheduleKindNational, ScheduleKindPrivate)
+		}
+
+		if d.DisplayOrder < 0 || d.DisplayOrder > MaxScheduleDisplayOrder {
+			return nil, fmt.Errorf("日程「%s」の表示順は0から%dの範囲である必要があります", d.Name, MaxScheduleDisplayOrder)
+		}
+
+		if codes[d.Code] {
+			return nil, fmt.Errorf("日程コード「%s」が重複しています", d.Code)
+		}
+
+		if _, ok := registry.byName[d.Name]; ok {
+			return nil, fmt.Errorf("日程名「%s」が重複しています", d.Name)
+		}
+
+		if d.Label == "" {
+			sorted[i].Label = d.Name
+		}
+
+		codes[d.Code] = true
+		registry.byName[d.Name] = sorted[i]
+	}
+
+	return registry, nil
+}
+
+// ParseScheduleRegistry はJSONの日程の定義の配列からレジストリを生成します
+func ParseScheduleRegistry(data []byte) (*ScheduleRegistry, error) {
+	var definitions []ScheduleDefinition
+	if err := json.Unmarshal(data, &definitions); err != nil {
+		return nil, fmt.Errorf("日程の定義の読み込みに失敗しました: %w", err)
+	}
+
+	return NewScheduleRegistry(definitions)
+}
+
+// Definitions は表示順の日程の定義のコピーを返します
+func (r *ScheduleRegistry) Definitions() []ScheduleDefinition {
+	definitions := make([]ScheduleDefinition, len(r.definitions))
+	copy(definitions, r.definitions)
+
+	return definitions
+}
+
+// Lookup は日程名から日程の定義を取得します
+func (r *ScheduleRegistry) Lookup(name string) (ScheduleDefinition, bool) {
+	d, ok := r.byName[name]
+	return d, ok
+}
+
+// Names は表示順の日程名を返します
+func (r *ScheduleRegistry) Names() []string {
+	names := make([]string, len(r.definitions))
+	for i, d := range r.definitions {
+		names[i] = d.Name
+	}
+
+	return names
+}
+
+// Label は日程名の表示用の名称を返します
+// 登録されていない日程名はそのまま返します
+func (r *ScheduleRegistry) Label(name string) string {
+	if d, ok := r.byName[name]; ok {
+		return d.Label
+	}
+
+	return name
+}
+
+// Describe は検証エラーのメッセージに使用する日程名の一覧を返します
+func (r *ScheduleRegistry) Describe() string {
+	quoted := make([]string, len(r.definitions))
+	for i, d := range r.definitions {
+		quoted[i] = "'" + d.Name + "'"
+	}
+
+	return strings.Join(quoted, "、")
+}
+
+// currentSchedules は現在の日程レジストリです
+var currentSchedules atomic.Pointer[ScheduleRegistry]
+
+func init() {
+	registry, err := NewScheduleRegistry(DefaultScheduleDefinitions())
+	if err != nil {
+		panic(err)
+	}
+
+	currentSchedules.Store(registry)
+}
+
+// Schedules は現在の日程レジストリを返します
+func Schedules() *ScheduleRegistry {
+	return currentSchedules.Load()
+}
+
+// SetSchedules は日程レジストリを置き換えます
+// 起動時に設定ファイルの定義を反映するために使用します
+func SetSchedules(registry *ScheduleRegistry) {
+	if registry != nil {
+		currentSchedules.Store(registry)
+	}
+}
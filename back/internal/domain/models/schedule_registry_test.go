@@ -0,0 +1,82 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultScheduleRegistry(t *testing.T) {
+	registry := Schedules()
+
+	assert.Equal(t, []string{
+		"前", "中", "後", "A方式", "B方式", "全学部日程", "共通テスト利用（前期）", "共通テスト利用（後期）",
+	}, registry.Names())
+
+	d, ok := registry.Lookup("全学部日程")
+	require.True(t, ok)
+	assert.Equal(t, ScheduleCodeAllFaculties, d.Code)
+	assert.Equal(t, ScheduleKindPrivate, d.Kind)
+
+	_, ok = registry.Lookup("前期")
+	assert.False(t, ok)
+
+	assert.Equal(t, "前期", registry.Label("前"))
+	assert.Equal(t, "A方式", registry.Label("A方式"), "表示用の名称を省略した場合は日程名")
+	assert.Equal(t, "未登録", registry.Label("未登録"))
+}
+
+func TestNewScheduleRegistry(t *testing.T) {
+	t.Run("表示順に並べる", func(t *testing.T) {
+		registry, err := NewScheduleRegistry([]ScheduleDefinition{
+			{Code: "b", Name: "後", Kind: ScheduleKindNational, DisplayOrder: 2},
+			{Code: "a", Name: "前", Kind: ScheduleKindNational, DisplayOrder: 1},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"前", "後"}, registry.Names())
+		assert.Equal(t, "'前'、'後'", registry.Describe())
+	})
+
+	tests := []struct {
+		name        string
+		definitions []ScheduleDefinition
+	}{
+		{name: "定義がない", definitions: nil},
+		{name: "コードが空", definitions: []ScheduleDefinition{{Name: "前", Kind: ScheduleKindNational}}},
+		{name: "日程名が長すぎる", definitions: []ScheduleDefinition{
+			{Code: "a", Name: "大学入学共通テスト利用入試（前期日程・3教科型）", Kind: ScheduleKindPrivate},
+		}},
+		{name: "未定義の区分", definitions: []ScheduleDefinition{{Code: "a", Name: "前", Kind: "public"}}},
+		{name: "表示順が範囲外", definitions: []ScheduleDefinition{
+			{Code: "a", Name: "前", Kind: ScheduleKindNational, DisplayOrder: MaxScheduleDisplayOrder + 1},
+		}},
+		{name: "コードの重複", definitions: []ScheduleDefinition{
+			{Code: "a", Name: "前", Kind: ScheduleKindNational},
+			{Code: "a", Name: "後", Kind: ScheduleKindNational},
+		}},
+		{name: "日程名の重複", definitions: []ScheduleDefinition{
+			{Code: "a", Name: "前", Kind: ScheduleKindNational},
+			{Code: "b", Name: "前", Kind: ScheduleKindNational},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewScheduleRegistry(tt.definitions)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestParseScheduleRegistry(t *testing.T) {
+	registry, err := ParseScheduleRegistry([]byte(
+		`[{"code":"first","name":"前","label":"前期","kind":"national","display_order":1},` +
+			`{"code":"general","name":"一般方式","kind":"private","display_order":2}]`,
+	))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"前", "一般方式"}, registry.Names())
+
+	_, err = ParseScheduleRegistry([]byte(`{"code":"first"}`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,199 @@
+package models
+
+import (
+	"sort"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// MinSubjectGroupMembers は選択科目群に所属させる科目の最小数です
+	MinSubjectGroupMembers = 2
+	// MaxSubjectGroupNameLength は選択科目群名の最大文字数です
+	MaxSubjectGroupNameLength = 20
+)
+
+// SubjectGroup は試験種別の中で所属する科目から指定数を選択する選択科目群（例：物理・化学・生物から2科目）を表現する構造体です
+// 以下のフィールドを含みます：
+// - BaseModel: 基本フィールド
+// - TestTypeID: 試験種別ID
+// - Name: 選択科目群名
+// - ChooseCount: 選択する科目数
+// - DisplayOrder: 表示順
+// - SubjectIDs: 所属する科目のID（科目の subject_group_id から設定）
+// - EffectiveScore: 選択科目群の換算後の配点（選択数の科目を換算後の配点の高い順に選んだ合計）
+// - Percentage: 選択科目群の配点比率
+// 配点比率の分母では、選択科目群を所属する科目の合計ではなく選択科目群の換算後の配点で1回だけ数えます
+type SubjectGroup struct {
+	BaseModel
+	TestTypeID     uint     `json:"test_type_id" gorm:"not null;index:idx_subject_group_test_type"`
+	Name           string   `json:"name" gorm:"not null;size:20;check:name <> ''"`
+	ChooseCount    int      `json:"choose_count" gorm:"not null;check:choose_count >= 1"`
+	DisplayOrder   int      `json:"display_order" gorm:"not null;default:0"`
+	SubjectIDs     []uint   `json:"subject_ids" gorm:"-"`
+	EffectiveScore float64  `json:"effective_score" gorm:"-"`
+	Percentage     float64  `json:"percentage" gorm:"-"`
+	TestType       TestType `json:"-" gorm:"foreignKey:TestTypeID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// Validate は選択科目群のバリデーションを行います
+// 所属する科目が同じ試験種別に属することはリポジトリで検証します
+func (g *SubjectGroup) Validate() error {
+	switch {
+	case g.TestTypeID == 0:
+		return &ValidationError{Field: "TestTypeID", Message: "試験種別IDは必須です", Code: "REQUIRED_TEST_TYPE_ID"}
+	case len([]rune(g.Name)) == 0 || len([]rune(g.Name)) > MaxSubjectGroupNameLength:
+		return &ValidationError{Field: "Name", Message: "選択科目群名は1-20文字である必要があります", Code: "INVALID_SUBJECT_GROUP_NAME"}
+	case len(g.SubjectIDs) < MinSubjectGroupMembers:
+		return &ValidationError{
+			Field:   "SubjectIDs",
+			Message: "選択科目群には2科目以上を指定する必要があります",
+			Code:    "INVALID_SUBJECT_GROUP_MEMBERS",
+		}
+	case hasDuplicateID(g.SubjectIDs):
+		return &ValidationError{Field: "SubjectIDs", Message: "同じ科目が重複して指定されています", Code: "DUPLICATE_SUBJECT_GROUP_MEMBER"}
+	case g.ChooseCount < 1 || g.ChooseCount >= len(g.SubjectIDs):
+		return &ValidationError{
+			Field:   "ChooseCount",
+			Message: "選択数は1以上、所属する科目数未満である必要があります",
+			Code:    "INVALID_CHOOSE_COUNT",
+		}
+	case g.DisplayOrder < 0 || g.DisplayOrder > 999:
+		return &ValidationError{Field: "DisplayOrder", Message: "表示順は0-999の範囲である必要があります", Code: "INVALID_DISPLAY_ORDER"}
+	}
+
+	return nil
+}
+
+// hasDuplicateID は重複するIDが含まれるかを返します
+func hasDuplicateID(ids []uint) bool {
+	seen := make(map[uint]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			return true
+		}
+
+		seen[id] = true
+	}
+
+	return false
+}
+
+// selectTop は科目を換算後の配点の高い順に並べ、先頭から指定数の科目を返します
+func selectTop(members []Subject, chooseCount int) []Subject {
+	sorted := make([]Subject, len(members))
+	copy(sorted, members)
+
+	for i := range sorted {
+		sorted[i].ApplyConversion()
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].EffectiveScore > sorted[j].EffectiveScore
+	})
+
+	if chooseCount < len(sorted) {
+		sorted = sorted[:chooseCount]
+	}
+
+	return sorted
+}
+
+// GroupEffectiveScore は選択科目群の換算後の配点を返します
+// 選択数の科目を換算後の配点の高い順に選んだ合計とし、所属する科目が選択数に満たない場合は所属する科目の合計とします
+func GroupEffectiveScore(members []Subject, chooseCount int) float64 {
+	var total float64
+	for _, s := range selectTop(members, chooseCount) {
+		total += s.EffectiveScore
+	}
+
+	return roundHundredths(total)
+}
+
+// SubjectGroupIDs は科目が属する選択科目群のIDを重複なく返します
+func SubjectGroupIDs(subjects []Subject) []uint {
+	seen := make(map[uint]bool)
+
+	var ids []uint
+
+	for _, s := range subjects {
+		if s.SubjectGroupID == nil || seen[*s.SubjectGroupID] {
+			continue
+		}
+
+		seen[*s.SubjectGroupID] = true
+		ids = append(ids, *s.SubjectGroupID)
+	}
+
+	return ids
+}
+
+// SelectionTotal は選択科目群を考慮した換算後の配点の合計を返します
+// 選択科目群に属さない科目はそのまま合計し、選択科目群は選択科目群の換算後の配点で1回だけ合計します
+// 選択科目群が見つからない科目は選択科目群に属さない科目として扱います
+func SelectionTotal(subjects []Subject, groups []SubjectGroup) float64 {
+	chooseCounts := make(map[uint]int, len(groups))
+	for _, g := range groups {
+		chooseCounts[g.ID] = g.ChooseCount
+	}
+
+	var total float64
+
+	members := make(map[uint][]Subject, len(groups))
+
+	for i := range subjects {
+		subjects[i].ApplyConversion()
+
+		groupID := subjects[i].SubjectGroupID
+		if groupID == nil {
+			total += subjects[i].EffectiveScore
+			continue
+		}
+
+		if _, ok := chooseCounts[*groupID]; !ok {
+			total += subjects[i].EffectiveScore
+			continue
+		}
+
+		members[*groupID] = append(members[*groupID], subjects[i])
+	}
+
+	for groupID, m := range members {
+		total += GroupEffectiveScore(m, chooseCounts[groupID])
+	}
+
+	return roundHundredths(total)
+}
+
+// ApplySelection は試験種別の科目から選択科目群の所属する科目・換算後の配点・配点比率を設定します
+// 選択科目群の配点比率は、選択数の科目を換算後の配点の高い順に選んだ科目の配点比率の合計です
+func ApplySelection(groups []SubjectGroup, subjects []Subject) {
+	for i := range groups {
+		var members []Subject
+
+		for _, s := range subjects {
+			if s.SubjectGroupID != nil && *s.SubjectGroupID == groups[i].ID {
+				members = append(members, s)
+			}
+		}
+
+		groups[i].SubjectIDs = make([]uint, 0, len(members))
+		for _, s := range members {
+			groups[i].SubjectIDs = append(groups[i].SubjectIDs, s.ID)
+		}
+
+		var percentage float64
+		for _, s := range selectTop(members, groups[i].ChooseCount) {
+			percentage += s.Percentage
+		}
+
+		groups[i].EffectiveScore = GroupEffectiveScore(members, groups[i].ChooseCount)
+		groups[i].Percentage = roundHundredths(percentage)
+	}
+}
+
+// AfterFind はGORMの取得後フックで、取得した科目から選択科目群の換算後の配点と配点比率を設定します
+func (t *TestType) AfterFind(_ *gorm.DB) error {
+	ApplySelection(t.SubjectGroups, t.Subjects)
+	return nil
+}
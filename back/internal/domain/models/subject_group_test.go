@@ -0,0 +1,120 @@
+package models
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// groupedSubject は選択科目群に属する科目を生成します
+func groupedSubject(id, groupID uint, score int, percentage float64) Subject {
+	return Subject{BaseModel: BaseModel{ID: id}, SubjectGroupID: &groupID, Score: score, Percentage: percentage}
+}
+
+func TestSubjectGroupValidate(t *testing.T) {
+	valid := func() SubjectGroup {
+		return SubjectGroup{TestTypeID: 1, Name: "理科", ChooseCount: 2, SubjectIDs: []uint{1, 2, 3}}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(g *SubjectGroup)
+		field  string
+	}{
+		{name: "正常", modify: func(_ *SubjectGroup) {}},
+		{name: "試験種別IDなし", modify: func(g *SubjectGroup) { g.TestTypeID = 0 }, field: "TestTypeID"},
+		{name: "名前なし", modify: func(g *SubjectGroup) { g.Name = "" }, field: "Name"},
+		{name: "所属する科目が1つ", modify: func(g *SubjectGroup) { g.SubjectIDs = []uint{1} }, field: "SubjectIDs"},
+		{name: "科目の重複", modify: func(g *SubjectGroup) { g.SubjectIDs = []uint{1, 2, 2} }, field: "SubjectIDs"},
+		{name: "選択数が0", modify: func(g *SubjectGroup) { g.ChooseCount = 0 }, field: "ChooseCount"},
+		{name: "選択数が所属する科目数と同じ", modify: func(g *SubjectGroup) { g.ChooseCount = 3 }, field: "ChooseCount"},
+		{name: "表示順が範囲外", modify: func(g *SubjectGroup) { g.DisplayOrder = 1000 }, field: "DisplayOrder"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			group := valid()
+			tt.modify(&group)
+
+			err := group.Validate()
+			if tt.field == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			var validationErr *ValidationError
+			require.True(t, errors.As(err, &validationErr))
+			assert.Equal(t, tt.field, validationErr.Field)
+		})
+	}
+}
+
+func TestGroupEffectiveScore(t *testing.T) {
+	members := []Subject{{Score: 100}, {Score: 150}, {Score: 100, ConversionRatio: 2}}
+
+	assert.Equal(t, 350.0, GroupEffectiveScore(members, 2), "換算後の配点の高い順に選択数の科目を合計する")
+	assert.Equal(t, 200.0, GroupEffectiveScore(members, 1))
+	assert.Equal(t, 450.0, GroupEffectiveScore(members, 5), "所属する科目が選択数に満たない場合は全ての科目を合計する")
+}
+
+func TestSelectionTotal(t *testing.T) {
+	// 英語200点と、物理・化学・生物（各100点）から2科目
+	subjects := []Subject{
+		{BaseModel: BaseModel{ID: 1}, Score: 200},
+		groupedSubject(2, 10, 100, 0),
+		groupedSubject(3, 10, 100, 0),
+		groupedSubject(4, 10, 100, 0),
+	}
+	groups := []SubjectGroup{{BaseModel: BaseModel{ID: 10}, ChooseCount: 2}}
+
+	assert.Equal(t, 400.0, SelectionTotal(subjects, groups))
+	assert.Equal(t, 500.0, SelectionTotal(subjects, nil), "選択科目群が見つからない科目はそれぞれ合計する")
+	assert.Equal(t, []uint{10}, SubjectGroupIDs(subjects))
+}
+
+func TestApplySelection(t *testing.T) {
+	subjects := []Subject{
+		{BaseModel: BaseModel{ID: 1}, Score: 200, Percentage: 50},
+		groupedSubject(2, 10, 100, 25),
+		groupedSubject(3, 10, 100, 25),
+		groupedSubject(4, 10, 100, 25),
+	}
+	groups := []SubjectGroup{{BaseModel: BaseModel{ID: 10}, ChooseCount: 2}, {BaseModel: BaseModel{ID: 11}, ChooseCount: 1}}
+
+	ApplySelection(groups, subjects)
+
+	assert.Equal(t, []uint{2, 3, 4}, groups[0].SubjectIDs)
+	assert.Equal(t, 200.0, groups[0].EffectiveScore)
+	assert.Equal(t, 50.0, groups[0].Percentage)
+
+	assert.Empty(t, groups[1].SubjectIDs)
+	assert.Zero(t, groups[1].EffectiveScore)
+}
+
+func TestSelectionPercentagesSumToHundred(t *testing.T) {
+	// 英語200点と、物理・化学・生物（各100点）から2科目
+	subjects := []Subject{
+		{BaseModel: BaseModel{ID: 1}, Score: 200},
+		groupedSubject(2, 10, 100, 0),
+		groupedSubject(3, 10, 100, 0),
+		groupedSubject(4, 10, 100, 0),
+	}
+	groups := []SubjectGroup{{BaseModel: BaseModel{ID: 10}, ChooseCount: 2}}
+
+	total := SelectionTotal(subjects, groups)
+	for i := range subjects {
+		subjects[i].Percentage = WeightedPercentage(subjects[i].EffectiveScore, total)
+	}
+
+	ApplySelection(groups, subjects)
+
+	var members float64
+	for _, s := range subjects[1:] {
+		members += s.Percentage
+	}
+
+	assert.Equal(t, 100.0, subjects[0].Percentage+groups[0].Percentage, "選択科目群に属さない科目と選択科目群の配点比率の合計は100になる")
+	assert.Equal(t, 125.0, subjects[0].Percentage+members, "所属する科目の配点比率は参考値のため合計すると100を超える")
+}
@@ -0,0 +1,62 @@
+package models
+
+import (
+	"math"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// DefaultConversionRatio は換算比率が指定されていない場合の値です（素点をそのまま使用する）
+	DefaultConversionRatio = 1.0
+	// MaxConversionRatio は指定可能な最大の換算比率です
+	MaxConversionRatio = 10.0
+)
+
+// ApplyConversion は換算比率から換算後の配点を算出します
+// 換算比率が指定されていない場合は素点をそのまま使用し、換算後の配点は小数点以下2桁に丸めます
+func (s *Subject) ApplyConversion() {
+	if s.ConversionRatio == 0 {
+		s.ConversionRatio = DefaultConversionRatio
+	}
+
+	s.EffectiveScore = roundHundredths(float64(s.Score) * s.ConversionRatio)
+}
+
+// BeforeSave はGORMの保存前フックで換算比率の既定値と換算後の配点を設定します
+func (s *Subject) BeforeSave(_ *gorm.DB) error {
+	s.ApplyConversion()
+	return nil
+}
+
+// AfterFind はGORMの取得後フックで換算後の配点を算出します
+func (s *Subject) AfterFind(_ *gorm.DB) error {
+	s.ApplyConversion()
+	return nil
+}
+
+// EffectiveTotal は科目の換算後の配点の合計を返します
+func EffectiveTotal(subjects []Subject) float64 {
+	var total float64
+	for i := range subjects {
+		subjects[i].ApplyConversion()
+		total += subjects[i].EffectiveScore
+	}
+
+	return total
+}
+
+// WeightedPercentage は換算後の配点の合計に対する科目の配点比率を小数点以下2桁で返します
+// 合計が0の場合は0を返します
+func WeightedPercentage(effectiveScore, total float64) float64 {
+	if total <= 0 {
+		return 0
+	}
+
+	return roundHundredths(effectiveScore / total * 100)
+}
+
+// roundHundredths は小数点以下2桁に丸めます
+func roundHundredths(v float64) float64 {
+	return math.Round(v*100) / 100
+}
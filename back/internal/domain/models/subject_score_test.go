@@ -0,0 +1,41 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubjectApplyConversion(t *testing.T) {
+	t.Run("換算比率を指定しない場合は素点をそのまま使用する", func(t *testing.T) {
+		subject := Subject{Score: 200}
+		subject.ApplyConversion()
+
+		assert.Equal(t, DefaultConversionRatio, subject.ConversionRatio)
+		assert.Equal(t, 200.0, subject.EffectiveScore)
+	})
+
+	t.Run("換算比率で圧縮した配点を小数点以下2桁で算出する", func(t *testing.T) {
+		subject := Subject{Score: 100, ConversionRatio: 0.333}
+		subject.ApplyConversion()
+
+		assert.Equal(t, 33.3, subject.EffectiveScore)
+	})
+}
+
+func TestWeightedPercentage(t *testing.T) {
+	// 共通テスト900点を450点に圧縮し、二次試験の素点550点と合算する
+	subjects := []Subject{
+		{Score: 900, ConversionRatio: 0.5},
+		{Score: 300},
+		{Score: 250},
+	}
+
+	total := EffectiveTotal(subjects)
+	assert.Equal(t, 1000.0, total)
+	assert.Equal(t, 45.0, WeightedPercentage(subjects[0].EffectiveScore, total))
+	assert.Equal(t, 30.0, WeightedPercentage(subjects[1].EffectiveScore, total))
+	assert.Equal(t, 25.0, WeightedPercentage(subjects[2].EffectiveScore, total))
+
+	assert.Zero(t, WeightedPercentage(100, 0))
+}
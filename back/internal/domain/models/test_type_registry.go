@@ -0,0 +1,212 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"unicode/utf8"
+)
+
+// 試験種別コード
+const (
+	TestTypeCodeCommon             = "common"
+	TestTypeCodeSecondary          = "secondary"
+	TestTypeCodeComprehensive      = "comprehensive"
+	TestTypeCodeRecommendation     = "recommendation"
+	TestTypeCodeUniversitySpecific = "university_specific"
+	TestTypeCodeEnglishExternal    = "english_external"
+	TestTypeCodeInterviewEssay     = "interview_essay"
+)
+
+// maxTestTypeNameLength は試験種別名の最大文字数です（test_types.name の列の長さ）
+const maxTestTypeNameLength = 10
+
+// TestTypeDefinition は試験種別レジストリに登録する試験種別の定義です
+// 以下のフィールドを含みます：
+// - Code: 試験種別コード
+// - Name: 表示名（試験種別の name として保存する値）
+// - CountsTowardTotal: 配点比率の分母（換算後の配点の合計）に含めるかどうか
+// - DisplayOrder: 表示順
+type TestTypeDefinition struct {
+	Code              string `json:"code"`
+	Name              string `json:"name"`
+	CountsTowardTotal bool   `json:"counts_toward_total"`
+	DisplayOrder      int    `json:"display_order"`
+}
+
+// DefaultTestTypeDefinitions は既定の試験種別の定義を返します
+// 英語外部試験は出願資格やみなし得点として扱うことが多いため、配点比率の分母に含めません
+func DefaultTestTypeDefinitions() []TestTypeDefinition {
+	return []TestTypeDefinition{
+		{Code: TestTypeCodeCommon, Name: "共通", CountsTowardTotal: true, DisplayOrder: 1},
+		{Code: TestTypeCodeSecondary, Name: "二次", CountsTowardTotal: true, DisplayOrder: 2},
+		{Code: TestTypeCodeComprehensive, Name: "総合型選抜", CountsTowardTotal: true, DisplayOrder: 3},
+		{Code: TestTypeCodeRecommendation, Name: "学校推薦型", CountsTowardTotal: true, DisplayOrder: 4},
+		{Code: TestTypeCodeUniversitySpecific, Name: "大学独自試験", CountsTowardTotal: true, DisplayOrder: 5},
+		{Code: TestTypeCodeEnglishExternal, Name: "英語外部試験", CountsTowardTotal: false, DisplayOrder: 6},
+		{Code: TestTypeCodeInterviewEssay, Name: "面接/小論文", CountsTowardTotal: true, DisplayOrder: 7},
+	}
+}
+
+// TestTypeRegistry は登録可能な試験種別の一覧です
+// 試験種別名の検証・表示順・配点比率の分母の算出はこのレジストリに従います
+type TestTypeRegistry struct {
+	definitions []TestTypeDefinition
+	byName      map[string]TestTypeDefinition
+}
+
+// NewTestTypeRegistry は試験種別の定義を検証し、表示順に並べたレジストリを生成します。
+// この関数は以下の検証を行います：
+// - 1件以上の定義があること
+// - コードと表示名が空でなく、重複しないこと
+// - 表示名が列の長さ以下であること
+// - 配点比率の分母に含める試験種別が1件以上あること
+func NewTestTypeRegistry(definitions []TestTypeDefinition) (*TestTypeRegistry, error) {
+	if len(definitions) == 0 {
+		return nil, fmt.Errorf("試験種別の定義が1件もありません")
+	}
+
+	sorted := make([]TestTypeDefinition, len(definitions))
+	copy(sorted, definitions)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].DisplayOrder < sorted[j].DisplayOrder
+	})
+
+	registry := &TestTypeRegistry{
+		definitions: sorted,
+		byName:      make(map[string]TestTypeDefinition, len(sorted)),
+	}
+	codes := make(map[string]bool, len(sorted))
+	counted := false
+
+	for _, d := range sorted {
+		if strings.TrimSpace(d.Code) == "" || strings.TrimSpace(d.Name) == "" {
+			return nil, fmt.Errorf("試験種別のコードと表示名は必須です")
+		}
+
+		if utf8.RuneCountInString(d.Name) > maxTestTypeNameLength {
+			return nil, fmt.Errorf("試験種別名「%s」は%d文字以下である必要があります", d.Name, maxTestTypeNameLength)
+		}
+
+		if codes[d.Code] {
+			return nil, fmt.Errorf("試験種別コード「%s」が重複しています", d.Code)
+		}
+
+		if _, ok := registry.byName[d.Name]; ok {
+			return nil, fmt.Errorf("試験種別名「%s」が重複しています", d.Name)
+		}
+
+		codes[d.Code] = true
+		registry.byName[d.Name] = d
+		counted = counted || d.CountsTowardTotal
+	}
+
+	if !counted {
+		return nil, fmt.Errorf("配点比率の分母に含める試験種別が1件もありません")
+	}
+
+	return registry, nil
+}
+
+// ParseTestTypeRegistry はJSONの試験種別の定義の配列からレジストリを生成します
+func ParseTestTypeRegistry(data []byte) (*TestTypeRegistry, error) {
+	var definitions []TestTypeDefinition
+	if err := json.Unmarshal(data, &definitions); err != nil {
+		return nil, fmt.Errorf("試験種別の定義の読み込みに失敗しました: %w", err)
+	}
+
+	return NewTestTypeRegistry(definitions)
+}
+
+// Definitions は表示順の試験種別の定義のコピーを返します
+func (r *TestTypeRegistry) Definitions() []TestTypeDefinition {
+	definitions := make([]TestTypeDefinition, len(r.definitions))
+	copy(definitions, r.definitions)
+
+	return definitions
+}
+
+// Lookup は表示名から試験種別の定義を取得します
+func (r *TestTypeRegistry) Lookup(name string) (TestTypeDefinition, bool) {
+	d, ok := r.byName[name]
+	return d, ok
+}
+
+// Names は表示順の試験種別名を返します
+func (r *TestTypeRegistry) Names() []string {
+	names := make([]string, len(r.definitions))
+	for i, d := range r.definitions {
+		names[i] = d.Name
+	}
+
+	return names
+}
+
+// CountedNames は配点比率の分母に含める試験種別名を表示順に返します
+func (r *TestTypeRegistry) CountedNames() []string {
+	names := make([]string, 0, len(r.definitions))
+	for _, d := range r.definitions {
+		if d.CountsTowardTotal {
+			names = append(names, d.Name)
+		}
+	}
+
+	return names
+}
+
+// CountsTowardTotal は試験種別が配点比率の分母に含まれるかどうかを返します
+// 登録されていない試験種別は分母に含めません
+func (r *TestTypeRegistry) CountsTowardTotal(name string) bool {
+	d, ok := r.byName[name]
+	return ok && d.CountsTowardTotal
+}
+
+// Denominator は試験種別名ごとの換算後の配点の合計から、配点比率の分母を算出します
+func (r *TestTypeRegistry) Denominator(totals map[string]float64) float64 {
+	var denominator float64
+
+	for name, total := range totals {
+		if r.CountsTowardTotal(name) {
+			denominator += total
+		}
+	}
+
+	return denominator
+}
+
+// describe は検証エラーのメッセージに使用する試験種別名の一覧を返します
+func (r *TestTypeRegistry) describe() string {
+	quoted := make([]string, len(r.definitions))
+	for i, d := range r.definitions {
+		quoted[i] = "'" + d.Name + "'"
+	}
+
+	return strings.Join(quoted, "、")
+}
+
+// currentTestTypes は現在の試験種別レジストリです
+var currentTestTypes atomic.Pointer[TestTypeRegistry]
+
+func init() {
+	registry, err := NewTestTypeRegistry(DefaultTestTypeDefinitions())
+	if err != nil {
+		panic(err)
+	}
+
+	currentTestTypes.Store(registry)
+}
+
+// TestTypes は現在の試験種別レジストリを返します
+func TestTypes() *TestTypeRegistry {
+	return currentTestTypes.Load()
+}
+
+// SetTestTypes は試験種別レジストリを置き換えます
+// 起動時に設定ファイルの定義を反映するために使用します
+func SetTestTypes(registry *TestTypeRegistry) {
+	if registry != nil {
+		currentTestTypes.Store(registry)
+	}
+}
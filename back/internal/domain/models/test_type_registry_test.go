@@ -0,0 +1,79 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultTestTypeRegistry(t *testing.T) {
+	registry := TestTypes()
+
+	assert.Equal(t, []string{"共通", "二次", "総合型選抜", "学校推薦型", "大学独自試験", "英語外部試験", "面接/小論文"}, registry.Names())
+	assert.NotContains(t, registry.CountedNames(), "英語外部試験")
+
+	d, ok := registry.Lookup("学校推薦型")
+	require.True(t, ok)
+	assert.Equal(t, TestTypeCodeRecommendation, d.Code)
+
+	_, ok = registry.Lookup("前期")
+	assert.False(t, ok)
+
+	assert.True(t, registry.CountsTowardTotal("共通"))
+	assert.False(t, registry.CountsTowardTotal("英語外部試験"))
+	assert.False(t, registry.CountsTowardTotal("前期"))
+
+	// 分母に含めない試験種別の合計点は除外する
+	assert.Equal(t, 750.0, registry.Denominator(map[string]float64{"共通": 450, "二次": 300, "英語外部試験": 100}))
+}
+
+func TestNewTestTypeRegistry(t *testing.T) {
+	t.Run("表示順に並べる", func(t *testing.T) {
+		registry, err := NewTestTypeRegistry([]TestTypeDefinition{
+			{Code: "b", Name: "二次", CountsTowardTotal: true, DisplayOrder: 2},
+			{Code: "a", Name: "共通", CountsTowardTotal: true, DisplayOrder: 1},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"共通", "二次"}, registry.Names())
+	})
+
+	tests := []struct {
+		name        string
+		definitions []TestTypeDefinition
+	}{
+		{name: "定義がない", definitions: nil},
+		{name: "コードが空", definitions: []TestTypeDefinition{{Name: "共通", CountsTowardTotal: true}}},
+		{name: "表示名が長すぎる", definitions: []TestTypeDefinition{
+			{Code: "a", Name: "とても長い試験種別の名前です", CountsTowardTotal: true},
+		}},
+		{name: "コードの重複", definitions: []TestTypeDefinition{
+			{Code: "a", Name: "共通", CountsTowardTotal: true},
+			{Code: "a", Name: "二次", CountsTowardTotal: true},
+		}},
+		{name: "表示名の重複", definitions: []TestTypeDefinition{
+			{Code: "a", Name: "共通", CountsTowardTotal: true},
+			{Code: "b", Name: "共通", CountsTowardTotal: true},
+		}},
+		{name: "分母に含める試験種別がない", definitions: []TestTypeDefinition{{Code: "a", Name: "面接"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewTestTypeRegistry(tt.definitions)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestParseTestTypeRegistry(t *testing.T) {
+	registry, err := ParseTestTypeRegistry([]byte(
+		`[{"code":"common","name":"共通","counts_toward_total":true,"display_order":1},` +
+			`{"code":"interview","name":"面接","counts_toward_total":false,"display_order":2}]`,
+	))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"共通"}, registry.CountedNames())
+
+	_, err = ParseTestTypeRegistry([]byte(`{"code":"common"}`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// TrashItem はゴミ箱（ソフトデリート済み）の要素を表現する構造体です
+// 以下のフィールドを含みます：
+// - EntityType: エンティティ種別（university, department など）
+// - ID: エンティティのID
+// - Name: 名称（入試情報など名称を持たない場合は空）
+// - ParentType: 親のエンティティ種別（大学の場合は空）
+// - ParentID: 親のエンティティのID
+// - DeletedAt: 削除日時
+type TrashItem struct {
+	EntityType string    `json:"entity_type"`
+	ID         uint      `json:"id"`
+	Name       string    `json:"name,omitempty"`
+	ParentType string    `json:"parent_type,omitempty"`
+	ParentID   uint      `json:"parent_id,omitempty"`
+	DeletedAt  time.Time `json:"deleted_at"`
+}
@@ -12,6 +12,7 @@ import (
 	"errors"
 	"fmt"
 	"runtime"
+	"strconv"
 	"strings"
 	"time"
 
@@ -43,6 +44,10 @@ const (
 	CodeTimeoutError Code = "TIMEOUT_ERROR"
 	// CodeRateLimitError はレート制限エラーを表します
 	CodeRateLimitError Code = "RATE_LIMIT_ERROR"
+	// CodeConflict は更新の競合エラーを表します
+	CodeConflict Code = "CONFLICT"
+	// CodePreconditionRequired は更新の前提条件（期待するバージョン）が指定されていないエラーを表します
+	CodePreconditionRequired Code = "PRECONDITION_REQUIRED"
 )
 
 // ErrorDetails はエラーの詳細情報を保持する構造体です
@@ -74,6 +79,7 @@ type ErrorDetails struct {
 // - Line: エラーが発生した行番号
 // - LogLevel: ログレベル
 // - Timestamp: エラーが発生した時刻
+// - Current: 競合時のサーバー上の現在の状態
 type Error struct {
 	Code      Code         `json:"code"`
 	Message   string       `json:"message"`
@@ -83,6 +89,7 @@ type Error struct {
 	Line      int          `json:"line,omitempty"`
 	LogLevel  string       `json:"log_level,omitempty"`
 	Timestamp time.Time    `json:"timestamp,omitempty"`
+	Current   interface{}  `json:"current,omitempty"`
 }
 
 // Error はerrorインターフェースを実装します
@@ -186,11 +193,13 @@ func NewInvalidInputError(field, message string, extra map[string]string) *Error
 
 // NewDatabaseError は新しいデータベースエラーを生成します
 // データベース操作でエラーが発生した場合に使用します
+// 元のエラーをラップするため、errors.Is でタイムアウトなどの原因を判定できます
 func NewDatabaseError(operation string, err error, extra map[string]string) *Error {
 	_, file, line, _ := runtime.Caller(1)
 	dbErr := &Error{
 		Code:    CodeDatabaseError,
 		Message: fmt.Sprintf("データベース操作 '%s' でエラーが発生しました: %v", operation, err),
+		Err:     err,
 		Details: ErrorDetails{
 			Operation: operation,
 			Extra:     extra,
@@ -342,6 +351,46 @@ func NewRateLimitError(message string, extra map[string]string) *Error {
 	}
 }
 
+// NewConflictError は新しい競合エラーを生成します
+// 期待するバージョンとサーバー上のバージョンが一致しない場合に使用します
+// current にはサーバー上の現在の状態を指定します
+func NewConflictError(resource string, id uint, currentVersion int, current interface{}) *Error {
+	_, file, line, _ := runtime.Caller(1)
+
+	return &Error{
+		Code:    CodeConflict,
+		Message: fmt.Sprintf("%s (ID: %d) は他の操作によって更新されています", resource, id),
+		Details: ErrorDetails{
+			Resource: resource,
+			ID:       id,
+			Extra:    map[string]string{"current_version": strconv.Itoa(currentVersion)},
+		},
+		File:      file,
+		Line:      line,
+		LogLevel:  "WARN",
+		Timestamp: time.Now(),
+		Current:   current,
+	}
+}
+
+// NewPreconditionRequiredError は新しい前提条件未指定エラーを生成します
+// 更新時に期待するバージョンが指定されていない場合に使用します
+func NewPreconditionRequiredError(message string, extra map[string]string) *Error {
+	_, file, line, _ := runtime.Caller(1)
+
+	return &Error{
+		Code:    CodePreconditionRequired,
+		Message: message,
+		Details: ErrorDetails{
+			Extra: extra,
+		},
+		File:      file,
+		Line:      line,
+		LogLevel:  "ERROR",
+		Timestamp: time.Now(),
+	}
+}
+
 // DBErrorType はデータベースエラーの種類を定義します
 // 以下の種類をサポートします：
 // - リソース未検出
@@ -182,6 +182,10 @@ func TestNewDatabaseError(t *testing.T) {
 	if err.Details.Operation != "SELECT" {
 		t.Errorf("NewDatabaseError().Details.Operation = %v, want %v", err.Details.Operation, "SELECT")
 	}
+
+	if !errors.Is(err, innerErr) {
+		t.Errorf("NewDatabaseError() should wrap %v", innerErr)
+	}
 }
 
 // TestTranslateDBError はTranslateDBError()関数のテストを行います
@@ -385,3 +389,43 @@ func TestNewRateLimitError(t *testing.T) {
 		t.Errorf("Expected extra window '%s', got '%s'", extra["window"], err.Details.Extra["window"])
 	}
 }
+
+func TestNewConflictError(t *testing.T) {
+	current := map[string]interface{}{"name": "最新の大学名", "version": 3}
+
+	err := NewConflictError("大学", 1, 3, current)
+	if err == nil {
+		t.Fatal("NewConflictError should not return nil")
+	}
+
+	if err.Code != CodeConflict {
+		t.Errorf("Expected code '%s', got '%s'", CodeConflict, err.Code)
+	}
+
+	if err.Details.ID != 1 {
+		t.Errorf("Expected ID 1, got %d", err.Details.ID)
+	}
+
+	if err.Details.Extra["current_version"] != "3" {
+		t.Errorf("Expected current_version '3', got '%s'", err.Details.Extra["current_version"])
+	}
+
+	if err.Current == nil {
+		t.Error("Expected current state to be set")
+	}
+}
+
+func TestNewPreconditionRequiredError(t *testing.T) {
+	message := "バージョンを指定してください"
+
+	err := NewPreconditionRequiredError(message, nil)
+	if err == nil {
+		t.Fatal("NewPreconditionRequiredError should not return nil")
+	}
+
+	expectedMsg := "PRECONDITION_REQUIRED: " + message
+
+	if err.Error() != expectedMsg {
+		t.Errorf(errMsgFormat, expectedMsg, err.Error())
+	}
+}
@@ -0,0 +1,213 @@
+// Package academicyear は年度別の入試データに関するHTTPリクエストを処理するパッケージです。
+// このパッケージは以下の機能を提供します：
+// - 学科に登録されている年度一覧の取得
+// - 年度別の学科→日程→試験種別→科目の内訳の取得
+// - 年度間の配点差分の取得
+// - エラーハンドリング
+// - ログ記録
+package academicyear
+
+import (
+	"context"
+	"net/http"
+	"time"
+	applogger "university-exam-api/internal/logger"
+	"university-exam-api/internal/pkg/errors"
+	"university-exam-api/internal/pkg/validation"
+	"university-exam-api/internal/repositories"
+	"university-exam-api/internal/usecases"
+
+	"github.com/labstack/echo/v4"
+)
+
+// パスパラメータ名
+const (
+	ParamAcademicYear = "academicYear"
+	ParamUniversityID = "universityID"
+	ParamDepartmentID = "departmentID"
+	ParamMajorID      = "majorID"
+	ParamSchedule     = "schedule"
+	QueryFromYear     = "from"
+	QueryToYear       = "to"
+)
+
+// Handler は年度別の入試データに関するHTTPリクエストを処理する構造体です。
+// この構造体は以下の機能を提供します：
+// - ユースケースとの連携
+// - リクエストタイムアウトの管理
+// - エラーハンドリング
+type Handler struct {
+	usecase usecases.AcademicYearUsecase
+	timeout time.Duration
+}
+
+// NewAcademicYearHandler は新しいHandlerインスタンスを生成します。
+// この関数は以下の処理を行います：
+// - ユースケースの初期化
+// - タイムアウトの設定
+func NewAcademicYearHandler(usecase usecases.AcademicYearUsecase, timeout time.Duration) *Handler {
+	return &Handler{
+		usecase: usecase,
+		timeout: timeout,
+	}
+}
+
+// bindMajorPath はパスパラメータから大学・学部・学科のIDを取得します
+func bindMajorPath(ctx context.Context, c echo.Context) (repositories.MajorPath, error) {
+	universityID, err := validation.ValidateUniversityID(ctx, c.Param(ParamUniversityID))
+	if err != nil {
+		return repositories.MajorPath{}, err
+	}
+
+	departmentID, err := validation.ValidateDepartmentID(ctx, c.Param(ParamDepartmentID))
+	if err != nil {
+		return repositories.MajorPath{}, err
+	}
+
+	majorID, err := validation.ValidateMajorID(ctx, c.Param(ParamMajorID))
+	if err != nil {
+		return repositories.MajorPath{}, err
+	}
+
+	return repositories.MajorPath{
+		UniversityID: universityID,
+		DepartmentID: departmentID,
+		MajorID:      majorID,
+	}, nil
+}
+
+// bindYearAndMajorPath はパスパラメータから学年度と大学・学部・学科のIDを取得します
+func bindYearAndMajorPath(ctx context.Context, c echo.Context) (int, repositories.MajorPath, error) {
+	year, err := validation.ValidateAcademicYear(ctx, c.Param(ParamAcademicYear))
+	if err != nil {
+		return 0, repositories.MajorPath{}, err
+	}
+
+	path, err := bindMajorPath(ctx, c)
+	if err != nil {
+		return 0, repositories.MajorPath{}, err
+	}
+
+	return year, path, nil
+}
+
+// GetAvailableYears は学科に登録されている年度の一覧を取得します。
+// この関数は以下の処理を行います：
+// - パスパラメータの検証
+// - 年度一覧の取得
+// - エラーハンドリング
+func (h *Handler) GetAvailableYears(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	path, err := bindMajorPath(ctx, c)
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	years, err := h.usecase.GetAvailableYears(ctx, path)
+	if err != nil {
+		applogger.Error(ctx, "年度一覧の取得に失敗しました (学科ID: %d): %v", path.MajorID, err)
+		return errors.HandleError(c, err)
+	}
+
+	applogger.Info(ctx, "年度一覧の取得に成功しました (学科ID: %d, 件数: %d)", path.MajorID, len(years))
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"data": years,
+	})
+}
+
+// GetMajorByYear は指定年度の学科の配点内訳を取得します。
+// この関数は以下の処理を行います：
+// - パスパラメータの検証
+// - 年度別の日程・試験種別・科目の取得
+// - エラーハンドリング
+func (h *Handler) GetMajorByYear(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	year, path, err := bindYearAndMajorPath(ctx, c)
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	major, err := h.usecase.GetMajorByYear(ctx, path, year)
+	if err != nil {
+		applogger.Error(ctx, "年度別の学科情報の取得に失敗しました (年度: %d, 学科ID: %d): %v", year, path.MajorID, err)
+		return errors.HandleError(c, err)
+	}
+
+	applogger.Info(ctx, "年度別の学科情報の取得に成功しました (年度: %d, 学科ID: %d)", year, path.MajorID)
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"data": major,
+	})
+}
+
+// GetScheduleByYear は指定年度・指定日程の学科の配点内訳を取得します。
+// この関数は以下の処理を行います：
+// - パスパラメータの検証
+// - 年度別・日程別の試験種別・科目の取得
+// - エラーハンドリング
+func (h *Handler) GetScheduleByYear(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	year, path, err := bindYearAndMajorPath(ctx, c)
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	schedule := c.Param(ParamSchedule)
+
+	major, err := h.usecase.GetScheduleByYear(ctx, path, year, schedule)
+	if err != nil {
+		applogger.Error(ctx, "年度別の日程情報の取得に失敗しました (年度: %d, 学科ID: %d, 日程: %s): %v",
+			year, path.MajorID, schedule, err)
+		return errors.HandleError(c, err)
+	}
+
+	applogger.Info(ctx, "年度別の日程情報の取得に成功しました (年度: %d, 学科ID: %d, 日程: %s)", year, path.MajorID, schedule)
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"data": major,
+	})
+}
+
+// CompareYears は学科の2つの年度間の配点差分を取得します。
+// この関数は以下の処理を行います：
+// - パスパラメータとクエリパラメータ（from, to）の検証
+// - 追加・削除・変更された科目の取得
+// - エラーハンドリング
+func (h *Handler) CompareYears(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	path, err := bindMajorPath(ctx, c)
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	fromYear, err := validation.ValidateAcademicYear(ctx, c.QueryParam(QueryFromYear))
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	toYear, err := validation.ValidateAcademicYear(ctx, c.QueryParam(QueryToYear))
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	comparison, err := h.usecase.CompareYears(ctx, path, fromYear, toYear)
+	if err != nil {
+		applogger.Error(ctx, "年度間の配点比較に失敗しました (学科ID: %d, %d→%d): %v", path.MajorID, fromYear, toYear, err)
+		return errors.HandleError(c, err)
+	}
+
+	applogger.Info(ctx, "年度間の配点比較に成功しました (学科ID: %d, %d→%d)", path.MajorID, fromYear, toYear)
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"data": comparison,
+	})
+}
@@ -0,0 +1,248 @@
+package academicyear
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	appErrors "university-exam-api/internal/errors"
+	applogger "university-exam-api/internal/logger"
+	"university-exam-api/internal/repositories"
+	"university-exam-api/internal/usecases"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockAcademicYearUsecase はAcademicYearUsecaseのモックです
+type mockAcademicYearUsecase struct {
+	mock.Mock
+}
+
+func (m *mockAcademicYearUsecase) GetAvailableYears(ctx context.Context, path repositories.MajorPath) ([]int, error) {
+	args := m.Called(ctx, path)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]int), args.Error(1)
+}
+
+func (m *mockAcademicYearUsecase) GetMajorByYear(
+	ctx context.Context,
+	path repositories.MajorPath,
+	year int,
+) (*repositories.YearScopedMajor, error) {
+	args := m.Called(ctx, path, year)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*repositories.YearScopedMajor), args.Error(1)
+}
+
+func (m *mockAcademicYearUsecase) GetScheduleByYear(
+	ctx context.Context,
+	path repositories.MajorPath,
+	year int,
+	schedule string,
+) (*repositories.YearScopedMajor, error) {
+	args := m.Called(ctx, path, year, schedule)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*repositories.YearScopedMajor), args.Error(1)
+}
+
+func (m *mockAcademicYearUsecase) CompareYears(
+	ctx context.Context,
+	path repositories.MajorPath,
+	fromYear, toYear int,
+) (*usecases.YearComparison, error) {
+	args := m.Called(ctx, path, fromYear, toYear)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*usecases.YearComparison), args.Error(1)
+}
+
+// newTestContext はパスパラメータを設定したテスト用のコンテキストを生成します
+func newTestContext(names, values []string) (echo.Context, *httptest.ResponseRecorder) {
+	return newTestContextWithTarget("/", names, values)
+}
+
+// newTestContextWithTarget はリクエストURLとパスパラメータを設定したテスト用のコンテキストを生成します
+func newTestContextWithTarget(target string, names, values []string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames(names...)
+	c.SetParamValues(values...)
+
+	return c, rec
+}
+
+var testPath = repositories.MajorPath{UniversityID: 1, DepartmentID: 2, MajorID: 3}
+
+func TestGetAvailableYears(t *testing.T) {
+	applogger.InitTestLogger()
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase := new(mockAcademicYearUsecase)
+		mockUsecase.On("GetAvailableYears", mock.Anything, testPath).Return([]int{2025, 2024}, nil)
+
+		h := NewAcademicYearHandler(mockUsecase, 2*time.Second)
+		c, rec := newTestContext(
+			[]string{ParamUniversityID, ParamDepartmentID, ParamMajorID},
+			[]string{"1", "2", "3"},
+		)
+
+		require.NoError(t, h.GetAvailableYears(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"data":[2025,2024]}`, rec.Body.String())
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("不正な学科ID", func(t *testing.T) {
+		mockUsecase := new(mockAcademicYearUsecase)
+
+		h := NewAcademicYearHandler(mockUsecase, 2*time.Second)
+		c, rec := newTestContext(
+			[]string{ParamUniversityID, ParamDepartmentID, ParamMajorID},
+			[]string{"1", "2", "abc"},
+		)
+
+		require.NoError(t, h.GetAvailableYears(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockUsecase.AssertNotCalled(t, "GetAvailableYears", mock.Anything, mock.Anything)
+	})
+}
+
+func TestGetMajorByYear(t *testing.T) {
+	applogger.InitTestLogger()
+
+	names := []string{ParamAcademicYear, ParamUniversityID, ParamDepartmentID, ParamMajorID}
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase := new(mockAcademicYearUsecase)
+		mockUsecase.On("GetMajorByYear", mock.Anything, testPath, 2025).Return(&repositories.YearScopedMajor{
+			AcademicYear: 2025,
+			Major:        repositories.YearScopedEntity{ID: 3, Name: "機械工学科"},
+		}, nil)
+
+		h := NewAcademicYearHandler(mockUsecase, 2*time.Second)
+		c, rec := newTestContext(names, []string{"2025", "1", "2", "3"})
+
+		require.NoError(t, h.GetMajorByYear(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"academic_year":2025`)
+		assert.Contains(t, rec.Body.String(), "機械工学科")
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("範囲外の年度", func(t *testing.T) {
+		mockUsecase := new(mockAcademicYearUsecase)
+
+		h := NewAcademicYearHandler(mockUsecase, 2*time.Second)
+		c, rec := newTestContext(names, []string{"1999", "1", "2", "3"})
+
+		require.NoError(t, h.GetMajorByYear(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockUsecase.AssertNotCalled(t, "GetMajorByYear", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("年度のデータが存在しない", func(t *testing.T) {
+		mockUsecase := new(mockAcademicYearUsecase)
+		mockUsecase.On("GetMajorByYear", mock.Anything, testPath, 2020).
+			Return(nil, appErrors.NewNotFoundError("入試情報", 3, nil))
+
+		h := NewAcademicYearHandler(mockUsecase, 2*time.Second)
+		c, rec := newTestContext(names, []string{"2020", "1", "2", "3"})
+
+		require.NoError(t, h.GetMajorByYear(c))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		mockUsecase.AssertExpectations(t)
+	})
+}
+
+func TestGetScheduleByYear(t *testing.T) {
+	applogger.InitTestLogger()
+
+	mockUsecase := new(mockAcademicYearUsecase)
+	mockUsecase.On("GetScheduleByYear", mock.Anything, testPath, 2024, "前").Return(&repositories.YearScopedMajor{
+		AcademicYear: 2024,
+		Schedules:    []repositories.YearScopedSchedule{{ID: 1, Name: "前"}},
+	}, nil)
+
+	h := NewAcademicYearHandler(mockUsecase, 2*time.Second)
+	c, rec := newTestContext(
+		[]string{ParamAcademicYear, ParamUniversityID, ParamDepartmentID, ParamMajorID, ParamSchedule},
+		[]string{"2024", "1", "2", "3", "前"},
+	)
+
+	require.NoError(t, h.GetScheduleByYear(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"name":"前"`)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestCompareYears(t *testing.T) {
+	applogger.InitTestLogger()
+
+	names := []string{ParamUniversityID, ParamDepartmentID, ParamMajorID}
+	values := []string{"1", "2", "3"}
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase := new(mockAcademicYearUsecase)
+		mockUsecase.On("CompareYears", mock.Anything, testPath, 2024, 2025).Return(&usecases.YearComparison{
+			FromYear: 2024,
+			ToYear:   2025,
+			Schedules: []usecases.ScheduleDiff{{
+				Name:   "前",
+				Status: usecases.DiffStatusChanged,
+				TestTypes: []usecases.TestTypeDiff{{
+					Name:    "二次",
+					Status:  usecases.DiffStatusChanged,
+					Changed: []usecases.SubjectDiff{{Name: "数学", FromScore: 200, ToScore: 300, ScoreDelta: 100}},
+				}},
+			}},
+		}, nil)
+
+		h := NewAcademicYearHandler(mockUsecase, 2*time.Second)
+		c, rec := newTestContextWithTarget("/?from=2024&to=2025", names, values)
+
+		require.NoError(t, h.CompareYears(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"score_delta":100`)
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("年度の指定がない", func(t *testing.T) {
+		mockUsecase := new(mockAcademicYearUsecase)
+
+		h := NewAcademicYearHandler(mockUsecase, 2*time.Second)
+		c, rec := newTestContextWithTarget("/?from=2024", names, values)
+
+		require.NoError(t, h.CompareYears(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockUsecase.AssertNotCalled(t, "CompareYears", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("同一年度の比較", func(t *testing.T) {
+		mockUsecase := new(mockAcademicYearUsecase)
+		mockUsecase.On("CompareYears", mock.Anything, testPath, 2025, 2025).
+			Return(nil, appErrors.NewInvalidInputError("to", "比較する年度は異なる必要があります", nil))
+
+		h := NewAcademicYearHandler(mockUsecase, 2*time.Second)
+		c, rec := newTestContextWithTarget("/?from=2025&to=2025", names, values)
+
+		require.NoError(t, h.CompareYears(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
@@ -0,0 +1,167 @@
+package admissioninfo
+
+import (
+	"context"
+	"net/http"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/infrastructure/audit"
+	applogger "university-exam-api/internal/logger"
+	"university-exam-api/internal/pkg/errors"
+	"university-exam-api/internal/pkg/etag"
+	"university-exam-api/internal/pkg/preview"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	logGetAdmissionDifficultySuccess    = "合格難易度の取得に成功しました (入試情報ID: %d)"
+	logCreateAdmissionDifficultySuccess = "合格難易度の作成に成功しました (入試情報ID: %d, ID: %d)"
+	logUpdateAdmissionDifficultySuccess = "合格難易度の更新に成功しました (入試情報ID: %d, ID: %d)"
+	logDeleteAdmissionDifficultySuccess = "合格難易度の削除に成功しました (入試情報ID: %d)"
+)
+
+// findScheduleAdmissionInfo は入試日程に属する入試情報を取得します。
+// 指定された入試日程に属さない入試情報の場合はNotFoundエラーを返します
+func (h *Handler) findScheduleAdmissionInfo(ctx context.Context, c echo.Context) (*models.AdmissionInfo, error) {
+	scheduleID, infoID, err := h.validateScheduleAndInfoID(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.repo.FindAdmissionInfo(ctx, scheduleID, infoID)
+}
+
+// GetAdmissionDifficulty は入試情報の合格難易度を取得します。
+// この関数は以下の処理を行います：
+// - 入試日程に属する入試情報の確認
+// - 公開中でない入試情報の除外（プレビュー時を除く）
+// - 合格難易度の取得
+func (h *Handler) GetAdmissionDifficulty(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	info, err := h.findScheduleAdmissionInfo(ctx, c)
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	// 公開前・公開終了の入試情報の合格難易度は管理者のプレビュー時のみ返却する
+	if info.Status != models.AdmissionStatusPublished && !preview.IncludeDrafts(ctx) {
+		return errors.HandleError(c, appErrors.NewNotFoundError("入試情報", info.ID, nil))
+	}
+
+	difficulty, err := h.repo.FindAdmissionDifficulty(ctx, info.ID)
+	if err != nil {
+		applogger.Error(ctx, "合格難易度の取得に失敗しました (入試情報ID: %d): %v", info.ID, err)
+		return errors.HandleError(c, err)
+	}
+
+	applogger.Info(ctx, logGetAdmissionDifficultySuccess, info.ID)
+
+	etag.SetHeader(c, difficulty.Version)
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"data": difficulty,
+	})
+}
+
+// CreateAdmissionDifficulty は入試情報に合格難易度を登録します。
+// この関数は以下の処理を行います：
+// - 入試日程に属する入試情報の確認
+// - リクエストのバインディング（ボーダー得点・共通テスト得点率・偏差値帯・出典）
+// - データベースへの保存（登録済みの場合は現在の状態とともに409を返却）
+// - エラーハンドリング
+func (h *Handler) CreateAdmissionDifficulty(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	info, err := h.findScheduleAdmissionInfo(ctx, c)
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	var difficulty models.AdmissionDifficulty
+	if err := h.bindRequest(ctx, c, &difficulty); err != nil {
+		return err
+	}
+
+	difficulty.ID = 0
+	difficulty.AdmissionInfoID = info.ID
+	audit.StampCreate(ctx, &difficulty.BaseModel)
+
+	if err := h.repo.CreateAdmissionDifficulty(ctx, &difficulty); err != nil {
+		applogger.Error(ctx, "合格難易度の作成に失敗しました (入試情報ID: %d): %v", info.ID, err)
+		return errors.HandleError(c, err)
+	}
+
+	applogger.Info(ctx, logCreateAdmissionDifficultySuccess, info.ID, difficulty.ID)
+
+	return c.JSON(http.StatusCreated, map[string]interface{}{
+		"data": difficulty,
+	})
+}
+
+// UpdateAdmissionDifficulty は入試情報の合格難易度を更新します。
+// この関数は以下の処理を行います：
+// - 入試日程に属する入試情報の確認
+// - 期待するバージョン（If-Match またはボディの version）の取得
+// - データベースの更新（バージョンが一致しない場合は409を返却）
+// - エラーハンドリング
+func (h *Handler) UpdateAdmissionDifficulty(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	info, err := h.findScheduleAdmissionInfo(ctx, c)
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	var difficulty models.AdmissionDifficulty
+	if err := h.bindRequest(ctx, c, &difficulty); err != nil {
+		return err
+	}
+
+	expectedVersion, err := etag.ExpectedVersion(c, difficulty.Version)
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	difficulty.AdmissionInfoID = info.ID
+	difficulty.Version = expectedVersion
+	audit.StampUpdate(ctx, &difficulty.BaseModel)
+
+	if err := h.repo.UpdateAdmissionDifficulty(ctx, &difficulty); err != nil {
+		applogger.Error(ctx, "合格難易度の更新に失敗しました (入試情報ID: %d): %v", info.ID, err)
+		return errors.HandleError(c, err)
+	}
+
+	applogger.Info(ctx, logUpdateAdmissionDifficultySuccess, info.ID, difficulty.ID)
+
+	etag.SetHeader(c, difficulty.Version)
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"data": difficulty,
+	})
+}
+
+// DeleteAdmissionDifficulty は入試情報の合格難易度を削除します。
+// 入試情報は削除しません。
+func (h *Handler) DeleteAdmissionDifficulty(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	info, err := h.findScheduleAdmissionInfo(ctx, c)
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	if err := h.repo.DeleteAdmissionDifficulty(ctx, info.ID); err != nil {
+		applogger.Error(ctx, "合格難易度の削除に失敗しました (入試情報ID: %d): %v", info.ID, err)
+		return errors.HandleError(c, err)
+	}
+
+	applogger.Info(ctx, logDeleteAdmissionDifficultySuccess, info.ID)
+
+	return c.NoContent(http.StatusNoContent)
+}
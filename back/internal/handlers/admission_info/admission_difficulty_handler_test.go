@@ -0,0 +1,226 @@
+package admissioninfo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	applogger "university-exam-api/internal/logger"
+	"university-exam-api/internal/pkg/etag"
+	"university-exam-api/internal/pkg/preview"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const schedule1Info2DifficultyPath = "/schedules/1/admission-info/2/difficulty"
+
+// findInfoWithStatus は指定したステータスの入試情報を返す取得関数を生成します
+func findInfoWithStatus(status string) func(scheduleID, infoID uint) (*models.AdmissionInfo, error) {
+	return func(scheduleID, infoID uint) (*models.AdmissionInfo, error) {
+		return &models.AdmissionInfo{
+			BaseModel:           models.BaseModel{ID: infoID, Version: 1},
+			AdmissionScheduleID: scheduleID,
+			AcademicYear:        2025,
+			Status:              status,
+		}, nil
+	}
+}
+
+// newDifficultyContext は合格難易度APIのテスト用コンテキストを生成します
+func newDifficultyContext(req *http.Request, rec *httptest.ResponseRecorder) echo.Context {
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("scheduleID", "infoID")
+	c.SetParamValues("1", "2")
+
+	return c
+}
+
+// --- 合格難易度取得APIのテスト ---
+func TestGetAdmissionDifficulty(t *testing.T) {
+	applogger.InitTestLogger()
+
+	rate := 82.5
+	difficulty := func(infoID uint) (*models.AdmissionDifficulty, error) {
+		return &models.AdmissionDifficulty{
+			BaseModel:       models.BaseModel{ID: 5, Version: 3},
+			AdmissionInfoID: infoID,
+			CommonTestRate:  &rate,
+		}, nil
+	}
+
+	t.Run("公開中の入試情報の合格難易度を返却", func(t *testing.T) {
+		h := newTestHandler(&mockUniversityRepo{
+			FindAdmissionInfoFunc:       findInfoWithStatus(models.AdmissionStatusPublished),
+			FindAdmissionDifficultyFunc: difficulty,
+		})
+
+		rec := httptest.NewRecorder()
+		c := newDifficultyContext(httptest.NewRequest(http.MethodGet, schedule1Info2DifficultyPath, nil), rec)
+
+		require.NoError(t, h.GetAdmissionDifficulty(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"3"`, rec.Header().Get(etag.HeaderETag))
+		assert.Contains(t, rec.Body.String(), `"common_test_rate":82.5`)
+		assert.NotContains(t, rec.Body.String(), "border_score")
+	})
+
+	t.Run("下書きの入試情報はプレビュー時のみ返却", func(t *testing.T) {
+		h := newTestHandler(&mockUniversityRepo{
+			FindAdmissionInfoFunc:       findInfoWithStatus(models.AdmissionStatusDraft),
+			FindAdmissionDifficultyFunc: difficulty,
+		})
+
+		rec := httptest.NewRecorder()
+		c := newDifficultyContext(httptest.NewRequest(http.MethodGet, schedule1Info2DifficultyPath, nil), rec)
+
+		require.NoError(t, h.GetAdmissionDifficulty(c))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+
+		req := httptest.NewRequest(http.MethodGet, schedule1Info2DifficultyPath, nil)
+		req = req.WithContext(preview.WithDrafts(req.Context()))
+		rec = httptest.NewRecorder()
+
+		require.NoError(t, h.GetAdmissionDifficulty(newDifficultyContext(req, rec)))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("未登録の場合は404", func(t *testing.T) {
+		h := newTestHandler(&mockUniversityRepo{
+			FindAdmissionInfoFunc: findInfoWithStatus(models.AdmissionStatusPublished),
+			FindAdmissionDifficultyFunc: func(infoID uint) (*models.AdmissionDifficulty, error) {
+				return nil, appErrors.NewNotFoundError("合格難易度", infoID, nil)
+			},
+		})
+
+		rec := httptest.NewRecorder()
+		c := newDifficultyContext(httptest.NewRequest(http.MethodGet, schedule1Info2DifficultyPath, nil), rec)
+
+		require.NoError(t, h.GetAdmissionDifficulty(c))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+// --- 合格難易度作成APIのテスト ---
+func TestCreateAdmissionDifficulty(t *testing.T) {
+	applogger.InitTestLogger()
+
+	called := false
+	h := newTestHandler(&mockUniversityRepo{
+		FindAdmissionInfoFunc: findInfoWithStatus(models.AdmissionStatusDraft),
+		CreateAdmissionDifficultyFunc: func(difficulty *models.AdmissionDifficulty) error {
+			called = true
+			assert.Zero(t, difficulty.ID)
+			assert.Equal(t, uint(2), difficulty.AdmissionInfoID, "パスの入試情報に登録する")
+			assert.Equal(t, 720.0, *difficulty.BorderScore)
+			assert.Equal(t, 62.5, *difficulty.DeviationLow)
+			assert.Equal(t, 65.0, *difficulty.DeviationHigh)
+			assert.Nil(t, difficulty.CommonTestRate)
+			difficulty.ID = 5
+			return nil
+		},
+	})
+
+	body := `{"id":9,"admission_info_id":99,"border_score":720,"deviation_low":62.5,"deviation_high":65,"source":"河合塾"}`
+	req := httptest.NewRequest(http.MethodPost, schedule1Info2DifficultyPath, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	require.NoError(t, h.CreateAdmissionDifficulty(newDifficultyContext(req, rec)))
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.True(t, called)
+
+	t.Run("登録済みの場合は409", func(t *testing.T) {
+		h := newTestHandler(&mockUniversityRepo{
+			FindAdmissionInfoFunc: findInfoWithStatus(models.AdmissionStatusPublished),
+			CreateAdmissionDifficultyFunc: func(_ *models.AdmissionDifficulty) error {
+				return appErrors.NewConflictError("合格難易度", 5, 2, nil)
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodPost, schedule1Info2DifficultyPath, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+
+		require.NoError(t, h.CreateAdmissionDifficulty(newDifficultyContext(req, rec)))
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+}
+
+// --- 合格難易度更新APIのテスト ---
+func TestUpdateAdmissionDifficulty(t *testing.T) {
+	applogger.InitTestLogger()
+
+	h := newTestHandler(&mockUniversityRepo{
+		FindAdmissionInfoFunc: findInfoWithStatus(models.AdmissionStatusPublished),
+		UpdateAdmissionDifficultyFunc: func(difficulty *models.AdmissionDifficulty) error {
+			assert.Equal(t, uint(2), difficulty.AdmissionInfoID)
+			assert.Equal(t, 2, difficulty.Version, "If-Matchのバージョンを期待するバージョンとする")
+			difficulty.ID = 5
+			difficulty.Version++
+			return nil
+		},
+	})
+
+	body := `{"common_test_rate":84}`
+
+	t.Run("バージョン指定で更新", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPut, schedule1Info2DifficultyPath, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(etag.HeaderIfMatch, `"2"`)
+		rec := httptest.NewRecorder()
+
+		require.NoError(t, h.UpdateAdmissionDifficulty(newDifficultyContext(req, rec)))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"3"`, rec.Header().Get(etag.HeaderETag))
+	})
+
+	t.Run("バージョン未指定は428", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPut, schedule1Info2DifficultyPath, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+
+		require.NoError(t, h.UpdateAdmissionDifficulty(newDifficultyContext(req, rec)))
+		assert.Equal(t, http.StatusPreconditionRequired, rec.Code)
+	})
+}
+
+// --- 合格難易度削除APIのテスト ---
+func TestDeleteAdmissionDifficulty(t *testing.T) {
+	applogger.InitTestLogger()
+
+	called := false
+	h := newTestHandler(&mockUniversityRepo{
+		FindAdmissionInfoFunc: findInfoWithStatus(models.AdmissionStatusPublished),
+		DeleteAdmissionDifficultyFunc: func(infoID uint) error {
+			called = true
+			assert.Equal(t, uint(2), infoID)
+			return nil
+		},
+	})
+
+	rec := httptest.NewRecorder()
+	c := newDifficultyContext(httptest.NewRequest(http.MethodDelete, schedule1Info2DifficultyPath, nil), rec)
+
+	require.NoError(t, h.DeleteAdmissionDifficulty(c))
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.True(t, called)
+
+	t.Run("入試情報が見つからない場合は404", func(t *testing.T) {
+		h := newTestHandler(&mockUniversityRepo{
+			FindAdmissionInfoFunc: func(_, infoID uint) (*models.AdmissionInfo, error) {
+				return nil, appErrors.NewNotFoundError("入試情報", infoID, nil)
+			},
+		})
+
+		rec := httptest.NewRecorder()
+		c := newDifficultyContext(httptest.NewRequest(http.MethodDelete, schedule1Info2DifficultyPath, nil), rec)
+
+		require.NoError(t, h.DeleteAdmissionDifficulty(c))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
@@ -12,8 +12,12 @@ import (
 	"net/http"
 	"time"
 	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/infrastructure/audit"
 	applogger "university-exam-api/internal/logger"
 	"university-exam-api/internal/pkg/errors"
+	"university-exam-api/internal/pkg/etag"
+	"university-exam-api/internal/pkg/preview"
 	"university-exam-api/internal/pkg/validation"
 	"university-exam-api/internal/repositories"
 
@@ -101,11 +105,12 @@ func NewHandler(repo repositories.IUniversityRepository, timeout time.Duration)
 		[]string{"operation"},
 	)
 
-	prometheus.MustRegister(requestDuration)
-	prometheus.MustRegister(errorCounter)
-	prometheus.MustRegister(requestSize)
-	prometheus.MustRegister(responseSize)
-	prometheus.MustRegister(dbDuration)
+	// 同じプロセスでハンドラーを複数回生成した場合は登録済みのメトリクスを共有する
+	requestDuration = registerCollector(requestDuration)
+	errorCounter = registerCollector(errorCounter)
+	requestSize = registerCollector(requestSize)
+	responseSize = registerCollector(responseSize)
+	dbDuration = registerCollector(dbDuration)
 
 	return &Handler{
 		repo:           repo,
@@ -118,6 +123,22 @@ func NewHandler(repo repositories.IUniversityRepository, timeout time.Duration)
 	}
 }
 
+// registerCollector はメトリクスを登録します。
+// 同じメトリクスが登録済みの場合は、登録済みのメトリクスを返します。
+func registerCollector[T prometheus.Collector](collector T) T {
+	if err := prometheus.Register(collector); err != nil {
+		if are, ok := err.(prometheus.AlreadyRegisteredError); ok {
+			if existing, ok := are.ExistingCollector.(T); ok {
+				return existing
+			}
+		}
+
+		panic(err)
+	}
+
+	return collector
+}
+
 // bindRequest はリクエストボディのバインディングを共通化します。
 // この関数は以下の処理を行います：
 // - リクエストボディのバインディング
@@ -138,12 +159,12 @@ func (h *Handler) bindRequest(ctx context.Context, c echo.Context, data interfac
 // - 情報IDの検証
 // - エラーハンドリング
 func (h *Handler) validateScheduleAndInfoID(ctx context.Context, c echo.Context) (uint, uint, error) {
-	scheduleID, err := validation.ValidateScheduleID(ctx, c.Param("scheduleId"))
+	scheduleID, err := validation.ValidateScheduleID(ctx, c.Param("scheduleID"))
 	if err != nil {
 		return 0, 0, errors.NewValidationError("無効な入試日程ID形式です")
 	}
 
-	infoID, err := validation.ValidateAdmissionInfoID(ctx, c.Param("infoId"))
+	infoID, err := validation.ValidateAdmissionInfoID(ctx, c.Param("infoID"))
 	if err != nil {
 		return 0, 0, errors.NewValidationError("無効な募集情報ID形式です")
 	}
@@ -155,6 +176,7 @@ func (h *Handler) validateScheduleAndInfoID(ctx context.Context, c echo.Context)
 // この関数は以下の処理を行います：
 // - パラメータの検証
 // - データベースからの取得
+// - 公開中でない募集情報の除外（プレビュー時を除く）
 // - パフォーマンスメトリクスの収集
 // - エラーハンドリング
 func (h *Handler) GetAdmissionInfo(c echo.Context) error {
@@ -179,7 +201,7 @@ func (h *Handler) GetAdmissionInfo(c echo.Context) error {
 
 	// データベース操作の処理時間計測
 	dbStart := time.Now()
-	info, err := h.repo.FindAdmissionInfo(scheduleID, infoID)
+	info, err := h.repo.FindAdmissionInfo(ctx, scheduleID, infoID)
 	h.dbDuration.WithLabelValues("find").Observe(time.Since(dbStart).Seconds())
 
 	if err != nil {
@@ -189,6 +211,11 @@ func (h *Handler) GetAdmissionInfo(c echo.Context) error {
 		return errors.HandleError(c, err)
 	}
 
+	// 公開前・公開終了の募集情報は管理者のプレビュー時のみ返却する
+	if info.Status != models.AdmissionStatusPublished && !preview.IncludeDrafts(ctx) {
+		return errors.HandleError(c, appErrors.NewNotFoundError("入試情報", infoID, nil))
+	}
+
 	// レスポンスサイズの計測
 	if responseData, err := json.Marshal(info); err == nil {
 		h.responseSize.WithLabelValues("GET", AdmissionInfoPath).Observe(float64(len(responseData)))
@@ -196,19 +223,21 @@ func (h *Handler) GetAdmissionInfo(c echo.Context) error {
 
 	applogger.Info(ctx, applogger.LogGetAdmissionInfoSuccess, scheduleID, infoID)
 
+	etag.SetHeader(c, info.Version)
+
 	return c.JSON(http.StatusOK, info)
 }
 
 // CreateAdmissionInfo は新しい募集情報を作成します。
 // この関数は以下の処理を行います：
 // - リクエストのバリデーション
-// - データベースへの保存
+// - 下書きステータスでのデータベースへの保存
 // - エラーハンドリング
 func (h *Handler) CreateAdmissionInfo(c echo.Context) error {
 	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
 	defer cancel()
 
-	scheduleID, err := validation.ValidateScheduleID(ctx, c.Param("scheduleId"))
+	scheduleID, err := validation.ValidateScheduleID(ctx, c.Param("scheduleID"))
 	if err != nil {
 		return errors.NewValidationError("無効な入試日程ID形式です")
 	}
@@ -219,7 +248,13 @@ func (h *Handler) CreateAdmissionInfo(c echo.Context) error {
 	}
 
 	info.AdmissionScheduleID = scheduleID
-	if err := h.repo.CreateAdmissionInfo(&info); err != nil {
+	// 合格難易度は専用のエンドポイントで登録する
+	info.Difficulty = nil
+	// 新規作成した募集情報は公開ワークフローを経るまで下書きとする
+	info.Status = models.AdmissionStatusDraft
+	audit.StampCreate(ctx, &info.BaseModel)
+
+	if err := h.repo.CreateAdmissionInfo(ctx, &info); err != nil {
 		applogger.Error(ctx, ErrMsgCreateAdmissionInfo, err)
 
 		return errors.HandleError(c, err)
@@ -234,7 +269,8 @@ func (h *Handler) CreateAdmissionInfo(c echo.Context) error {
 // この関数は以下の処理を行います：
 // - パラメータの検証
 // - リクエストのバリデーション
-// - データベースの更新
+// - 期待するバージョン（If-Match またはボディの version）の取得
+// - データベースの更新（バージョンが一致しない場合は現在の状態とともに409を返却）
 // - エラーハンドリング
 func (h *Handler) UpdateAdmissionInfo(c echo.Context) error {
 	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
@@ -250,16 +286,26 @@ func (h *Handler) UpdateAdmissionInfo(c echo.Context) error {
 		return err
 	}
 
+	expectedVersion, err := etag.ExpectedVersion(c, info.Version)
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
 	info.ID = infoID
 	info.AdmissionScheduleID = scheduleID
+	info.Version = expectedVersion
+	info.Difficulty = nil
+	audit.StampUpdate(ctx, &info.BaseModel)
 
-	if err := h.repo.UpdateAdmissionInfo(&info); err != nil {
+	if err := h.repo.UpdateAdmissionInfo(ctx, &info); err != nil {
 		applogger.Error(ctx, ErrMsgUpdateAdmissionInfo, infoID, err)
 		return errors.HandleError(c, err)
 	}
 
 	applogger.Info(ctx, applogger.LogUpdateAdmissionInfoSuccess, infoID)
 
+	etag.SetHeader(c, info.Version)
+
 	return c.JSON(http.StatusOK, info)
 }
 
@@ -272,12 +318,12 @@ func (h *Handler) DeleteAdmissionInfo(c echo.Context) error {
 	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
 	defer cancel()
 
-	infoID, err := validation.ValidateAdmissionInfoID(ctx, c.Param("infoId"))
+	infoID, err := validation.ValidateAdmissionInfoID(ctx, c.Param("infoID"))
 	if err != nil {
 		return errors.NewValidationError("無効な募集情報ID形式です")
 	}
 
-	if err := h.repo.DeleteAdmissionInfo(infoID); err != nil {
+	if err := h.repo.DeleteAdmissionInfo(ctx, infoID); err != nil {
 		applogger.Error(ctx, ErrMsgDeleteAdmissionInfo, infoID, err)
 
 		return errors.HandleError(c, err)
@@ -11,6 +11,11 @@ import (
 
 	"university-exam-api/internal/domain/models"
 	applogger "university-exam-api/internal/logger"
+	"university-exam-api/internal/pkg/etag"
+	"university-exam-api/internal/pkg/pagination"
+	"university-exam-api/internal/pkg/preview"
+	"university-exam-api/internal/pkg/textsearch"
+	"university-exam-api/internal/repositories"
 
 	"github.com/labstack/echo/v4"
 	"github.com/prometheus/client_golang/prometheus"
@@ -27,13 +32,33 @@ type mockUniversityRepo struct {
 	CreateAdmissionInfoFunc func(info *models.AdmissionInfo) error
 	UpdateAdmissionInfoFunc func(info *models.AdmissionInfo) error
 	DeleteAdmissionInfoFunc func(infoID uint) error
+	FindAdmissionDifficultyFunc   func(infoID uint) (*models.AdmissionDifficulty, error)
+	CreateAdmissionDifficultyFunc func(difficulty *models.AdmissionDifficulty) error
+	UpdateAdmissionDifficultyFunc func(difficulty *models.AdmissionDifficulty) error
+	DeleteAdmissionDifficultyFunc func(infoID uint) error
 }
 
-func (m *mockUniversityRepo) FindAdmissionInfo(scheduleID, infoID uint) (*models.AdmissionInfo, error) {
+func (m *mockUniversityRepo) FindAdmissionDifficulty(_ context.Context, infoID uint) (*models.AdmissionDifficulty, error) {
+	return m.FindAdmissionDifficultyFunc(infoID)
+}
+
+func (m *mockUniversityRepo) CreateAdmissionDifficulty(_ context.Context, difficulty *models.AdmissionDifficulty) error {
+	return m.CreateAdmissionDifficultyFunc(difficulty)
+}
+
+func (m *mockUniversityRepo) UpdateAdmissionDifficulty(_ context.Context, difficulty *models.AdmissionDifficulty) error {
+	return m.UpdateAdmissionDifficultyFunc(difficulty)
+}
+
+func (m *mockUniversityRepo) DeleteAdmissionDifficulty(_ context.Context, infoID uint) error {
+	return m.DeleteAdmissionDifficultyFunc(infoID)
+}
+
+func (m *mockUniversityRepo) FindAdmissionInfo(_ context.Context, scheduleID, infoID uint) (*models.AdmissionInfo, error) {
 	return m.FindAdmissionInfoFunc(scheduleID, infoID)
 }
 
-func (m *mockUniversityRepo) CreateAdmissionInfo(info *models.AdmissionInfo) error {
+func (m *mockUniversityRepo) CreateAdmissionInfo(_ context.Context, info *models.AdmissionInfo) error {
 	if m.CreateAdmissionInfoFunc != nil {
 		return m.CreateAdmissionInfoFunc(info)
 	}
@@ -41,7 +66,7 @@ func (m *mockUniversityRepo) CreateAdmissionInfo(info *models.AdmissionInfo) err
 	panic(errNotImplemented)
 }
 
-func (m *mockUniversityRepo) UpdateAdmissionInfo(info *models.AdmissionInfo) error {
+func (m *mockUniversityRepo) UpdateAdmissionInfo(_ context.Context, info *models.AdmissionInfo) error {
 	if m.UpdateAdmissionInfoFunc != nil {
 		return m.UpdateAdmissionInfoFunc(info)
 	}
@@ -49,7 +74,7 @@ func (m *mockUniversityRepo) UpdateAdmissionInfo(info *models.AdmissionInfo) err
 	panic(errNotImplemented)
 }
 
-func (m *mockUniversityRepo) DeleteAdmissionInfo(infoID uint) error {
+func (m *mockUniversityRepo) DeleteAdmissionInfo(_ context.Context, infoID uint) error {
 	if m.DeleteAdmissionInfoFunc != nil {
 		return m.DeleteAdmissionInfoFunc(infoID)
 	}
@@ -59,25 +84,82 @@ func (m *mockUniversityRepo) DeleteAdmissionInfo(infoID uint) error {
 
 // 他のIUniversityRepositoryメソッドはpanicでOK
 func (m *mockUniversityRepo) FindAll(_ context.Context) ([]models.University, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) FindByID(_ uint) (*models.University, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) Search(_ string) ([]models.University, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) Create(_ *models.University) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) Update(_ *models.University) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) Delete(_ uint) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) CreateDepartment(_ *models.Department) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateDepartment(_ *models.Department) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) DeleteDepartment(_ uint) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) CreateSubject(_ *models.Subject) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateSubject(_ *models.Subject) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) DeleteSubject(_ uint) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) CreateMajor(_ *models.Major) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateMajor(_ *models.Major) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) DeleteMajor(_ uint) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) FindDepartment(_, _ uint) (*models.Department, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) FindSubject(_, _ uint) (*models.Subject, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) FindMajor(_, _ uint) (*models.Major, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateSubjectsBatch(_ uint, _ []models.Subject) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateAdmissionSchedule(_ *models.AdmissionSchedule) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindPage(_ context.Context, _ pagination.Params) (*repositories.UniversityPage, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) SearchPage(_ context.Context, _ string, _ pagination.Params) (*repositories.UniversityPage, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) Suggest(_ context.Context, _ string, _ []string, _ int) (textsearch.Suggestions, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) SearchHits(_ context.Context, _ string) ([]textsearch.Hit, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) WarmSearchIndex(_ context.Context) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) InvalidateSearchIndex()                  {}
+func (m *mockUniversityRepo) ImportRows(
+	_ context.Context,
+	_ []repositories.ImportRow,
+	_ bool,
+) (*repositories.ImportResult, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindByID(_ context.Context, _ uint) (*models.University, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) Search(_ context.Context, _ string) ([]models.University, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) Create(_ context.Context, _ *models.University) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) Update(_ context.Context, _ *models.University) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) Delete(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateDepartment(_ context.Context, _ *models.Department) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateDepartment(_ context.Context, _ *models.Department) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteDepartment(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateSubject(_ context.Context, _ *models.Subject) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateSubject(_ context.Context, _ *models.Subject) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteSubject(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateMajor(_ context.Context, _ *models.Major) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateMajor(_ context.Context, _ *models.Major) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteMajor(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindDepartment(_ context.Context, _, _ uint) (*models.Department, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindSubject(_ context.Context, _, _ uint) (*models.Subject, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindMajor(_ context.Context, _, _ uint) (*models.Major, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateSubjectsBatch(_ context.Context, _ uint, _ []models.Subject) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateAdmissionSchedule(_ context.Context, _ *models.AdmissionSchedule) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindAdmissionInfoForPublication(_ context.Context, _ uint) (*models.AdmissionInfo, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) UpdateAdmissionInfoStatus(_ context.Context, _ *models.AdmissionInfo, _ string) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindAdmissionSchedule(_ context.Context, _, _ uint) (*models.AdmissionSchedule, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) CreateAdmissionSchedule(_ context.Context, _ *models.AdmissionSchedule) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteAdmissionSchedule(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindTestType(_ context.Context, _, _ uint) (*models.TestType, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateTestType(_ context.Context, _ *models.TestType) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateTestType(_ context.Context, _ *models.TestType) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteTestType(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateSubjectGroup(_ context.Context, _ *models.SubjectGroup) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) UpdateSubjectGroup(_ context.Context, _ *models.SubjectGroup) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) DeleteSubjectGroup(_ context.Context, _, _ uint) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindTrash(_ context.Context, _ string, _ pagination.Params) (*repositories.TrashPage, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) RestoreFromTrash(_ context.Context, _ string, _ uint) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) PurgeFromTrash(_ context.Context, _ string, _ uint) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) PurgeTrashBefore(_ context.Context, _ time.Time) (int64, error) {
+	panic(errNotImplemented)
+}
 
 func newTestHandler(repo *mockUniversityRepo) *Handler {
 	return &Handler{
@@ -127,7 +209,7 @@ func TestGetAdmissionInfoSuccess(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, schedule1Info2Path, nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	c.SetParamNames("scheduleId", "infoId")
+	c.SetParamNames("scheduleID", "infoID")
 	c.SetParamValues("1", "2")
 
 	err := h.GetAdmissionInfo(c)
@@ -136,6 +218,49 @@ func TestGetAdmissionInfoSuccess(t *testing.T) {
 	assert.Contains(t, rec.Body.String(), "published")
 }
 
+// --- 下書きの募集情報取得APIのテスト ---
+func TestGetAdmissionInfoDraft(t *testing.T) {
+	applogger.InitTestLogger()
+
+	e := echo.New()
+	mockRepo := &mockUniversityRepo{
+		FindAdmissionInfoFunc: func(_, infoID uint) (*models.AdmissionInfo, error) {
+			return &models.AdmissionInfo{
+				BaseModel:           models.BaseModel{ID: infoID, Version: 1},
+				AdmissionScheduleID: 1,
+				Enrollment:          100,
+				AcademicYear:        2024,
+				Status:              models.AdmissionStatusDraft,
+			}, nil
+		},
+	}
+	h := newTestHandler(mockRepo)
+
+	t.Run("プレビューでない場合は404", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, schedule1Info2Path, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("scheduleID", "infoID")
+		c.SetParamValues("1", "2")
+
+		require.NoError(t, h.GetAdmissionInfo(c))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("プレビュー時は下書きを返却", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, schedule1Info2Path, nil)
+		req = req.WithContext(preview.WithDrafts(req.Context()))
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("scheduleID", "infoID")
+		c.SetParamValues("1", "2")
+
+		require.NoError(t, h.GetAdmissionInfo(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), models.AdmissionStatusDraft)
+	})
+}
+
 // --- 募集情報取得APIの異常系テスト ---
 func TestGetAdmissionInfoError(t *testing.T) {
 	applogger.InitTestLogger()
@@ -151,7 +276,7 @@ func TestGetAdmissionInfoError(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, schedule1Info2Path, nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	c.SetParamNames("scheduleId", "infoId")
+	c.SetParamNames("scheduleID", "infoID")
 	c.SetParamValues("1", "2")
 
 	err := h.GetAdmissionInfo(c)
@@ -172,6 +297,7 @@ func TestCreateAdmissionInfoSuccess(t *testing.T) {
 			assert.Equal(t, uint(1), info.AdmissionScheduleID)
 			assert.Equal(t, 100, info.Enrollment)
 			assert.Equal(t, 2024, info.AcademicYear)
+			assert.Equal(t, models.AdmissionStatusDraft, info.Status)
 			return nil
 		},
 	}
@@ -183,7 +309,7 @@ func TestCreateAdmissionInfoSuccess(t *testing.T) {
 
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	c.SetParamNames("scheduleId")
+	c.SetParamNames("scheduleID")
 	c.SetParamValues("1")
 
 	err := h.CreateAdmissionInfo(c)
@@ -210,7 +336,7 @@ func TestCreateAdmissionInfoError(t *testing.T) {
 
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	c.SetParamNames("scheduleId")
+	c.SetParamNames("scheduleID")
 	c.SetParamValues("1")
 
 	err := h.CreateAdmissionInfo(c)
@@ -238,11 +364,12 @@ func TestUpdateAdmissionInfoSuccess(t *testing.T) {
 
 	body := `{"enrollment":150,"academic_year":2024,"status":"published"}`
 	req := httptest.NewRequest(http.MethodPut, schedule1Info2Path, strings.NewReader(body))
+	req.Header.Set(etag.HeaderIfMatch, `"1"`)
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	c.SetParamNames("scheduleId", "infoId")
+	c.SetParamNames("scheduleID", "infoID")
 	c.SetParamValues("1", "2")
 
 	err := h.UpdateAdmissionInfo(c)
@@ -265,11 +392,12 @@ func TestUpdateAdmissionInfoError(t *testing.T) {
 
 	body := `{"enrollment":150,"academic_year":2024,"status":"published"}`
 	req := httptest.NewRequest(http.MethodPut, schedule1Info2Path, strings.NewReader(body))
+	req.Header.Set(etag.HeaderIfMatch, `"1"`)
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	c.SetParamNames("scheduleId", "infoId")
+	c.SetParamNames("scheduleID", "infoID")
 	c.SetParamValues("1", "2")
 
 	err := h.UpdateAdmissionInfo(c)
@@ -296,7 +424,7 @@ func TestDeleteAdmissionInfoSuccess(t *testing.T) {
 	req := httptest.NewRequest(http.MethodDelete, schedule1Info2Path, nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	c.SetParamNames("infoId")
+	c.SetParamNames("infoID")
 	c.SetParamValues("2")
 
 	err := h.DeleteAdmissionInfo(c)
@@ -320,7 +448,7 @@ func TestDeleteAdmissionInfoError(t *testing.T) {
 	req := httptest.NewRequest(http.MethodDelete, schedule1Info2Path, nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	c.SetParamNames("infoId")
+	c.SetParamNames("infoID")
 	c.SetParamValues("2")
 
 	err := h.DeleteAdmissionInfo(c)
@@ -358,6 +486,11 @@ func TestNewHandler(t *testing.T) {
 	assert.NotNil(t, handler.requestSize)
 	assert.NotNil(t, handler.responseSize)
 	assert.NotNil(t, handler.dbDuration)
+
+	// 2回目の生成では登録済みのメトリクスを共有する
+	another := NewHandler(repo, timeout)
+	assert.Same(t, handler.requestDuration, another.requestDuration)
+	assert.Same(t, handler.dbDuration, another.dbDuration)
 }
 
 // --- bindRequest関数のテスト ---
@@ -403,7 +536,7 @@ func TestValidateScheduleAndInfoID(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, schedule1Info2Path, nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	c.SetParamNames("scheduleId", "infoId")
+	c.SetParamNames("scheduleID", "infoID")
 	c.SetParamValues("1", "2")
 
 	scheduleID, infoID, err := h.validateScheduleAndInfoID(ctx, c)
@@ -415,7 +548,7 @@ func TestValidateScheduleAndInfoID(t *testing.T) {
 	req = httptest.NewRequest(http.MethodGet, schedule1Info2Path, nil)
 	rec = httptest.NewRecorder()
 	c = e.NewContext(req, rec)
-	c.SetParamNames("scheduleId", "infoId")
+	c.SetParamNames("scheduleID", "infoID")
 	c.SetParamValues("invalid", "2")
 
 	_, _, err = h.validateScheduleAndInfoID(ctx, c)
@@ -425,7 +558,7 @@ func TestValidateScheduleAndInfoID(t *testing.T) {
 	req = httptest.NewRequest(http.MethodGet, schedule1Info2Path, nil)
 	rec = httptest.NewRecorder()
 	c = e.NewContext(req, rec)
-	c.SetParamNames("scheduleId", "infoId")
+	c.SetParamNames("scheduleID", "infoID")
 	c.SetParamValues("1", "invalid")
 
 	_, _, err = h.validateScheduleAndInfoID(ctx, c)
@@ -8,11 +8,14 @@ package admissionschedule
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"time"
 	"university-exam-api/internal/domain/models"
+	"university-exam-api/internal/infrastructure/audit"
 	applogger "university-exam-api/internal/logger"
 	"university-exam-api/internal/pkg/errors"
+	"university-exam-api/internal/pkg/etag"
 	"university-exam-api/internal/pkg/validation"
 	"university-exam-api/internal/repositories"
 
@@ -91,12 +94,12 @@ func (h *Handler) bindRequest(ctx context.Context, c echo.Context, data interfac
 // - スケジュールIDの検証
 // - エラーハンドリング
 func (h *Handler) validateMajorAndScheduleID(ctx context.Context, c echo.Context) (uint, uint, error) {
-	majorID, err := validation.ValidateMajorID(ctx, c.Param("majorId"))
+	majorID, err := validation.ValidateMajorID(ctx, c.Param("majorID"))
 	if err != nil {
 		return 0, 0, err
 	}
 
-	scheduleID, err := validation.ValidateScheduleID(ctx, c.Param("scheduleId"))
+	scheduleID, err := validation.ValidateScheduleID(ctx, c.Param("scheduleID"))
 	if err != nil {
 		return 0, 0, err
 	}
@@ -104,11 +107,126 @@ func (h *Handler) validateMajorAndScheduleID(ctx context.Context, c echo.Context
 	return majorID, scheduleID, nil
 }
 
-// UpdateAdmissionSchedule は入試日程を更新します。
+// validateScheduleRequest は入試日程リクエストのバリデーションを共通化します。
+// この関数は以下の処理を行います：
+// - 日程名が日程レジストリに登録されていることの検証
+// - 表示順の検証
+func (h *Handler) validateScheduleRequest(schedule *models.AdmissionSchedule) error {
+	if _, ok := models.Schedules().Lookup(schedule.Name); !ok {
+		return errors.NewValidationError(fmt.Sprintf(
+			"日程名は%sのいずれかである必要があります",
+			models.Schedules().Describe(),
+		))
+	}
+
+	if schedule.DisplayOrder < 0 || schedule.DisplayOrder > models.MaxScheduleDisplayOrder {
+		return errors.NewValidationError(fmt.Sprintf("表示順は0から%dの範囲で指定してください", models.MaxScheduleDisplayOrder))
+	}
+
+	return nil
+}
+
+// ListDefinitions は日程レジストリに登録された日程の定義を表示順に取得します。
+// 入試日程の作成・更新では、この一覧の日程名（name）を指定します。
+func (h *Handler) ListDefinitions(c echo.Context) error {
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"data": models.Schedules().Definitions(),
+	})
+}
+
+// GetAdmissionSchedule は指定された入試日程を取得します。
+// この関数は以下の処理を行います：
+// - パラメータの検証
+// - データベースからの取得
+// - パフォーマンスメトリクスの収集
+// - エラーハンドリング
+func (h *Handler) GetAdmissionSchedule(c echo.Context) error {
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+
+	defer cancel()
+
+	majorID, scheduleID, err := h.validateMajorAndScheduleID(ctx, c)
+	if err != nil {
+		h.errorCounter.WithLabelValues(c.Request().Method, c.Path(), "validation").Inc()
+		return errors.HandleError(c, err)
+	}
+
+	dbStart := time.Now()
+	schedule, err := h.repo.FindAdmissionSchedule(ctx, majorID, scheduleID)
+	h.dbDuration.WithLabelValues("find").Observe(time.Since(dbStart).Seconds())
+
+	if err != nil {
+		h.errorCounter.WithLabelValues(c.Request().Method, c.Path(), "database").Inc()
+		applogger.Error(ctx, "入試日程の取得に失敗しました (学科ID: %d, 入試日程ID: %d): %v", majorID, scheduleID, err)
+
+		return errors.HandleError(c, err)
+	}
+
+	applogger.Info(ctx, "入試日程を取得しました (学科ID: %d, 入試日程ID: %d)", majorID, scheduleID)
+	h.requestDuration.WithLabelValues(c.Request().Method, c.Path(), "200").Observe(time.Since(start).Seconds())
+
+	etag.SetHeader(c, schedule.Version)
+
+	return c.JSON(http.StatusOK, schedule)
+}
+
+// CreateAdmissionSchedule は新しい入試日程を作成します。
 // この関数は以下の処理を行います：
 // - パラメータの検証
 // - リクエストのバリデーション
-// - データベースの更新
+// - データベースへの保存
+// - パフォーマンスメトリクスの収集
+// - エラーハンドリング
+func (h *Handler) CreateAdmissionSchedule(c echo.Context) error {
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+
+	defer cancel()
+
+	majorID, err := validation.ValidateMajorID(ctx, c.Param("majorID"))
+	if err != nil {
+		h.errorCounter.WithLabelValues(c.Request().Method, c.Path(), "validation").Inc()
+		return errors.HandleError(c, err)
+	}
+
+	var schedule models.AdmissionSchedule
+	if err := h.bindRequest(ctx, c, &schedule); err != nil {
+		h.errorCounter.WithLabelValues(c.Request().Method, c.Path(), "binding").Inc()
+		return err
+	}
+
+	if err := h.validateScheduleRequest(&schedule); err != nil {
+		h.errorCounter.WithLabelValues(c.Request().Method, c.Path(), "validation").Inc()
+		return errors.HandleError(c, err)
+	}
+
+	schedule.MajorID = majorID
+	audit.StampCreate(ctx, &schedule.BaseModel)
+
+	dbStart := time.Now()
+	err = h.repo.CreateAdmissionSchedule(ctx, &schedule)
+	h.dbDuration.WithLabelValues("create").Observe(time.Since(dbStart).Seconds())
+
+	if err != nil {
+		h.errorCounter.WithLabelValues(c.Request().Method, c.Path(), "database").Inc()
+		applogger.Error(ctx, "入試日程の作成に失敗しました: %v", err)
+
+		return errors.HandleError(c, err)
+	}
+
+	applogger.Info(ctx, "入試日程ID %dを作成しました", schedule.ID)
+	h.requestDuration.WithLabelValues(c.Request().Method, c.Path(), "201").Observe(time.Since(start).Seconds())
+
+	return c.JSON(http.StatusCreated, schedule)
+}
+
+// UpdateAdmissionSchedule は入試日程を更新します。
+// この関数は以下の処理を行います：
+// - パラメータの検証
+// - リクエストのバリデーション（日程名・表示順）
+// - 期待するバージョン（If-Match またはボディの version）の取得
+// - データベースの更新（バージョンが一致しない場合は現在の状態とともに409を返却）
 // - パフォーマンスメトリクスの収集
 // - エラーハンドリング
 func (h *Handler) UpdateAdmissionSchedule(c echo.Context) error {
@@ -129,11 +247,24 @@ func (h *Handler) UpdateAdmissionSchedule(c echo.Context) error {
 		return err
 	}
 
+	if err := h.validateScheduleRequest(&schedule); err != nil {
+		h.errorCounter.WithLabelValues(c.Request().Method, c.Path(), "validation").Inc()
+		return errors.HandleError(c, err)
+	}
+
+	expectedVersion, err := etag.ExpectedVersion(c, schedule.Version)
+	if err != nil {
+		h.errorCounter.WithLabelValues(c.Request().Method, c.Path(), "precondition").Inc()
+		return errors.HandleError(c, err)
+	}
+
 	schedule.ID = scheduleID
 	schedule.MajorID = majorID
+	schedule.Version = expectedVersion
+	audit.StampUpdate(ctx, &schedule.BaseModel)
 
 	dbStart := time.Now()
-	err = h.repo.UpdateAdmissionSchedule(&schedule)
+	err = h.repo.UpdateAdmissionSchedule(ctx, &schedule)
 
 	if err != nil {
 		h.errorCounter.WithLabelValues(c.Request().Method, c.Path(), "database").Inc()
@@ -147,5 +278,42 @@ func (h *Handler) UpdateAdmissionSchedule(c echo.Context) error {
 	applogger.Info(ctx, "入試日程ID %dを更新しました", scheduleID)
 	h.requestDuration.WithLabelValues(c.Request().Method, c.Path(), "200").Observe(time.Since(start).Seconds())
 
+	etag.SetHeader(c, schedule.Version)
+
 	return c.JSON(http.StatusOK, schedule)
 }
+
+// DeleteAdmissionSchedule は入試日程を、入試情報・試験種別などの配下の要素とともに削除します。
+// この関数は以下の処理を行います：
+// - パラメータの検証
+// - データベースからの削除（ゴミ箱から復元可能）
+// - パフォーマンスメトリクスの収集
+// - エラーハンドリング
+func (h *Handler) DeleteAdmissionSchedule(c echo.Context) error {
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+
+	defer cancel()
+
+	_, scheduleID, err := h.validateMajorAndScheduleID(ctx, c)
+	if err != nil {
+		h.errorCounter.WithLabelValues(c.Request().Method, c.Path(), "validation").Inc()
+		return errors.HandleError(c, err)
+	}
+
+	dbStart := time.Now()
+	err = h.repo.DeleteAdmissionSchedule(ctx, scheduleID)
+	h.dbDuration.WithLabelValues("delete").Observe(time.Since(dbStart).Seconds())
+
+	if err != nil {
+		h.errorCounter.WithLabelValues(c.Request().Method, c.Path(), "database").Inc()
+		applogger.Error(ctx, "入試日程ID %dの削除に失敗しました: %v", scheduleID, err)
+
+		return errors.HandleError(c, err)
+	}
+
+	applogger.Info(ctx, "入試日程ID %dを削除しました", scheduleID)
+	h.requestDuration.WithLabelValues(c.Request().Method, c.Path(), "204").Observe(time.Since(start).Seconds())
+
+	return c.NoContent(http.StatusNoContent)
+}
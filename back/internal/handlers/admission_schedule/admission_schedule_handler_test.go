@@ -12,6 +12,10 @@ import (
 
 	"university-exam-api/internal/domain/models"
 	applogger "university-exam-api/internal/logger"
+	"university-exam-api/internal/pkg/etag"
+	"university-exam-api/internal/pkg/pagination"
+	"university-exam-api/internal/pkg/textsearch"
+	"university-exam-api/internal/repositories"
 
 	"github.com/labstack/echo/v4"
 	"github.com/prometheus/client_golang/prometheus"
@@ -23,35 +27,111 @@ const errNotImplemented = "not implemented"
 
 type mockUniversityRepo struct {
 	UpdateAdmissionScheduleFunc func(schedule *models.AdmissionSchedule) error
+	FindAdmissionScheduleFunc   func(majorID, scheduleID uint) (*models.AdmissionSchedule, error)
+	CreateAdmissionScheduleFunc func(schedule *models.AdmissionSchedule) error
+	DeleteAdmissionScheduleFunc func(ctx context.Context, id uint) error
 }
 
-func (m *mockUniversityRepo) UpdateAdmissionSchedule(schedule *models.AdmissionSchedule) error {
+func (m *mockUniversityRepo) UpdateAdmissionSchedule(_ context.Context, schedule *models.AdmissionSchedule) error {
 	return m.UpdateAdmissionScheduleFunc(schedule)
 }
 // 他のIUniversityRepositoryメソッドはpanicでOK
 func (m *mockUniversityRepo) FindAll(_ context.Context) ([]models.University, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) FindByID(_ uint) (*models.University, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) Search(_ string) ([]models.University, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) Create(_ *models.University) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) Update(_ *models.University) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) Delete(_ uint) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) CreateDepartment(_ *models.Department) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateDepartment(_ *models.Department) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) DeleteDepartment(_ uint) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) CreateSubject(_ *models.Subject) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateSubject(_ *models.Subject) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) DeleteSubject(_ uint) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) CreateMajor(_ *models.Major) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateMajor(_ *models.Major) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) DeleteMajor(_ uint) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) CreateAdmissionInfo(_ *models.AdmissionInfo) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) DeleteAdmissionInfo(_ uint) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateAdmissionInfo(_ *models.AdmissionInfo) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) FindDepartment(_, _ uint) (*models.Department, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) FindSubject(_, _ uint) (*models.Subject, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) FindMajor(_, _ uint) (*models.Major, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) FindAdmissionInfo(_, _ uint) (*models.AdmissionInfo, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateSubjectsBatch(_ uint, _ []models.Subject) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindPage(_ context.Context, _ pagination.Params) (*repositories.UniversityPage, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) SearchPage(_ context.Context, _ string, _ pagination.Params) (*repositories.UniversityPage, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) Suggest(_ context.Context, _ string, _ []string, _ int) (textsearch.Suggestions, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) SearchHits(_ context.Context, _ string) ([]textsearch.Hit, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) WarmSearchIndex(_ context.Context) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) InvalidateSearchIndex()                  {}
+func (m *mockUniversityRepo) ImportRows(
+	_ context.Context,
+	_ []repositories.ImportRow,
+	_ bool,
+) (*repositories.ImportResult, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindByID(_ context.Context, _ uint) (*models.University, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) Search(_ context.Context, _ string) ([]models.University, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) Create(_ context.Context, _ *models.University) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) Update(_ context.Context, _ *models.University) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) Delete(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateDepartment(_ context.Context, _ *models.Department) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateDepartment(_ context.Context, _ *models.Department) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteDepartment(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateSubject(_ context.Context, _ *models.Subject) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateSubject(_ context.Context, _ *models.Subject) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteSubject(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateMajor(_ context.Context, _ *models.Major) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateMajor(_ context.Context, _ *models.Major) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteMajor(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateAdmissionInfo(_ context.Context, _ *models.AdmissionInfo) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteAdmissionInfo(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateAdmissionInfo(_ context.Context, _ *models.AdmissionInfo) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindAdmissionInfoForPublication(_ context.Context, _ uint) (*models.AdmissionInfo, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) UpdateAdmissionInfoStatus(_ context.Context, _ *models.AdmissionInfo, _ string) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindAdmissionSchedule(_ context.Context, majorID, scheduleID uint) (*models.AdmissionSchedule, error) {
+	return m.FindAdmissionScheduleFunc(majorID, scheduleID)
+}
+func (m *mockUniversityRepo) CreateAdmissionSchedule(_ context.Context, schedule *models.AdmissionSchedule) error {
+	return m.CreateAdmissionScheduleFunc(schedule)
+}
+func (m *mockUniversityRepo) DeleteAdmissionSchedule(ctx context.Context, id uint) error {
+	return m.DeleteAdmissionScheduleFunc(ctx, id)
+}
+func (m *mockUniversityRepo) FindTestType(_ context.Context, _, _ uint) (*models.TestType, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateTestType(_ context.Context, _ *models.TestType) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateTestType(_ context.Context, _ *models.TestType) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteTestType(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateSubjectGroup(_ context.Context, _ *models.SubjectGroup) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) UpdateSubjectGroup(_ context.Context, _ *models.SubjectGroup) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) DeleteSubjectGroup(_ context.Context, _, _ uint) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindAdmissionDifficulty(_ context.Context, _ uint) (*models.AdmissionDifficulty, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) CreateAdmissionDifficulty(_ context.Context, _ *models.AdmissionDifficulty) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) UpdateAdmissionDifficulty(_ context.Context, _ *models.AdmissionDifficulty) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) DeleteAdmissionDifficulty(_ context.Context, _ uint) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindTrash(_ context.Context, _ string, _ pagination.Params) (*repositories.TrashPage, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) RestoreFromTrash(_ context.Context, _ string, _ uint) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) PurgeFromTrash(_ context.Context, _ string, _ uint) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) PurgeTrashBefore(_ context.Context, _ time.Time) (int64, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindDepartment(_ context.Context, _, _ uint) (*models.Department, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindSubject(_ context.Context, _, _ uint) (*models.Subject, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindMajor(_ context.Context, _, _ uint) (*models.Major, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindAdmissionInfo(_ context.Context, _, _ uint) (*models.AdmissionInfo, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateSubjectsBatch(_ context.Context, _ uint, _ []models.Subject) error { panic(errNotImplemented) }
 
 func newTestHandler(repo *mockUniversityRepo) *Handler {
 	return &Handler{
@@ -85,22 +165,23 @@ func TestUpdateAdmissionScheduleSuccess(t *testing.T) {
 	h := newTestHandler(mockRepo)
 
 	body := models.AdmissionSchedule{
-		Name: "前期",
+		Name: "全学部日程",
 	}
 	jsonBody, _ := json.Marshal(body)
 
 	req := httptest.NewRequest(http.MethodPut, "/majors/1/schedules/2", bytes.NewReader(jsonBody))
+	req.Header.Set(etag.HeaderIfMatch, `"1"`)
 	req.Header.Set("Content-Type", "application/json")
 
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	c.SetParamNames("majorId", "scheduleId")
+	c.SetParamNames("majorID", "scheduleID")
 	c.SetParamValues("1", "2")
 
 	err := h.UpdateAdmissionSchedule(c)
 	require.NoError(t, err)
 	assert.Equal(t, http.StatusOK, rec.Code)
-	assert.Contains(t, rec.Body.String(), "前期")
+	assert.Contains(t, rec.Body.String(), "全学部日程")
 }
 
 // --- 入試日程更新APIの異常系テスト ---
@@ -116,16 +197,17 @@ func TestUpdateAdmissionScheduleError(t *testing.T) {
 	h := newTestHandler(mockRepo)
 
 	body := models.AdmissionSchedule{
-		Name: "後期",
+		Name: "後",
 	}
 	jsonBody, _ := json.Marshal(body)
 
 	req := httptest.NewRequest(http.MethodPut, "/majors/1/schedules/2", bytes.NewReader(jsonBody))
+	req.Header.Set(etag.HeaderIfMatch, `"1"`)
 	req.Header.Set("Content-Type", "application/json")
 
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	c.SetParamNames("majorId", "scheduleId")
+	c.SetParamNames("majorID", "scheduleID")
 	c.SetParamValues("1", "2")
 
 	err := h.UpdateAdmissionSchedule(c)
@@ -133,3 +215,182 @@ func TestUpdateAdmissionScheduleError(t *testing.T) {
 	assert.Equal(t, http.StatusInternalServerError, rec.Code)
 	assert.Contains(t, rec.Body.String(), "エラー")
 }
+
+// --- 入試日程取得APIのテスト ---
+func TestGetAdmissionSchedule(t *testing.T) {
+	applogger.InitTestLogger()
+
+	t.Run("正常系", func(t *testing.T) {
+		mockRepo := &mockUniversityRepo{
+			FindAdmissionScheduleFunc: func(majorID, scheduleID uint) (*models.AdmissionSchedule, error) {
+				assert.Equal(t, uint(1), majorID)
+				assert.Equal(t, uint(2), scheduleID)
+
+				return &models.AdmissionSchedule{
+					BaseModel: models.BaseModel{ID: scheduleID, Version: 3},
+					MajorID:   majorID,
+					Name:      "前",
+				}, nil
+			},
+		}
+		h := newTestHandler(mockRepo)
+
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/majors/1/schedules/2", nil), rec)
+		c.SetParamNames("majorID", "scheduleID")
+		c.SetParamValues("1", "2")
+
+		require.NoError(t, h.GetAdmissionSchedule(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"3"`, rec.Header().Get(etag.HeaderETag))
+		assert.Contains(t, rec.Body.String(), `"name":"前"`)
+	})
+
+	t.Run("不正な入試日程ID", func(t *testing.T) {
+		h := newTestHandler(&mockUniversityRepo{})
+
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/majors/1/schedules/abc", nil), rec)
+		c.SetParamNames("majorID", "scheduleID")
+		c.SetParamValues("1", "abc")
+
+		require.NoError(t, h.GetAdmissionSchedule(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+// --- 入試日程作成APIのテスト ---
+func TestCreateAdmissionSchedule(t *testing.T) {
+	applogger.InitTestLogger()
+
+	t.Run("正常系", func(t *testing.T) {
+		var created *models.AdmissionSchedule
+
+		mockRepo := &mockUniversityRepo{
+			CreateAdmissionScheduleFunc: func(schedule *models.AdmissionSchedule) error {
+				schedule.ID = 5
+				created = schedule
+
+				return nil
+			},
+		}
+		h := newTestHandler(mockRepo)
+
+		jsonBody, _ := json.Marshal(models.AdmissionSchedule{Name: "後", DisplayOrder: 2})
+		req := httptest.NewRequest(http.MethodPost, "/majors/1/schedules", bytes.NewReader(jsonBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		c.SetParamNames("majorID")
+		c.SetParamValues("1")
+
+		require.NoError(t, h.CreateAdmissionSchedule(c))
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		require.NotNil(t, created)
+		assert.Equal(t, uint(1), created.MajorID)
+	})
+
+	t.Run("日程レジストリに登録された私立の方式", func(t *testing.T) {
+		mockRepo := &mockUniversityRepo{
+			CreateAdmissionScheduleFunc: func(schedule *models.AdmissionSchedule) error {
+				assert.Equal(t, "共通テスト利用（前期）", schedule.Name)
+				return nil
+			},
+		}
+		h := newTestHandler(mockRepo)
+
+		jsonBody, _ := json.Marshal(models.AdmissionSchedule{Name: "共通テスト利用（前期）", DisplayOrder: 7})
+		req := httptest.NewRequest(http.MethodPost, "/majors/1/schedules", bytes.NewReader(jsonBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		c.SetParamNames("majorID")
+		c.SetParamValues("1")
+
+		require.NoError(t, h.CreateAdmissionSchedule(c))
+		assert.Equal(t, http.StatusCreated, rec.Code)
+	})
+
+	t.Run("不正な日程名", func(t *testing.T) {
+		h := newTestHandler(&mockUniversityRepo{})
+
+		jsonBody, _ := json.Marshal(models.AdmissionSchedule{Name: "前期"})
+		req := httptest.NewRequest(http.MethodPost, "/majors/1/schedules", bytes.NewReader(jsonBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		c.SetParamNames("majorID")
+		c.SetParamValues("1")
+
+		require.NoError(t, h.CreateAdmissionSchedule(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+// --- 入試日程削除APIのテスト ---
+func TestDeleteAdmissionSchedule(t *testing.T) {
+	applogger.InitTestLogger()
+
+	mockRepo := &mockUniversityRepo{
+		DeleteAdmissionScheduleFunc: func(_ context.Context, id uint) error {
+			assert.Equal(t, uint(2), id)
+			return nil
+		},
+	}
+	h := newTestHandler(mockRepo)
+
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(httptest.NewRequest(http.MethodDelete, "/majors/1/schedules/2", nil), rec)
+	c.SetParamNames("majorID", "scheduleID")
+	c.SetParamValues("1", "2")
+
+	require.NoError(t, h.DeleteAdmissionSchedule(c))
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+// --- 入試日程更新APIの日程名の検証のテスト ---
+func TestUpdateAdmissionScheduleInvalidName(t *testing.T) {
+	applogger.InitTestLogger()
+
+	h := newTestHandler(&mockUniversityRepo{
+		UpdateAdmissionScheduleFunc: func(_ *models.AdmissionSchedule) error {
+			t.Fatal("日程名が不正な場合は更新しない")
+			return nil
+		},
+	})
+
+	jsonBody, _ := json.Marshal(models.AdmissionSchedule{Name: "C方式"})
+	req := httptest.NewRequest(http.MethodPut, "/majors/1/schedules/2", bytes.NewReader(jsonBody))
+	req.Header.Set(etag.HeaderIfMatch, `"1"`)
+	req.Header.Set("Content-Type", "application/json")
+
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("majorID", "scheduleID")
+	c.SetParamValues("1", "2")
+
+	require.NoError(t, h.UpdateAdmissionSchedule(c))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+// --- 日程レジストリAPIのテスト ---
+func TestListDefinitions(t *testing.T) {
+	h := newTestHandler(&mockUniversityRepo{})
+
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/api/schedule-types", nil), rec)
+
+	require.NoError(t, h.ListDefinitions(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var body struct {
+		Data []models.ScheduleDefinition `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.Len(t, body.Data, len(models.Schedules().Names()))
+	assert.Equal(t, "前", body.Data[0].Name)
+	assert.Equal(t, "前期", body.Data[0].Label)
+}
@@ -11,8 +11,10 @@ import (
 	"net/http"
 	"time"
 	"university-exam-api/internal/domain/models"
+	"university-exam-api/internal/infrastructure/audit"
 	applogger "university-exam-api/internal/logger"
 	"university-exam-api/internal/pkg/errors"
+	"university-exam-api/internal/pkg/etag"
 	"university-exam-api/internal/pkg/validation"
 	"university-exam-api/internal/repositories"
 
@@ -109,12 +111,12 @@ func (h *Handler) validateDepartmentRequest(department *models.Department) error
 // - 学部IDの検証
 // - エラーハンドリング
 func (h *Handler) validateUniversityAndDepartmentID(ctx context.Context, c echo.Context) (uint, uint, error) {
-	universityID, err := validation.ValidateUniversityID(ctx, c.Param("universityId"))
+	universityID, err := validation.ValidateUniversityID(ctx, c.Param("universityID"))
 	if err != nil {
 		return 0, 0, err
 	}
 
-	departmentID, err := validation.ValidateDepartmentID(ctx, c.Param("departmentId"))
+	departmentID, err := validation.ValidateDepartmentID(ctx, c.Param("departmentID"))
 	if err != nil {
 		return 0, 0, err
 	}
@@ -143,7 +145,7 @@ func (h *Handler) GetDepartment(c echo.Context) error {
 	}
 
 	dbStart := time.Now()
-	department, err := h.repo.FindDepartment(universityID, departmentID)
+	department, err := h.repo.FindDepartment(ctx, universityID, departmentID)
 
 	if err != nil {
 		h.errorCounter.WithLabelValues(c.Request().Method, c.Path(), "database").Inc()
@@ -157,6 +159,8 @@ func (h *Handler) GetDepartment(c echo.Context) error {
 	applogger.Info(ctx, applogger.LogGetDepartmentSuccess, universityID, departmentID)
 	h.requestDuration.WithLabelValues(c.Request().Method, c.Path(), "200").Observe(time.Since(start).Seconds())
 
+	etag.SetHeader(c, department.Version)
+
 	return c.JSON(http.StatusOK, map[string]interface{}{
 		"data": department,
 	})
@@ -171,7 +175,7 @@ func (h *Handler) CreateDepartment(c echo.Context) error {
 	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
 	defer cancel()
 
-	universityID, err := validation.ValidateUniversityID(ctx, c.Param("universityId"))
+	universityID, err := validation.ValidateUniversityID(ctx, c.Param("universityID"))
 	if err != nil {
 		return errors.HandleError(c, err)
 	}
@@ -186,7 +190,9 @@ func (h *Handler) CreateDepartment(c echo.Context) error {
 	}
 
 	department.UniversityID = universityID
-	if err := h.repo.CreateDepartment(&department); err != nil {
+	audit.StampCreate(ctx, &department.BaseModel)
+
+	if err := h.repo.CreateDepartment(ctx, &department); err != nil {
 		applogger.Error(ctx, "学部の作成に失敗しました: %v", err)
 		return errors.HandleError(c, err)
 	}
@@ -202,7 +208,8 @@ func (h *Handler) CreateDepartment(c echo.Context) error {
 // この関数は以下の処理を行います：
 // - パラメータの検証
 // - リクエストのバリデーション
-// - データベースの更新
+// - 期待するバージョン（If-Match またはボディの version）の取得
+// - データベースの更新（バージョンが一致しない場合は現在の状態とともに409を返却）
 // - エラーハンドリング
 func (h *Handler) UpdateDepartment(c echo.Context) error {
 	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
@@ -222,14 +229,24 @@ func (h *Handler) UpdateDepartment(c echo.Context) error {
 		return errors.HandleError(c, err)
 	}
 
+	expectedVersion, err := etag.ExpectedVersion(c, department.Version)
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
 	department.ID = departmentID
-	if err := h.repo.UpdateDepartment(&department); err != nil {
+	department.Version = expectedVersion
+	audit.StampUpdate(ctx, &department.BaseModel)
+
+	if err := h.repo.UpdateDepartment(ctx, &department); err != nil {
 		applogger.Error(ctx, "学部ID %dの更新に失敗しました: %v", departmentID, err)
 		return errors.HandleError(c, err)
 	}
 
 	applogger.Info(ctx, applogger.LogUpdateDepartmentSuccess, departmentID)
 
+	etag.SetHeader(c, department.Version)
+
 	return c.JSON(http.StatusOK, map[string]interface{}{
 		"data": department,
 	})
@@ -249,7 +266,7 @@ func (h *Handler) DeleteDepartment(c echo.Context) error {
 		return errors.HandleError(c, err)
 	}
 
-	if err := h.repo.DeleteDepartment(departmentID); err != nil {
+	if err := h.repo.DeleteDepartment(ctx, departmentID); err != nil {
 		applogger.Error(ctx, "学部ID %dの削除に失敗しました: %v", departmentID, err)
 		return errors.HandleError(c, err)
 	}
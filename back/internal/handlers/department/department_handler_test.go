@@ -10,7 +10,12 @@ import (
 	"time"
 
 	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
 	applogger "university-exam-api/internal/logger"
+	"university-exam-api/internal/pkg/etag"
+	"university-exam-api/internal/pkg/pagination"
+	"university-exam-api/internal/pkg/textsearch"
+	"university-exam-api/internal/repositories"
 
 	"github.com/labstack/echo/v4"
 	"github.com/prometheus/client_golang/prometheus"
@@ -29,39 +34,108 @@ type mockUniversityRepo struct {
 	DeleteDepartmentFunc func(uint) error
 }
 
-func (m *mockUniversityRepo) FindDepartment(universityID, departmentID uint) (*models.Department, error) {
+func (m *mockUniversityRepo) FindDepartment(_ context.Context, universityID, departmentID uint) (*models.Department, error) {
 	return m.FindDepartmentFunc(universityID, departmentID)
 }
 // 他のIUniversityRepositoryメソッドはpanicでOK
 func (m *mockUniversityRepo) FindAll(_ context.Context) ([]models.University, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) FindByID(_ uint) (*models.University, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) Search(_ string) ([]models.University, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) Create(_ *models.University) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) Update(_ *models.University) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) Delete(_ uint) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) CreateDepartment(department *models.Department) error {
+func (m *mockUniversityRepo) FindPage(_ context.Context, _ pagination.Params) (*repositories.UniversityPage, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) SearchPage(_ context.Context, _ string, _ pagination.Params) (*repositories.UniversityPage, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) Suggest(_ context.Context, _ string, _ []string, _ int) (textsearch.Suggestions, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) SearchHits(_ context.Context, _ string) ([]textsearch.Hit, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) WarmSearchIndex(_ context.Context) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) InvalidateSearchIndex()                  {}
+func (m *mockUniversityRepo) ImportRows(
+	_ context.Context,
+	_ []repositories.ImportRow,
+	_ bool,
+) (*repositories.ImportResult, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindByID(_ context.Context, _ uint) (*models.University, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) Search(_ context.Context, _ string) ([]models.University, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) Create(_ context.Context, _ *models.University) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) Update(_ context.Context, _ *models.University) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) Delete(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateDepartment(_ context.Context, department *models.Department) error {
 	return m.CreateDepartmentFunc(department)
 }
-func (m *mockUniversityRepo) UpdateDepartment(department *models.Department) error {
+func (m *mockUniversityRepo) UpdateDepartment(_ context.Context, department *models.Department) error {
 	return m.UpdateDepartmentFunc(department)
 }
-func (m *mockUniversityRepo) DeleteDepartment(departmentID uint) error {
+func (m *mockUniversityRepo) DeleteDepartment(_ context.Context, departmentID uint) error {
 	return m.DeleteDepartmentFunc(departmentID)
 }
-func (m *mockUniversityRepo) CreateSubject(_ *models.Subject) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateSubject(_ *models.Subject) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) DeleteSubject(_ uint) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) CreateMajor(_ *models.Major) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateMajor(_ *models.Major) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) DeleteMajor(_ uint) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) CreateAdmissionInfo(_ *models.AdmissionInfo) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) DeleteAdmissionInfo(_ uint) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateAdmissionInfo(_ *models.AdmissionInfo) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) FindSubject(_, _ uint) (*models.Subject, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) FindMajor(_, _ uint) (*models.Major, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) FindAdmissionInfo(_, _ uint) (*models.AdmissionInfo, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateSubjectsBatch(_ uint, _ []models.Subject) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateAdmissionSchedule(_ *models.AdmissionSchedule) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateSubject(_ context.Context, _ *models.Subject) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateSubject(_ context.Context, _ *models.Subject) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteSubject(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateMajor(_ context.Context, _ *models.Major) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateMajor(_ context.Context, _ *models.Major) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteMajor(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateAdmissionInfo(_ context.Context, _ *models.AdmissionInfo) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteAdmissionInfo(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateAdmissionInfo(_ context.Context, _ *models.AdmissionInfo) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindSubject(_ context.Context, _, _ uint) (*models.Subject, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindMajor(_ context.Context, _, _ uint) (*models.Major, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindAdmissionInfo(_ context.Context, _, _ uint) (*models.AdmissionInfo, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateSubjectsBatch(_ context.Context, _ uint, _ []models.Subject) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateAdmissionSchedule(_ context.Context, _ *models.AdmissionSchedule) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindAdmissionInfoForPublication(_ context.Context, _ uint) (*models.AdmissionInfo, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) UpdateAdmissionInfoStatus(_ context.Context, _ *models.AdmissionInfo, _ string) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindAdmissionSchedule(_ context.Context, _, _ uint) (*models.AdmissionSchedule, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) CreateAdmissionSchedule(_ context.Context, _ *models.AdmissionSchedule) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteAdmissionSchedule(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindTestType(_ context.Context, _, _ uint) (*models.TestType, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateTestType(_ context.Context, _ *models.TestType) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateTestType(_ context.Context, _ *models.TestType) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteTestType(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateSubjectGroup(_ context.Context, _ *models.SubjectGroup) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) UpdateSubjectGroup(_ context.Context, _ *models.SubjectGroup) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) DeleteSubjectGroup(_ context.Context, _, _ uint) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindAdmissionDifficulty(_ context.Context, _ uint) (*models.AdmissionDifficulty, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) CreateAdmissionDifficulty(_ context.Context, _ *models.AdmissionDifficulty) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) UpdateAdmissionDifficulty(_ context.Context, _ *models.AdmissionDifficulty) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) DeleteAdmissionDifficulty(_ context.Context, _ uint) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindTrash(_ context.Context, _ string, _ pagination.Params) (*repositories.TrashPage, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) RestoreFromTrash(_ context.Context, _ string, _ uint) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) PurgeFromTrash(_ context.Context, _ string, _ uint) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) PurgeTrashBefore(_ context.Context, _ time.Time) (int64, error) {
+	panic(errNotImplemented)
+}
 
 func TestGetDepartmentSuccess(t *testing.T) {
 	applogger.InitTestLogger()
@@ -82,7 +156,7 @@ func TestGetDepartmentSuccess(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, university1Department2Path, nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	c.SetParamNames("universityId", "departmentId")
+	c.SetParamNames("universityID", "departmentID")
 	c.SetParamValues("1", "2")
 
 	err := h.GetDepartment(c)
@@ -105,7 +179,7 @@ func TestGetDepartmentError(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, university1Department2Path, nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	c.SetParamNames("universityId", "departmentId")
+	c.SetParamNames("universityID", "departmentID")
 	c.SetParamValues("1", "2")
 
 	err := h.GetDepartment(c)
@@ -210,7 +284,7 @@ func TestValidateUniversityAndDepartmentID(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, university1Department2Path, nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	c.SetParamNames("universityId", "departmentId")
+	c.SetParamNames("universityID", "departmentID")
 	c.SetParamValues("1", "2")
 
 	universityID, departmentID, err := h.validateUniversityAndDepartmentID(ctx, c)
@@ -222,7 +296,7 @@ func TestValidateUniversityAndDepartmentID(t *testing.T) {
 	req = httptest.NewRequest(http.MethodGet, "/universities/invalid/departments/2", nil)
 	rec = httptest.NewRecorder()
 	c = e.NewContext(req, rec)
-	c.SetParamNames("universityId", "departmentId")
+	c.SetParamNames("universityID", "departmentID")
 	c.SetParamValues("invalid", "2")
 
 	_, _, err = h.validateUniversityAndDepartmentID(ctx, c)
@@ -232,7 +306,7 @@ func TestValidateUniversityAndDepartmentID(t *testing.T) {
 	req = httptest.NewRequest(http.MethodGet, "/universities/1/departments/invalid", nil)
 	rec = httptest.NewRecorder()
 	c = e.NewContext(req, rec)
-	c.SetParamNames("universityId", "departmentId")
+	c.SetParamNames("universityID", "departmentID")
 	c.SetParamValues("1", "invalid")
 
 	_, _, err = h.validateUniversityAndDepartmentID(ctx, c)
@@ -260,7 +334,7 @@ func TestCreateDepartmentSuccess(t *testing.T) {
 
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	c.SetParamNames("universityId")
+	c.SetParamNames("universityID")
 	c.SetParamValues("1")
 
 	err := h.CreateDepartment(c)
@@ -286,7 +360,7 @@ func TestCreateDepartmentError(t *testing.T) {
 
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	c.SetParamNames("universityId")
+	c.SetParamNames("universityID")
 	c.SetParamValues("1")
 
 	err := h.CreateDepartment(c)
@@ -312,11 +386,12 @@ func TestUpdateDepartmentSuccess(t *testing.T) {
 
 	body := `{"name":"更新された学部","university_id":1}`
 	req := httptest.NewRequest(http.MethodPut, university1Department2Path, strings.NewReader(body))
+	req.Header.Set(etag.HeaderIfMatch, `"1"`)
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	c.SetParamNames("universityId", "departmentId")
+	c.SetParamNames("universityID", "departmentID")
 	c.SetParamValues("1", "2")
 
 	err := h.UpdateDepartment(c)
@@ -338,11 +413,12 @@ func TestUpdateDepartmentError(t *testing.T) {
 
 	body := `{"name":"更新された学部","university_id":1}`
 	req := httptest.NewRequest(http.MethodPut, university1Department2Path, strings.NewReader(body))
+	req.Header.Set(etag.HeaderIfMatch, `"1"`)
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	c.SetParamNames("universityId", "departmentId")
+	c.SetParamNames("universityID", "departmentID")
 	c.SetParamValues("1", "2")
 
 	err := h.UpdateDepartment(c)
@@ -351,6 +427,39 @@ func TestUpdateDepartmentError(t *testing.T) {
 	assert.Contains(t, rec.Body.String(), "DBエラー")
 }
 
+func TestUpdateDepartmentConflict(t *testing.T) {
+	applogger.InitTestLogger()
+
+	e := echo.New()
+	current := &models.Department{
+		BaseModel:    models.BaseModel{ID: 2, Version: 5},
+		Name:         "他の管理者が更新した学部",
+		UniversityID: 1,
+	}
+	mockRepo := &mockUniversityRepo{
+		UpdateDepartmentFunc: func(department *models.Department) error {
+			assert.Equal(t, 4, department.Version)
+			return appErrors.NewConflictError("学部", department.ID, current.Version, current)
+		},
+	}
+	h := NewDepartmentHandler(mockRepo, 2*time.Second)
+
+	body := `{"name":"更新された学部","university_id":1,"version":4}`
+	req := httptest.NewRequest(http.MethodPut, university1Department2Path, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("universityID", "departmentID")
+	c.SetParamValues("1", "2")
+
+	err := h.UpdateDepartment(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Equal(t, `"5"`, rec.Header().Get(etag.HeaderETag))
+	assert.Contains(t, rec.Body.String(), "他の管理者が更新した学部")
+}
+
 func TestDeleteDepartmentSuccess(t *testing.T) {
 	applogger.InitTestLogger()
 
@@ -368,7 +477,7 @@ func TestDeleteDepartmentSuccess(t *testing.T) {
 	req := httptest.NewRequest(http.MethodDelete, university1Department2Path, nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	c.SetParamNames("universityId", "departmentId")
+	c.SetParamNames("universityID", "departmentID")
 	c.SetParamValues("1", "2")
 
 	err := h.DeleteDepartment(c)
@@ -391,7 +500,7 @@ func TestDeleteDepartmentError(t *testing.T) {
 	req := httptest.NewRequest(http.MethodDelete, university1Department2Path, nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	c.SetParamNames("universityId", "departmentId")
+	c.SetParamNames("universityID", "departmentID")
 	c.SetParamValues("1", "2")
 
 	err := h.DeleteDepartment(c)
@@ -0,0 +1,96 @@
+// Package history はエンティティの変更履歴に関するHTTPリクエストを処理するパッケージです。
+// このパッケージは以下の機能を提供します：
+// - エンティティごとの変更履歴（監査ログ）の取得
+// - ページネーション
+// - エラーハンドリング
+// - ログ記録
+package history
+
+import (
+	"context"
+	"net/http"
+	"time"
+	applogger "university-exam-api/internal/logger"
+	"university-exam-api/internal/pkg/errors"
+	"university-exam-api/internal/pkg/pagination"
+	"university-exam-api/internal/pkg/validation"
+	"university-exam-api/internal/usecases"
+
+	"github.com/labstack/echo/v4"
+)
+
+// パスパラメータ名
+const (
+	ParamEntityType = "entityType"
+	ParamEntityID   = "entityID"
+)
+
+const (
+	// msgInvalidEntityID はエンティティIDの形式が不正な場合のログメッセージです
+	msgInvalidEntityID = "エンティティIDの形式が不正です: %v"
+)
+
+// Handler は変更履歴に関するHTTPリクエストを処理する構造体です。
+// この構造体は以下の機能を提供します：
+// - ユースケースとの連携
+// - リクエストタイムアウトの管理
+// - エラーハンドリング
+type Handler struct {
+	usecase usecases.AuditUsecase
+	timeout time.Duration
+}
+
+// NewHistoryHandler は新しいHandlerインスタンスを生成します。
+// この関数は以下の処理を行います：
+// - ユースケースの初期化
+// - タイムアウトの設定
+func NewHistoryHandler(usecase usecases.AuditUsecase, timeout time.Duration) *Handler {
+	return &Handler{
+		usecase: usecase,
+		timeout: timeout,
+	}
+}
+
+// GetHistory はエンティティの変更履歴を新しい順に取得します。
+// この関数は以下の処理を行います：
+// - パスパラメータの検証
+// - ページ番号・件数の解析（並び順は作成日時の降順で固定）
+// - 変更履歴の取得
+// - next/prevリンクの設定
+func (h *Handler) GetHistory(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	entityType := c.Param(ParamEntityType)
+
+	entityID, err := validation.ParseID(ctx, c.Param(ParamEntityID), msgInvalidEntityID, "エンティティIDの形式が不正です")
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	params, err := pagination.ParseParams(c)
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	// 変更履歴はオフセット方式のみ対応
+	params.Cursor = nil
+
+	result, err := h.usecase.GetHistory(ctx, entityType, entityID, params)
+	if err != nil {
+		applogger.Error(ctx, "変更履歴の取得に失敗しました (%s: %d): %v", entityType, entityID, err)
+		return errors.HandleError(c, err)
+	}
+
+	links := pagination.BuildLinks(c, params, result.Page)
+	pagination.SetLinkHeader(c, links)
+
+	applogger.Info(ctx, "変更履歴を取得しました (%s: %d, 件数: %d)", entityType, entityID, len(result.Logs))
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"success":   true,
+		"timestamp": time.Now().Unix(),
+		"data":      result,
+		"links":     links,
+	})
+}
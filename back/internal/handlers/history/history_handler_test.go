@@ -0,0 +1,115 @@
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	applogger "university-exam-api/internal/logger"
+	"university-exam-api/internal/pkg/pagination"
+	"university-exam-api/internal/repositories"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockAuditUsecase はAuditUsecaseのモックです
+type mockAuditUsecase struct {
+	mock.Mock
+}
+
+func (m *mockAuditUsecase) GetHistory(
+	ctx context.Context,
+	entityType string,
+	entityID uint,
+	params pagination.Params,
+) (*repositories.AuditPage, error) {
+	args := m.Called(ctx, entityType, entityID, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*repositories.AuditPage), args.Error(1)
+}
+
+// newTestContext はリクエストURLとパスパラメータを設定したテスト用のコンテキストを生成します
+func newTestContext(target, entityType, entityID string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames(ParamEntityType, ParamEntityID)
+	c.SetParamValues(entityType, entityID)
+
+	return c, rec
+}
+
+func TestGetHistory(t *testing.T) {
+	applogger.InitTestLogger()
+
+	t.Run("正常系", func(t *testing.T) {
+		params := pagination.DefaultParams()
+		params.Page = 2
+		params.PerPage = 1
+
+		mockUsecase := new(mockAuditUsecase)
+		mockUsecase.On("GetHistory", mock.Anything, models.AuditEntityUniversity, uint(1), params).Return(&repositories.AuditPage{
+			Logs: []models.AuditLog{{
+				ID:         2,
+				EntityType: models.AuditEntityUniversity,
+				EntityID:   1,
+				Action:     models.AuditActionUpdate,
+				Actor:      "alice",
+				Changes:    map[string]models.FieldChange{"name": {Before: "旧大学", After: "新大学"}},
+			}},
+			Page: pagination.Page{Total: 3, Page: 2, PerPage: 1, HasNext: true, HasPrev: true},
+		}, nil)
+
+		h := NewHistoryHandler(mockUsecase, 2*time.Second)
+		c, rec := newTestContext("/api/audit/university/1?page=2&perPage=1", models.AuditEntityUniversity, "1")
+
+		require.NoError(t, h.GetHistory(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Header().Get("Link"), `rel="next"`)
+		assert.Contains(t, rec.Header().Get("Link"), `rel="prev"`)
+
+		var body struct {
+			Data struct {
+				Logs []models.AuditLog `json:"logs"`
+			} `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		require.Len(t, body.Data.Logs, 1)
+		assert.Equal(t, "alice", body.Data.Logs[0].Actor)
+		assert.Equal(t, "新大学", body.Data.Logs[0].Changes["name"].After)
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("不正なエンティティID", func(t *testing.T) {
+		mockUsecase := new(mockAuditUsecase)
+
+		h := NewHistoryHandler(mockUsecase, 2*time.Second)
+		c, rec := newTestContext("/api/audit/university/abc", models.AuditEntityUniversity, "abc")
+
+		require.NoError(t, h.GetHistory(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockUsecase.AssertNotCalled(t, "GetHistory")
+	})
+
+	t.Run("不正なエンティティ種別", func(t *testing.T) {
+		mockUsecase := new(mockAuditUsecase)
+		mockUsecase.On("GetHistory", mock.Anything, "region", uint(1), mock.Anything).
+			Return(nil, appErrors.NewInvalidInputError("entityType", "エンティティ種別が不正です", nil))
+
+		h := NewHistoryHandler(mockUsecase, 2*time.Second)
+		c, rec := newTestContext("/api/audit/region/1", "region", "1")
+
+		require.NoError(t, h.GetHistory(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
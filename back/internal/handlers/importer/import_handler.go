@@ -0,0 +1,176 @@
+// Package importer は入試データの一括取り込みに関するHTTPリクエストを処理するパッケージです。
+// このパッケージは以下の機能を提供します：
+// - CSV・Excelブックのアップロードの受け付け
+// - ドライランによる事前検証
+// - 行単位のエラーの返却
+// - ログ記録
+package importer
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+	appErrors "university-exam-api/internal/errors"
+	applogger "university-exam-api/internal/logger"
+	"university-exam-api/internal/pkg/errors"
+	"university-exam-api/internal/usecases"
+
+	"github.com/labstack/echo/v4"
+)
+
+// リクエストのパラメータ名
+const (
+	ParamFile   = "file"
+	ParamFormat = "format"
+	ParamDryRun = "dry_run"
+)
+
+const (
+	// MaxImportFileSize は取り込みファイルの最大サイズです
+	MaxImportFileSize = 10 * 1024 * 1024
+	// mimeXLSX はExcelブックのMIMEタイプです
+	mimeXLSX = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+)
+
+// Handler は入試データの一括取り込みに関するHTTPリクエストを処理する構造体です。
+// この構造体は以下の機能を提供します：
+// - ユースケースとの連携
+// - リクエストタイムアウトの管理
+// - エラーハンドリング
+type Handler struct {
+	usecase usecases.ImportUsecase
+	timeout time.Duration
+}
+
+// NewImportHandler は新しいHandlerインスタンスを生成します。
+// この関数は以下の処理を行います：
+// - ユースケースの初期化
+// - タイムアウトの設定
+func NewImportHandler(usecase usecases.ImportUsecase, timeout time.Duration) *Handler {
+	return &Handler{
+		usecase: usecase,
+		timeout: timeout,
+	}
+}
+
+// detectFormat はファイル名・Content-Typeから取り込みファイルの形式を判定します
+func detectFormat(filename, contentType string) string {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".csv":
+		return usecases.ImportFormatCSV
+	case ".xlsx":
+		return usecases.ImportFormatXLSX
+	}
+
+	switch {
+	case strings.HasPrefix(contentType, mimeXLSX):
+		return usecases.ImportFormatXLSX
+	case strings.HasPrefix(contentType, "text/csv"):
+		return usecases.ImportFormatCSV
+	default:
+		return ""
+	}
+}
+
+// readLimited はサイズ上限を超えない範囲で取り込みファイルを読み込みます
+func readLimited(r io.Reader) ([]byte, error) {
+	var buf bytes.Buffer
+
+	n, err := io.Copy(&buf, io.LimitReader(r, MaxImportFileSize+1))
+	if err != nil {
+		return nil, appErrors.NewInvalidInputError(ParamFile, "取り込みファイルの読み込みに失敗しました", nil)
+	}
+
+	if n > MaxImportFileSize {
+		return nil, appErrors.NewInvalidInputError(ParamFile, "取り込みファイルは10MB以下である必要があります", nil)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// bindImportFile はマルチパートの file フィールド、またはリクエストボディから取り込みファイルを取得します
+// 形式は format パラメータを優先し、指定がない場合はファイル名・Content-Typeから判定します
+func bindImportFile(c echo.Context) (string, []byte, error) {
+	format := c.QueryParam(ParamFormat)
+
+	if strings.HasPrefix(c.Request().Header.Get(echo.HeaderContentType), echo.MIMEMultipartForm) {
+		fileHeader, err := c.FormFile(ParamFile)
+		if err != nil {
+			return "", nil, appErrors.NewInvalidInputError(ParamFile, "取り込みファイルが指定されていません", nil)
+		}
+
+		file, err := fileHeader.Open()
+		if err != nil {
+			return "", nil, appErrors.NewInvalidInputError(ParamFile, "取り込みファイルを開けません", nil)
+		}
+		defer file.Close()
+
+		if format == "" {
+			format = detectFormat(fileHeader.Filename, fileHeader.Header.Get(echo.HeaderContentType))
+		}
+
+		data, err := readLimited(file)
+
+		return format, data, err
+	}
+
+	if format == "" {
+		format = detectFormat("", c.Request().Header.Get(echo.HeaderContentType))
+	}
+
+	data, err := readLimited(c.Request().Body)
+
+	return format, data, err
+}
+
+// ImportAdmissions は入試データの取り込みファイルを検証・登録します。
+// この関数は以下の処理を行います：
+// - 取り込みファイル・ドライラン指定の取得
+// - ファイルの解析と行単位の検証
+// - 1つのトランザクションでの登録（ドライラン・エラー時は取り消し）
+// - 行エラーがある場合は422での結果の返却
+func (h *Handler) ImportAdmissions(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	dryRun := false
+
+	if v := c.QueryParam(ParamDryRun); v != "" {
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			applogger.Error(ctx, "ドライラン指定のバリデーションに失敗しました: %v", err)
+			return errors.HandleError(c, appErrors.NewInvalidInputError(ParamDryRun, "dry_runはtrueまたはfalseである必要があります", nil))
+		}
+
+		dryRun = parsed
+	}
+
+	format, data, err := bindImportFile(c)
+	if err != nil {
+		applogger.Error(ctx, "取り込みファイルの取得に失敗しました: %v", err)
+		return errors.HandleError(c, err)
+	}
+
+	result, err := h.usecase.Import(ctx, format, data, dryRun)
+	if err != nil {
+		applogger.Error(ctx, "入試データの取り込みに失敗しました: %v", err)
+		return errors.HandleError(c, err)
+	}
+
+	status := http.StatusOK
+	if len(result.Errors) > 0 {
+		status = http.StatusUnprocessableEntity
+	}
+
+	applogger.Info(ctx, "入試データの取り込みを処理しました: format=%s, rows=%d, errors=%d",
+		format, result.Rows, len(result.Errors))
+
+	return c.JSON(status, map[string]interface{}{
+		"data": result,
+	})
+}
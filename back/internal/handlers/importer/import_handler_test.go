@@ -0,0 +1,209 @@
+package importer
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	appErrors "university-exam-api/internal/errors"
+	applogger "university-exam-api/internal/logger"
+	custom_middleware "university-exam-api/internal/middleware"
+	"university-exam-api/internal/repositories"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testCSV = "university,department,major,schedule,test_type,subject,score\n" +
+	"一橋大学,経済学部,経済学科,前,共通,英語,200\n"
+
+// mockImportUsecase はImportUsecaseのモックです
+type mockImportUsecase struct {
+	mock.Mock
+}
+
+func (m *mockImportUsecase) Import(
+	ctx context.Context,
+	format string,
+	data []byte,
+	dryRun bool,
+) (*repositories.ImportResult, error) {
+	args := m.Called(ctx, format, data, dryRun)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*repositories.ImportResult), args.Error(1)
+}
+
+// newMultipartRequest はファイルを添付したマルチパートのリクエストを生成します
+func newMultipartRequest(t *testing.T, target, filename, content string) *http.Request {
+	t.Helper()
+
+	var body bytes.Buffer
+
+	w := multipart.NewWriter(&body)
+	part, err := w.CreateFormFile(ParamFile, filename)
+	require.NoError(t, err)
+	_, err = part.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	req := httptest.NewRequest(http.MethodPost, target, &body)
+	req.Header.Set(echo.HeaderContentType, w.FormDataContentType())
+
+	return req
+}
+
+func TestImportAdmissionsMultipart(t *testing.T) {
+	applogger.InitTestLogger()
+
+	result := repositories.NewImportResult(true)
+	result.Rows = 1
+
+	mockUsecase := new(mockImportUsecase)
+	mockUsecase.On("Import", mock.Anything, "csv", []byte(testCSV), true).Return(result, nil)
+
+	h := NewImportHandler(mockUsecase, 2*time.Second)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(newMultipartRequest(t, "/imports/admissions?dry_run=true", "data.csv", testCSV), rec)
+
+	require.NoError(t, h.ImportAdmissions(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"dry_run":true`)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestImportAdmissionsRequiresAdmin(t *testing.T) {
+	applogger.InitTestLogger()
+
+	result := repositories.NewImportResult(true)
+	result.Rows = 1
+
+	mockUsecase := new(mockImportUsecase)
+	mockUsecase.On("Import", mock.Anything, "csv", []byte(testCSV), true).Return(result, nil)
+
+	h := NewImportHandler(mockUsecase, 2*time.Second)
+
+	// ルーティングと同じく管理者のみに取り込みを許可する
+	newServer := func(user map[string]string) *echo.Echo {
+		e := echo.New()
+		e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+			return func(c echo.Context) error {
+				if user != nil {
+					c.Set("user", user)
+				}
+
+				return next(c)
+			}
+		})
+		e.POST("/imports/admissions", h.ImportAdmissions, custom_middleware.AuthorizeRole(custom_middleware.RoleAdmin))
+
+		return e
+	}
+
+	tests := []struct {
+		name     string
+		user     map[string]string
+		wantCode int
+	}{
+		{name: "未認証", wantCode: http.StatusForbidden},
+		{name: "管理者以外", user: map[string]string{"role": "user"}, wantCode: http.StatusForbidden},
+		{name: "管理者", user: map[string]string{"role": custom_middleware.RoleAdmin}, wantCode: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			newServer(tt.user).ServeHTTP(rec, newMultipartRequest(t, "/imports/admissions?dry_run=true", "data.csv", testCSV))
+			assert.Equal(t, tt.wantCode, rec.Code)
+		})
+	}
+
+	mockUsecase.AssertNumberOfCalls(t, "Import", 1)
+}
+
+func TestImportAdmissionsRawBodyWithRowErrors(t *testing.T) {
+	applogger.InitTestLogger()
+
+	result := repositories.NewImportResult(false)
+	result.AddError(2, repositories.ImportFieldSchedule, "日程が不正です")
+
+	mockUsecase := new(mockImportUsecase)
+	mockUsecase.On("Import", mock.Anything, "csv", []byte(testCSV), false).Return(result, nil)
+
+	h := NewImportHandler(mockUsecase, 2*time.Second)
+	req := httptest.NewRequest(http.MethodPost, "/imports/admissions", strings.NewReader(testCSV))
+	req.Header.Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	require.NoError(t, h.ImportAdmissions(c))
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"line":2`)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestImportAdmissionsInvalidRequest(t *testing.T) {
+	applogger.InitTestLogger()
+
+	t.Run("不正なドライラン指定", func(t *testing.T) {
+		mockUsecase := new(mockImportUsecase)
+		h := NewImportHandler(mockUsecase, 2*time.Second)
+
+		req := httptest.NewRequest(http.MethodPost, "/imports/admissions?dry_run=maybe", strings.NewReader(testCSV))
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+
+		require.NoError(t, h.ImportAdmissions(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockUsecase.AssertNotCalled(t, "Import", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("ファイルの指定なし", func(t *testing.T) {
+		mockUsecase := new(mockImportUsecase)
+		h := NewImportHandler(mockUsecase, 2*time.Second)
+
+		var body bytes.Buffer
+
+		w := multipart.NewWriter(&body)
+		require.NoError(t, w.Close())
+
+		req := httptest.NewRequest(http.MethodPost, "/imports/admissions", &body)
+		req.Header.Set(echo.HeaderContentType, w.FormDataContentType())
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+
+		require.NoError(t, h.ImportAdmissions(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("ファイル形式の誤り", func(t *testing.T) {
+		mockUsecase := new(mockImportUsecase)
+		mockUsecase.On("Import", mock.Anything, "", mock.Anything, false).
+			Return(nil, appErrors.NewInvalidInputError(ParamFormat, "形式が不正です", nil))
+
+		h := NewImportHandler(mockUsecase, 2*time.Second)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(newMultipartRequest(t, "/imports/admissions", "data.txt", testCSV), rec)
+
+		require.NoError(t, h.ImportAdmissions(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockUsecase.AssertExpectations(t)
+	})
+}
+
+func TestDetectFormat(t *testing.T) {
+	assert.Equal(t, "csv", detectFormat("Data.CSV", ""))
+	assert.Equal(t, "xlsx", detectFormat("data.xlsx", "application/octet-stream"))
+	assert.Equal(t, "xlsx", detectFormat("", mimeXLSX))
+	assert.Equal(t, "csv", detectFormat("", "text/csv"))
+	assert.Equal(t, "", detectFormat("data.json", "application/json"))
+}
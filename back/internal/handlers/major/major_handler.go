@@ -11,8 +11,10 @@ import (
 	"net/http"
 	"time"
 	"university-exam-api/internal/domain/models"
+	"university-exam-api/internal/infrastructure/audit"
 	applogger "university-exam-api/internal/logger"
 	"university-exam-api/internal/pkg/errors"
+	"university-exam-api/internal/pkg/etag"
 	"university-exam-api/internal/pkg/validation"
 	"university-exam-api/internal/repositories"
 
@@ -89,12 +91,12 @@ func (h *Handler) validateMajorRequest(major *models.Major) error {
 // - 学科IDの検証
 // - エラーハンドリング
 func (h *Handler) validateDepartmentAndMajorID(ctx context.Context, c echo.Context) (uint, uint, error) {
-	departmentID, err := validation.ValidateDepartmentID(ctx, c.Param("departmentId"))
+	departmentID, err := validation.ValidateDepartmentID(ctx, c.Param("departmentID"))
 	if err != nil {
 		return 0, 0, err
 	}
 
-	majorID, err := validation.ValidateMajorID(ctx, c.Param("majorId"))
+	majorID, err := validation.ValidateMajorID(ctx, c.Param("majorID"))
 	if err != nil {
 		return 0, 0, err
 	}
@@ -116,7 +118,7 @@ func (h *Handler) GetMajor(c echo.Context) error {
 		return errors.HandleError(c, err)
 	}
 
-	major, err := h.repo.FindMajor(departmentID, majorID)
+	major, err := h.repo.FindMajor(ctx, departmentID, majorID)
 	if err != nil {
 		applogger.Error(ctx, "学科の取得に失敗しました (学部ID: %d, 学科ID: %d): %v", departmentID, majorID, err)
 		return errors.HandleError(c, err)
@@ -124,6 +126,8 @@ func (h *Handler) GetMajor(c echo.Context) error {
 
 	applogger.Info(ctx, logGetMajorSuccess, departmentID, majorID)
 
+	etag.SetHeader(c, major.Version)
+
 	return c.JSON(http.StatusOK, map[string]interface{}{
 		"data": major,
 	})
@@ -138,7 +142,7 @@ func (h *Handler) CreateMajor(c echo.Context) error {
 	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
 	defer cancel()
 
-	departmentID, err := validation.ValidateDepartmentID(ctx, c.Param("departmentId"))
+	departmentID, err := validation.ValidateDepartmentID(ctx, c.Param("departmentID"))
 	if err != nil {
 		return errors.HandleError(c, err)
 	}
@@ -153,7 +157,9 @@ func (h *Handler) CreateMajor(c echo.Context) error {
 	}
 
 	major.DepartmentID = departmentID
-	if err := h.repo.CreateMajor(&major); err != nil {
+	audit.StampCreate(ctx, &major.BaseModel)
+
+	if err := h.repo.CreateMajor(ctx, &major); err != nil {
 		applogger.Error(ctx, "学科の作成に失敗しました: %v", err)
 		return errors.HandleError(c, err)
 	}
@@ -169,13 +175,14 @@ func (h *Handler) CreateMajor(c echo.Context) error {
 // この関数は以下の処理を行います：
 // - パラメータの検証
 // - リクエストのバリデーション
-// - データベースの更新
+// - 期待するバージョン（If-Match またはボディの version）の取得
+// - データベースの更新（バージョンが一致しない場合は現在の状態とともに409を返却）
 // - エラーハンドリング
 func (h *Handler) UpdateMajor(c echo.Context) error {
 	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
 	defer cancel()
 
-	majorID, err := validation.ValidateMajorID(ctx, c.Param("majorId"))
+	majorID, err := validation.ValidateMajorID(ctx, c.Param("majorID"))
 	if err != nil {
 		return errors.HandleError(c, err)
 	}
@@ -189,14 +196,24 @@ func (h *Handler) UpdateMajor(c echo.Context) error {
 		return errors.HandleError(c, err)
 	}
 
+	expectedVersion, err := etag.ExpectedVersion(c, major.Version)
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
 	major.ID = majorID
-	if err := h.repo.UpdateMajor(&major); err != nil {
+	major.Version = expectedVersion
+	audit.StampUpdate(ctx, &major.BaseModel)
+
+	if err := h.repo.UpdateMajor(ctx, &major); err != nil {
 		applogger.Error(ctx, "学科ID %dの更新に失敗しました: %v", majorID, err)
 		return errors.HandleError(c, err)
 	}
 
 	applogger.Info(ctx, logUpdateMajorSuccess, majorID)
 
+	etag.SetHeader(c, major.Version)
+
 	return c.JSON(http.StatusOK, map[string]interface{}{
 		"data": major,
 	})
@@ -211,12 +228,12 @@ func (h *Handler) DeleteMajor(c echo.Context) error {
 	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
 	defer cancel()
 
-	majorID, err := validation.ValidateMajorID(ctx, c.Param("majorId"))
+	majorID, err := validation.ValidateMajorID(ctx, c.Param("majorID"))
 	if err != nil {
 		return errors.HandleError(c, err)
 	}
 
-	if err := h.repo.DeleteMajor(majorID); err != nil {
+	if err := h.repo.DeleteMajor(ctx, majorID); err != nil {
 		applogger.Error(ctx, "学科ID %dの削除に失敗しました: %v", majorID, err)
 		return errors.HandleError(c, err)
 	}
@@ -11,6 +11,10 @@ import (
 
 	"university-exam-api/internal/domain/models"
 	applogger "university-exam-api/internal/logger"
+	"university-exam-api/internal/pkg/etag"
+	"university-exam-api/internal/pkg/pagination"
+	"university-exam-api/internal/pkg/textsearch"
+	"university-exam-api/internal/repositories"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
@@ -27,43 +31,112 @@ type mockUniversityRepo struct {
 	DeleteMajorFunc     func(majorID uint) error
 }
 
-func (m *mockUniversityRepo) FindMajor(departmentID, majorID uint) (*models.Major, error) {
+func (m *mockUniversityRepo) FindMajor(_ context.Context, departmentID, majorID uint) (*models.Major, error) {
 	return m.FindMajorFunc(departmentID, majorID)
 }
 
-func (m *mockUniversityRepo) CreateMajor(major *models.Major) error {
+func (m *mockUniversityRepo) CreateMajor(_ context.Context, major *models.Major) error {
 	return m.CreateMajorFunc(major)
 }
 
-func (m *mockUniversityRepo) UpdateMajor(major *models.Major) error {
+func (m *mockUniversityRepo) UpdateMajor(_ context.Context, major *models.Major) error {
 	return m.UpdateMajorFunc(major)
 }
 
-func (m *mockUniversityRepo) DeleteMajor(majorID uint) error {
+func (m *mockUniversityRepo) DeleteMajor(_ context.Context, majorID uint) error {
 	return m.DeleteMajorFunc(majorID)
 }
 
 // 他のIUniversityRepositoryメソッドはpanicでOK
 func (m *mockUniversityRepo) FindAll(_ context.Context) ([]models.University, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) FindByID(_ uint) (*models.University, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) Search(_ string) ([]models.University, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) Create(_ *models.University) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) Update(_ *models.University) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) Delete(_ uint) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) CreateDepartment(_ *models.Department) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateDepartment(_ *models.Department) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) DeleteDepartment(_ uint) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) CreateSubject(_ *models.Subject) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateSubject(_ *models.Subject) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) DeleteSubject(_ uint) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) CreateAdmissionInfo(_ *models.AdmissionInfo) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) DeleteAdmissionInfo(_ uint) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateAdmissionInfo(_ *models.AdmissionInfo) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) FindDepartment(_, _ uint) (*models.Department, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) FindSubject(_, _ uint) (*models.Subject, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) FindAdmissionInfo(_, _ uint) (*models.AdmissionInfo, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateSubjectsBatch(_ uint, _ []models.Subject) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateAdmissionSchedule(_ *models.AdmissionSchedule) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindPage(_ context.Context, _ pagination.Params) (*repositories.UniversityPage, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) SearchPage(_ context.Context, _ string, _ pagination.Params) (*repositories.UniversityPage, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) Suggest(_ context.Context, _ string, _ []string, _ int) (textsearch.Suggestions, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) SearchHits(_ context.Context, _ string) ([]textsearch.Hit, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) WarmSearchIndex(_ context.Context) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) InvalidateSearchIndex()                  {}
+func (m *mockUniversityRepo) ImportRows(
+	_ context.Context,
+	_ []repositories.ImportRow,
+	_ bool,
+) (*repositories.ImportResult, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindByID(_ context.Context, _ uint) (*models.University, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) Search(_ context.Context, _ string) ([]models.University, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) Create(_ context.Context, _ *models.University) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) Update(_ context.Context, _ *models.University) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) Delete(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateDepartment(_ context.Context, _ *models.Department) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateDepartment(_ context.Context, _ *models.Department) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteDepartment(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateSubject(_ context.Context, _ *models.Subject) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateSubject(_ context.Context, _ *models.Subject) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteSubject(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateAdmissionInfo(_ context.Context, _ *models.AdmissionInfo) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteAdmissionInfo(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateAdmissionInfo(_ context.Context, _ *models.AdmissionInfo) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindDepartment(_ context.Context, _, _ uint) (*models.Department, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindSubject(_ context.Context, _, _ uint) (*models.Subject, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindAdmissionInfo(_ context.Context, _, _ uint) (*models.AdmissionInfo, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateSubjectsBatch(_ context.Context, _ uint, _ []models.Subject) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateAdmissionSchedule(_ context.Context, _ *models.AdmissionSchedule) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindAdmissionInfoForPublication(_ context.Context, _ uint) (*models.AdmissionInfo, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) UpdateAdmissionInfoStatus(_ context.Context, _ *models.AdmissionInfo, _ string) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindAdmissionSchedule(_ context.Context, _, _ uint) (*models.AdmissionSchedule, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) CreateAdmissionSchedule(_ context.Context, _ *models.AdmissionSchedule) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteAdmissionSchedule(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindTestType(_ context.Context, _, _ uint) (*models.TestType, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateTestType(_ context.Context, _ *models.TestType) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateTestType(_ context.Context, _ *models.TestType) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteTestType(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateSubjectGroup(_ context.Context, _ *models.SubjectGroup) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) UpdateSubjectGroup(_ context.Context, _ *models.SubjectGroup) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) DeleteSubjectGroup(_ context.Context, _, _ uint) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindAdmissionDifficulty(_ context.Context, _ uint) (*models.AdmissionDifficulty, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) CreateAdmissionDifficulty(_ context.Context, _ *models.AdmissionDifficulty) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) UpdateAdmissionDifficulty(_ context.Context, _ *models.AdmissionDifficulty) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) DeleteAdmissionDifficulty(_ context.Context, _ uint) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindTrash(_ context.Context, _ string, _ pagination.Params) (*repositories.TrashPage, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) RestoreFromTrash(_ context.Context, _ string, _ uint) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) PurgeFromTrash(_ context.Context, _ string, _ uint) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) PurgeTrashBefore(_ context.Context, _ time.Time) (int64, error) {
+	panic(errNotImplemented)
+}
 
 // --- 学科取得APIの正常系テスト ---
 func TestGetMajorSuccess(t *testing.T) {
@@ -84,7 +157,7 @@ func TestGetMajorSuccess(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, "/departments/1/majors/2", nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	c.SetParamNames("departmentId", "majorId")
+	c.SetParamNames("departmentID", "majorID")
 	c.SetParamValues("1", "2")
 
 	err := h.GetMajor(c)
@@ -108,7 +181,7 @@ func TestGetMajorError(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, "/departments/1/majors/2", nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	c.SetParamNames("departmentId", "majorId")
+	c.SetParamNames("departmentID", "majorID")
 	c.SetParamValues("1", "2")
 
 	err := h.GetMajor(c)
@@ -211,7 +284,7 @@ func TestCreateMajor(t *testing.T) {
 
 		rec := httptest.NewRecorder()
 		c := echo.New().NewContext(req, rec)
-		c.SetParamNames("departmentId")
+		c.SetParamNames("departmentID")
 		c.SetParamValues("1")
 
 		h := NewMajorHandler(&mockUniversityRepo{
@@ -239,7 +312,7 @@ func TestCreateMajor(t *testing.T) {
 
 		rec := httptest.NewRecorder()
 		c := echo.New().NewContext(req, rec)
-		c.SetParamNames("departmentId")
+		c.SetParamNames("departmentID")
 		c.SetParamValues("1")
 
 		err := h.CreateMajor(c)
@@ -261,11 +334,12 @@ func TestUpdateMajor(t *testing.T) {
 
 	t.Run("正常系", func(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPut, majors1Path, strings.NewReader(`{"name": "更新された学科", "department_id": 1}`))
+		req.Header.Set(etag.HeaderIfMatch, `"1"`)
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 
 		rec := httptest.NewRecorder()
 		c := echo.New().NewContext(req, rec)
-		c.SetParamNames("majorId")
+		c.SetParamNames("majorID")
 		c.SetParamValues("1")
 
 		err := h.UpdateMajor(c)
@@ -284,7 +358,7 @@ func TestUpdateMajor(t *testing.T) {
 
 		rec := httptest.NewRecorder()
 		c := echo.New().NewContext(req, rec)
-		c.SetParamNames("majorId")
+		c.SetParamNames("majorID")
 		c.SetParamValues("1")
 
 		err := h.UpdateMajor(c)
@@ -308,7 +382,7 @@ func TestDeleteMajor(t *testing.T) {
 		req := httptest.NewRequest(http.MethodDelete, majors1Path, nil)
 		rec := httptest.NewRecorder()
 		c := echo.New().NewContext(req, rec)
-		c.SetParamNames("majorId")
+		c.SetParamNames("majorID")
 		c.SetParamValues("1")
 
 		err := h.DeleteMajor(c)
@@ -320,7 +394,7 @@ func TestDeleteMajor(t *testing.T) {
 		req := httptest.NewRequest(http.MethodDelete, "/majors/invalid", nil)
 		rec := httptest.NewRecorder()
 		c := echo.New().NewContext(req, rec)
-		c.SetParamNames("majorId")
+		c.SetParamNames("majorID")
 		c.SetParamValues("invalid")
 
 		err := h.DeleteMajor(c)
@@ -0,0 +1,241 @@
+// Package masterdata は地域・都道府県・設置区分・小分類・学問系統のマスタデータに関するHTTPリクエストを処理するパッケージです。
+// このパッケージは以下の機能を提供します：
+// - 所属先ごとのマスタデータの一覧取得
+// - マスタデータの取得、作成、更新、削除
+// - 所属先内での並び替え
+// - エラーハンドリング
+// - ログ記録
+package masterdata
+
+import (
+	"context"
+	"net/http"
+	"time"
+	"university-exam-api/internal/domain/models"
+	"university-exam-api/internal/infrastructure/audit"
+	applogger "university-exam-api/internal/logger"
+	"university-exam-api/internal/pkg/errors"
+	"university-exam-api/internal/pkg/etag"
+	"university-exam-api/internal/pkg/validation"
+	"university-exam-api/internal/usecases"
+
+	"github.com/labstack/echo/v4"
+)
+
+// パスパラメータ名
+const ParamID = "id"
+
+// QueryParentID は一覧を絞り込む所属先のIDのクエリパラメータ名です
+const QueryParentID = "parent_id"
+
+const (
+	// msgInvalidID はIDの形式が不正な場合のログメッセージです
+	msgInvalidID = "マスタデータのIDの形式が不正です: %v"
+	// msgInvalidParentID は所属先のIDの形式が不正な場合のログメッセージです
+	msgInvalidParentID = "所属先のIDの形式が不正です: %v"
+)
+
+// ReorderRequest は並び替えのリクエストボディです
+// - ParentID: 並び替える所属先のID
+// - IDs: 並び替え後の順序でのマスタデータのID（所属先の全てのIDを指定する）
+type ReorderRequest struct {
+	ParentID uint   `json:"parent_id"`
+	IDs      []uint `json:"ids"`
+}
+
+// Handler はマスタデータに関するHTTPリクエストを処理する構造体です。
+// この構造体は以下の機能を提供します：
+// - ユースケースとの連携
+// - リクエストタイムアウトの管理
+// - エラーハンドリング
+type Handler[T any, PT models.MasterDataModel[T]] struct {
+	usecase usecases.MasterDataUsecase[T]
+	label   string
+	timeout time.Duration
+}
+
+// NewHandler は新しいHandlerインスタンスを生成します。
+// label はログに使用するマスタデータの名称です
+func NewHandler[T any, PT models.MasterDataModel[T]](
+	usecase usecases.MasterDataUsecase[T],
+	label string,
+	timeout time.Duration,
+) *Handler[T, PT] {
+	return &Handler[T, PT]{
+		usecase: usecase,
+		label:   label,
+		timeout: timeout,
+	}
+}
+
+// List は所属先のマスタデータを表示順に取得します。
+// parent_id を指定しない場合は全ての所属先のマスタデータを取得します。
+func (h *Handler[T, PT]) List(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	var parentID uint
+
+	if value := c.QueryParam(QueryParentID); value != "" {
+		var err error
+		if parentID, err = validation.ParseID(ctx, value, msgInvalidParentID, "所属先のIDの形式が不正です"); err != nil {
+			return errors.HandleError(c, err)
+		}
+	}
+
+	items, err := h.usecase.List(ctx, parentID)
+	if err != nil {
+		applogger.Error(ctx, "%sの一覧取得に失敗しました (所属先ID: %d): %v", h.label, parentID, err)
+		return errors.HandleError(c, err)
+	}
+
+	applogger.Info(ctx, "%sの一覧を取得しました (所属先ID: %d, 件数: %d)", h.label, parentID, len(items))
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"data": items,
+	})
+}
+
+// Get は指定されたマスタデータを取得します。
+func (h *Handler[T, PT]) Get(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	id, err := validation.ParseID(ctx, c.Param(ParamID), msgInvalidID, "IDの形式が不正です")
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	item, err := h.usecase.Get(ctx, id)
+	if err != nil {
+		applogger.Error(ctx, "%sの取得に失敗しました (ID: %d): %v", h.label, id, err)
+		return errors.HandleError(c, err)
+	}
+
+	etag.SetHeader(c, PT(item).Base().Version)
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"data": item,
+	})
+}
+
+// Create はマスタデータを作成します。
+// この関数は以下の処理を行います：
+// - リクエストボディのバインディング
+// - フィルターオプションの階層ルールによる検証
+// - 所属先の末尾への追加（表示順を指定しない場合）
+func (h *Handler[T, PT]) Create(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	item := PT(new(T))
+	if err := c.Bind(item); err != nil {
+		applogger.Error(ctx, errors.MsgBindRequestFailed, err)
+		return errors.HandleError(c, err)
+	}
+
+	*item.Base() = models.BaseModel{}
+	audit.StampCreate(ctx, item.Base())
+
+	if err := h.usecase.Create(ctx, (*T)(item)); err != nil {
+		applogger.Error(ctx, "%sの作成に失敗しました: %v", h.label, err)
+		return errors.HandleError(c, err)
+	}
+
+	applogger.Info(ctx, "%sを作成しました (ID: %d)", h.label, item.Base().ID)
+
+	etag.SetHeader(c, item.Base().Version)
+
+	return c.JSON(http.StatusCreated, map[string]interface{}{
+		"data": item,
+	})
+}
+
+// Update はマスタデータを更新します。
+// この関数は以下の処理を行います：
+// - パラメータの検証
+// - 期待するバージョン（If-Match またはボディの version）の取得
+// - フィルターオプションの階層ルールによる検証
+// - 楽観的ロックによる更新（バージョンが一致しない場合は現在の状態とともに409を返却）
+// 所属先のIDを指定した場合は所属先を付け替えます。
+func (h *Handler[T, PT]) Update(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	id, err := validation.ParseID(ctx, c.Param(ParamID), msgInvalidID, "IDの形式が不正です")
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	item := PT(new(T))
+	if err := c.Bind(item); err != nil {
+		applogger.Error(ctx, errors.MsgBindRequestFailed, err)
+		return errors.HandleError(c, err)
+	}
+
+	expectedVersion, err := etag.ExpectedVersion(c, item.Base().Version)
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	*item.Base() = models.BaseModel{ID: id, Version: expectedVersion}
+	audit.StampUpdate(ctx, item.Base())
+
+	if err := h.usecase.Update(ctx, (*T)(item)); err != nil {
+		applogger.Error(ctx, "%sの更新に失敗しました (ID: %d): %v", h.label, id, err)
+		return errors.HandleError(c, err)
+	}
+
+	applogger.Info(ctx, "%sを更新しました (ID: %d)", h.label, id)
+
+	etag.SetHeader(c, item.Base().Version)
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"data": item,
+	})
+}
+
+// Reorder は所属先のマスタデータを指定されたIDの順に並び替えます。
+// 所属先の全てのIDを過不足なく指定する必要があります。
+func (h *Handler[T, PT]) Reorder(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	var req ReorderRequest
+	if err := c.Bind(&req); err != nil {
+		applogger.Error(ctx, errors.MsgBindRequestFailed, err)
+		return errors.HandleError(c, err)
+	}
+
+	items, err := h.usecase.Reorder(ctx, req.ParentID, req.IDs)
+	if err != nil {
+		applogger.Error(ctx, "%sの並び替えに失敗しました (所属先ID: %d): %v", h.label, req.ParentID, err)
+		return errors.HandleError(c, err)
+	}
+
+	applogger.Info(ctx, "%sを並び替えました (所属先ID: %d)", h.label, req.ParentID)
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"data": items,
+	})
+}
+
+// Delete はマスタデータを配下の要素とともにソフトデリートします。
+func (h *Handler[T, PT]) Delete(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	id, err := validation.ParseID(ctx, c.Param(ParamID), msgInvalidID, "IDの形式が不正です")
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	if err := h.usecase.Delete(ctx, id); err != nil {
+		applogger.Error(ctx, "%sの削除に失敗しました (ID: %d): %v", h.label, id, err)
+		return errors.HandleError(c, err)
+	}
+
+	applogger.Info(ctx, "%sを削除しました (ID: %d)", h.label, id)
+
+	return c.NoContent(http.StatusNoContent)
+}
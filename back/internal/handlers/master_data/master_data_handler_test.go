@@ -0,0 +1,214 @@
+package masterdata
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	applogger "university-exam-api/internal/logger"
+	"university-exam-api/internal/pkg/etag"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockMasterDataUsecase はMasterDataUsecaseのモックです
+type mockMasterDataUsecase[T any] struct {
+	mock.Mock
+}
+
+func (m *mockMasterDataUsecase[T]) List(ctx context.Context, ownerID uint) ([]T, error) {
+	args := m.Called(ctx, ownerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]T), args.Error(1)
+}
+
+func (m *mockMasterDataUsecase[T]) Get(ctx context.Context, id uint) (*T, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*T), args.Error(1)
+}
+
+func (m *mockMasterDataUsecase[T]) Create(ctx context.Context, item *T) error {
+	return m.Called(ctx, item).Error(0)
+}
+
+func (m *mockMasterDataUsecase[T]) Update(ctx context.Context, item *T) error {
+	return m.Called(ctx, item).Error(0)
+}
+
+func (m *mockMasterDataUsecase[T]) Reorder(ctx context.Context, ownerID uint, ids []uint) ([]T, error) {
+	args := m.Called(ctx, ownerID, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]T), args.Error(1)
+}
+
+func (m *mockMasterDataUsecase[T]) Delete(ctx context.Context, id uint) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+// newTestContext はリクエストボディとパスパラメータを設定したテスト用のコンテキストを生成します
+func newTestContext(method, target, body, id string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	if id != "" {
+		c.SetParamNames(ParamID)
+		c.SetParamValues(id)
+	}
+
+	return c, rec
+}
+
+func TestList(t *testing.T) {
+	applogger.InitTestLogger()
+
+	t.Run("所属先で絞り込む", func(t *testing.T) {
+		mockUsecase := new(mockMasterDataUsecase[models.Region])
+		mockUsecase.On("List", mock.Anything, uint(3)).Return([]models.Region{{Name: "関東"}}, nil)
+
+		h := NewHandler(mockUsecase, "地域", 2*time.Second)
+		c, rec := newTestContext(http.MethodGet, "/api/master-data/regions?parent_id=3", "", "")
+
+		require.NoError(t, h.List(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var body struct {
+			Data []models.Region `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		require.Len(t, body.Data, 1)
+		assert.Equal(t, "関東", body.Data[0].Name)
+	})
+
+	t.Run("不正な所属先のID", func(t *testing.T) {
+		mockUsecase := new(mockMasterDataUsecase[models.Region])
+
+		h := NewHandler(mockUsecase, "地域", 2*time.Second)
+		c, rec := newTestContext(http.MethodGet, "/api/master-data/regions?parent_id=abc", "", "")
+
+		require.NoError(t, h.List(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockUsecase.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+	})
+}
+
+func TestCreate(t *testing.T) {
+	applogger.InitTestLogger()
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase := new(mockMasterDataUsecase[models.Prefecture])
+		mockUsecase.On("Create", mock.Anything, mock.MatchedBy(func(p *models.Prefecture) bool {
+			return p.RegionID == 2 && p.Name == "東京" && p.ID == 0
+		})).Run(func(args mock.Arguments) {
+			p := args.Get(1).(*models.Prefecture)
+			p.ID = 10
+			p.Version = 1
+		}).Return(nil)
+
+		h := NewHandler(mockUsecase, "都道府県", 2*time.Second)
+		c, rec := newTestContext(http.MethodPost, "/api/master-data/prefectures",
+			`{"id":99,"region_id":2,"name":"東京"}`, "")
+
+		require.NoError(t, h.Create(c))
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Equal(t, etag.Format(1), rec.Header().Get(etag.HeaderETag))
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("検証エラー", func(t *testing.T) {
+		mockUsecase := new(mockMasterDataUsecase[models.Prefecture])
+		mockUsecase.On("Create", mock.Anything, mock.Anything).
+			Return(appErrors.NewValidationError("Parent", "親子カテゴリの組み合わせが不正です", nil))
+
+		h := NewHandler(mockUsecase, "都道府県", 2*time.Second)
+		c, rec := newTestContext(http.MethodPost, "/api/master-data/prefectures", `{"region_id":2,"name":"東京"}`, "")
+
+		require.NoError(t, h.Create(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	applogger.InitTestLogger()
+
+	t.Run("If-Match のバージョンで更新する", func(t *testing.T) {
+		mockUsecase := new(mockMasterDataUsecase[models.Classification])
+		mockUsecase.On("Update", mock.Anything, mock.MatchedBy(func(cl *models.Classification) bool {
+			return cl.ID == 4 && cl.Version == 2 && cl.Name == "私立"
+		})).Run(func(args mock.Arguments) {
+			args.Get(1).(*models.Classification).Version = 3
+		}).Return(nil)
+
+		h := NewHandler(mockUsecase, "設置区分", 2*time.Second)
+		c, rec := newTestContext(http.MethodPut, "/api/master-data/classifications/4", `{"name":"私立"}`, "4")
+		c.Request().Header.Set(etag.HeaderIfMatch, etag.Format(2))
+
+		require.NoError(t, h.Update(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, etag.Format(3), rec.Header().Get(etag.HeaderETag))
+	})
+
+	t.Run("バージョンを指定しない場合は428", func(t *testing.T) {
+		mockUsecase := new(mockMasterDataUsecase[models.Classification])
+
+		h := NewHandler(mockUsecase, "設置区分", 2*time.Second)
+		c, rec := newTestContext(http.MethodPut, "/api/master-data/classifications/4", `{"name":"私立"}`, "4")
+
+		require.NoError(t, h.Update(c))
+		assert.Equal(t, http.StatusPreconditionRequired, rec.Code)
+		mockUsecase.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestReorderAndDelete(t *testing.T) {
+	applogger.InitTestLogger()
+
+	t.Run("並び替え", func(t *testing.T) {
+		mockUsecase := new(mockMasterDataUsecase[models.AcademicField])
+		mockUsecase.On("Reorder", mock.Anything, uint(7), []uint{3, 1, 2}).
+			Return([]models.AcademicField{{Name: "工学"}, {Name: "理学"}, {Name: "医学"}}, nil)
+
+		h := NewHandler(mockUsecase, "学問系統", 2*time.Second)
+		c, rec := newTestContext(http.MethodPut, "/api/master-data/academic-fields/order",
+			`{"parent_id":7,"ids":[3,1,2]}`, "")
+
+		require.NoError(t, h.Reorder(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("削除", func(t *testing.T) {
+		mockUsecase := new(mockMasterDataUsecase[models.SubClassification])
+		mockUsecase.On("Delete", mock.Anything, uint(5)).Return(nil)
+		mockUsecase.On("Delete", mock.Anything, uint(6)).Return(appErrors.NewNotFoundError("小分類", 6, nil))
+
+		h := NewHandler(mockUsecase, "小分類", 2*time.Second)
+
+		c, rec := newTestContext(http.MethodDelete, "/api/master-data/sub-classifications/5", "", "5")
+		require.NoError(t, h.Delete(c))
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+
+		c, rec = newTestContext(http.MethodDelete, "/api/master-data/sub-classifications/6", "", "6")
+		require.NoError(t, h.Delete(c))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
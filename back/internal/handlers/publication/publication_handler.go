@@ -0,0 +1,115 @@
+// Package publication は入試情報の公開ワークフローに関するHTTPリクエストを処理するパッケージです。
+// このパッケージは以下の機能を提供します：
+// - 入試情報の公開・公開取り消し・アーカイブ
+// - If-Match ヘッダーまたはリクエストボディの version による楽観的ロック
+// - エラーハンドリング
+// - ログ記録
+package publication
+
+import (
+	"context"
+	"net/http"
+	"time"
+	"university-exam-api/internal/domain/models"
+	applogger "university-exam-api/internal/logger"
+	"university-exam-api/internal/pkg/errors"
+	"university-exam-api/internal/pkg/etag"
+	"university-exam-api/internal/pkg/validation"
+	"university-exam-api/internal/usecases"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ParamInfoID は入試情報IDのパスパラメータ名です
+const ParamInfoID = "infoID"
+
+const (
+	// msgInvalidInfoID は入試情報IDの形式が不正な場合のログメッセージです
+	msgInvalidInfoID = "入試情報IDの形式が不正です: %v"
+)
+
+// transitionRequest はステータス遷移のリクエストボディです
+// If-Match ヘッダーを送れないクライアントは version で更新前のバージョンを指定します
+type transitionRequest struct {
+	Version int `json:"version"`
+}
+
+// Handler は入試情報の公開ワークフローに関するHTTPリクエストを処理する構造体です。
+// この構造体は以下の機能を提供します：
+// - ユースケースとの連携
+// - リクエストタイムアウトの管理
+// - エラーハンドリング
+type Handler struct {
+	usecase usecases.AdmissionPublicationUsecase
+	timeout time.Duration
+}
+
+// NewPublicationHandler は新しいHandlerインスタンスを生成します。
+// この関数は以下の処理を行います：
+// - ユースケースの初期化
+// - タイムアウトの設定
+func NewPublicationHandler(usecase usecases.AdmissionPublicationUsecase, timeout time.Duration) *Handler {
+	return &Handler{
+		usecase: usecase,
+		timeout: timeout,
+	}
+}
+
+// Publish は下書きの入試情報を公開します。
+// 入試日程・試験種別・科目が揃っていない場合は不足している項目とともに400を返却します。
+func (h *Handler) Publish(c echo.Context) error {
+	return h.transition(c, models.AdmissionActionPublish)
+}
+
+// Unpublish は公開中の入試情報を下書きに戻します。
+func (h *Handler) Unpublish(c echo.Context) error {
+	return h.transition(c, models.AdmissionActionUnpublish)
+}
+
+// Archive は下書きまたは公開中の入試情報をアーカイブします。
+func (h *Handler) Archive(c echo.Context) error {
+	return h.transition(c, models.AdmissionActionArchive)
+}
+
+// transition は入試情報のステータス遷移を共通化します。
+// この関数は以下の処理を行います：
+// - パスパラメータの検証
+// - If-Match ヘッダーまたはリクエストボディの version の解析（どちらもない場合は428）
+// - ステータスの遷移
+// - 新しいバージョンのETagの設定
+func (h *Handler) transition(c echo.Context, action string) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	infoID, err := validation.ParseID(ctx, c.Param(ParamInfoID), msgInvalidInfoID, "入試情報IDの形式が不正です")
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	var req transitionRequest
+	if err := c.Bind(&req); err != nil {
+		applogger.Error(ctx, errors.MsgBindRequestFailed, err)
+		return errors.HandleError(c, err)
+	}
+
+	expectedVersion, err := etag.ExpectedVersion(c, req.Version)
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	info, err := h.usecase.Transition(ctx, infoID, action, expectedVersion)
+	if err != nil {
+		applogger.Error(ctx, "入試情報のステータス変更に失敗しました (ID: %d, 操作: %s): %v", infoID, action, err)
+		return errors.HandleError(c, err)
+	}
+
+	applogger.Info(ctx, "入試情報のステータスを変更しました (ID: %d, ステータス: %s)", infoID, info.Status)
+
+	etag.SetHeader(c, info.Version)
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"success":   true,
+		"timestamp": time.Now().Unix(),
+		"data":      info,
+	})
+}
@@ -0,0 +1,185 @@
+package publication
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	applogger "university-exam-api/internal/logger"
+	"university-exam-api/internal/pkg/etag"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockPublicationUsecase はAdmissionPublicationUsecaseのモックです
+type mockPublicationUsecase struct {
+	mock.Mock
+}
+
+func (m *mockPublicationUsecase) Transition(
+	ctx context.Context,
+	infoID uint,
+	action string,
+	expectedVersion int,
+) (*models.AdmissionInfo, error) {
+	args := m.Called(ctx, infoID, action, expectedVersion)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*models.AdmissionInfo), args.Error(1)
+}
+
+// newTestContext はパスパラメータとIf-Matchヘッダーを設定したテスト用のコンテキストを生成します
+func newTestContext(infoID, ifMatch string) (echo.Context, *httptest.ResponseRecorder) {
+	return newTestContextWithBody(infoID, ifMatch, "")
+}
+
+// newTestContextWithBody はリクエストボディも設定したテスト用のコンテキストを生成します
+func newTestContextWithBody(infoID, ifMatch, body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/api/admission-infos/"+infoID+"/publish", strings.NewReader(body))
+	if ifMatch != "" {
+		req.Header.Set(etag.HeaderIfMatch, ifMatch)
+	}
+
+	if body != "" {
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	}
+
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames(ParamInfoID)
+	c.SetParamValues(infoID)
+
+	return c, rec
+}
+
+func TestTransition(t *testing.T) {
+	applogger.InitTestLogger()
+
+	t.Run("If-Match付きで公開", func(t *testing.T) {
+		mockUsecase := new(mockPublicationUsecase)
+		mockUsecase.On("Transition", mock.Anything, uint(1), models.AdmissionActionPublish, 2).Return(&models.AdmissionInfo{
+			BaseModel: models.BaseModel{ID: 1, Version: 3},
+			Status:    models.AdmissionStatusPublished,
+		}, nil)
+
+		h := NewPublicationHandler(mockUsecase, 2*time.Second)
+		c, rec := newTestContext("1", `"2"`)
+
+		require.NoError(t, h.Publish(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"3"`, rec.Header().Get(etag.HeaderETag))
+
+		var body map[string]interface{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, true, body["success"])
+		assert.Equal(t, models.AdmissionStatusPublished, body["data"].(map[string]interface{})["status"])
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("ボディのversionで公開取り消しとアーカイブ", func(t *testing.T) {
+		mockUsecase := new(mockPublicationUsecase)
+		mockUsecase.On("Transition", mock.Anything, uint(1), models.AdmissionActionUnpublish, 3).Return(&models.AdmissionInfo{
+			BaseModel: models.BaseModel{ID: 1, Version: 4},
+			Status:    models.AdmissionStatusDraft,
+		}, nil)
+		mockUsecase.On("Transition", mock.Anything, uint(1), models.AdmissionActionArchive, 4).Return(&models.AdmissionInfo{
+			BaseModel: models.BaseModel{ID: 1, Version: 5},
+			Status:    models.AdmissionStatusArchived,
+		}, nil)
+
+		h := NewPublicationHandler(mockUsecase, 2*time.Second)
+
+		c, rec := newTestContextWithBody("1", "", `{"version":3}`)
+		require.NoError(t, h.Unpublish(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		c, rec = newTestContextWithBody("1", "", `{"version":4}`)
+		require.NoError(t, h.Archive(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"5"`, rec.Header().Get(etag.HeaderETag))
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("バージョンの指定なし", func(t *testing.T) {
+		mockUsecase := new(mockPublicationUsecase)
+		h := NewPublicationHandler(mockUsecase, 2*time.Second)
+
+		for _, transition := range []func(echo.Context) error{h.Publish, h.Unpublish, h.Archive} {
+			c, rec := newTestContext("1", "")
+
+			require.NoError(t, transition(c))
+			assert.Equal(t, http.StatusPreconditionRequired, rec.Code)
+		}
+
+		mockUsecase.AssertNotCalled(t, "Transition", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("If-Matchとボディのversionの不一致", func(t *testing.T) {
+		mockUsecase := new(mockPublicationUsecase)
+		h := NewPublicationHandler(mockUsecase, 2*time.Second)
+		c, rec := newTestContextWithBody("1", `"2"`, `{"version":3}`)
+
+		require.NoError(t, h.Publish(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockUsecase.AssertNotCalled(t, "Transition", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("不正な入試情報ID", func(t *testing.T) {
+		mockUsecase := new(mockPublicationUsecase)
+		h := NewPublicationHandler(mockUsecase, 2*time.Second)
+		c, rec := newTestContext("abc", "")
+
+		require.NoError(t, h.Publish(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockUsecase.AssertNotCalled(t, "Transition", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("不正なIf-Match", func(t *testing.T) {
+		mockUsecase := new(mockPublicationUsecase)
+		h := NewPublicationHandler(mockUsecase, 2*time.Second)
+		c, rec := newTestContext("1", "*")
+
+		require.NoError(t, h.Publish(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockUsecase.AssertNotCalled(t, "Transition", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("公開に必要な情報の不足", func(t *testing.T) {
+		mockUsecase := new(mockPublicationUsecase)
+		mockUsecase.On("Transition", mock.Anything, uint(1), models.AdmissionActionPublish, 2).Return(nil,
+			appErrors.NewValidationError("status", "公開に必要な入試情報が揃っていません", map[string]string{
+				"test_types": "試験種別が登録されていません",
+			}))
+
+		h := NewPublicationHandler(mockUsecase, 2*time.Second)
+		c, rec := newTestContext("1", `"2"`)
+
+		require.NoError(t, h.Publish(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "試験種別が登録されていません")
+	})
+
+	t.Run("バージョンの競合", func(t *testing.T) {
+		current := &models.AdmissionInfo{BaseModel: models.BaseModel{ID: 1, Version: 3}}
+		mockUsecase := new(mockPublicationUsecase)
+		mockUsecase.On("Transition", mock.Anything, uint(1), models.AdmissionActionPublish, 2).
+			Return(nil, appErrors.NewConflictError("入試情報", 1, 3, current))
+
+		h := NewPublicationHandler(mockUsecase, 2*time.Second)
+		c, rec := newTestContext("1", `"2"`)
+
+		require.NoError(t, h.Publish(c))
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		assert.Equal(t, `"3"`, rec.Header().Get(etag.HeaderETag))
+	})
+}
@@ -0,0 +1,320 @@
+// Package scheduleevent は入試日程の年度ごとの日程とカレンダー配信に関するHTTPリクエストを処理するパッケージです。
+// このパッケージは以下の機能を提供します：
+// - 日程（出願期間・試験日・合格発表・入学手続締切）の取得、作成、更新、削除
+// - 学科ごと・選択した学科ごとのiCalendar形式のカレンダーの配信
+// - エラーハンドリング
+// - ログ記録
+package scheduleevent
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/infrastructure/audit"
+	applogger "university-exam-api/internal/logger"
+	"university-exam-api/internal/pkg/errors"
+	"university-exam-api/internal/pkg/etag"
+	"university-exam-api/internal/pkg/ical"
+	"university-exam-api/internal/pkg/validation"
+	"university-exam-api/internal/usecases"
+
+	"github.com/labstack/echo/v4"
+)
+
+// パスパラメータ名
+const (
+	ParamMajorID    = "majorID"
+	ParamScheduleID = "scheduleID"
+	ParamEventID    = "eventID"
+)
+
+// クエリパラメータ名
+const (
+	// QueryAcademicYear は学年度で絞り込むクエリパラメータ名です
+	QueryAcademicYear = "year"
+	// QueryMajors はカレンダーにまとめる学科のIDのクエリパラメータ名です（カンマ区切り・繰り返し指定が可能）
+	QueryMajors = "majors"
+)
+
+const (
+	// msgInvalidEventID は日程IDの形式が不正な場合のログメッセージです
+	msgInvalidEventID = "日程IDの形式が不正です: %v"
+	// msgInvalidMajorID は学科IDの形式が不正な場合のログメッセージです
+	msgInvalidMajorID = "学科IDの形式が不正です: %v"
+	// calendarFilename はカレンダーのファイル名です
+	calendarFilename = "schedule.ics"
+)
+
+// Handler は入試日程の日程とカレンダー配信に関するHTTPリクエストを処理する構造体です。
+// この構造体は以下の機能を提供します：
+// - ユースケースとの連携
+// - リクエストタイムアウトの管理
+// - エラーハンドリング
+type Handler struct {
+	usecase usecases.ScheduleEventUsecase
+	timeout time.Duration
+}
+
+// NewScheduleEventHandler は新しいHandlerインスタンスを生成します。
+// この関数は以下の処理を行います：
+// - ユースケースの初期化
+// - タイムアウトの設定
+func NewScheduleEventHandler(usecase usecases.ScheduleEventUsecase, timeout time.Duration) *Handler {
+	return &Handler{
+		usecase: usecase,
+		timeout: timeout,
+	}
+}
+
+// bindAcademicYear はクエリパラメータから学年度を取得します（指定されていない場合は0）
+func bindAcademicYear(ctx context.Context, c echo.Context) (int, error) {
+	value := c.QueryParam(QueryAcademicYear)
+	if value == "" {
+		return 0, nil
+	}
+
+	return validation.ValidateAcademicYear(ctx, value)
+}
+
+// bindScheduleAndEventID はパスパラメータから入試日程IDと日程IDを取得します
+func bindScheduleAndEventID(ctx context.Context, c echo.Context) (uint, uint, error) {
+	scheduleID, err := validation.ValidateScheduleID(ctx, c.Param(ParamScheduleID))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	eventID, err := validation.ParseID(ctx, c.Param(ParamEventID), msgInvalidEventID, "日程IDの形式が不正です")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return scheduleID, eventID, nil
+}
+
+// bindEvent はリクエストボディを日程にバインドします。
+// 日付の形式が不正な場合もリクエストボディの不正として扱います。
+func bindEvent(ctx context.Context, c echo.Context, event *models.ScheduleEvent) error {
+	if err := c.Bind(event); err != nil {
+		applogger.Error(ctx, errors.MsgBindRequestFailed, err)
+		return appErrors.NewInvalidInputError("request", errors.MsgInvalidRequestBody, nil)
+	}
+
+	return nil
+}
+
+// List は入試日程の日程を取得します。
+// year を指定した場合は指定した学年度の日程のみを取得します。
+func (h *Handler) List(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	scheduleID, err := validation.ValidateScheduleID(ctx, c.Param(ParamScheduleID))
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	year, err := bindAcademicYear(ctx, c)
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	events, err := h.usecase.List(ctx, scheduleID, year)
+	if err != nil {
+		applogger.Error(ctx, "日程の一覧取得に失敗しました (入試日程ID: %d): %v", scheduleID, err)
+		return errors.HandleError(c, err)
+	}
+
+	applogger.Info(ctx, "日程の一覧を取得しました (入試日程ID: %d, 件数: %d)", scheduleID, len(events))
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"data": events,
+	})
+}
+
+// Get は指定された日程を取得します。
+func (h *Handler) Get(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	scheduleID, eventID, err := bindScheduleAndEventID(ctx, c)
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	event, err := h.usecase.Get(ctx, scheduleID, eventID)
+	if err != nil {
+		applogger.Error(ctx, "日程の取得に失敗しました (入試日程ID: %d, 日程ID: %d): %v", scheduleID, eventID, err)
+		return errors.HandleError(c, err)
+	}
+
+	etag.SetHeader(c, event.Version)
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"data": event,
+	})
+}
+
+// Create は入試日程の日程を作成します。
+// この関数は以下の処理を行います：
+// - リクエストボディのバインディング（日付は YYYY-MM-DD 形式）
+// - イベント種別・学年度・期間の検証
+// - 同じ学年度・イベント種別の日程の重複確認
+func (h *Handler) Create(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	scheduleID, err := validation.ValidateScheduleID(ctx, c.Param(ParamScheduleID))
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	var event models.ScheduleEvent
+	if err := bindEvent(ctx, c, &event); err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	event.BaseModel = models.BaseModel{}
+	event.AdmissionScheduleID = scheduleID
+	audit.StampCreate(ctx, &event.BaseModel)
+
+	if err := h.usecase.Create(ctx, &event); err != nil {
+		applogger.Error(ctx, "日程の作成に失敗しました (入試日程ID: %d): %v", scheduleID, err)
+		return errors.HandleError(c, err)
+	}
+
+	applogger.Info(ctx, "日程を作成しました (入試日程ID: %d, 日程ID: %d)", scheduleID, event.ID)
+
+	etag.SetHeader(c, event.Version)
+
+	return c.JSON(http.StatusCreated, map[string]interface{}{
+		"data": event,
+	})
+}
+
+// Update は入試日程の日程を更新します。
+// この関数は以下の処理を行います：
+// - パラメータの検証
+// - 期待するバージョン（If-Match またはボディの version）の取得
+// - 楽観的ロックによる更新（バージョンが一致しない場合は現在の状態とともに409を返却）
+func (h *Handler) Update(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	scheduleID, eventID, err := bindScheduleAndEventID(ctx, c)
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	var event models.ScheduleEvent
+	if err := bindEvent(ctx, c, &event); err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	expectedVersion, err := etag.ExpectedVersion(c, event.Version)
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	event.BaseModel = models.BaseModel{ID: eventID, Version: expectedVersion}
+	event.AdmissionScheduleID = scheduleID
+	audit.StampUpdate(ctx, &event.BaseModel)
+
+	if err := h.usecase.Update(ctx, &event); err != nil {
+		applogger.Error(ctx, "日程の更新に失敗しました (入試日程ID: %d, 日程ID: %d): %v", scheduleID, eventID, err)
+		return errors.HandleError(c, err)
+	}
+
+	applogger.Info(ctx, "日程を更新しました (入試日程ID: %d, 日程ID: %d)", scheduleID, eventID)
+
+	etag.SetHeader(c, event.Version)
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"data": event,
+	})
+}
+
+// Delete は入試日程の日程をソフトデリートします。
+func (h *Handler) Delete(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	scheduleID, eventID, err := bindScheduleAndEventID(ctx, c)
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	if err := h.usecase.Delete(ctx, scheduleID, eventID); err != nil {
+		applogger.Error(ctx, "日程の削除に失敗しました (入試日程ID: %d, 日程ID: %d): %v", scheduleID, eventID, err)
+		return errors.HandleError(c, err)
+	}
+
+	applogger.Info(ctx, "日程を削除しました (入試日程ID: %d, 日程ID: %d)", scheduleID, eventID)
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// MajorCalendar は学科の日程をiCalendar形式で配信します。
+// year を指定した場合は指定した学年度の日程のみを配信します。
+func (h *Handler) MajorCalendar(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	majorID, err := validation.ValidateMajorID(ctx, c.Param(ParamMajorID))
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	return h.writeCalendar(ctx, c, []uint{majorID})
+}
+
+// Calendar は majors で指定された学科の日程を1つのカレンダーにまとめてiCalendar形式で配信します。
+// この関数は以下の処理を行います：
+// - 学科のIDの解析（カンマ区切り・繰り返し指定が可能）
+// - 学年度の解析
+// - カレンダーの生成と配信
+func (h *Handler) Calendar(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	var majorIDs []uint
+
+	for _, raw := range c.QueryParams()[QueryMajors] {
+		for _, value := range strings.Split(raw, ",") {
+			if value = strings.TrimSpace(value); value == "" {
+				continue
+			}
+
+			id, err := validation.ParseID(ctx, value, msgInvalidMajorID, "学科IDの形式が不正です")
+			if err != nil {
+				return errors.HandleError(c, err)
+			}
+
+			majorIDs = append(majorIDs, id)
+		}
+	}
+
+	return h.writeCalendar(ctx, c, majorIDs)
+}
+
+// writeCalendar は学科の日程のカレンダーを生成し、iCalendar形式のレスポンスとして書き出します
+func (h *Handler) writeCalendar(ctx context.Context, c echo.Context, majorIDs []uint) error {
+	year, err := bindAcademicYear(ctx, c)
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	calendar, err := h.usecase.Calendar(ctx, majorIDs, year)
+	if err != nil {
+		applogger.Error(ctx, "カレンダーの生成に失敗しました (学科ID: %v): %v", majorIDs, err)
+		return errors.HandleError(c, err)
+	}
+
+	applogger.Info(ctx, "カレンダーを配信しました (学科ID: %v, 件数: %d)", majorIDs, len(calendar.Events))
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, `inline; filename="`+calendarFilename+`"`)
+
+	return c.Blob(http.StatusOK, ical.ContentType, calendar.Bytes())
+}
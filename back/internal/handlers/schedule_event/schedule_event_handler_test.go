@@ -0,0 +1,256 @@
+package scheduleevent
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	applogger "university-exam-api/internal/logger"
+	"university-exam-api/internal/pkg/etag"
+	"university-exam-api/internal/pkg/ical"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockScheduleEventUsecase はScheduleEventUsecaseのモックです
+type mockScheduleEventUsecase struct {
+	mock.Mock
+}
+
+func (m *mockScheduleEventUsecase) List(ctx context.Context, scheduleID uint, year int) ([]models.ScheduleEvent, error) {
+	args := m.Called(ctx, scheduleID, year)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]models.ScheduleEvent), args.Error(1)
+}
+
+func (m *mockScheduleEventUsecase) Get(ctx context.Context, scheduleID, eventID uint) (*models.ScheduleEvent, error) {
+	args := m.Called(ctx, scheduleID, eventID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*models.ScheduleEvent), args.Error(1)
+}
+
+func (m *mockScheduleEventUsecase) Create(ctx context.Context, event *models.ScheduleEvent) error {
+	return m.Called(ctx, event).Error(0)
+}
+
+func (m *mockScheduleEventUsecase) Update(ctx context.Context, event *models.ScheduleEvent) error {
+	return m.Called(ctx, event).Error(0)
+}
+
+func (m *mockScheduleEventUsecase) Delete(ctx context.Context, scheduleID, eventID uint) error {
+	return m.Called(ctx, scheduleID, eventID).Error(0)
+}
+
+func (m *mockScheduleEventUsecase) Calendar(ctx context.Context, majorIDs []uint, year int) (*ical.Calendar, error) {
+	args := m.Called(ctx, majorIDs, year)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*ical.Calendar), args.Error(1)
+}
+
+// newTestContext はリクエストボディとパスパラメータを設定したテスト用のコンテキストを生成します
+func newTestContext(method, target, body string, params map[string]string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	names := make([]string, 0, len(params))
+	values := make([]string, 0, len(params))
+
+	for name, value := range params {
+		names = append(names, name)
+		values = append(values, value)
+	}
+
+	c.SetParamNames(names...)
+	c.SetParamValues(values...)
+
+	return c, rec
+}
+
+func TestCreate(t *testing.T) {
+	applogger.InitTestLogger()
+
+	t.Run("パスの入試日程に日程を作成する", func(t *testing.T) {
+		mockUsecase := new(mockScheduleEventUsecase)
+		mockUsecase.On("Create", mock.Anything, mock.MatchedBy(func(e *models.ScheduleEvent) bool {
+			return e.ID == 0 && e.AdmissionScheduleID == 5 && e.Type == models.ScheduleEventApplication &&
+				e.StartDate.String() == "2026-01-26" && e.EndDate != nil && e.EndDate.String() == "2026-02-04"
+		})).Run(func(args mock.Arguments) {
+			e := args.Get(1).(*models.ScheduleEvent)
+			e.ID = 11
+			e.Version = 1
+		}).Return(nil)
+
+		h := NewScheduleEventHandler(mockUsecase, 2*time.Second)
+		c, rec := newTestContext(http.MethodPost, "/events",
+			`{"id":99,"admission_schedule_id":1,"academic_year":2026,"type":"application",`+
+				`"start_date":"2026-01-26","end_date":"2026-02-04"}`,
+			map[string]string{ParamScheduleID: "5"})
+
+		require.NoError(t, h.Create(c))
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Equal(t, etag.Format(1), rec.Header().Get(etag.HeaderETag))
+		assert.Contains(t, rec.Body.String(), `"start_date":"2026-01-26"`)
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("日付の形式が不正な場合は400", func(t *testing.T) {
+		mockUsecase := new(mockScheduleEventUsecase)
+
+		h := NewScheduleEventHandler(mockUsecase, 2*time.Second)
+		c, rec := newTestContext(http.MethodPost, "/events",
+			`{"academic_year":2026,"type":"exam","start_date":"2026/02/25"}`,
+			map[string]string{ParamScheduleID: "5"})
+
+		require.NoError(t, h.Create(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockUsecase.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestUpdateAndDelete(t *testing.T) {
+	applogger.InitTestLogger()
+
+	t.Run("If-Match のバージョンで更新する", func(t *testing.T) {
+		mockUsecase := new(mockScheduleEventUsecase)
+		mockUsecase.On("Update", mock.Anything, mock.MatchedBy(func(e *models.ScheduleEvent) bool {
+			return e.ID == 11 && e.AdmissionScheduleID == 5 && e.Version == 2
+		})).Run(func(args mock.Arguments) {
+			args.Get(1).(*models.ScheduleEvent).Version = 3
+		}).Return(nil)
+
+		h := NewScheduleEventHandler(mockUsecase, 2*time.Second)
+		c, rec := newTestContext(http.MethodPut, "/events/11",
+			`{"academic_year":2026,"type":"exam","start_date":"2026-02-25"}`,
+			map[string]string{ParamScheduleID: "5", ParamEventID: "11"})
+		c.Request().Header.Set(etag.HeaderIfMatch, etag.Format(2))
+
+		require.NoError(t, h.Update(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, etag.Format(3), rec.Header().Get(etag.HeaderETag))
+	})
+
+	t.Run("バージョンを指定しない場合は428", func(t *testing.T) {
+		mockUsecase := new(mockScheduleEventUsecase)
+
+		h := NewScheduleEventHandler(mockUsecase, 2*time.Second)
+		c, rec := newTestContext(http.MethodPut, "/events/11",
+			`{"academic_year":2026,"type":"exam","start_date":"2026-02-25"}`,
+			map[string]string{ParamScheduleID: "5", ParamEventID: "11"})
+
+		require.NoError(t, h.Update(c))
+		assert.Equal(t, http.StatusPreconditionRequired, rec.Code)
+		mockUsecase.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("削除", func(t *testing.T) {
+		mockUsecase := new(mockScheduleEventUsecase)
+		mockUsecase.On("Delete", mock.Anything, uint(5), uint(11)).Return(nil)
+		mockUsecase.On("Delete", mock.Anything, uint(5), uint(12)).
+			Return(appErrors.NewNotFoundError("入試日程の日程", 12, nil))
+
+		h := NewScheduleEventHandler(mockUsecase, 2*time.Second)
+
+		c, rec := newTestContext(http.MethodDelete, "/events/11", "", map[string]string{ParamScheduleID: "5", ParamEventID: "11"})
+		require.NoError(t, h.Delete(c))
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+
+		c, rec = newTestContext(http.MethodDelete, "/events/12", "", map[string]string{ParamScheduleID: "5", ParamEventID: "12"})
+		require.NoError(t, h.Delete(c))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestList(t *testing.T) {
+	applogger.InitTestLogger()
+
+	mockUsecase := new(mockScheduleEventUsecase)
+	mockUsecase.On("List", mock.Anything, uint(5), 2026).Return([]models.ScheduleEvent{
+		{AcademicYear: 2026, Type: models.ScheduleEventExam, StartDate: models.NewDate(2026, 2, 25)},
+	}, nil)
+
+	h := NewScheduleEventHandler(mockUsecase, 2*time.Second)
+	c, rec := newTestContext(http.MethodGet, "/events?year=2026", "", map[string]string{ParamScheduleID: "5"})
+
+	require.NoError(t, h.List(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var body struct {
+		Data []models.ScheduleEvent `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.Len(t, body.Data, 1)
+	assert.Equal(t, "2026-02-25", body.Data[0].StartDate.String())
+}
+
+func TestCalendar(t *testing.T) {
+	applogger.InitTestLogger()
+
+	calendar := &ical.Calendar{ProdID: "-//test//JA", Name: "入試日程"}
+
+	t.Run("学科の日程をiCalendar形式で配信する", func(t *testing.T) {
+		mockUsecase := new(mockScheduleEventUsecase)
+		mockUsecase.On("Calendar", mock.Anything, []uint{3}, 2026).Return(calendar, nil)
+
+		h := NewScheduleEventHandler(mockUsecase, 2*time.Second)
+		c, rec := newTestContext(http.MethodGet, "/calendar.ics?year=2026", "", map[string]string{ParamMajorID: "3"})
+
+		require.NoError(t, h.MajorCalendar(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, ical.ContentType, rec.Header().Get(echo.HeaderContentType))
+		assert.Contains(t, rec.Header().Get(echo.HeaderContentDisposition), "schedule.ics")
+		assert.True(t, strings.HasPrefix(rec.Body.String(), "BEGIN:VCALENDAR\r\n"))
+	})
+
+	t.Run("カンマ区切りと繰り返し指定の学科をまとめる", func(t *testing.T) {
+		mockUsecase := new(mockScheduleEventUsecase)
+		mockUsecase.On("Calendar", mock.Anything, []uint{3, 4, 8}, 0).Return(calendar, nil)
+
+		h := NewScheduleEventHandler(mockUsecase, 2*time.Second)
+		c, rec := newTestContext(http.MethodGet, "/api/calendar.ics?majors=3,4&majors=8", "", nil)
+
+		require.NoError(t, h.Calendar(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("学科IDの形式が不正な場合は400", func(t *testing.T) {
+		mockUsecase := new(mockScheduleEventUsecase)
+
+		h := NewScheduleEventHandler(mockUsecase, 2*time.Second)
+		c, rec := newTestContext(http.MethodGet, "/api/calendar.ics?majors=3,abc", "", nil)
+
+		require.NoError(t, h.Calendar(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockUsecase.AssertNotCalled(t, "Calendar", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("存在しない学科を指定した場合は404", func(t *testing.T) {
+		mockUsecase := new(mockScheduleEventUsecase)
+		mockUsecase.On("Calendar", mock.Anything, []uint{9}, 0).Return(nil, appErrors.NewNotFoundError("学科", 9, nil))
+
+		h := NewScheduleEventHandler(mockUsecase, 2*time.Second)
+		c, rec := newTestContext(http.MethodGet, "/api/calendar.ics?majors=9", "", nil)
+
+		require.NoError(t, h.Calendar(c))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
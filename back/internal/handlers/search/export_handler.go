@@ -0,0 +1,133 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+	appErrors "university-exam-api/internal/errors"
+	applogger "university-exam-api/internal/logger"
+	errorHandler "university-exam-api/internal/pkg/errors"
+	"university-exam-api/internal/pkg/validation"
+	"university-exam-api/internal/repositories"
+	"university-exam-api/internal/usecases"
+
+	"github.com/labstack/echo/v4"
+)
+
+// paramFormat はエクスポート形式のクエリパラメータ名です
+const paramFormat = "format"
+
+// ExportHandler は検索結果のエクスポートのHTTPリクエストを処理する構造体です。
+// この構造体は以下の機能を提供します：
+// - ユースケースとの連携
+// - リクエストタイムアウトの管理
+// - エラーハンドリング
+type ExportHandler struct {
+	usecase usecases.ExportUsecase
+	timeout time.Duration
+}
+
+// NewExportHandler は新しいExportHandlerインスタンスを生成します。
+// この関数は以下の処理を行います：
+// - ユースケースの初期化
+// - タイムアウトの設定
+func NewExportHandler(usecase usecases.ExportUsecase, timeout time.Duration) *ExportHandler {
+	return &ExportHandler{
+		usecase: usecase,
+		timeout: timeout,
+	}
+}
+
+// bindExportCriteria はクエリパラメータからエクスポートの条件を生成します
+func bindExportCriteria(ctx context.Context, c echo.Context) (repositories.ExportCriteria, error) {
+	facets, err := bindFacetCriteria(c)
+	if err != nil {
+		return repositories.ExportCriteria{}, err
+	}
+
+	criteria := repositories.ExportCriteria{
+		FacetSearchCriteria: facets,
+	}
+
+	if criteria.Query != "" {
+		if err := validateQueryContent(criteria.Query); err != nil {
+			return criteria, err
+		}
+	}
+
+	if year := c.QueryParam(paramAcademicYear); year != "" {
+		if criteria.AcademicYear, err = validation.ValidateAcademicYear(ctx, year); err != nil {
+			return criteria, err
+		}
+	}
+
+	return criteria, nil
+}
+
+// exportFilename はダウンロード時のファイル名を返します
+func exportFilename(format string, academicYear int) string {
+	if academicYear != 0 {
+		return fmt.Sprintf("universities-%d.%s", academicYear, format)
+	}
+
+	return "universities." + format
+}
+
+// Export は条件に一致する大学・学部・学科・入試日程・科目をファイルとして書き出します。
+// この関数は以下の処理を行います：
+// - 形式（csv・ndjson・xlsx、既定はcsv）とファセット条件・学年度の取得とバリデーション
+// - ダウンロード用のヘッダーの設定
+// - 科目単位の行のレスポンスへの逐次書き出し
+// 書き出し開始後にエラーが発生した場合はステータスを変更できないため、ログへの記録のみ行います
+func (h *ExportHandler) Export(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	format := strings.ToLower(c.QueryParam(paramFormat))
+	if format == "" {
+		format = usecases.ExportFormatCSV
+	}
+
+	contentType, ok := usecases.ExportContentTypes[format]
+	if !ok {
+		err := appErrors.NewInvalidInputError(paramFormat, "エクスポートの形式はcsv・ndjson・xlsxのいずれかである必要があります", nil)
+		applogger.Error(ctx, "エクスポート形式のバリデーションに失敗しました: %v", err)
+
+		return errorHandler.HandleError(c, err)
+	}
+
+	criteria, err := bindExportCriteria(ctx, c)
+	if err != nil {
+		applogger.Error(ctx, "エクスポート条件のバリデーションに失敗しました: %v", err)
+		return errorHandler.HandleError(c, err)
+	}
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, contentType)
+	res.Header().Set(echo.HeaderContentDisposition,
+		fmt.Sprintf("attachment; filename=%q", exportFilename(format, criteria.AcademicYear)))
+
+	if err := h.usecase.Export(ctx, format, criteria, res); err != nil {
+		applogger.Error(ctx, "エクスポートに失敗しました: %v", err)
+
+		if res.Committed {
+			return nil
+		}
+
+		// エラーはJSONで返すため、ダウンロード用のヘッダーを取り消す
+		res.Header().Del(echo.HeaderContentType)
+		res.Header().Del(echo.HeaderContentDisposition)
+
+		return errorHandler.HandleError(c, err)
+	}
+
+	if !res.Committed {
+		res.WriteHeader(http.StatusOK)
+	}
+
+	applogger.Info(ctx, "エクスポートに成功しました: format=%s, size=%d", format, res.Size)
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	appErrors "university-exam-api/internal/errors"
+	applogger "university-exam-api/internal/logger"
+	"university-exam-api/internal/repositories"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockExportUsecase はExportUsecaseのモックです
+// 成功時は content をそのまま書き出します
+type mockExportUsecase struct {
+	mock.Mock
+	content string
+}
+
+func (m *mockExportUsecase) Export(
+	ctx context.Context,
+	format string,
+	criteria repositories.ExportCriteria,
+	w io.Writer,
+) error {
+	args := m.Called(ctx, format, criteria)
+	if err := args.Error(0); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, m.content)
+
+	return err
+}
+
+// newExportContext はエクスポートのリクエストのコンテキストを生成します
+func newExportContext(query url.Values) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodGet, "/universities/export?"+query.Encode(), nil)
+	rec := httptest.NewRecorder()
+
+	return echo.New().NewContext(req, rec), rec
+}
+
+func TestExportSuccess(t *testing.T) {
+	applogger.InitTestLogger()
+
+	t.Run("形式・学年度・ファセットの指定", func(t *testing.T) {
+		mockUsecase := &mockExportUsecase{content: "{}\n"}
+		mockUsecase.On("Export", mock.Anything, "ndjson", repositories.ExportCriteria{
+			FacetSearchCriteria: repositories.FacetSearchCriteria{Regions: []string{"関東"}},
+			AcademicYear:        2025,
+		}).Return(nil)
+
+		c, rec := newExportContext(url.Values{
+			"format":        {"NDJSON"},
+			"region":        {"関東"},
+			"academic_year": {"2025"},
+		})
+
+		require.NoError(t, NewExportHandler(mockUsecase, 2*time.Second).Export(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "application/x-ndjson", rec.Header().Get(echo.HeaderContentType))
+		assert.Equal(t, `attachment; filename="universities-2025.ndjson"`, rec.Header().Get(echo.HeaderContentDisposition))
+		assert.Equal(t, "{}\n", rec.Body.String())
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("形式の指定がない場合はCSV", func(t *testing.T) {
+		mockUsecase := &mockExportUsecase{}
+		mockUsecase.On("Export", mock.Anything, "csv", repositories.ExportCriteria{}).Return(nil)
+
+		c, rec := newExportContext(url.Values{})
+
+		require.NoError(t, NewExportHandler(mockUsecase, 2*time.Second).Export(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "text/csv; charset=utf-8", rec.Header().Get(echo.HeaderContentType))
+		assert.Equal(t, `attachment; filename="universities.csv"`, rec.Header().Get(echo.HeaderContentDisposition))
+	})
+}
+
+func TestExportErrors(t *testing.T) {
+	applogger.InitTestLogger()
+
+	t.Run("未対応の形式", func(t *testing.T) {
+		mockUsecase := &mockExportUsecase{}
+		c, rec := newExportContext(url.Values{"format": {"pdf"}})
+
+		require.NoError(t, NewExportHandler(mockUsecase, 2*time.Second).Export(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockUsecase.AssertNotCalled(t, "Export", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("不正な学年度", func(t *testing.T) {
+		mockUsecase := &mockExportUsecase{}
+		c, rec := newExportContext(url.Values{"academic_year": {"abc"}})
+
+		require.NoError(t, NewExportHandler(mockUsecase, 2*time.Second).Export(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockUsecase.AssertNotCalled(t, "Export", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("書き出し前のエラーはJSONで返す", func(t *testing.T) {
+		mockUsecase := &mockExportUsecase{}
+		mockUsecase.On("Export", mock.Anything, "xlsx", mock.Anything).
+			Return(appErrors.NewDatabaseError("エクスポート処理", errors.New("db error"), nil))
+
+		c, rec := newExportContext(url.Values{"format": {"xlsx"}})
+
+		require.NoError(t, NewExportHandler(mockUsecase, 2*time.Second).Export(c))
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Contains(t, rec.Header().Get(echo.HeaderContentType), echo.MIMEApplicationJSON)
+		assert.Empty(t, rec.Header().Get(echo.HeaderContentDisposition))
+	})
+}
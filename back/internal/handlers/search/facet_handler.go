@@ -0,0 +1,180 @@
+package search
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	applogger "university-exam-api/internal/logger"
+	errorHandler "university-exam-api/internal/pkg/errors"
+	"university-exam-api/internal/pkg/pagination"
+	"university-exam-api/internal/pkg/preview"
+	"university-exam-api/internal/repositories"
+	"university-exam-api/internal/usecases"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ファセット検索のクエリパラメータ名
+const (
+	paramRegion            = "region"
+	paramPrefecture        = "prefecture"
+	paramClassification    = "classification"
+	paramSubClassification = "sub_classification"
+	paramSchedule          = "schedule"
+	paramAcademicField     = "academic_field"
+	paramMinDeviation      = "min_deviation"
+	paramMaxDeviation      = "max_deviation"
+	paramMinCommonTestRate = "min_common_test_rate"
+	paramMaxCommonTestRate = "max_common_test_rate"
+	paramMinBorderScore    = "min_border_score"
+	paramMaxBorderScore    = "max_border_score"
+)
+
+// FacetHandler はファセット検索のHTTPリクエストを処理する構造体です。
+// この構造体は以下の機能を提供します：
+// - ユースケースとの連携
+// - リクエストタイムアウトの管理
+// - エラーハンドリング
+type FacetHandler struct {
+	usecase usecases.FacetSearchUsecase
+	timeout time.Duration
+}
+
+// NewFacetHandler は新しいFacetHandlerインスタンスを生成します。
+// この関数は以下の処理を行います：
+// - ユースケースの初期化
+// - タイムアウトの設定
+func NewFacetHandler(usecase usecases.FacetSearchUsecase, timeout time.Duration) *FacetHandler {
+	return &FacetHandler{
+		usecase: usecase,
+		timeout: timeout,
+	}
+}
+
+// queryValues は同名パラメータの繰り返しとカンマ区切りの両方に対応して値を取得します
+func queryValues(c echo.Context, name string) []string {
+	var values []string
+
+	for _, raw := range c.QueryParams()[name] {
+		for _, v := range strings.Split(raw, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				values = append(values, v)
+			}
+		}
+	}
+
+	return values
+}
+
+// queryNumber は数値のクエリパラメータを取得します
+// パラメータが指定されていない場合はnilを返します
+func queryNumber(c echo.Context, name string) (*float64, error) {
+	raw := strings.TrimSpace(c.QueryParam(name))
+	if raw == "" {
+		return nil, nil
+	}
+
+	v, err := strconv.ParseFloat(raw, 64)
+	if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+		return nil, appErrors.NewInvalidInputError(name, "数値である必要があります", nil)
+	}
+
+	return &v, nil
+}
+
+// bindFacetCriteria はクエリパラメータからファセット検索条件を生成します
+// 合格難易度の範囲のパラメータが数値でない場合はエラーを返します
+func bindFacetCriteria(c echo.Context) (repositories.FacetSearchCriteria, error) {
+	criteria := repositories.FacetSearchCriteria{
+		Query:              strings.TrimSpace(c.QueryParam("q")),
+		Regions:            queryValues(c, paramRegion),
+		Prefectures:        queryValues(c, paramPrefecture),
+		Classifications:    queryValues(c, paramClassification),
+		SubClassifications: queryValues(c, paramSubClassification),
+		Schedules:          queryValues(c, paramSchedule),
+		AcademicFields:     queryValues(c, paramAcademicField),
+	}
+
+	bounds := []struct {
+		name   string
+		target **float64
+	}{
+		{paramMinDeviation, &criteria.Deviation.Min},
+		{paramMaxDeviation, &criteria.Deviation.Max},
+		{paramMinCommonTestRate, &criteria.CommonTestRate.Min},
+		{paramMaxCommonTestRate, &criteria.CommonTestRate.Max},
+		{paramMinBorderScore, &criteria.BorderScore.Min},
+		{paramMaxBorderScore, &criteria.BorderScore.Max},
+	}
+
+	for _, b := range bounds {
+		v, err := queryNumber(c, b.name)
+		if err != nil {
+			return criteria, err
+		}
+
+		*b.target = v
+	}
+
+	return criteria, nil
+}
+
+// SearchWithFacets は地域・都道府県・設置区分・日程・学問系統とフリーテキストを組み合わせて大学を検索します。
+// この関数は以下の処理を行います：
+// - 検索条件・ページネーション指定の取得とバリデーション
+// - ファセット検索の実行
+// - 検索結果・ページ情報とファセット件数の整形
+func (h *FacetHandler) SearchWithFacets(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	criteria, err := bindFacetCriteria(c)
+	if err != nil {
+		applogger.Error(ctx, "検索条件のバリデーションに失敗しました: %v", err)
+		return errorHandler.HandleError(c, err)
+	}
+
+	if criteria.Query != "" {
+		if err := validateQueryContent(criteria.Query); err != nil {
+			applogger.Error(ctx, "検索クエリのバリデーションに失敗しました: %v", err)
+			return errorHandler.HandleError(c, err)
+		}
+	}
+
+	params, err := pagination.ParseParams(c)
+	if err != nil {
+		applogger.Error(ctx, "ページネーション指定のバリデーションに失敗しました: %v", err)
+		return errorHandler.HandleError(c, err)
+	}
+
+	// 検索クエリがありソートキーの指定がない場合は、大学の検索と同じく関連度順とする
+	if criteria.Query != "" && params.Cursor == nil && c.QueryParam(pagination.ParamSort) == "" {
+		params.Sort = pagination.SortRelevance
+	}
+
+	result, err := h.usecase.SearchWithFacets(ctx, criteria, params)
+	if err != nil {
+		applogger.Error(ctx, "ファセット検索に失敗しました: %v", err)
+		return errorHandler.HandleError(c, err)
+	}
+
+	// 公開中でない入試情報は管理者のプレビュー時のみ返却する
+	if !preview.IncludeDrafts(ctx) {
+		result.Universities = models.PublishedUniversities(result.Universities)
+	}
+
+	links := pagination.BuildLinks(c, params, result.Page)
+	pagination.SetLinkHeader(c, links)
+
+	applogger.Info(ctx, "ファセット検索に成功しました: query=%s, 件数=%d", criteria.Query, len(result.Universities))
+
+	resp := searchResponse(criteria.Query, result.Universities, result.Page, links)
+	resp["facets"] = result.Facets
+
+	return c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,172 @@
+package search
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"university-exam-api/internal/domain/models"
+	applogger "university-exam-api/internal/logger"
+	"university-exam-api/internal/pkg/pagination"
+	"university-exam-api/internal/repositories"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockFacetSearchUsecase はFacetSearchUsecaseのモックです
+type mockFacetSearchUsecase struct {
+	mock.Mock
+}
+
+func (m *mockFacetSearchUsecase) SearchWithFacets(
+	ctx context.Context,
+	criteria repositories.FacetSearchCriteria,
+	params pagination.Params,
+) (*repositories.FacetSearchResult, error) {
+	args := m.Called(ctx, criteria, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*repositories.FacetSearchResult), args.Error(1)
+}
+
+func TestSearchWithFacetsSuccess(t *testing.T) {
+	applogger.InitTestLogger()
+
+	// 検索クエリがありソートキーの指定がない場合は関連度順
+	params := pagination.DefaultParams()
+	params.Sort = pagination.SortRelevance
+
+	mockUsecase := new(mockFacetSearchUsecase)
+	mockUsecase.On("SearchWithFacets", mock.Anything, repositories.FacetSearchCriteria{
+		Query:           "工学",
+		Regions:         []string{"関東", "関西"},
+		Classifications: []string{"国公立"},
+		Schedules:       []string{"前"},
+	}, params).Return(&repositories.FacetSearchResult{
+		Universities: []models.University{{Name: "テスト大学"}},
+		Facets: map[string][]repositories.FacetCount{
+			models.FilterCategoryRegion: {{Name: "関東", Count: 1}},
+		},
+		Page: pagination.Page{Total: 1, Page: 1, PerPage: pagination.DefaultPerPage},
+	}, nil)
+
+	h := NewFacetHandler(mockUsecase, 2*time.Second)
+
+	req := httptest.NewRequest(
+		http.MethodGet,
+		"/search/facets?q=工学&region=関東,関西&classification=国公立&schedule=前",
+		nil,
+	)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	err := h.SearchWithFacets(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "テスト大学")
+	assert.Contains(t, rec.Body.String(), `"facets":{"REGION":[{"name":"関東","count":1}]}`)
+	assert.Contains(t, rec.Body.String(), `"total":1`)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestSearchWithFacetsRepeatedParams(t *testing.T) {
+	applogger.InitTestLogger()
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/search/facets?academic_field=工学&academic_field=理学", nil)
+	c := e.NewContext(req, httptest.NewRecorder())
+
+	criteria, err := bindFacetCriteria(c)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"工学", "理学"}, criteria.AcademicFields)
+	assert.Empty(t, criteria.Query)
+}
+
+func TestSearchWithFacetsDifficultyRange(t *testing.T) {
+	applogger.InitTestLogger()
+
+	t.Run("合格難易度の範囲を取得", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet,
+			"/search/facets?min_deviation=55&max_deviation=62.5&min_common_test_rate=80&max_border_score=650", nil)
+		c := echo.New().NewContext(req, httptest.NewRecorder())
+
+		criteria, err := bindFacetCriteria(c)
+		require.NoError(t, err)
+
+		minDeviation, maxDeviation, minRate, maxBorder := 55.0, 62.5, 80.0, 650.0
+		assert.Equal(t, repositories.DifficultyRange{Min: &minDeviation, Max: &maxDeviation}, criteria.Deviation)
+		assert.Equal(t, repositories.DifficultyRange{Min: &minRate}, criteria.CommonTestRate)
+		assert.Equal(t, repositories.DifficultyRange{Max: &maxBorder}, criteria.BorderScore)
+	})
+
+	t.Run("数値でない範囲は400", func(t *testing.T) {
+		mockUsecase := new(mockFacetSearchUsecase)
+		h := NewFacetHandler(mockUsecase, 2*time.Second)
+
+		req := httptest.NewRequest(http.MethodGet, "/search/facets?min_deviation=abc", nil)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+
+		require.NoError(t, h.SearchWithFacets(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "min_deviation")
+		mockUsecase.AssertNotCalled(t, "SearchWithFacets", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestSearchWithFacetsInvalidQuery(t *testing.T) {
+	applogger.InitTestLogger()
+
+	mockUsecase := new(mockFacetSearchUsecase)
+	h := NewFacetHandler(mockUsecase, 2*time.Second)
+
+	req := httptest.NewRequest(http.MethodGet, "/search/facets?q=abc%3B", nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	err := h.SearchWithFacets(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUsecase.AssertNotCalled(t, "SearchWithFacets", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSearchWithFacetsUsecaseError(t *testing.T) {
+	applogger.InitTestLogger()
+
+	mockUsecase := new(mockFacetSearchUsecase)
+	mockUsecase.On("SearchWithFacets", mock.Anything, mock.Anything, mock.Anything).Return(nil, assert.AnError)
+
+	h := NewFacetHandler(mockUsecase, 2*time.Second)
+
+	req := httptest.NewRequest(http.MethodGet, "/search/facets", nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	err := h.SearchWithFacets(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestSearchWithFacetsInvalidPagination(t *testing.T) {
+	applogger.InitTestLogger()
+
+	mockUsecase := new(mockFacetSearchUsecase)
+	h := NewFacetHandler(mockUsecase, 2*time.Second)
+
+	req := httptest.NewRequest(http.MethodGet, "/search/facets?limit=1000", nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	err := h.SearchWithFacets(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUsecase.AssertNotCalled(t, "SearchWithFacets", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -11,8 +11,11 @@ import (
 	"net/http"
 	"strings"
 	"time"
+	"university-exam-api/internal/domain/models"
 	applogger "university-exam-api/internal/logger"
 	errorHandler "university-exam-api/internal/pkg/errors"
+	"university-exam-api/internal/pkg/pagination"
+	"university-exam-api/internal/pkg/preview"
 	"university-exam-api/internal/repositories"
 
 	"github.com/labstack/echo/v4"
@@ -57,11 +60,19 @@ func (h *Handler) validateSearchQuery(query string) error {
 		return errorHandler.NewValidationError("検索クエリは必須です")
 	}
 
+	return validateQueryContent(query)
+}
+
+// validateQueryContent は検索クエリの文字数と使用文字を検証します。
+// この関数は以下の処理を行います：
+// - 文字数制限の検証
+// - 不正文字の検証
+func validateQueryContent(query string) error {
 	if len(query) > maxQueryLength {
 		return errorHandler.NewValidationError("検索クエリは100文字以内で入力してください")
 	}
 
-	if strings.ContainsAny(query, ";%") {
+	if strings.Contains(query, ";") {
 		return errorHandler.NewValidationError("検索クエリに不正な文字が含まれています")
 	}
 
@@ -70,9 +81,9 @@ func (h *Handler) validateSearchQuery(query string) error {
 
 // SearchUniversities は大学を検索します。
 // この関数は以下の処理を行います：
-// - 検索クエリの取得とバリデーション
-// - データベースからの検索
-// - 検索結果の整形
+// - 検索クエリ・ページネーション指定の取得とバリデーション
+// - 関連度順（既定）またはソートキー順でのページ単位の検索
+// - 検索結果とページ情報の整形
 // - エラーハンドリング
 func (h *Handler) SearchUniversities(c echo.Context) error {
 	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
@@ -85,22 +96,51 @@ func (h *Handler) SearchUniversities(c echo.Context) error {
 		return errorHandler.HandleError(c, err)
 	}
 
+	params, err := pagination.ParseParams(c)
+	if err != nil {
+		applogger.Error(ctx, "ページネーション指定のバリデーションに失敗しました: %v", err)
+		return errorHandler.HandleError(c, err)
+	}
+
+	// ソートキーの指定がない場合は関連度順とする
+	if params.Cursor == nil && c.QueryParam(pagination.ParamSort) == "" {
+		params.Sort = pagination.SortRelevance
+	}
+
 	applogger.Info(ctx, "大学の検索を開始します: query=%s", query)
-	universities, err := h.repo.Search(query)
+	result, err := h.repo.SearchPage(ctx, query, params)
 
 	if err != nil {
 		applogger.Error(ctx, "検索クエリ '%s' での大学検索に失敗しました: %v", query, err)
 		return errorHandler.HandleError(c, err)
 	}
 
-	applogger.Info(ctx, applogger.LogSearchUniversitiesSuccess, query, len(universities))
+	if result.Universities == nil {
+		result.Universities = []models.University{}
+	}
+
+	// 公開中でない入試情報は管理者のプレビュー時のみ返却する
+	if !preview.IncludeDrafts(ctx) {
+		result.Universities = models.PublishedUniversities(result.Universities)
+	}
+
+	links := pagination.BuildLinks(c, params, result.Page)
+	pagination.SetLinkHeader(c, links)
+
+	applogger.Info(ctx, applogger.LogSearchUniversitiesSuccess, query, len(result.Universities))
+
+	return c.JSON(http.StatusOK, searchResponse(query, result.Universities, result.Page, links))
+}
 
-	return c.JSON(http.StatusOK, map[string]interface{}{
-		"data": universities,
-		"meta": map[string]interface{}{
-			"query":     query,
-			"count":     len(universities),
-			"timestamp": time.Now().Unix(),
-		},
-	})
+// searchResponse は検索結果のレスポンスを、一覧と共通の形式に検索語を加えて生成します
+func searchResponse(
+	query string,
+	universities []models.University,
+	page pagination.Page,
+	links pagination.Links,
+) map[string]interface{} {
+	resp := pagination.BuildResponse("universities", universities, page, links)
+	resp["query"] = query
+
+	return resp
 }
@@ -10,6 +10,9 @@ import (
 
 	"university-exam-api/internal/domain/models"
 	applogger "university-exam-api/internal/logger"
+	"university-exam-api/internal/pkg/pagination"
+	"university-exam-api/internal/pkg/textsearch"
+	"university-exam-api/internal/repositories"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
@@ -19,36 +22,118 @@ const errNotImplemented = "not implemented"
 
 // mockUniversityRepo は IUniversityRepository のSearchのみモックする構造体です。
 type mockUniversityRepo struct {
-	SearchFunc func(query string) ([]models.University, error)
+	SearchFunc     func(query string) ([]models.University, error)
+	SearchPageFunc func(query string, params pagination.Params) (*repositories.UniversityPage, error)
+	SuggestFunc    func(prefix string, types []string, limit int) (textsearch.Suggestions, error)
 }
 
-func (m *mockUniversityRepo) Search(query string) ([]models.University, error) {
+func (m *mockUniversityRepo) Search(_ context.Context, query string) ([]models.University, error) {
 	return m.SearchFunc(query)
 }
+
+func (m *mockUniversityRepo) SearchPage(
+	_ context.Context,
+	query string,
+	params pagination.Params,
+) (*repositories.UniversityPage, error) {
+	return m.SearchPageFunc(query, params)
+}
+
+func (m *mockUniversityRepo) Suggest(
+	_ context.Context,
+	prefix string,
+	types []string,
+	limit int,
+) (textsearch.Suggestions, error) {
+	return m.SuggestFunc(prefix, types, limit)
+}
+func (m *mockUniversityRepo) SearchHits(_ context.Context, _ string) ([]textsearch.Hit, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) WarmSearchIndex(_ context.Context) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) InvalidateSearchIndex()                  {}
+func (m *mockUniversityRepo) ImportRows(
+	_ context.Context,
+	_ []repositories.ImportRow,
+	_ bool,
+) (*repositories.ImportResult, error) {
+	panic(errNotImplemented)
+}
 // 他のIUniversityRepositoryメソッドはpanicでOK
 func (m *mockUniversityRepo) FindAll(_ context.Context) ([]models.University, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) FindByID(_ uint) (*models.University, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) Create(_ *models.University) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) Update(_ *models.University) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) Delete(_ uint) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) CreateDepartment(_ *models.Department) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateDepartment(_ *models.Department) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) DeleteDepartment(_ uint) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) CreateSubject(_ *models.Subject) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateSubject(_ *models.Subject) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) DeleteSubject(_ uint) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) CreateMajor(_ *models.Major) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateMajor(_ *models.Major) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) DeleteMajor(_ uint) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) CreateAdmissionInfo(_ *models.AdmissionInfo) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) DeleteAdmissionInfo(_ uint) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateAdmissionInfo(_ *models.AdmissionInfo) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) FindDepartment(_, _ uint) (*models.Department, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) FindSubject(_, _ uint) (*models.Subject, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) FindMajor(_, _ uint) (*models.Major, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateSubjectsBatch(_ uint, _ []models.Subject) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateAdmissionSchedule(_ *models.AdmissionSchedule) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) FindAdmissionInfo(_, _ uint) (*models.AdmissionInfo, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindPage(_ context.Context, _ pagination.Params) (*repositories.UniversityPage, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindByID(_ context.Context, _ uint) (*models.University, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) Create(_ context.Context, _ *models.University) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) Update(_ context.Context, _ *models.University) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) Delete(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateDepartment(_ context.Context, _ *models.Department) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateDepartment(_ context.Context, _ *models.Department) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteDepartment(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateSubject(_ context.Context, _ *models.Subject) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateSubject(_ context.Context, _ *models.Subject) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteSubject(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateMajor(_ context.Context, _ *models.Major) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateMajor(_ context.Context, _ *models.Major) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteMajor(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateAdmissionInfo(_ context.Context, _ *models.AdmissionInfo) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteAdmissionInfo(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateAdmissionInfo(_ context.Context, _ *models.AdmissionInfo) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindDepartment(_ context.Context, _, _ uint) (*models.Department, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindSubject(_ context.Context, _, _ uint) (*models.Subject, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindMajor(_ context.Context, _, _ uint) (*models.Major, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateSubjectsBatch(_ context.Context, _ uint, _ []models.Subject) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateAdmissionSchedule(_ context.Context, _ *models.AdmissionSchedule) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindAdmissionInfoForPublication(_ context.Context, _ uint) (*models.AdmissionInfo, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) UpdateAdmissionInfoStatus(_ context.Context, _ *models.AdmissionInfo, _ string) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindAdmissionSchedule(_ context.Context, _, _ uint) (*models.AdmissionSchedule, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) CreateAdmissionSchedule(_ context.Context, _ *models.AdmissionSchedule) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteAdmissionSchedule(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindTestType(_ context.Context, _, _ uint) (*models.TestType, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateTestType(_ context.Context, _ *models.TestType) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateTestType(_ context.Context, _ *models.TestType) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteTestType(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateSubjectGroup(_ context.Context, _ *models.SubjectGroup) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) UpdateSubjectGroup(_ context.Context, _ *models.SubjectGroup) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) DeleteSubjectGroup(_ context.Context, _, _ uint) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindAdmissionDifficulty(_ context.Context, _ uint) (*models.AdmissionDifficulty, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) CreateAdmissionDifficulty(_ context.Context, _ *models.AdmissionDifficulty) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) UpdateAdmissionDifficulty(_ context.Context, _ *models.AdmissionDifficulty) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) DeleteAdmissionDifficulty(_ context.Context, _ uint) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindTrash(_ context.Context, _ string, _ pagination.Params) (*repositories.TrashPage, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) RestoreFromTrash(_ context.Context, _ string, _ uint) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) PurgeFromTrash(_ context.Context, _ string, _ uint) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) PurgeTrashBefore(_ context.Context, _ time.Time) (int64, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindAdmissionInfo(_ context.Context, _, _ uint) (*models.AdmissionInfo, error) { panic(errNotImplemented) }
 
 // --- 正常系: ヒットあり ---
 func TestSearchUniversitiesSuccess(t *testing.T) {
@@ -56,8 +141,11 @@ func TestSearchUniversitiesSuccess(t *testing.T) {
 
 	e := echo.New()
 	mockRepo := &mockUniversityRepo{
-		SearchFunc: func(_ string) ([]models.University, error) {
-			return []models.University{{Name: "テスト大学"}}, nil
+		SearchPageFunc: func(_ string, params pagination.Params) (*repositories.UniversityPage, error) {
+			return &repositories.UniversityPage{
+				Universities: []models.University{{Name: "テスト大学"}},
+				Page:         pagination.Page{Total: 1, Page: params.Page, PerPage: params.PerPage},
+			}, nil
 		},
 	}
 	h := NewSearchHandler(mockRepo, 2*time.Second)
@@ -78,8 +166,11 @@ func TestSearchUniversitiesNoHit(t *testing.T) {
 
 	e := echo.New()
 	mockRepo := &mockUniversityRepo{
-		SearchFunc: func(_ string) ([]models.University, error) {
-			return []models.University{}, nil
+		SearchPageFunc: func(_ string, params pagination.Params) (*repositories.UniversityPage, error) {
+			return &repositories.UniversityPage{
+				Universities: []models.University{},
+				Page:         pagination.Page{Page: params.Page, PerPage: params.PerPage},
+			}, nil
 		},
 	}
 	h := NewSearchHandler(mockRepo, 2*time.Second)
@@ -91,7 +182,7 @@ func TestSearchUniversitiesNoHit(t *testing.T) {
 	err := h.SearchUniversities(c)
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusOK, rec.Code)
-	assert.Contains(t, rec.Body.String(), "\"data\":[]")
+	assert.Contains(t, rec.Body.String(), "\"universities\":[]")
 }
 
 // --- バリデーション: 空クエリ ---
@@ -160,7 +251,7 @@ func TestSearchUniversitiesRepoError(t *testing.T) {
 
 	e := echo.New()
 	mockRepo := &mockUniversityRepo{
-		SearchFunc: func(_ string) ([]models.University, error) {
+		SearchPageFunc: func(_ string, _ pagination.Params) (*repositories.UniversityPage, error) {
 			return nil, errors.New("DBエラー")
 		},
 	}
@@ -175,3 +266,94 @@ func TestSearchUniversitiesRepoError(t *testing.T) {
 	assert.Equal(t, http.StatusInternalServerError, rec.Code)
 	assert.Contains(t, rec.Body.String(), "エラー")
 }
+
+// --- 正常系: ページネーション ---
+func TestSearchUniversitiesPagination(t *testing.T) {
+	applogger.InitTestLogger()
+
+	e := echo.New()
+	mockRepo := &mockUniversityRepo{
+		SearchPageFunc: func(query string, params pagination.Params) (*repositories.UniversityPage, error) {
+			assert.Equal(t, "大学", query)
+			assert.Equal(t, 5, params.PerPage)
+			assert.Equal(t, pagination.SortUpdatedAt, params.Sort)
+
+			return &repositories.UniversityPage{
+				Universities: []models.University{{Name: "テスト大学"}},
+				Page: pagination.Page{
+					Total: 12, Page: params.Page, PerPage: params.PerPage, HasNext: true, NextCursor: "next",
+				},
+			}, nil
+		},
+	}
+	h := NewSearchHandler(mockRepo, 2*time.Second)
+
+	req := httptest.NewRequest(http.MethodGet, "/search?q=大学&perPage=5&sort=updated_at", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := h.SearchUniversities(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"total":12`)
+	assert.Contains(t, rec.Body.String(), `"nextCursor":"next"`)
+	assert.Contains(t, rec.Header().Get("Link"), `rel="next"`)
+}
+
+// --- バリデーション: 不正なページ指定 ---
+func TestSearchUniversitiesInvalidPage(t *testing.T) {
+	applogger.InitTestLogger()
+
+	e := echo.New()
+	mockRepo := &mockUniversityRepo{}
+	h := NewSearchHandler(mockRepo, 2*time.Second)
+
+	req := httptest.NewRequest(http.MethodGet, "/search?q=大学&page=0", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := h.SearchUniversities(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+// --- 関連度順の既定ソートとワイルドカード文字 ---
+func TestSearchUniversitiesRelevanceDefault(t *testing.T) {
+	applogger.InitTestLogger()
+
+	tests := []struct {
+		name     string
+		target   string
+		wantSort string
+	}{
+		{name: "ソート指定なしは関連度順", target: "/search?q=100%25", wantSort: pagination.SortRelevance},
+		{name: "ソート指定ありはその順序", target: "/search?q=東大&sort=name", wantSort: pagination.SortName},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotSort string
+
+			mockRepo := &mockUniversityRepo{
+				SearchPageFunc: func(_ string, params pagination.Params) (*repositories.UniversityPage, error) {
+					gotSort = params.Sort
+
+					return &repositories.UniversityPage{
+						Universities: []models.University{},
+						Page:         pagination.Page{Page: params.Page, PerPage: params.PerPage},
+					}, nil
+				},
+			}
+			h := NewSearchHandler(mockRepo, 2*time.Second)
+
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+
+			err := h.SearchUniversities(c)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, tt.wantSort, gotSort)
+		})
+	}
+}
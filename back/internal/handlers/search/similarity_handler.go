@@ -0,0 +1,142 @@
+package search
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	appErrors "university-exam-api/internal/errors"
+	applogger "university-exam-api/internal/logger"
+	errorHandler "university-exam-api/internal/pkg/errors"
+	"university-exam-api/internal/pkg/validation"
+	"university-exam-api/internal/repositories"
+	"university-exam-api/internal/usecases"
+
+	"github.com/labstack/echo/v4"
+)
+
+// 類似検索のクエリパラメータ名
+const (
+	paramWeight       = "weight"
+	paramAcademicYear = "academic_year"
+	paramLimit        = "limit"
+)
+
+// SimilarityHandler は配点比率の類似検索のHTTPリクエストを処理する構造体です。
+// この構造体は以下の機能を提供します：
+// - ユースケースとの連携
+// - リクエストタイムアウトの管理
+// - エラーハンドリング
+type SimilarityHandler struct {
+	usecase usecases.SimilarityUsecase
+	timeout time.Duration
+}
+
+// NewSimilarityHandler は新しいSimilarityHandlerインスタンスを生成します。
+// この関数は以下の処理を行います：
+// - ユースケースの初期化
+// - タイムアウトの設定
+func NewSimilarityHandler(usecase usecases.SimilarityUsecase, timeout time.Duration) *SimilarityHandler {
+	return &SimilarityHandler{
+		usecase: usecase,
+		timeout: timeout,
+	}
+}
+
+// parseWeights は「試験種別:科目名:配点比率」形式のパラメータを配点比率に変換します
+func parseWeights(values []string) ([]repositories.SubjectWeight, error) {
+	weights := make([]repositories.SubjectWeight, 0, len(values))
+
+	for _, v := range values {
+		parts := strings.Split(v, ":")
+		if len(parts) != 3 {
+			return nil, appErrors.NewInvalidInputError(paramWeight, "配点比率は「試験種別:科目名:配点比率」の形式で指定してください", nil)
+		}
+
+		percentage, err := strconv.ParseFloat(strings.TrimSpace(parts[2]), 64)
+		if err != nil || math.IsNaN(percentage) || math.IsInf(percentage, 0) {
+			return nil, appErrors.NewInvalidInputError(paramWeight, "配点比率は数値である必要があります", nil)
+		}
+
+		weights = append(weights, repositories.SubjectWeight{
+			TestType:   parts[0],
+			Subject:    parts[1],
+			Percentage: percentage,
+		})
+	}
+
+	return weights, nil
+}
+
+// bindSimilarityCriteria はクエリパラメータから類似検索の条件を生成します
+func bindSimilarityCriteria(ctx context.Context, c echo.Context) (repositories.SimilarityCriteria, error) {
+	facets, err := bindFacetCriteria(c)
+	if err != nil {
+		return repositories.SimilarityCriteria{}, err
+	}
+
+	criteria := repositories.SimilarityCriteria{
+		FacetSearchCriteria: facets,
+	}
+
+	if criteria.Query != "" {
+		if err := validateQueryContent(criteria.Query); err != nil {
+			return criteria, err
+		}
+	}
+
+	weights, err := parseWeights(queryValues(c, paramWeight))
+	if err != nil {
+		return criteria, err
+	}
+
+	criteria.Target = weights
+
+	if year := c.QueryParam(paramAcademicYear); year != "" {
+		if criteria.AcademicYear, err = validation.ValidateAcademicYear(ctx, year); err != nil {
+			return criteria, err
+		}
+	}
+
+	if limit := c.QueryParam(paramLimit); limit != "" {
+		if criteria.Limit, err = strconv.Atoi(limit); err != nil {
+			return criteria, appErrors.NewInvalidInputError(paramLimit, "取得件数は数値である必要があります", nil)
+		}
+	}
+
+	return criteria, nil
+}
+
+// FindSimilarMajors は目標の配点比率に近い学科・入試日程を検索します。
+// この関数は以下の処理を行います：
+// - 目標の配点比率・ファセット条件の取得とバリデーション
+// - 類似検索の実行
+// - 距離の昇順に並んだ検索結果の整形
+func (h *SimilarityHandler) FindSimilarMajors(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	criteria, err := bindSimilarityCriteria(ctx, c)
+	if err != nil {
+		applogger.Error(ctx, "類似検索条件のバリデーションに失敗しました: %v", err)
+		return errorHandler.HandleError(c, err)
+	}
+
+	results, err := h.usecase.FindSimilarMajors(ctx, criteria)
+	if err != nil {
+		applogger.Error(ctx, "類似検索に失敗しました: %v", err)
+		return errorHandler.HandleError(c, err)
+	}
+
+	applogger.Info(ctx, "類似検索に成功しました: 科目数=%d, 件数=%d", len(criteria.Target), len(results))
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"data": results,
+		"meta": map[string]interface{}{
+			"count":  len(results),
+			"metric": "euclidean",
+		},
+	})
+}
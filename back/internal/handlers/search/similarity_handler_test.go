@@ -0,0 +1,104 @@
+package search
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	applogger "university-exam-api/internal/logger"
+	"university-exam-api/internal/repositories"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockSimilarityUsecase はSimilarityUsecaseのモックです
+type mockSimilarityUsecase struct {
+	mock.Mock
+}
+
+func (m *mockSimilarityUsecase) FindSimilarMajors(
+	ctx context.Context,
+	criteria repositories.SimilarityCriteria,
+) ([]repositories.SimilarMajor, error) {
+	args := m.Called(ctx, criteria)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]repositories.SimilarMajor), args.Error(1)
+}
+
+func TestFindSimilarMajorsSuccess(t *testing.T) {
+	applogger.InitTestLogger()
+
+	mockUsecase := new(mockSimilarityUsecase)
+	mockUsecase.On("FindSimilarMajors", mock.Anything, repositories.SimilarityCriteria{
+		FacetSearchCriteria: repositories.FacetSearchCriteria{Regions: []string{"関東"}},
+		Target: []repositories.SubjectWeight{
+			{TestType: "共通", Subject: "英語", Percentage: 40},
+			{TestType: "二次", Subject: "数学", Percentage: 60},
+		},
+		AcademicYear: 2025,
+		Limit:        5,
+	}).Return([]repositories.SimilarMajor{{
+		Major:    repositories.YearScopedEntity{ID: 1, Name: "機械工学科"},
+		Distance: 14.14,
+	}}, nil)
+
+	h := NewSimilarityHandler(mockUsecase, 2*time.Second)
+
+	query := url.Values{
+		"weight":        {"共通:英語:40,二次:数学:60"},
+		"region":        {"関東"},
+		"academic_year": {"2025"},
+		"limit":         {"5"},
+	}
+	req := httptest.NewRequest(http.MethodGet, "/search/similar?"+query.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	err := h.FindSimilarMajors(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "機械工学科")
+	assert.Contains(t, rec.Body.String(), `"distance":14.14`)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestFindSimilarMajorsInvalidParams(t *testing.T) {
+	applogger.InitTestLogger()
+
+	tests := []struct {
+		name  string
+		query url.Values
+	}{
+		{name: "形式が不正な配点比率", query: url.Values{"weight": {"共通:英語"}}},
+		{name: "数値でない配点比率", query: url.Values{"weight": {"共通:英語:abc"}}},
+		{name: "NaNの配点比率", query: url.Values{"weight": {"共通:数学:NaN"}}},
+		{name: "無限大の配点比率", query: url.Values{"weight": {"共通:数学:Inf"}}},
+		{name: "範囲外の年度", query: url.Values{"weight": {"共通:英語:40"}, "academic_year": {"1999"}}},
+		{name: "数値でない取得件数", query: url.Values{"weight": {"共通:英語:40"}, "limit": {"many"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUsecase := new(mockSimilarityUsecase)
+			h := NewSimilarityHandler(mockUsecase, 2*time.Second)
+
+			req := httptest.NewRequest(http.MethodGet, "/search/similar?"+tt.query.Encode(), nil)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+
+			err := h.FindSimilarMajors(c)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			mockUsecase.AssertNotCalled(t, "FindSimilarMajors", mock.Anything, mock.Anything)
+		})
+	}
+}
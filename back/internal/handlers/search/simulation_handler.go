@@ -0,0 +1,135 @@
+package search
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	appErrors "university-exam-api/internal/errors"
+	applogger "university-exam-api/internal/logger"
+	errorHandler "university-exam-api/internal/pkg/errors"
+	"university-exam-api/internal/pkg/validation"
+	"university-exam-api/internal/repositories"
+	"university-exam-api/internal/usecases"
+
+	"github.com/labstack/echo/v4"
+)
+
+// SimulationRequest は得点シミュレーションのリクエストボディを表現する構造体です
+// 絞り込み条件のキーはファセット検索のクエリパラメータ名と同じです
+type SimulationRequest struct {
+	Scores             []repositories.SubjectScore `json:"scores"`
+	MajorIDs           []uint                      `json:"major_ids"`
+	Query              string                      `json:"q"`
+	Regions            []string                    `json:"region"`
+	Prefectures        []string                    `json:"prefecture"`
+	Classifications    []string                    `json:"classification"`
+	SubClassifications []string                    `json:"sub_classification"`
+	Schedules          []string                    `json:"schedule"`
+	AcademicFields     []string                    `json:"academic_field"`
+	MinDeviation       *float64                    `json:"min_deviation"`
+	MaxDeviation       *float64                    `json:"max_deviation"`
+	MinCommonTestRate  *float64                    `json:"min_common_test_rate"`
+	MaxCommonTestRate  *float64                    `json:"max_common_test_rate"`
+	MinBorderScore     *float64                    `json:"min_border_score"`
+	MaxBorderScore     *float64                    `json:"max_border_score"`
+	AcademicYear       int                         `json:"academic_year"`
+	Limit              int                         `json:"limit"`
+}
+
+// SimulationHandler は得点シミュレーションのHTTPリクエストを処理する構造体です。
+// この構造体は以下の機能を提供します：
+// - ユースケースとの連携
+// - リクエストタイムアウトの管理
+// - エラーハンドリング
+type SimulationHandler struct {
+	usecase usecases.ScoreSimulationUsecase
+	timeout time.Duration
+}
+
+// NewSimulationHandler は新しいSimulationHandlerインスタンスを生成します。
+// この関数は以下の処理を行います：
+// - ユースケースの初期化
+// - タイムアウトの設定
+func NewSimulationHandler(usecase usecases.ScoreSimulationUsecase, timeout time.Duration) *SimulationHandler {
+	return &SimulationHandler{
+		usecase: usecase,
+		timeout: timeout,
+	}
+}
+
+// bindSimulationCriteria はリクエストボディから得点シミュレーションの条件を生成します
+func bindSimulationCriteria(ctx context.Context, c echo.Context) (repositories.SimulationCriteria, error) {
+	var req SimulationRequest
+	if err := c.Bind(&req); err != nil {
+		applogger.Error(ctx, errorHandler.MsgBindRequestFailed, err)
+		return repositories.SimulationCriteria{}, appErrors.NewInvalidInputError("request", errorHandler.MsgInvalidRequestBody, nil)
+	}
+
+	criteria := repositories.SimulationCriteria{
+		FacetSearchCriteria: repositories.FacetSearchCriteria{
+			Query:              strings.TrimSpace(req.Query),
+			Regions:            req.Regions,
+			Prefectures:        req.Prefectures,
+			Classifications:    req.Classifications,
+			SubClassifications: req.SubClassifications,
+			Schedules:          req.Schedules,
+			AcademicFields:     req.AcademicFields,
+			Deviation:          repositories.DifficultyRange{Min: req.MinDeviation, Max: req.MaxDeviation},
+			CommonTestRate:     repositories.DifficultyRange{Min: req.MinCommonTestRate, Max: req.MaxCommonTestRate},
+			BorderScore:        repositories.DifficultyRange{Min: req.MinBorderScore, Max: req.MaxBorderScore},
+		},
+		MajorIDs: req.MajorIDs,
+		Scores:   req.Scores,
+		Limit:    req.Limit,
+	}
+
+	if criteria.Query != "" {
+		if err := validateQueryContent(criteria.Query); err != nil {
+			return criteria, err
+		}
+	}
+
+	if req.AcademicYear != 0 {
+		year, err := validation.ValidateAcademicYear(ctx, strconv.Itoa(req.AcademicYear))
+		if err != nil {
+			return criteria, err
+		}
+
+		criteria.AcademicYear = year
+	}
+
+	return criteria, nil
+}
+
+// SimulateScores は入力した得点から学科・入試日程ごとの合計点と得点率を算出します。
+// この関数は以下の処理を行います：
+// - 得点・学科ID・絞り込み条件の取得とバリデーション
+// - 各学科の配点（換算比率・選択科目群を含む）による合計点の算出
+// - 得点率の降順に順位付けされた結果の整形
+func (h *SimulationHandler) SimulateScores(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	criteria, err := bindSimulationCriteria(ctx, c)
+	if err != nil {
+		applogger.Error(ctx, "得点シミュレーション条件のバリデーションに失敗しました: %v", err)
+		return errorHandler.HandleError(c, err)
+	}
+
+	results, err := h.usecase.SimulateScores(ctx, criteria)
+	if err != nil {
+		applogger.Error(ctx, "得点シミュレーションに失敗しました: %v", err)
+		return errorHandler.HandleError(c, err)
+	}
+
+	applogger.Info(ctx, "得点シミュレーションに成功しました: 科目数=%d, 件数=%d", len(criteria.Scores), len(results))
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"data": results,
+		"meta": map[string]interface{}{
+			"count": len(results),
+		},
+	})
+}
@@ -0,0 +1,125 @@
+package search
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	appErrors "university-exam-api/internal/errors"
+	applogger "university-exam-api/internal/logger"
+	"university-exam-api/internal/repositories"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockSimulationUsecase はScoreSimulationUsecaseのモックです
+type mockSimulationUsecase struct {
+	mock.Mock
+}
+
+func (m *mockSimulationUsecase) SimulateScores(
+	ctx context.Context,
+	criteria repositories.SimulationCriteria,
+) ([]repositories.SimulationResult, error) {
+	args := m.Called(ctx, criteria)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]repositories.SimulationResult), args.Error(1)
+}
+
+// newSimulationContext は得点シミュレーションのリクエストボディを設定したテスト用のコンテキストを生成します
+func newSimulationContext(body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/search/simulate", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	rec := httptest.NewRecorder()
+
+	return echo.New().NewContext(req, rec), rec
+}
+
+func TestSimulateScoresSuccess(t *testing.T) {
+	applogger.InitTestLogger()
+
+	minDeviation := 55.0
+
+	mockUsecase := new(mockSimulationUsecase)
+	mockUsecase.On("SimulateScores", mock.Anything, repositories.SimulationCriteria{
+		FacetSearchCriteria: repositories.FacetSearchCriteria{
+			Regions:   []string{"関東"},
+			Deviation: repositories.DifficultyRange{Min: &minDeviation},
+		},
+		MajorIDs: []uint{3},
+		Scores: []repositories.SubjectScore{
+			{TestType: "共通", Subject: "英語", Score: 160, MaxScore: 200},
+		},
+		AcademicYear: 2025,
+		Limit:        5,
+	}).Return([]repositories.SimulationResult{{
+		Rank:       1,
+		Major:      repositories.YearScopedEntity{ID: 3, Name: "機械工学科"},
+		Score:      400,
+		MaxScore:   500,
+		Percentage: 80,
+	}}, nil)
+
+	h := NewSimulationHandler(mockUsecase, 2*time.Second)
+	c, rec := newSimulationContext(`{
+		"scores": [{"test_type": "共通", "subject": "英語", "score": 160, "max_score": 200}],
+		"major_ids": [3],
+		"region": ["関東"],
+		"min_deviation": 55,
+		"academic_year": 2025,
+		"limit": 5
+	}`)
+
+	require.NoError(t, h.SimulateScores(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "機械工学科")
+	assert.Contains(t, rec.Body.String(), `"percentage":80`)
+	assert.Contains(t, rec.Body.String(), `"count":1`)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestSimulateScoresInvalidRequest(t *testing.T) {
+	applogger.InitTestLogger()
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "JSONの形式が不正", body: `{"scores":`},
+		{name: "範囲外の年度", body: `{"scores": [], "major_ids": [1], "academic_year": 1999}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUsecase := new(mockSimulationUsecase)
+			h := NewSimulationHandler(mockUsecase, 2*time.Second)
+			c, rec := newSimulationContext(tt.body)
+
+			require.NoError(t, h.SimulateScores(c))
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			mockUsecase.AssertNotCalled(t, "SimulateScores", mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("ユースケースの検証エラー", func(t *testing.T) {
+		mockUsecase := new(mockSimulationUsecase)
+		mockUsecase.On("SimulateScores", mock.Anything, mock.Anything).
+			Return(nil, appErrors.NewInvalidInputError("scores", "得点は必須です", nil))
+
+		h := NewSimulationHandler(mockUsecase, 2*time.Second)
+		c, rec := newSimulationContext(`{"major_ids": [1]}`)
+
+		require.NoError(t, h.SimulateScores(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
@@ -0,0 +1,117 @@
+package search
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	appErrors "university-exam-api/internal/errors"
+	applogger "university-exam-api/internal/logger"
+	errorHandler "university-exam-api/internal/pkg/errors"
+	"university-exam-api/internal/pkg/textsearch"
+
+	"github.com/labstack/echo/v4"
+)
+
+// 入力補完のクエリパラメータ名と取得件数
+const (
+	paramSuggestType    = "type"
+	defaultSuggestLimit = 5
+	maxSuggestLimit     = 20
+)
+
+// bindSuggestTypes は候補の種別の指定を検証します。指定がない場合は全種別を対象とします
+func bindSuggestTypes(c echo.Context) ([]string, error) {
+	values := queryValues(c, paramSuggestType)
+	if len(values) == 0 {
+		return textsearch.SuggestTypes, nil
+	}
+
+	valid := make(map[string]bool, len(textsearch.SuggestTypes))
+	for _, t := range textsearch.SuggestTypes {
+		valid[t] = true
+	}
+
+	types := make([]string, 0, len(values))
+	seen := make(map[string]bool, len(values))
+
+	for _, v := range values {
+		if !valid[v] {
+			return nil, appErrors.NewInvalidInputError(
+				paramSuggestType,
+				"候補の種別は "+strings.Join(textsearch.SuggestTypes, ", ")+" のいずれかを指定してください",
+				nil,
+			)
+		}
+
+		if !seen[v] {
+			seen[v] = true
+			types = append(types, v)
+		}
+	}
+
+	return types, nil
+}
+
+// bindSuggestLimit は種別ごとの取得件数を検証します
+func bindSuggestLimit(c echo.Context) (int, error) {
+	raw := c.QueryParam(paramLimit)
+	if raw == "" {
+		return defaultSuggestLimit, nil
+	}
+
+	limit, err := strconv.Atoi(raw)
+	if err != nil || limit < 1 || limit > maxSuggestLimit {
+		return 0, appErrors.NewInvalidInputError(paramLimit, "取得件数は1から20の範囲で指定してください", nil)
+	}
+
+	return limit, nil
+}
+
+// Suggest は入力途中の文字列から大学・学部・学科・学問系統の名称の候補を返します。
+// この関数は以下の処理を行います：
+// - 入力文字列・種別・取得件数の取得とバリデーション
+// - 候補インデックスによる前方一致検索
+// - 種別ごとの候補の整形
+func (h *Handler) Suggest(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	prefix := strings.TrimSpace(c.QueryParam("q"))
+
+	if err := h.validateSearchQuery(prefix); err != nil {
+		applogger.Error(ctx, "入力補完クエリのバリデーションに失敗しました: %v", err)
+		return errorHandler.HandleError(c, err)
+	}
+
+	types, err := bindSuggestTypes(c)
+	if err != nil {
+		applogger.Error(ctx, "候補の種別のバリデーションに失敗しました: %v", err)
+		return errorHandler.HandleError(c, err)
+	}
+
+	limit, err := bindSuggestLimit(c)
+	if err != nil {
+		applogger.Error(ctx, "取得件数のバリデーションに失敗しました: %v", err)
+		return errorHandler.HandleError(c, err)
+	}
+
+	suggestions, err := h.repo.Suggest(ctx, prefix, types, limit)
+	if err != nil {
+		applogger.Error(ctx, "入力補完クエリ '%s' での候補取得に失敗しました: %v", prefix, err)
+		return errorHandler.HandleError(c, err)
+	}
+
+	applogger.Info(ctx, "入力補完の候補を取得しました: query=%s", prefix)
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"data": suggestions,
+		"meta": map[string]interface{}{
+			"query":     prefix,
+			"types":     types,
+			"limit":     limit,
+			"timestamp": time.Now().Unix(),
+		},
+	})
+}
@@ -0,0 +1,108 @@
+package search
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	applogger "university-exam-api/internal/logger"
+	"university-exam-api/internal/pkg/textsearch"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuggestSuccess(t *testing.T) {
+	applogger.InitTestLogger()
+
+	var gotTypes []string
+
+	var gotLimit int
+
+	mockRepo := &mockUniversityRepo{
+		SuggestFunc: func(prefix string, types []string, limit int) (textsearch.Suggestions, error) {
+			gotTypes, gotLimit = types, limit
+
+			return textsearch.Suggestions{
+				textsearch.SuggestTypeUniversity: {{Type: textsearch.SuggestTypeUniversity, Text: "東京大学", ID: 1, Count: 1}},
+				textsearch.SuggestTypeMajor:      {},
+			}, nil
+		},
+	}
+	h := NewSearchHandler(mockRepo, 2*time.Second)
+
+	query := url.Values{"q": {"東"}, "type": {"university,major,university"}}
+	req := httptest.NewRequest(http.MethodGet, "/suggest?"+query.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	err := h.Suggest(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, []string{textsearch.SuggestTypeUniversity, textsearch.SuggestTypeMajor}, gotTypes)
+	assert.Equal(t, defaultSuggestLimit, gotLimit)
+	assert.Contains(t, rec.Body.String(), `"text":"東京大学"`)
+	assert.Contains(t, rec.Body.String(), `"major":[]`)
+}
+
+func TestSuggestAllTypesByDefault(t *testing.T) {
+	applogger.InitTestLogger()
+
+	var gotTypes []string
+
+	mockRepo := &mockUniversityRepo{
+		SuggestFunc: func(_ string, types []string, _ int) (textsearch.Suggestions, error) {
+			gotTypes = types
+			return textsearch.Suggestions{}, nil
+		},
+	}
+	h := NewSearchHandler(mockRepo, 2*time.Second)
+
+	req := httptest.NewRequest(http.MethodGet, "/suggest?q=%E5%B7%A5&limit=20", nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	err := h.Suggest(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, textsearch.SuggestTypes, gotTypes)
+}
+
+func TestSuggestInvalidParams(t *testing.T) {
+	applogger.InitTestLogger()
+
+	tests := []struct {
+		name  string
+		query url.Values
+	}{
+		{name: "入力文字列なし", query: url.Values{}},
+		{name: "不正な文字", query: url.Values{"q": {"東;"}}},
+		{name: "不正な種別", query: url.Values{"q": {"東"}, "type": {"campus"}}},
+		{name: "数値でない取得件数", query: url.Values{"q": {"東"}, "limit": {"many"}}},
+		{name: "上限を超える取得件数", query: url.Values{"q": {"東"}, "limit": {"21"}}},
+		{name: "0件の取得件数", query: url.Values{"q": {"東"}, "limit": {"0"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mockUniversityRepo{
+				SuggestFunc: func(_ string, _ []string, _ int) (textsearch.Suggestions, error) {
+					t.Fatal("Suggest should not be called")
+					return nil, nil
+				},
+			}
+			h := NewSearchHandler(mockRepo, 2*time.Second)
+
+			req := httptest.NewRequest(http.MethodGet, "/suggest?"+tt.query.Encode(), nil)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+
+			err := h.Suggest(c)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		})
+	}
+}
@@ -9,10 +9,14 @@ package subject
 import (
 	"context"
 	"net/http"
+	"strconv"
 	"time"
 	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/infrastructure/audit"
 	applogger "university-exam-api/internal/logger"
 	"university-exam-api/internal/pkg/errors"
+	"university-exam-api/internal/pkg/etag"
 	"university-exam-api/internal/pkg/validation"
 	"university-exam-api/internal/repositories"
 
@@ -26,6 +30,8 @@ const (
 	ErrMsgSubjectNameLength    = "科目名は100文字以内で入力してください"
 	// ErrMsgTestTypeIDRequired は試験種別IDが未入力の場合のエラーメッセージです
 	ErrMsgTestTypeIDRequired   = "試験種別IDは必須です"
+	// ErrMsgConversionRatioRange は換算比率が範囲外の場合のエラーメッセージです
+	ErrMsgConversionRatioRange = "換算比率は0より大きく10以下である必要があります"
 	// ErrMsgBatchUpdateFailed は科目の一括更新に失敗した場合のエラーメッセージです
 	ErrMsgBatchUpdateFailed    = "科目の一括更新に失敗しました"
 )
@@ -71,6 +77,7 @@ func (h *Handler) bindRequest(ctx context.Context, c echo.Context, data interfac
 // - 科目名の必須チェック
 // - 科目名の長さチェック
 // - 試験種別IDの必須チェック
+// - 換算比率の範囲チェック（0は未指定として既定値の1を使用）
 func (h *Handler) validateSubjectRequest(subject *models.Subject) error {
 	if subject.Name == "" {
 		return errors.NewValidationError(ErrMsgSubjectNameRequired)
@@ -84,6 +91,10 @@ func (h *Handler) validateSubjectRequest(subject *models.Subject) error {
 		return errors.NewValidationError(ErrMsgTestTypeIDRequired)
 	}
 
+	if subject.ConversionRatio < 0 || subject.ConversionRatio > models.MaxConversionRatio {
+		return errors.NewValidationError(ErrMsgConversionRatioRange)
+	}
+
 	return nil
 }
 
@@ -97,17 +108,17 @@ func (h *Handler) GetSubject(c echo.Context) error {
 	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
 	defer cancel()
 
-	departmentID, err := validation.ValidateDepartmentID(ctx, c.Param("departmentId"))
+	departmentID, err := validation.ValidateDepartmentID(ctx, c.Param("departmentID"))
 	if err != nil {
 		return errors.HandleError(c, err)
 	}
 
-	subjectID, err := validation.ValidateSubjectID(ctx, c.Param("subjectId"))
+	subjectID, err := validation.ValidateSubjectID(ctx, c.Param("subjectID"))
 	if err != nil {
 		return errors.HandleError(c, err)
 	}
 
-	subject, err := h.repo.FindSubject(departmentID, subjectID)
+	subject, err := h.repo.FindSubject(ctx, departmentID, subjectID)
 	if err != nil {
 		applogger.Error(ctx, "科目の取得に失敗しました (学部ID: %d, 科目ID: %d): %v", departmentID, subjectID, err)
 		return errors.HandleError(c, err)
@@ -115,6 +126,8 @@ func (h *Handler) GetSubject(c echo.Context) error {
 
 	applogger.Info(ctx, applogger.LogGetSubjectSuccess, departmentID, subjectID)
 
+	etag.SetHeader(c, subject.Version)
+
 	return c.JSON(http.StatusOK, map[string]interface{}{
 		"data": subject,
 	})
@@ -131,7 +144,7 @@ func (h *Handler) CreateSubject(c echo.Context) error {
 	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
 	defer cancel()
 
-	departmentID, err := validation.ValidateDepartmentID(ctx, c.Param("departmentId"))
+	departmentID, err := validation.ValidateDepartmentID(ctx, c.Param("departmentID"))
 	if err != nil {
 		return errors.HandleError(c, err)
 	}
@@ -147,7 +160,9 @@ func (h *Handler) CreateSubject(c echo.Context) error {
 		return errors.HandleError(c, err)
 	}
 
-	if err := h.repo.CreateSubject(&subject); err != nil {
+	audit.StampCreate(ctx, &subject.BaseModel)
+
+	if err := h.repo.CreateSubject(ctx, &subject); err != nil {
 		applogger.Error(ctx, "科目の作成に失敗しました: %v", err)
 		return errors.HandleError(c, err)
 	}
@@ -164,13 +179,14 @@ func (h *Handler) CreateSubject(c echo.Context) error {
 // - 科目IDのバリデーション
 // - リクエストボディのバインディング
 // - 科目のバリデーション
-// - 科目の更新
+// - 期待するバージョン（If-Match またはボディの version）の取得
+// - 科目の更新（バージョンが一致しない場合は現在の状態とともに409を返却）
 // - エラーハンドリング
 func (h *Handler) UpdateSubject(c echo.Context) error {
 	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
 	defer cancel()
 
-	subjectID, err := validation.ValidateSubjectID(ctx, c.Param("subjectId"))
+	subjectID, err := validation.ValidateSubjectID(ctx, c.Param("subjectID"))
 	if err != nil {
 		return errors.HandleError(c, err)
 	}
@@ -186,13 +202,23 @@ func (h *Handler) UpdateSubject(c echo.Context) error {
 		return errors.HandleError(c, err)
 	}
 
-	if err := h.repo.UpdateSubject(&subject); err != nil {
+	expectedVersion, err := etag.ExpectedVersion(c, subject.Version)
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	subject.Version = expectedVersion
+	audit.StampUpdate(ctx, &subject.BaseModel)
+
+	if err := h.repo.UpdateSubject(ctx, &subject); err != nil {
 		applogger.Error(ctx, "科目ID %dの更新に失敗しました: %v", subjectID, err)
 		return errors.HandleError(c, err)
 	}
 
 	applogger.Info(ctx, applogger.LogUpdateSubjectSuccess, subjectID)
 
+	etag.SetHeader(c, subject.Version)
+
 	return c.JSON(http.StatusOK, map[string]interface{}{
 		"data": subject,
 	})
@@ -207,12 +233,12 @@ func (h *Handler) DeleteSubject(c echo.Context) error {
 	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
 	defer cancel()
 
-	subjectID, err := validation.ValidateSubjectID(ctx, c.Param("subjectId"))
+	subjectID, err := validation.ValidateSubjectID(ctx, c.Param("subjectID"))
 	if err != nil {
 		return errors.HandleError(c, err)
 	}
 
-	if err := h.repo.DeleteSubject(subjectID); err != nil {
+	if err := h.repo.DeleteSubject(ctx, subjectID); err != nil {
 		applogger.Error(ctx, "科目ID %dの削除に失敗しました: %v", subjectID, err)
 		return errors.HandleError(c, err)
 	}
@@ -227,13 +253,14 @@ func (h *Handler) DeleteSubject(c echo.Context) error {
 // - 学部IDのバリデーション
 // - リクエストボディのバインディング
 // - 科目のバリデーション
-// - 科目の一括更新
+// - 科目ごとの期待するバージョン（ボディの version）の確認
+// - 科目の一括更新（いずれかのバージョンが一致しない場合は全体を取り消して409を返却）
 // - エラーハンドリング
 func (h *Handler) UpdateSubjectsBatch(c echo.Context) error {
 	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
 	defer cancel()
 
-	departmentID, err := validation.ValidateDepartmentID(ctx, c.Param("departmentId"))
+	departmentID, err := validation.ValidateDepartmentID(ctx, c.Param("departmentID"))
 	if err != nil {
 		return errors.HandleError(c, err)
 	}
@@ -245,12 +272,22 @@ func (h *Handler) UpdateSubjectsBatch(c echo.Context) error {
 
 	for i := range subjects {
 		subjects[i].TestTypeID = departmentID
+		audit.StampUpdate(ctx, &subjects[i].BaseModel)
+
 		if err := h.validateSubjectRequest(&subjects[i]); err != nil {
 			return errors.HandleError(c, err)
 		}
+
+		// 一括更新では科目ごとに異なるバージョンを持つため、If-Match ではなくボディの version で指定する
+		if subjects[i].Version < 1 {
+			return errors.HandleError(c, appErrors.NewPreconditionRequiredError(
+				"一括更新では各科目の version に更新前のバージョンを指定してください",
+				map[string]string{"index": strconv.Itoa(i)},
+			))
+		}
 	}
 
-	if err := h.repo.UpdateSubjectsBatch(departmentID, subjects); err != nil {
+	if err := h.repo.UpdateSubjectsBatch(ctx, departmentID, subjects); err != nil {
 		applogger.Error(ctx, ErrMsgBatchUpdateFailed+": %v", err)
 		return errors.HandleError(c, err)
 	}
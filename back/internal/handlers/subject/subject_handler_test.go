@@ -11,6 +11,10 @@ import (
 
 	"university-exam-api/internal/domain/models"
 	applogger "university-exam-api/internal/logger"
+	"university-exam-api/internal/pkg/etag"
+	"university-exam-api/internal/pkg/pagination"
+	"university-exam-api/internal/pkg/textsearch"
+	"university-exam-api/internal/repositories"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
@@ -29,11 +33,11 @@ type mockUniversityRepo struct {
 	UpdateSubjectsBatchFunc func(departmentID uint, subjects []models.Subject) error
 }
 
-func (m *mockUniversityRepo) FindSubject(departmentID, subjectID uint) (*models.Subject, error) {
+func (m *mockUniversityRepo) FindSubject(_ context.Context, departmentID, subjectID uint) (*models.Subject, error) {
 	return m.FindSubjectFunc(departmentID, subjectID)
 }
 
-func (m *mockUniversityRepo) CreateSubject(subject *models.Subject) error {
+func (m *mockUniversityRepo) CreateSubject(_ context.Context, subject *models.Subject) error {
 	if m.CreateSubjectFunc != nil {
 		return m.CreateSubjectFunc(subject)
 	}
@@ -41,7 +45,7 @@ func (m *mockUniversityRepo) CreateSubject(subject *models.Subject) error {
 	panic(errNotImplemented)
 }
 
-func (m *mockUniversityRepo) UpdateSubject(subject *models.Subject) error {
+func (m *mockUniversityRepo) UpdateSubject(_ context.Context, subject *models.Subject) error {
 	if m.UpdateSubjectFunc != nil {
 		return m.UpdateSubjectFunc(subject)
 	}
@@ -49,7 +53,7 @@ func (m *mockUniversityRepo) UpdateSubject(subject *models.Subject) error {
 	panic(errNotImplemented)
 }
 
-func (m *mockUniversityRepo) DeleteSubject(id uint) error {
+func (m *mockUniversityRepo) DeleteSubject(_ context.Context, id uint) error {
 	if m.DeleteSubjectFunc != nil {
 		return m.DeleteSubjectFunc(id)
 	}
@@ -59,31 +63,100 @@ func (m *mockUniversityRepo) DeleteSubject(id uint) error {
 
 // 他のIUniversityRepositoryメソッドはpanicでOK
 func (m *mockUniversityRepo) FindAll(_ context.Context) ([]models.University, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) FindByID(_ uint) (*models.University, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) Search(_ string) ([]models.University, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) Create(_ *models.University) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) Update(_ *models.University) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) Delete(_ uint) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) CreateDepartment(_ *models.Department) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateDepartment(_ *models.Department) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) DeleteDepartment(_ uint) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) CreateMajor(_ *models.Major) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateMajor(_ *models.Major) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) DeleteMajor(_ uint) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) CreateAdmissionInfo(_ *models.AdmissionInfo) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) DeleteAdmissionInfo(_ uint) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateAdmissionInfo(_ *models.AdmissionInfo) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) FindDepartment(_, _ uint) (*models.Department, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) FindMajor(_, _ uint) (*models.Major, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateSubjectsBatch(departmentID uint, subjects []models.Subject) error {
+func (m *mockUniversityRepo) FindPage(_ context.Context, _ pagination.Params) (*repositories.UniversityPage, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) SearchPage(_ context.Context, _ string, _ pagination.Params) (*repositories.UniversityPage, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) Suggest(_ context.Context, _ string, _ []string, _ int) (textsearch.Suggestions, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) SearchHits(_ context.Context, _ string) ([]textsearch.Hit, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) WarmSearchIndex(_ context.Context) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) InvalidateSearchIndex()                  {}
+func (m *mockUniversityRepo) ImportRows(
+	_ context.Context,
+	_ []repositories.ImportRow,
+	_ bool,
+) (*repositories.ImportResult, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindByID(_ context.Context, _ uint) (*models.University, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) Search(_ context.Context, _ string) ([]models.University, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) Create(_ context.Context, _ *models.University) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) Update(_ context.Context, _ *models.University) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) Delete(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateDepartment(_ context.Context, _ *models.Department) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateDepartment(_ context.Context, _ *models.Department) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteDepartment(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateMajor(_ context.Context, _ *models.Major) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateMajor(_ context.Context, _ *models.Major) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteMajor(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateAdmissionInfo(_ context.Context, _ *models.AdmissionInfo) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteAdmissionInfo(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateAdmissionInfo(_ context.Context, _ *models.AdmissionInfo) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindDepartment(_ context.Context, _, _ uint) (*models.Department, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindMajor(_ context.Context, _, _ uint) (*models.Major, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateSubjectsBatch(_ context.Context, departmentID uint, subjects []models.Subject) error {
 	if m.UpdateSubjectsBatchFunc != nil {
 		return m.UpdateSubjectsBatchFunc(departmentID, subjects)
 	}
 
 	panic(errNotImplemented)
 }
-func (m *mockUniversityRepo) UpdateAdmissionSchedule(_ *models.AdmissionSchedule) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) FindAdmissionInfo(_, _ uint) (*models.AdmissionInfo, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateAdmissionSchedule(_ context.Context, _ *models.AdmissionSchedule) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindAdmissionInfoForPublication(_ context.Context, _ uint) (*models.AdmissionInfo, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) UpdateAdmissionInfoStatus(_ context.Context, _ *models.AdmissionInfo, _ string) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindAdmissionSchedule(_ context.Context, _, _ uint) (*models.AdmissionSchedule, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) CreateAdmissionSchedule(_ context.Context, _ *models.AdmissionSchedule) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteAdmissionSchedule(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindTestType(_ context.Context, _, _ uint) (*models.TestType, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateTestType(_ context.Context, _ *models.TestType) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateTestType(_ context.Context, _ *models.TestType) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteTestType(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateSubjectGroup(_ context.Context, _ *models.SubjectGroup) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) UpdateSubjectGroup(_ context.Context, _ *models.SubjectGroup) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) DeleteSubjectGroup(_ context.Context, _, _ uint) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindAdmissionDifficulty(_ context.Context, _ uint) (*models.AdmissionDifficulty, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) CreateAdmissionDifficulty(_ context.Context, _ *models.AdmissionDifficulty) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) UpdateAdmissionDifficulty(_ context.Context, _ *models.AdmissionDifficulty) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) DeleteAdmissionDifficulty(_ context.Context, _ uint) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindTrash(_ context.Context, _ string, _ pagination.Params) (*repositories.TrashPage, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) RestoreFromTrash(_ context.Context, _ string, _ uint) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) PurgeFromTrash(_ context.Context, _ string, _ uint) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) PurgeTrashBefore(_ context.Context, _ time.Time) (int64, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindAdmissionInfo(_ context.Context, _, _ uint) (*models.AdmissionInfo, error) { panic(errNotImplemented) }
 
 // --- 科目取得APIの正常系テスト ---
 func TestGetSubjectSuccess(t *testing.T) {
@@ -107,7 +180,7 @@ func TestGetSubjectSuccess(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, "/departments/1/subjects/2", nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	c.SetParamNames("departmentId", "subjectId")
+	c.SetParamNames("departmentID", "subjectID")
 	c.SetParamValues("1", "2")
 
 	err := h.GetSubject(c)
@@ -131,7 +204,7 @@ func TestGetSubjectError(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, "/departments/1/subjects/2", nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	c.SetParamNames("departmentId", "subjectId")
+	c.SetParamNames("departmentID", "subjectID")
 	c.SetParamValues("1", "2")
 
 	err := h.GetSubject(c)
@@ -158,7 +231,7 @@ func TestCreateSubjectSuccess(t *testing.T) {
 
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	c.SetParamNames("departmentId")
+	c.SetParamNames("departmentID")
 	c.SetParamValues("1")
 
 	err := h.CreateSubject(c)
@@ -184,7 +257,7 @@ func TestCreateSubjectError(t *testing.T) {
 
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	c.SetParamNames("departmentId")
+	c.SetParamNames("departmentID")
 	c.SetParamValues("1")
 
 	err := h.CreateSubject(c)
@@ -211,11 +284,12 @@ func TestUpdateSubjectSuccess(t *testing.T) {
 
 	body := `{"name":"国語","test_type_id":1}`
 	req := httptest.NewRequest(http.MethodPut, subject2Path, strings.NewReader(body))
+	req.Header.Set(etag.HeaderIfMatch, `"1"`)
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	c.SetParamNames("subjectId")
+	c.SetParamNames("subjectID")
 	c.SetParamValues("2")
 
 	err := h.UpdateSubject(c)
@@ -240,11 +314,12 @@ func TestUpdateSubjectError(t *testing.T) {
 
 	body := `{"name":"国語","test_type_id":1}`
 	req := httptest.NewRequest(http.MethodPut, subject2Path, strings.NewReader(body))
+	req.Header.Set(etag.HeaderIfMatch, `"1"`)
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	c.SetParamNames("subjectId")
+	c.SetParamNames("subjectID")
 	c.SetParamValues("2")
 
 	err := h.UpdateSubject(c)
@@ -268,7 +343,7 @@ func TestDeleteSubjectSuccess(t *testing.T) {
 	req := httptest.NewRequest(http.MethodDelete, subject2Path, nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	c.SetParamNames("subjectId")
+	c.SetParamNames("subjectID")
 	c.SetParamValues("2")
 
 	err := h.DeleteSubject(c)
@@ -291,7 +366,7 @@ func TestDeleteSubjectError(t *testing.T) {
 	req := httptest.NewRequest(http.MethodDelete, subject2Path, nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	c.SetParamNames("subjectId")
+	c.SetParamNames("subjectID")
 	c.SetParamValues("2")
 
 	err := h.DeleteSubject(c)
@@ -313,13 +388,13 @@ func TestUpdateSubjectsBatch(t *testing.T) {
 		}
 		h := NewSubjectHandler(mockRepo, 2*time.Second)
 
-		body := `[{"name":"数学","test_type_id":1},{"name":"英語","test_type_id":2}]`
+		body := `[{"name":"数学","test_type_id":1,"version":1},{"name":"英語","test_type_id":2,"version":3}]`
 		req := httptest.NewRequest(http.MethodPut, batchSubjectsPath, strings.NewReader(body))
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames("departmentId")
+		c.SetParamNames("departmentID")
 		c.SetParamValues("1")
 
 		err := h.UpdateSubjectsBatch(c)
@@ -342,7 +417,7 @@ func TestUpdateSubjectsBatch(t *testing.T) {
 
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames("departmentId")
+		c.SetParamNames("departmentID")
 		c.SetParamValues("1")
 
 		err := h.UpdateSubjectsBatch(c)
@@ -359,13 +434,13 @@ func TestUpdateSubjectsBatch(t *testing.T) {
 		}
 		h := NewSubjectHandler(mockRepo, 2*time.Second)
 
-		body := `[{"name":"数学","test_type_id":1}]`
+		body := `[{"name":"数学","test_type_id":1,"version":1}]`
 		req := httptest.NewRequest(http.MethodPut, batchSubjectsPath, strings.NewReader(body))
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames("departmentId")
+		c.SetParamNames("departmentID")
 		c.SetParamValues("1")
 
 		err := h.UpdateSubjectsBatch(c)
@@ -373,6 +448,30 @@ func TestUpdateSubjectsBatch(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, rec.Code)
 		assert.Contains(t, rec.Body.String(), "エラー")
 	})
+
+	t.Run("異常系 - バージョン未指定", func(t *testing.T) {
+		e := echo.New()
+		mockRepo := &mockUniversityRepo{
+			UpdateSubjectsBatchFunc: func(_ uint, _ []models.Subject) error {
+				t.Fatal("バージョン未指定の場合は更新してはいけません")
+				return nil
+			},
+		}
+		h := NewSubjectHandler(mockRepo, 2*time.Second)
+
+		body := `[{"name":"数学","test_type_id":1,"version":1},{"name":"英語","test_type_id":1}]`
+		req := httptest.NewRequest(http.MethodPut, batchSubjectsPath, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("departmentID")
+		c.SetParamValues("1")
+
+		err := h.UpdateSubjectsBatch(c)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusPreconditionRequired, rec.Code)
+	})
 }
 
 // --- bindRequestのテスト ---
@@ -442,4 +541,17 @@ func TestValidateSubjectRequest(t *testing.T) {
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "試験種別IDは必須です")
 	})
+
+	t.Run("異常系 - 換算比率が範囲外", func(t *testing.T) {
+		for _, ratio := range []float64{-0.5, 10.5} {
+			subject := &models.Subject{
+				Name:            "数学",
+				TestTypeID:      1,
+				ConversionRatio: ratio,
+			}
+			err := h.validateSubjectRequest(subject)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), ErrMsgConversionRatioRange)
+		}
+	})
 }
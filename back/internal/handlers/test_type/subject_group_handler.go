@@ -0,0 +1,173 @@
+package testtype
+
+import (
+	"context"
+	"net/http"
+	"university-exam-api/internal/domain/models"
+	"university-exam-api/internal/infrastructure/audit"
+	applogger "university-exam-api/internal/logger"
+	"university-exam-api/internal/pkg/errors"
+	"university-exam-api/internal/pkg/etag"
+	"university-exam-api/internal/pkg/validation"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ParamSubjectGroupID は選択科目群IDのパスパラメータ名です
+const ParamSubjectGroupID = "subjectGroupID"
+
+const (
+	logCreateSubjectGroupSuccess = "選択科目群の作成に成功しました (試験種別ID: %d, ID: %d)"
+	logUpdateSubjectGroupSuccess = "選択科目群の更新に成功しました (試験種別ID: %d, ID: %d)"
+	logDeleteSubjectGroupSuccess = "選択科目群の削除に成功しました (試験種別ID: %d, ID: %d)"
+	// msgInvalidSubjectGroupID は選択科目群IDの形式が不正な場合のログメッセージです
+	msgInvalidSubjectGroupID = "選択科目群IDの形式が不正です: %v"
+)
+
+// findScheduleTestType は入試日程に属する試験種別を選択科目群とともに取得します。
+// 指定された入試日程に属さない試験種別の場合はNotFoundエラーを返します
+func (h *Handler) findScheduleTestType(ctx context.Context, c echo.Context) (*models.TestType, error) {
+	scheduleID, testTypeID, err := h.validateScheduleAndTestTypeID(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.repo.FindTestType(ctx, scheduleID, testTypeID)
+}
+
+// validateSubjectGroupID は選択科目群IDのバリデーションを行います
+func validateSubjectGroupID(ctx context.Context, c echo.Context) (uint, error) {
+	return validation.ParseID(ctx, c.Param(ParamSubjectGroupID), msgInvalidSubjectGroupID, "選択科目群IDの形式が不正です")
+}
+
+// ListSubjectGroups は試験種別の選択科目群を表示順に取得します。
+// 各選択科目群には所属する科目のID、選択科目群の換算後の配点と配点比率が含まれます。
+func (h *Handler) ListSubjectGroups(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	testType, err := h.findScheduleTestType(ctx, c)
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	groups := testType.SubjectGroups
+	if groups == nil {
+		groups = []models.SubjectGroup{}
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"data": groups,
+	})
+}
+
+// CreateSubjectGroup は試験種別に新しい選択科目群を作成します。
+// この関数は以下の処理を行います：
+// - 入試日程に属する試験種別の確認
+// - リクエストのバインディング（選択科目群名・選択数・所属する科目のID）
+// - データベースへの保存（所属する科目の検証と、同じ入試日程の配点比率の再計算を含む）
+// - エラーハンドリング
+func (h *Handler) CreateSubjectGroup(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	testType, err := h.findScheduleTestType(ctx, c)
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	var group models.SubjectGroup
+	if err := h.bindRequest(ctx, c, &group); err != nil {
+		return err
+	}
+
+	group.ID = 0
+	group.TestTypeID = testType.ID
+	audit.StampCreate(ctx, &group.BaseModel)
+
+	if err := h.repo.CreateSubjectGroup(ctx, &group); err != nil {
+		applogger.Error(ctx, "選択科目群の作成に失敗しました (試験種別ID: %d): %v", testType.ID, err)
+		return errors.HandleError(c, err)
+	}
+
+	applogger.Info(ctx, logCreateSubjectGroupSuccess, testType.ID, group.ID)
+
+	return c.JSON(http.StatusCreated, map[string]interface{}{
+		"data": group,
+	})
+}
+
+// UpdateSubjectGroup は既存の選択科目群を更新します。
+// この関数は以下の処理を行います：
+// - 入試日程に属する試験種別の確認
+// - 期待するバージョン（If-Match またはボディの version）の取得
+// - データベースの更新（所属する科目の入れ替えと配点比率の再計算を含む。バージョンが一致しない場合は409を返却）
+// - エラーハンドリング
+func (h *Handler) UpdateSubjectGroup(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	testType, err := h.findScheduleTestType(ctx, c)
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	groupID, err := validateSubjectGroupID(ctx, c)
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	var group models.SubjectGroup
+	if err := h.bindRequest(ctx, c, &group); err != nil {
+		return err
+	}
+
+	expectedVersion, err := etag.ExpectedVersion(c, group.Version)
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	group.ID = groupID
+	group.TestTypeID = testType.ID
+	group.Version = expectedVersion
+	audit.StampUpdate(ctx, &group.BaseModel)
+
+	if err := h.repo.UpdateSubjectGroup(ctx, &group); err != nil {
+		applogger.Error(ctx, "選択科目群ID %dの更新に失敗しました: %v", groupID, err)
+		return errors.HandleError(c, err)
+	}
+
+	applogger.Info(ctx, logUpdateSubjectGroupSuccess, testType.ID, groupID)
+
+	etag.SetHeader(c, group.Version)
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"data": group,
+	})
+}
+
+// DeleteSubjectGroup は選択科目群を削除します。
+// 所属していた科目は削除せず、選択科目群に属さない科目として配点比率を再計算します。
+func (h *Handler) DeleteSubjectGroup(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	testType, err := h.findScheduleTestType(ctx, c)
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	groupID, err := validateSubjectGroupID(ctx, c)
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	if err := h.repo.DeleteSubjectGroup(ctx, testType.ID, groupID); err != nil {
+		applogger.Error(ctx, "選択科目群ID %dの削除に失敗しました: %v", groupID, err)
+		return errors.HandleError(c, err)
+	}
+
+	applogger.Info(ctx, logDeleteSubjectGroupSuccess, testType.ID, groupID)
+
+	return c.NoContent(http.StatusNoContent)
+}
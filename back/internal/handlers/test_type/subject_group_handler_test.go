@@ -0,0 +1,215 @@
+package testtype
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	applogger "university-exam-api/internal/logger"
+	"university-exam-api/internal/pkg/etag"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newSubjectGroupContext は入試日程・試験種別・選択科目群のIDを設定したテスト用のコンテキストを生成します
+func newSubjectGroupContext(method, body, groupID string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, "/schedules/1/test-types/2/subject-groups", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames(ParamScheduleID, ParamTestTypeID, ParamSubjectGroupID)
+	c.SetParamValues("1", "2", groupID)
+
+	return c, rec
+}
+
+// findTestTypeWithGroups は選択科目群を持つ試験種別を返すモックの関数です
+func findTestTypeWithGroups(scheduleID, testTypeID uint) (*models.TestType, error) {
+	return &models.TestType{
+		BaseModel:           models.BaseModel{ID: testTypeID, Version: 1},
+		AdmissionScheduleID: scheduleID,
+		Name:                "共通",
+		SubjectGroups: []models.SubjectGroup{{
+			BaseModel:      models.BaseModel{ID: 5, Version: 1},
+			TestTypeID:     testTypeID,
+			Name:           "理科",
+			ChooseCount:    2,
+			SubjectIDs:     []uint{3, 4, 5},
+			EffectiveScore: 200,
+			Percentage:     40,
+		}},
+	}, nil
+}
+
+func TestListSubjectGroups(t *testing.T) {
+	applogger.InitTestLogger()
+
+	t.Run("正常系", func(t *testing.T) {
+		h := NewTestTypeHandler(&mockUniversityRepo{FindTestTypeFunc: findTestTypeWithGroups}, 2*time.Second)
+		c, rec := newSubjectGroupContext(http.MethodGet, "", "")
+
+		require.NoError(t, h.ListSubjectGroups(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var body struct {
+			Data []models.SubjectGroup `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		require.Len(t, body.Data, 1)
+		assert.Equal(t, []uint{3, 4, 5}, body.Data[0].SubjectIDs)
+		assert.Equal(t, 200.0, body.Data[0].EffectiveScore)
+		assert.Equal(t, 40.0, body.Data[0].Percentage)
+	})
+
+	t.Run("選択科目群がない場合は空の一覧", func(t *testing.T) {
+		mockRepo := &mockUniversityRepo{
+			FindTestTypeFunc: func(_, testTypeID uint) (*models.TestType, error) {
+				return &models.TestType{BaseModel: models.BaseModel{ID: testTypeID}}, nil
+			},
+		}
+		h := NewTestTypeHandler(mockRepo, 2*time.Second)
+		c, rec := newSubjectGroupContext(http.MethodGet, "", "")
+
+		require.NoError(t, h.ListSubjectGroups(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"data":[]}`, rec.Body.String())
+	})
+
+	t.Run("入試日程に属さない試験種別", func(t *testing.T) {
+		mockRepo := &mockUniversityRepo{
+			FindTestTypeFunc: func(_, testTypeID uint) (*models.TestType, error) {
+				return nil, appErrors.NewNotFoundError("試験種別", testTypeID, nil)
+			},
+		}
+		h := NewTestTypeHandler(mockRepo, 2*time.Second)
+		c, rec := newSubjectGroupContext(http.MethodGet, "", "")
+
+		require.NoError(t, h.ListSubjectGroups(c))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestCreateSubjectGroup(t *testing.T) {
+	applogger.InitTestLogger()
+
+	t.Run("正常系", func(t *testing.T) {
+		mockRepo := &mockUniversityRepo{
+			FindTestTypeFunc: findTestTypeWithGroups,
+			CreateSubjectGroupFunc: func(group *models.SubjectGroup) error {
+				assert.Equal(t, uint(2), group.TestTypeID)
+				assert.Equal(t, 2, group.ChooseCount)
+				assert.Equal(t, []uint{3, 4, 5}, group.SubjectIDs)
+
+				group.ID = 6
+
+				return nil
+			},
+		}
+		h := NewTestTypeHandler(mockRepo, 2*time.Second)
+		c, rec := newSubjectGroupContext(http.MethodPost,
+			`{"name":"理科","choose_count":2,"subject_ids":[3,4,5],"test_type_id":9}`, "")
+
+		require.NoError(t, h.CreateSubjectGroup(c))
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"id":6`)
+	})
+
+	t.Run("不整合な選択科目群", func(t *testing.T) {
+		mockRepo := &mockUniversityRepo{
+			FindTestTypeFunc: findTestTypeWithGroups,
+			CreateSubjectGroupFunc: func(_ *models.SubjectGroup) error {
+				return appErrors.NewValidationError("ChooseCount", "選択数は1以上、所属する科目数未満である必要があります", nil)
+			},
+		}
+		h := NewTestTypeHandler(mockRepo, 2*time.Second)
+		c, rec := newSubjectGroupContext(http.MethodPost, `{"name":"理科","choose_count":3,"subject_ids":[3,4,5]}`, "")
+
+		require.NoError(t, h.CreateSubjectGroup(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestUpdateSubjectGroup(t *testing.T) {
+	applogger.InitTestLogger()
+
+	t.Run("正常系", func(t *testing.T) {
+		mockRepo := &mockUniversityRepo{
+			FindTestTypeFunc: findTestTypeWithGroups,
+			UpdateSubjectGroupFunc: func(group *models.SubjectGroup) error {
+				assert.Equal(t, uint(5), group.ID)
+				assert.Equal(t, uint(2), group.TestTypeID)
+				assert.Equal(t, 1, group.Version)
+
+				group.Version++
+
+				return nil
+			},
+		}
+		h := NewTestTypeHandler(mockRepo, 2*time.Second)
+		c, rec := newSubjectGroupContext(http.MethodPut, `{"name":"理科","choose_count":1,"subject_ids":[3,4]}`, "5")
+		c.Request().Header.Set(etag.HeaderIfMatch, `"1"`)
+
+		require.NoError(t, h.UpdateSubjectGroup(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"2"`, rec.Header().Get(etag.HeaderETag))
+	})
+
+	t.Run("バージョンの指定がない", func(t *testing.T) {
+		h := NewTestTypeHandler(&mockUniversityRepo{FindTestTypeFunc: findTestTypeWithGroups}, 2*time.Second)
+		c, rec := newSubjectGroupContext(http.MethodPut, `{"name":"理科","choose_count":1,"subject_ids":[3,4]}`, "5")
+
+		require.NoError(t, h.UpdateSubjectGroup(c))
+		assert.Equal(t, http.StatusPreconditionRequired, rec.Code)
+	})
+
+	t.Run("不正な選択科目群ID", func(t *testing.T) {
+		h := NewTestTypeHandler(&mockUniversityRepo{FindTestTypeFunc: findTestTypeWithGroups}, 2*time.Second)
+		c, rec := newSubjectGroupContext(http.MethodPut, `{"name":"理科"}`, "abc")
+
+		require.NoError(t, h.UpdateSubjectGroup(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestDeleteSubjectGroup(t *testing.T) {
+	applogger.InitTestLogger()
+
+	t.Run("正常系", func(t *testing.T) {
+		mockRepo := &mockUniversityRepo{
+			FindTestTypeFunc: findTestTypeWithGroups,
+			DeleteSubjectGroupFunc: func(testTypeID, groupID uint) error {
+				assert.Equal(t, uint(2), testTypeID)
+				assert.Equal(t, uint(5), groupID)
+
+				return nil
+			},
+		}
+		h := NewTestTypeHandler(mockRepo, 2*time.Second)
+		c, rec := newSubjectGroupContext(http.MethodDelete, "", "5")
+
+		require.NoError(t, h.DeleteSubjectGroup(c))
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("存在しない選択科目群", func(t *testing.T) {
+		mockRepo := &mockUniversityRepo{
+			FindTestTypeFunc: findTestTypeWithGroups,
+			DeleteSubjectGroupFunc: func(_, groupID uint) error {
+				return appErrors.NewNotFoundError("選択科目群", groupID, nil)
+			},
+		}
+		h := NewTestTypeHandler(mockRepo, 2*time.Second)
+		c, rec := newSubjectGroupContext(http.MethodDelete, "", "9")
+
+		require.NoError(t, h.DeleteSubjectGroup(c))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
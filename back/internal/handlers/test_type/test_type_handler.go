@@ -0,0 +1,259 @@
+// Package testtype は試験種別関連のHTTPリクエストを処理するハンドラーを提供します。
+// このパッケージは以下の機能を提供します：
+// - 試験種別の取得、作成、更新、削除
+// - 選択科目群の取得、作成、更新、削除
+// - リクエストのバリデーション
+// - エラーハンドリング
+// - ログ記録
+package testtype
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+	"university-exam-api/internal/domain/models"
+	"university-exam-api/internal/infrastructure/audit"
+	applogger "university-exam-api/internal/logger"
+	"university-exam-api/internal/pkg/errors"
+	"university-exam-api/internal/pkg/etag"
+	"university-exam-api/internal/pkg/validation"
+	"university-exam-api/internal/repositories"
+
+	"github.com/labstack/echo/v4"
+)
+
+// パスパラメータ名
+const (
+	ParamScheduleID = "scheduleID"
+	ParamTestTypeID = "testTypeID"
+)
+
+const (
+	logGetTestTypeSuccess    = "試験種別の取得に成功しました (入試日程ID: %d, 試験種別ID: %d)"
+	logCreateTestTypeSuccess = "試験種別の作成に成功しました (ID: %d)"
+	logUpdateTestTypeSuccess = "試験種別の更新に成功しました (ID: %d)"
+	logDeleteTestTypeSuccess = "試験種別の削除に成功しました (ID: %d)"
+)
+
+// Handler は試験種別関連のHTTPリクエストを処理する構造体です。
+// この構造体は以下の機能を提供します：
+// - リポジトリとの連携
+// - リクエストタイムアウトの管理
+// - エラーハンドリング
+type Handler struct {
+	repo    repositories.IUniversityRepository
+	timeout time.Duration
+}
+
+// NewTestTypeHandler は新しいHandlerインスタンスを生成します。
+// この関数は以下の処理を行います：
+// - リポジトリの初期化
+// - タイムアウトの設定
+// - ハンドラーの初期化
+func NewTestTypeHandler(repo repositories.IUniversityRepository, timeout time.Duration) *Handler {
+	return &Handler{
+		repo:    repo,
+		timeout: timeout,
+	}
+}
+
+// bindRequest はリクエストボディのバインディングを共通化します。
+// この関数は以下の処理を行います：
+// - リクエストボディのバインディング
+// - エラーログの記録
+// - バリデーションエラーの生成
+func (h *Handler) bindRequest(ctx context.Context, c echo.Context, data interface{}) error {
+	if err := c.Bind(data); err != nil {
+		applogger.Error(ctx, errors.MsgBindRequestFailed, err)
+		return errors.HandleError(c, err)
+	}
+
+	return nil
+}
+
+// validateTestTypeRequest は試験種別リクエストのバリデーションを共通化します。
+// この関数は以下の処理を行います：
+// - 試験種別名が試験種別レジストリに登録されていることの検証
+func (h *Handler) validateTestTypeRequest(testType *models.TestType) error {
+	if _, ok := models.TestTypes().Lookup(testType.Name); !ok {
+		return errors.NewValidationError(fmt.Sprintf(
+			"試験種別名は%sのいずれかである必要があります",
+			strings.Join(models.TestTypes().Names(), "、"),
+		))
+	}
+
+	return nil
+}
+
+// validateScheduleAndTestTypeID は入試日程IDと試験種別IDのバリデーションを共通化します。
+// この関数は以下の処理を行います：
+// - 入試日程IDの検証
+// - 試験種別IDの検証
+// - エラーハンドリング
+func (h *Handler) validateScheduleAndTestTypeID(ctx context.Context, c echo.Context) (uint, uint, error) {
+	scheduleID, err := validation.ValidateScheduleID(ctx, c.Param(ParamScheduleID))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	testTypeID, err := validation.ValidateTestTypeID(ctx, c.Param(ParamTestTypeID))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return scheduleID, testTypeID, nil
+}
+
+// ListDefinitions は試験種別レジストリに登録された試験種別の定義を表示順に取得します。
+// 試験種別の作成・更新では、この一覧の表示名（name）を指定します。
+func (h *Handler) ListDefinitions(c echo.Context) error {
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"data": models.TestTypes().Definitions(),
+	})
+}
+
+// GetTestType は指定された試験種別を科目とともに取得します。
+// この関数は以下の処理を行います：
+// - パラメータの検証
+// - データベースからの取得
+// - エラーハンドリング
+func (h *Handler) GetTestType(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	scheduleID, testTypeID, err := h.validateScheduleAndTestTypeID(ctx, c)
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	testType, err := h.repo.FindTestType(ctx, scheduleID, testTypeID)
+	if err != nil {
+		applogger.Error(ctx, "試験種別の取得に失敗しました (入試日程ID: %d, 試験種別ID: %d): %v", scheduleID, testTypeID, err)
+		return errors.HandleError(c, err)
+	}
+
+	applogger.Info(ctx, logGetTestTypeSuccess, scheduleID, testTypeID)
+
+	etag.SetHeader(c, testType.Version)
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"data": testType,
+	})
+}
+
+// CreateTestType は新しい試験種別を作成します。
+// この関数は以下の処理を行います：
+// - リクエストのバリデーション
+// - データベースへの保存（同じ入試日程の配点比率の再計算を含む）
+// - エラーハンドリング
+func (h *Handler) CreateTestType(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	scheduleID, err := validation.ValidateScheduleID(ctx, c.Param(ParamScheduleID))
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	var testType models.TestType
+	if err := h.bindRequest(ctx, c, &testType); err != nil {
+		return err
+	}
+
+	if err := h.validateTestTypeRequest(&testType); err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	testType.AdmissionScheduleID = scheduleID
+	testType.SubjectGroups = nil
+	audit.StampCreate(ctx, &testType.BaseModel)
+
+	if err := h.repo.CreateTestType(ctx, &testType); err != nil {
+		applogger.Error(ctx, "試験種別の作成に失敗しました: %v", err)
+		return errors.HandleError(c, err)
+	}
+
+	applogger.Info(ctx, logCreateTestTypeSuccess, testType.ID)
+
+	return c.JSON(http.StatusCreated, map[string]interface{}{
+		"data": testType,
+	})
+}
+
+// UpdateTestType は既存の試験種別を更新します。
+// この関数は以下の処理を行います：
+// - パラメータの検証
+// - リクエストのバリデーション
+// - 期待するバージョン（If-Match またはボディの version）の取得
+// - データベースの更新（バージョンが一致しない場合は現在の状態とともに409を返却）
+// - エラーハンドリング
+// 科目・選択科目群は更新しません。科目・選択科目群はそれぞれのエンドポイントで更新してください。
+func (h *Handler) UpdateTestType(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	scheduleID, testTypeID, err := h.validateScheduleAndTestTypeID(ctx, c)
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	var testType models.TestType
+	if err := h.bindRequest(ctx, c, &testType); err != nil {
+		return err
+	}
+
+	if err := h.validateTestTypeRequest(&testType); err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	expectedVersion, err := etag.ExpectedVersion(c, testType.Version)
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	testType.ID = testTypeID
+	testType.AdmissionScheduleID = scheduleID
+	testType.Version = expectedVersion
+	testType.Subjects = nil
+	testType.SubjectGroups = nil
+	audit.StampUpdate(ctx, &testType.BaseModel)
+
+	if err := h.repo.UpdateTestType(ctx, &testType); err != nil {
+		applogger.Error(ctx, "試験種別ID %dの更新に失敗しました: %v", testTypeID, err)
+		return errors.HandleError(c, err)
+	}
+
+	applogger.Info(ctx, logUpdateTestTypeSuccess, testTypeID)
+
+	etag.SetHeader(c, testType.Version)
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"data": testType,
+	})
+}
+
+// DeleteTestType は試験種別を科目とともに削除します。
+// この関数は以下の処理を行います：
+// - パラメータの検証
+// - データベースからの削除（同じ入試日程の配点比率の再計算を含む）
+// - エラーハンドリング
+func (h *Handler) DeleteTestType(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	_, testTypeID, err := h.validateScheduleAndTestTypeID(ctx, c)
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	if err := h.repo.DeleteTestType(ctx, testTypeID); err != nil {
+		applogger.Error(ctx, "試験種別ID %dの削除に失敗しました: %v", testTypeID, err)
+		return errors.HandleError(c, err)
+	}
+
+	applogger.Info(ctx, logDeleteTestTypeSuccess, testTypeID)
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,390 @@
+package testtype
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	applogger "university-exam-api/internal/logger"
+	"university-exam-api/internal/pkg/etag"
+	"university-exam-api/internal/pkg/pagination"
+	"university-exam-api/internal/pkg/textsearch"
+	"university-exam-api/internal/repositories"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const errNotImplemented = "not implemented"
+
+type mockUniversityRepo struct {
+	FindTestTypeFunc   func(scheduleID, testTypeID uint) (*models.TestType, error)
+	CreateTestTypeFunc func(testType *models.TestType) error
+	UpdateTestTypeFunc func(testType *models.TestType) error
+	DeleteTestTypeFunc func(testTypeID uint) error
+
+	CreateSubjectGroupFunc func(group *models.SubjectGroup) error
+	UpdateSubjectGroupFunc func(group *models.SubjectGroup) error
+	DeleteSubjectGroupFunc func(testTypeID, groupID uint) error
+}
+
+func (m *mockUniversityRepo) FindTestType(_ context.Context, scheduleID, testTypeID uint) (*models.TestType, error) {
+	return m.FindTestTypeFunc(scheduleID, testTypeID)
+}
+
+func (m *mockUniversityRepo) CreateTestType(_ context.Context, testType *models.TestType) error {
+	return m.CreateTestTypeFunc(testType)
+}
+
+func (m *mockUniversityRepo) UpdateTestType(_ context.Context, testType *models.TestType) error {
+	return m.UpdateTestTypeFunc(testType)
+}
+
+func (m *mockUniversityRepo) DeleteTestType(_ context.Context, testTypeID uint) error {
+	return m.DeleteTestTypeFunc(testTypeID)
+}
+
+func (m *mockUniversityRepo) CreateSubjectGroup(_ context.Context, group *models.SubjectGroup) error {
+	return m.CreateSubjectGroupFunc(group)
+}
+
+func (m *mockUniversityRepo) UpdateSubjectGroup(_ context.Context, group *models.SubjectGroup) error {
+	return m.UpdateSubjectGroupFunc(group)
+}
+
+func (m *mockUniversityRepo) DeleteSubjectGroup(_ context.Context, testTypeID, groupID uint) error {
+	return m.DeleteSubjectGroupFunc(testTypeID, groupID)
+}
+
+// 他のIUniversityRepositoryメソッドはpanicでOK
+func (m *mockUniversityRepo) FindAdmissionDifficulty(_ context.Context, _ uint) (*models.AdmissionDifficulty, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) CreateAdmissionDifficulty(_ context.Context, _ *models.AdmissionDifficulty) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) UpdateAdmissionDifficulty(_ context.Context, _ *models.AdmissionDifficulty) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) DeleteAdmissionDifficulty(_ context.Context, _ uint) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindMajor(_ context.Context, _, _ uint) (*models.Major, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) CreateMajor(_ context.Context, _ *models.Major) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) UpdateMajor(_ context.Context, _ *models.Major) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) DeleteMajor(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindAll(_ context.Context) ([]models.University, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindPage(_ context.Context, _ pagination.Params) (*repositories.UniversityPage, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) SearchPage(_ context.Context, _ string, _ pagination.Params) (*repositories.UniversityPage, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) Suggest(_ context.Context, _ string, _ []string, _ int) (textsearch.Suggestions, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) SearchHits(_ context.Context, _ string) ([]textsearch.Hit, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) WarmSearchIndex(_ context.Context) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) InvalidateSearchIndex()                  {}
+func (m *mockUniversityRepo) ImportRows(
+	_ context.Context,
+	_ []repositories.ImportRow,
+	_ bool,
+) (*repositories.ImportResult, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindByID(_ context.Context, _ uint) (*models.University, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) Search(_ context.Context, _ string) ([]models.University, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) Create(_ context.Context, _ *models.University) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) Update(_ context.Context, _ *models.University) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) Delete(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateDepartment(_ context.Context, _ *models.Department) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) UpdateDepartment(_ context.Context, _ *models.Department) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) DeleteDepartment(_ context.Context, _ uint) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) CreateSubject(_ context.Context, _ *models.Subject) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) UpdateSubject(_ context.Context, _ *models.Subject) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) DeleteSubject(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateAdmissionInfo(_ context.Context, _ *models.AdmissionInfo) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) DeleteAdmissionInfo(_ context.Context, _ uint) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) UpdateAdmissionInfo(_ context.Context, _ *models.AdmissionInfo) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindDepartment(_ context.Context, _, _ uint) (*models.Department, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindSubject(_ context.Context, _, _ uint) (*models.Subject, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindAdmissionInfo(_ context.Context, _, _ uint) (*models.AdmissionInfo, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) UpdateSubjectsBatch(_ context.Context, _ uint, _ []models.Subject) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) UpdateAdmissionSchedule(_ context.Context, _ *models.AdmissionSchedule) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindAdmissionInfoForPublication(_ context.Context, _ uint) (*models.AdmissionInfo, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) UpdateAdmissionInfoStatus(_ context.Context, _ *models.AdmissionInfo, _ string) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindAdmissionSchedule(_ context.Context, _, _ uint) (*models.AdmissionSchedule, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) CreateAdmissionSchedule(_ context.Context, _ *models.AdmissionSchedule) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) DeleteAdmissionSchedule(_ context.Context, _ uint) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindTrash(_ context.Context, _ string, _ pagination.Params) (*repositories.TrashPage, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) RestoreFromTrash(_ context.Context, _ string, _ uint) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) PurgeFromTrash(_ context.Context, _ string, _ uint) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) PurgeTrashBefore(_ context.Context, _ time.Time) (int64, error) {
+	panic(errNotImplemented)
+}
+
+// newTestContext はリクエストとパスパラメータを設定したテスト用のコンテキストを生成します
+func newTestContext(method, body string, params ...string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, "/schedules/1/test-types", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames(ParamScheduleID, ParamTestTypeID)
+	c.SetParamValues(params...)
+
+	return c, rec
+}
+
+// --- 試験種別取得APIのテスト ---
+func TestGetTestType(t *testing.T) {
+	applogger.InitTestLogger()
+
+	t.Run("正常系", func(t *testing.T) {
+		mockRepo := &mockUniversityRepo{
+			FindTestTypeFunc: func(scheduleID, testTypeID uint) (*models.TestType, error) {
+				return &models.TestType{
+					BaseModel:           models.BaseModel{ID: testTypeID, Version: 2},
+					AdmissionScheduleID: scheduleID,
+					Name:                "共通",
+					Subjects:            []models.Subject{{Name: "英語", Score: 200}},
+				}, nil
+			},
+		}
+		h := NewTestTypeHandler(mockRepo, 2*time.Second)
+		c, rec := newTestContext(http.MethodGet, "", "1", "2")
+
+		require.NoError(t, h.GetTestType(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"2"`, rec.Header().Get(etag.HeaderETag))
+		assert.Contains(t, rec.Body.String(), "英語")
+	})
+
+	t.Run("存在しない試験種別", func(t *testing.T) {
+		mockRepo := &mockUniversityRepo{
+			FindTestTypeFunc: func(_, testTypeID uint) (*models.TestType, error) {
+				return nil, appErrors.NewNotFoundError("試験種別", testTypeID, nil)
+			},
+		}
+		h := NewTestTypeHandler(mockRepo, 2*time.Second)
+		c, rec := newTestContext(http.MethodGet, "", "1", "9")
+
+		require.NoError(t, h.GetTestType(c))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("不正な試験種別ID", func(t *testing.T) {
+		h := NewTestTypeHandler(&mockUniversityRepo{}, 2*time.Second)
+		c, rec := newTestContext(http.MethodGet, "", "1", "abc")
+
+		require.NoError(t, h.GetTestType(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+// --- 試験種別作成APIのテスト ---
+func TestCreateTestType(t *testing.T) {
+	applogger.InitTestLogger()
+
+	t.Run("正常系", func(t *testing.T) {
+		var created *models.TestType
+
+		mockRepo := &mockUniversityRepo{
+			CreateTestTypeFunc: func(testType *models.TestType) error {
+				testType.ID = 3
+				created = testType
+
+				return nil
+			},
+		}
+		h := NewTestTypeHandler(mockRepo, 2*time.Second)
+		c, rec := newTestContext(http.MethodPost, `{"name":"二次"}`, "1")
+
+		require.NoError(t, h.CreateTestType(c))
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		require.NotNil(t, created)
+		assert.Equal(t, uint(1), created.AdmissionScheduleID)
+	})
+
+	t.Run("不正な試験種別名", func(t *testing.T) {
+		h := NewTestTypeHandler(&mockUniversityRepo{}, 2*time.Second)
+		c, rec := newTestContext(http.MethodPost, `{"name":"前期"}`, "1")
+
+		require.NoError(t, h.CreateTestType(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("試験種別レジストリに登録された試験種別", func(t *testing.T) {
+		mockRepo := &mockUniversityRepo{
+			CreateTestTypeFunc: func(_ *models.TestType) error { return nil },
+		}
+		h := NewTestTypeHandler(mockRepo, 2*time.Second)
+		c, rec := newTestContext(http.MethodPost, `{"name":"学校推薦型"}`, "1")
+
+		require.NoError(t, h.CreateTestType(c))
+		assert.Equal(t, http.StatusCreated, rec.Code)
+	})
+
+	t.Run("同名の試験種別がある", func(t *testing.T) {
+		mockRepo := &mockUniversityRepo{
+			CreateTestTypeFunc: func(_ *models.TestType) error {
+				return appErrors.NewValidationError("name", "この入試日程には既に共通の試験種別があります", nil)
+			},
+		}
+		h := NewTestTypeHandler(mockRepo, 2*time.Second)
+		c, rec := newTestContext(http.MethodPost, `{"name":"共通"}`, "1")
+
+		require.NoError(t, h.CreateTestType(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestListDefinitions(t *testing.T) {
+	h := NewTestTypeHandler(&mockUniversityRepo{}, 2*time.Second)
+	c, rec := newTestContext(http.MethodGet, "")
+
+	require.NoError(t, h.ListDefinitions(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var body struct {
+		Data []models.TestTypeDefinition `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, models.TestTypes().Definitions(), body.Data)
+}
+
+// --- 試験種別更新APIのテスト ---
+func TestUpdateTestType(t *testing.T) {
+	applogger.InitTestLogger()
+
+	t.Run("正常系", func(t *testing.T) {
+		mockRepo := &mockUniversityRepo{
+			UpdateTestTypeFunc: func(testType *models.TestType) error {
+				assert.Equal(t, uint(2), testType.ID)
+				assert.Equal(t, uint(1), testType.AdmissionScheduleID)
+				assert.Equal(t, 1, testType.Version)
+				assert.Nil(t, testType.Subjects)
+
+				testType.Version++
+
+				return nil
+			},
+		}
+		h := NewTestTypeHandler(mockRepo, 2*time.Second)
+		c, rec := newTestContext(http.MethodPut, `{"name":"二次","subjects":[{"name":"英語"}]}`, "1", "2")
+		c.Request().Header.Set(etag.HeaderIfMatch, `"1"`)
+
+		require.NoError(t, h.UpdateTestType(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"2"`, rec.Header().Get(etag.HeaderETag))
+	})
+
+	t.Run("バージョンの指定がない", func(t *testing.T) {
+		h := NewTestTypeHandler(&mockUniversityRepo{}, 2*time.Second)
+		c, rec := newTestContext(http.MethodPut, `{"name":"二次"}`, "1", "2")
+
+		require.NoError(t, h.UpdateTestType(c))
+		assert.Equal(t, http.StatusPreconditionRequired, rec.Code)
+	})
+}
+
+// --- 試験種別削除APIのテスト ---
+func TestDeleteTestType(t *testing.T) {
+	applogger.InitTestLogger()
+
+	t.Run("正常系", func(t *testing.T) {
+		mockRepo := &mockUniversityRepo{
+			DeleteTestTypeFunc: func(testTypeID uint) error {
+				assert.Equal(t, uint(2), testTypeID)
+				return nil
+			},
+		}
+		h := NewTestTypeHandler(mockRepo, 2*time.Second)
+		c, rec := newTestContext(http.MethodDelete, "", "1", "2")
+
+		require.NoError(t, h.DeleteTestType(c))
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("削除に失敗", func(t *testing.T) {
+		mockRepo := &mockUniversityRepo{
+			DeleteTestTypeFunc: func(_ uint) error {
+				return errors.New("DBエラー")
+			},
+		}
+		h := NewTestTypeHandler(mockRepo, 2*time.Second)
+		c, rec := newTestContext(http.MethodDelete, "", "1", "2")
+
+		require.NoError(t, h.DeleteTestType(c))
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
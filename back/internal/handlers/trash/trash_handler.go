@@ -0,0 +1,137 @@
+// Package trash はゴミ箱（ソフトデリートした要素）に関するHTTPリクエストを処理するパッケージです。
+// このパッケージは以下の機能を提供します：
+// - エンティティ種別ごとのゴミ箱の一覧取得
+// - 配下の要素を含む復元
+// - 配下の要素を含む完全削除
+// - エラーハンドリング
+// - ログ記録
+package trash
+
+import (
+	"context"
+	"net/http"
+	"time"
+	applogger "university-exam-api/internal/logger"
+	"university-exam-api/internal/pkg/errors"
+	"university-exam-api/internal/pkg/pagination"
+	"university-exam-api/internal/pkg/validation"
+	"university-exam-api/internal/usecases"
+
+	"github.com/labstack/echo/v4"
+)
+
+// パスパラメータ名
+const (
+	ParamEntityType = "entityType"
+	ParamEntityID   = "entityID"
+)
+
+const (
+	// msgInvalidEntityID はエンティティIDの形式が不正な場合のログメッセージです
+	msgInvalidEntityID = "エンティティIDの形式が不正です: %v"
+)
+
+// Handler はゴミ箱に関するHTTPリクエストを処理する構造体です。
+// この構造体は以下の機能を提供します：
+// - ユースケースとの連携
+// - リクエストタイムアウトの管理
+// - エラーハンドリング
+type Handler struct {
+	usecase usecases.TrashUsecase
+	timeout time.Duration
+}
+
+// NewTrashHandler は新しいHandlerインスタンスを生成します。
+// この関数は以下の処理を行います：
+// - ユースケースの初期化
+// - タイムアウトの設定
+func NewTrashHandler(usecase usecases.TrashUsecase, timeout time.Duration) *Handler {
+	return &Handler{
+		usecase: usecase,
+		timeout: timeout,
+	}
+}
+
+// ListTrash はゴミ箱の要素を削除日時の新しい順に取得します。
+// この関数は以下の処理を行います：
+// - ページ番号・件数の解析（並び順は削除日時の降順で固定）
+// - ゴミ箱の要素の取得
+// - next/prevリンクの設定
+func (h *Handler) ListTrash(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	entityType := c.Param(ParamEntityType)
+
+	params, err := pagination.ParseParams(c)
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	// ゴミ箱の一覧はオフセット方式のみ対応
+	params.Cursor = nil
+
+	result, err := h.usecase.List(ctx, entityType, params)
+	if err != nil {
+		applogger.Error(ctx, "ゴミ箱の取得に失敗しました (%s): %v", entityType, err)
+		return errors.HandleError(c, err)
+	}
+
+	links := pagination.BuildLinks(c, params, result.Page)
+	pagination.SetLinkHeader(c, links)
+
+	applogger.Info(ctx, "ゴミ箱を取得しました (%s, 件数: %d)", entityType, len(result.Items))
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"success":   true,
+		"timestamp": time.Now().Unix(),
+		"data":      result,
+		"links":     links,
+	})
+}
+
+// RestoreTrash はゴミ箱の要素を、一緒に削除された配下の要素とともに復元します。
+// 親の要素が削除されている場合は400を返却します。
+func (h *Handler) RestoreTrash(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	entityType := c.Param(ParamEntityType)
+
+	entityID, err := validation.ParseID(ctx, c.Param(ParamEntityID), msgInvalidEntityID, "エンティティIDの形式が不正です")
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	if err := h.usecase.Restore(ctx, entityType, entityID); err != nil {
+		applogger.Error(ctx, "ゴミ箱からの復元に失敗しました (%s: %d): %v", entityType, entityID, err)
+		return errors.HandleError(c, err)
+	}
+
+	applogger.Info(ctx, "ゴミ箱から復元しました (%s: %d)", entityType, entityID)
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// PurgeTrash はゴミ箱の要素を配下の要素とともに完全削除します。
+// 完全削除した要素は復元できません。
+func (h *Handler) PurgeTrash(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	entityType := c.Param(ParamEntityType)
+
+	entityID, err := validation.ParseID(ctx, c.Param(ParamEntityID), msgInvalidEntityID, "エンティティIDの形式が不正です")
+	if err != nil {
+		return errors.HandleError(c, err)
+	}
+
+	if err := h.usecase.Purge(ctx, entityType, entityID); err != nil {
+		applogger.Error(ctx, "ゴミ箱からの完全削除に失敗しました (%s: %d): %v", entityType, entityID, err)
+		return errors.HandleError(c, err)
+	}
+
+	applogger.Info(ctx, "ゴミ箱から完全削除しました (%s: %d)", entityType, entityID)
+
+	return c.NoContent(http.StatusNoContent)
+}
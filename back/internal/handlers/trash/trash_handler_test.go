@@ -0,0 +1,183 @@
+package trash
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	applogger "university-exam-api/internal/logger"
+	"university-exam-api/internal/pkg/pagination"
+	"university-exam-api/internal/repositories"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockTrashUsecase はTrashUsecaseのモックです
+type mockTrashUsecase struct {
+	mock.Mock
+}
+
+func (m *mockTrashUsecase) List(
+	ctx context.Context,
+	entityType string,
+	params pagination.Params,
+) (*repositories.TrashPage, error) {
+	args := m.Called(ctx, entityType, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*repositories.TrashPage), args.Error(1)
+}
+
+func (m *mockTrashUsecase) Restore(ctx context.Context, entityType string, id uint) error {
+	return m.Called(ctx, entityType, id).Error(0)
+}
+
+func (m *mockTrashUsecase) Purge(ctx context.Context, entityType string, id uint) error {
+	return m.Called(ctx, entityType, id).Error(0)
+}
+
+func (m *mockTrashUsecase) PurgeExpired(ctx context.Context, retention time.Duration) (int64, error) {
+	args := m.Called(ctx, retention)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// newTestContext はリクエストURLとパスパラメータを設定したテスト用のコンテキストを生成します
+func newTestContext(method, target, entityType, entityID string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, target, nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames(ParamEntityType, ParamEntityID)
+	c.SetParamValues(entityType, entityID)
+
+	return c, rec
+}
+
+func TestListTrash(t *testing.T) {
+	applogger.InitTestLogger()
+
+	t.Run("正常系", func(t *testing.T) {
+		params := pagination.DefaultParams()
+		params.PerPage = 1
+
+		mockUsecase := new(mockTrashUsecase)
+		mockUsecase.On("List", mock.Anything, models.AuditEntityMajor, params).Return(&repositories.TrashPage{
+			Items: []models.TrashItem{{
+				EntityType: models.AuditEntityMajor,
+				ID:         3,
+				Name:       "機械工学科",
+				ParentType: models.AuditEntityDepartment,
+				ParentID:   2,
+				DeletedAt:  time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC),
+			}},
+			Page: pagination.Page{Total: 2, Page: 1, PerPage: 1, HasNext: true},
+		}, nil)
+
+		h := NewTrashHandler(mockUsecase, 2*time.Second)
+		c, rec := newTestContext(http.MethodGet, "/api/trash/major?perPage=1", models.AuditEntityMajor, "")
+
+		require.NoError(t, h.ListTrash(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Header().Get("Link"), `rel="next"`)
+
+		var body struct {
+			Data repositories.TrashPage `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		require.Len(t, body.Data.Items, 1)
+		assert.Equal(t, "機械工学科", body.Data.Items[0].Name)
+		assert.Equal(t, models.AuditEntityDepartment, body.Data.Items[0].ParentType)
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("不正なエンティティ種別", func(t *testing.T) {
+		mockUsecase := new(mockTrashUsecase)
+		mockUsecase.On("List", mock.Anything, "region", mock.Anything).
+			Return(nil, appErrors.NewInvalidInputError("entityType", "エンティティ種別が不正です", nil))
+
+		h := NewTrashHandler(mockUsecase, 2*time.Second)
+		c, rec := newTestContext(http.MethodGet, "/api/trash/region", "region", "")
+
+		require.NoError(t, h.ListTrash(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestRestoreTrash(t *testing.T) {
+	applogger.InitTestLogger()
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase := new(mockTrashUsecase)
+		mockUsecase.On("Restore", mock.Anything, models.AuditEntityUniversity, uint(1)).Return(nil)
+
+		h := NewTrashHandler(mockUsecase, 2*time.Second)
+		c, rec := newTestContext(http.MethodPost, "/api/trash/university/1/restore", models.AuditEntityUniversity, "1")
+
+		require.NoError(t, h.RestoreTrash(c))
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("親が削除されている", func(t *testing.T) {
+		mockUsecase := new(mockTrashUsecase)
+		mockUsecase.On("Restore", mock.Anything, models.AuditEntityDepartment, uint(2)).Return(
+			appErrors.NewValidationError("entityID", "親の大学が削除されているため復元できません", map[string]string{
+				"parent_type": models.AuditEntityUniversity,
+				"parent_id":   "1",
+			}))
+
+		h := NewTrashHandler(mockUsecase, 2*time.Second)
+		c, rec := newTestContext(http.MethodPost, "/api/trash/department/2/restore", models.AuditEntityDepartment, "2")
+
+		require.NoError(t, h.RestoreTrash(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "親の大学が削除されているため復元できません")
+	})
+
+	t.Run("不正なエンティティID", func(t *testing.T) {
+		mockUsecase := new(mockTrashUsecase)
+
+		h := NewTrashHandler(mockUsecase, 2*time.Second)
+		c, rec := newTestContext(http.MethodPost, "/api/trash/university/abc/restore", models.AuditEntityUniversity, "abc")
+
+		require.NoError(t, h.RestoreTrash(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockUsecase.AssertNotCalled(t, "Restore", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestPurgeTrash(t *testing.T) {
+	applogger.InitTestLogger()
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase := new(mockTrashUsecase)
+		mockUsecase.On("Purge", mock.Anything, models.AuditEntitySubject, uint(5)).Return(nil)
+
+		h := NewTrashHandler(mockUsecase, 2*time.Second)
+		c, rec := newTestContext(http.MethodDelete, "/api/trash/subject/5", models.AuditEntitySubject, "5")
+
+		require.NoError(t, h.PurgeTrash(c))
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("ゴミ箱にない要素", func(t *testing.T) {
+		mockUsecase := new(mockTrashUsecase)
+		mockUsecase.On("Purge", mock.Anything, models.AuditEntitySubject, uint(9)).
+			Return(appErrors.NewNotFoundError("ゴミ箱の科目", 9, nil))
+
+		h := NewTrashHandler(mockUsecase, 2*time.Second)
+		c, rec := newTestContext(http.MethodDelete, "/api/trash/subject/9", models.AuditEntitySubject, "9")
+
+		require.NoError(t, h.PurgeTrash(c))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
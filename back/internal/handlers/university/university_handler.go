@@ -13,8 +13,12 @@ import (
 	"time"
 	"university-exam-api/internal/domain/models"
 	customErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/infrastructure/audit"
 	applogger "university-exam-api/internal/logger"
 	errorMessages "university-exam-api/internal/pkg/errors"
+	"university-exam-api/internal/pkg/etag"
+	"university-exam-api/internal/pkg/pagination"
+	"university-exam-api/internal/pkg/preview"
 	"university-exam-api/internal/pkg/validation"
 	"university-exam-api/internal/repositories"
 
@@ -105,15 +109,29 @@ func (h *Handler) handleError(ctx context.Context, c echo.Context, err error) er
 			statusCode = http.StatusUnauthorized
 		case customErrors.CodeAuthzError:
 			statusCode = http.StatusForbidden
+		case customErrors.CodeConflict:
+			statusCode = http.StatusConflict
+		case customErrors.CodePreconditionRequired:
+			statusCode = http.StatusPreconditionRequired
 		}
 
 		applogger.Error(ctx, "エラーが発生しました: %v", e)
 
-		return c.JSON(statusCode, map[string]interface{}{
+		body := map[string]interface{}{
 			"code":    e.Code,
 			"message": e.Message,
 			"details": e.Details,
-		})
+		}
+
+		if e.Code == customErrors.CodeConflict {
+			if current, ok := e.Current.(*models.University); ok {
+				etag.SetHeader(c, current.Version)
+			}
+
+			body["current"] = e.Current
+		}
+
+		return c.JSON(statusCode, body)
 	default:
 		applogger.Error(ctx, "予期せぬエラーが発生しました: %v", err)
 
@@ -137,16 +155,22 @@ func (h *Handler) bindRequest(ctx context.Context, c echo.Context, data interfac
 	return nil
 }
 
-// GetUniversities は大学一覧を取得します。
+// GetUniversities は大学一覧をページ単位で取得します。
 // この関数は以下の処理を行います：
+// - ページネーション・ソート条件の解析
 // - 大学一覧の取得
+// - next/prevリンクの設定
 // - エラーハンドリング
-// - ログ記録
 func (h *Handler) GetUniversities(c echo.Context) error {
 	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
 	defer cancel()
 
-	universities, err := h.repo.FindAll(ctx)
+	params, err := pagination.ParseParams(c)
+	if err != nil {
+		return h.handleError(ctx, c, err)
+	}
+
+	result, err := h.repo.FindPage(ctx, params)
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
 			applogger.Error(ctx, "リクエストがタイムアウトしました")
@@ -169,13 +193,21 @@ func (h *Handler) GetUniversities(c echo.Context) error {
 		return h.handleError(ctx, c, err)
 	}
 
-	if universities == nil {
-		universities = []models.University{}
+	if result.Universities == nil {
+		result.Universities = []models.University{}
 	}
 
-	applogger.Info(ctx, applogger.LogGetUniversitiesSuccess, len(universities))
+	// 公開中でない入試情報は管理者のプレビュー時のみ返却する
+	if !preview.IncludeDrafts(ctx) {
+		result.Universities = models.PublishedUniversities(result.Universities)
+	}
 
-	return c.JSON(http.StatusOK, universities)
+	links := pagination.BuildLinks(c, params, result.Page)
+	pagination.SetLinkHeader(c, links)
+
+	applogger.Info(ctx, applogger.LogGetUniversitiesSuccess, len(result.Universities))
+
+	return c.JSON(http.StatusOK, pagination.BuildResponse("universities", result.Universities, result.Page, links))
 }
 
 // GetUniversity は指定された大学の情報を取得します。
@@ -192,7 +224,7 @@ func (h *Handler) GetUniversity(c echo.Context) error {
 		return h.handleError(ctx, c, err)
 	}
 
-	university, err := h.repo.FindByID(id)
+	university, err := h.repo.FindByID(ctx, id)
 	if err != nil {
 		applogger.Error(ctx, ErrMsgGetUniversityFailed+": %v", err)
 		return h.handleError(ctx, c, err)
@@ -200,6 +232,12 @@ func (h *Handler) GetUniversity(c echo.Context) error {
 
 	applogger.Info(ctx, applogger.LogGetUniversitySuccess, id)
 
+	etag.SetHeader(c, university.Version)
+
+	if !preview.IncludeDrafts(ctx) {
+		return c.JSON(http.StatusOK, university.PublishedOnly())
+	}
+
 	return c.JSON(http.StatusOK, university)
 }
 
@@ -217,7 +255,9 @@ func (h *Handler) CreateUniversity(c echo.Context) error {
 		return h.handleError(ctx, c, err)
 	}
 
-	if err := h.repo.Create(&university); err != nil {
+	audit.StampCreate(ctx, &university.BaseModel)
+
+	if err := h.repo.Create(ctx, &university); err != nil {
 		applogger.Error(ctx, ErrMsgCreateUniversityFailed+": %v", err)
 		return h.handleError(ctx, c, err)
 	}
@@ -231,7 +271,8 @@ func (h *Handler) CreateUniversity(c echo.Context) error {
 // この関数は以下の処理を行います：
 // - 大学IDのバリデーション
 // - リクエストボディのバインディング
-// - 大学の更新
+// - 期待するバージョン（If-Match またはボディの version）の取得
+// - 大学の更新（バージョンが一致しない場合は現在の状態とともに409を返却）
 // - エラーハンドリング
 func (h *Handler) UpdateUniversity(c echo.Context) error {
 	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
@@ -243,7 +284,7 @@ func (h *Handler) UpdateUniversity(c echo.Context) error {
 	}
 
 	// 大学の存在確認
-	existingUniversity, err := h.repo.FindByID(id)
+	existingUniversity, err := h.repo.FindByID(ctx, id)
 	if err != nil {
 		if _, ok := err.(*customErrors.Error); ok && err.(*customErrors.Error).Code == customErrors.CodeNotFound {
 			applogger.Error(ctx, ErrMsgUniversityNotFound, id)
@@ -263,17 +304,26 @@ func (h *Handler) UpdateUniversity(c echo.Context) error {
 		return h.handleError(ctx, c, err)
 	}
 
+	expectedVersion, err := etag.ExpectedVersion(c, university.Version)
+	if err != nil {
+		return h.handleError(ctx, c, err)
+	}
+
 	university.ID = id
+	university.Version = expectedVersion
 	university.CreatedAt = existingUniversity.CreatedAt
 	university.CreatedBy = existingUniversity.CreatedBy
+	audit.StampUpdate(ctx, &university.BaseModel)
 
-	if err := h.repo.Update(&university); err != nil {
+	if err := h.repo.Update(ctx, &university); err != nil {
 		applogger.Error(ctx, ErrMsgUpdateUniversityFailed+": %v", err)
 		return h.handleError(ctx, c, err)
 	}
 
 	applogger.Info(ctx, applogger.LogUpdateUniversitySuccess, id)
 
+	etag.SetHeader(c, university.Version)
+
 	return c.JSON(http.StatusOK, university)
 }
 
@@ -292,7 +342,7 @@ func (h *Handler) DeleteUniversity(c echo.Context) error {
 	}
 
 	// 大学の存在確認
-	_, err = h.repo.FindByID(id)
+	_, err = h.repo.FindByID(ctx, id)
 	if err != nil {
 		if _, ok := err.(*customErrors.Error); ok && err.(*customErrors.Error).Code == customErrors.CodeNotFound {
 			applogger.Error(ctx, ErrMsgUniversityNotFound, id)
@@ -307,7 +357,7 @@ func (h *Handler) DeleteUniversity(c echo.Context) error {
 		return h.handleError(ctx, c, err)
 	}
 
-	if err := h.repo.Delete(id); err != nil {
+	if err := h.repo.Delete(ctx, id); err != nil {
 		applogger.Error(ctx, ErrMsgDeleteUniversityFailed+": %v", err)
 		return h.handleError(ctx, c, err)
 	}
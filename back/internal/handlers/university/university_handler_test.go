@@ -12,6 +12,11 @@ import (
 	"university-exam-api/internal/domain/models"
 	customErrors "university-exam-api/internal/errors"
 	applogger "university-exam-api/internal/logger"
+	"university-exam-api/internal/pkg/etag"
+	"university-exam-api/internal/pkg/pagination"
+	"university-exam-api/internal/pkg/preview"
+	"university-exam-api/internal/pkg/textsearch"
+	"university-exam-api/internal/repositories"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
@@ -24,6 +29,7 @@ const universitiesPath = "/universities"
 // --- モックリポジトリ定義 ---
 type mockUniversityRepo struct {
 	FindAllFunc  func(ctx context.Context) ([]models.University, error)
+	FindPageFunc func(ctx context.Context, params pagination.Params) (*repositories.UniversityPage, error)
 	FindByIDFunc func(id uint) (*models.University, error)
 	CreateFunc   func(u *models.University) error
 	UpdateFunc   func(u *models.University) error
@@ -34,7 +40,15 @@ func (m *mockUniversityRepo) FindAll(ctx context.Context) ([]models.University,
 	return m.FindAllFunc(ctx)
 }
 
-func (m *mockUniversityRepo) FindByID(id uint) (*models.University, error) {
+func (m *mockUniversityRepo) FindPage(ctx context.Context, params pagination.Params) (*repositories.UniversityPage, error) {
+	if m.FindPageFunc != nil {
+		return m.FindPageFunc(ctx, params)
+	}
+
+	panic(errNotImplemented)
+}
+
+func (m *mockUniversityRepo) FindByID(_ context.Context, id uint) (*models.University, error) {
 	if m.FindByIDFunc != nil {
 		return m.FindByIDFunc(id)
 	}
@@ -42,7 +56,7 @@ func (m *mockUniversityRepo) FindByID(id uint) (*models.University, error) {
 	panic(errNotImplemented)
 }
 
-func (m *mockUniversityRepo) Create(u *models.University) error {
+func (m *mockUniversityRepo) Create(_ context.Context, u *models.University) error {
 	if m.CreateFunc != nil {
 		return m.CreateFunc(u)
 	}
@@ -50,7 +64,7 @@ func (m *mockUniversityRepo) Create(u *models.University) error {
 	panic(errNotImplemented)
 }
 
-func (m *mockUniversityRepo) Update(u *models.University) error {
+func (m *mockUniversityRepo) Update(_ context.Context, u *models.University) error {
 	if m.UpdateFunc != nil {
 		return m.UpdateFunc(u)
 	}
@@ -58,7 +72,7 @@ func (m *mockUniversityRepo) Update(u *models.University) error {
 	panic(errNotImplemented)
 }
 
-func (m *mockUniversityRepo) Delete(id uint) error {
+func (m *mockUniversityRepo) Delete(_ context.Context, id uint) error {
 	if m.DeleteFunc != nil {
 		return m.DeleteFunc(id)
 	}
@@ -67,25 +81,91 @@ func (m *mockUniversityRepo) Delete(id uint) error {
 }
 
 // 他のIUniversityRepositoryメソッドはpanicでOK（本テストでは使わないため）
-func (m *mockUniversityRepo) Search(_ string) ([]models.University, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) CreateDepartment(_ *models.Department) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateDepartment(_ *models.Department) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) DeleteDepartment(_ uint) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) CreateSubject(_ *models.Subject) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateSubject(_ *models.Subject) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) DeleteSubject(_ uint) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) CreateMajor(_ *models.Major) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateMajor(_ *models.Major) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) DeleteMajor(_ uint) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) CreateAdmissionInfo(_ *models.AdmissionInfo) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) DeleteAdmissionInfo(_ uint) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateAdmissionInfo(_ *models.AdmissionInfo) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) FindDepartment(_, _ uint) (*models.Department, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) FindSubject(_, _ uint) (*models.Subject, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) FindMajor(_, _ uint) (*models.Major, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) FindAdmissionInfo(_, _ uint) (*models.AdmissionInfo, error) { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateSubjectsBatch(_ uint, _ []models.Subject) error { panic(errNotImplemented) }
-func (m *mockUniversityRepo) UpdateAdmissionSchedule(_ *models.AdmissionSchedule) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) Search(_ context.Context, _ string) ([]models.University, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) SearchPage(_ context.Context, _ string, _ pagination.Params) (*repositories.UniversityPage, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) Suggest(_ context.Context, _ string, _ []string, _ int) (textsearch.Suggestions, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) SearchHits(_ context.Context, _ string) ([]textsearch.Hit, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) WarmSearchIndex(_ context.Context) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) InvalidateSearchIndex()                  {}
+func (m *mockUniversityRepo) ImportRows(
+	_ context.Context,
+	_ []repositories.ImportRow,
+	_ bool,
+) (*repositories.ImportResult, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) CreateDepartment(_ context.Context, _ *models.Department) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateDepartment(_ context.Context, _ *models.Department) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteDepartment(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateSubject(_ context.Context, _ *models.Subject) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateSubject(_ context.Context, _ *models.Subject) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteSubject(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateMajor(_ context.Context, _ *models.Major) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateMajor(_ context.Context, _ *models.Major) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteMajor(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateAdmissionInfo(_ context.Context, _ *models.AdmissionInfo) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteAdmissionInfo(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateAdmissionInfo(_ context.Context, _ *models.AdmissionInfo) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindDepartment(_ context.Context, _, _ uint) (*models.Department, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindSubject(_ context.Context, _, _ uint) (*models.Subject, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindMajor(_ context.Context, _, _ uint) (*models.Major, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindAdmissionInfo(_ context.Context, _, _ uint) (*models.AdmissionInfo, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateSubjectsBatch(_ context.Context, _ uint, _ []models.Subject) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateAdmissionSchedule(_ context.Context, _ *models.AdmissionSchedule) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindAdmissionInfoForPublication(_ context.Context, _ uint) (*models.AdmissionInfo, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) UpdateAdmissionInfoStatus(_ context.Context, _ *models.AdmissionInfo, _ string) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindAdmissionSchedule(_ context.Context, _, _ uint) (*models.AdmissionSchedule, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) CreateAdmissionSchedule(_ context.Context, _ *models.AdmissionSchedule) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteAdmissionSchedule(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) FindTestType(_ context.Context, _, _ uint) (*models.TestType, error) { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateTestType(_ context.Context, _ *models.TestType) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) UpdateTestType(_ context.Context, _ *models.TestType) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) DeleteTestType(_ context.Context, _ uint) error { panic(errNotImplemented) }
+func (m *mockUniversityRepo) CreateSubjectGroup(_ context.Context, _ *models.SubjectGroup) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) UpdateSubjectGroup(_ context.Context, _ *models.SubjectGroup) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) DeleteSubjectGroup(_ context.Context, _, _ uint) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindAdmissionDifficulty(_ context.Context, _ uint) (*models.AdmissionDifficulty, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) CreateAdmissionDifficulty(_ context.Context, _ *models.AdmissionDifficulty) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) UpdateAdmissionDifficulty(_ context.Context, _ *models.AdmissionDifficulty) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) DeleteAdmissionDifficulty(_ context.Context, _ uint) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) FindTrash(_ context.Context, _ string, _ pagination.Params) (*repositories.TrashPage, error) {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) RestoreFromTrash(_ context.Context, _ string, _ uint) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) PurgeFromTrash(_ context.Context, _ string, _ uint) error {
+	panic(errNotImplemented)
+}
+func (m *mockUniversityRepo) PurgeTrashBefore(_ context.Context, _ time.Time) (int64, error) {
+	panic(errNotImplemented)
+}
 
 // --- テスト本体 ---
 func TestGetUniversitiesSuccess(t *testing.T) {
@@ -93,8 +173,11 @@ func TestGetUniversitiesSuccess(t *testing.T) {
 
 	e := echo.New()
 	mockRepo := &mockUniversityRepo{
-		FindAllFunc: func(_ context.Context) ([]models.University, error) {
-			return []models.University{{Name: "テスト大学"}}, nil
+		FindPageFunc: func(_ context.Context, _ pagination.Params) (*repositories.UniversityPage, error) {
+			return &repositories.UniversityPage{
+				Universities: []models.University{{Name: "テスト大学"}},
+				Page:         pagination.Page{Total: 1, Page: 1, PerPage: pagination.DefaultPerPage},
+			}, nil
 		},
 	}
 	h := NewUniversityHandler(mockRepo, 2*time.Second)
@@ -109,12 +192,67 @@ func TestGetUniversitiesSuccess(t *testing.T) {
 	assert.Contains(t, rec.Body.String(), "テスト大学")
 }
 
+func TestGetUniversitiesPagination(t *testing.T) {
+	applogger.InitTestLogger()
+
+	e := echo.New()
+	mockRepo := &mockUniversityRepo{
+		FindPageFunc: func(_ context.Context, params pagination.Params) (*repositories.UniversityPage, error) {
+			assert.Equal(t, 2, params.Page)
+			assert.Equal(t, 10, params.PerPage)
+			assert.Equal(t, pagination.SortEnrollment, params.Sort)
+			assert.Equal(t, pagination.OrderDesc, params.Order)
+
+			return &repositories.UniversityPage{
+				Universities: []models.University{{Name: "テスト大学"}},
+				Page: pagination.Page{
+					Total: 35, Page: params.Page, PerPage: params.PerPage, HasNext: true, HasPrev: true,
+				},
+			}, nil
+		},
+	}
+	h := NewUniversityHandler(mockRepo, 2*time.Second)
+
+	req := httptest.NewRequest(http.MethodGet, universitiesPath+"?page=2&limit=10&sort=enrollment&order=desc", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := h.GetUniversities(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"success":true`)
+	assert.Contains(t, rec.Body.String(), `"universities":[{`)
+	assert.Contains(t, rec.Body.String(), `"total":35`)
+	assert.Contains(t, rec.Body.String(), `"page":2`)
+	assert.Contains(t, rec.Body.String(), `"perPage":10`)
+	assert.Contains(t, rec.Header().Get("Link"), `page=3`)
+	assert.Contains(t, rec.Header().Get("Link"), `rel="next"`)
+	assert.Contains(t, rec.Header().Get("Link"), `page=1`)
+	assert.Contains(t, rec.Header().Get("Link"), `rel="prev"`)
+}
+
+func TestGetUniversitiesInvalidPagination(t *testing.T) {
+	applogger.InitTestLogger()
+
+	e := echo.New()
+	mockRepo := &mockUniversityRepo{}
+	h := NewUniversityHandler(mockRepo, 2*time.Second)
+
+	req := httptest.NewRequest(http.MethodGet, universitiesPath+"?sort=unknown", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := h.GetUniversities(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
 func TestGetUniversitiesError(t *testing.T) {
 	applogger.InitTestLogger()
 
 	e := echo.New()
 	mockRepo := &mockUniversityRepo{
-		FindAllFunc: func(_ context.Context) ([]models.University, error) {
+		FindPageFunc: func(_ context.Context, _ pagination.Params) (*repositories.UniversityPage, error) {
 			return nil, errors.New("DBエラー")
 		},
 	}
@@ -231,6 +369,58 @@ func TestGetUniversitySuccess(t *testing.T) {
 	assert.Contains(t, rec.Body.String(), "大学X")
 }
 
+func TestGetUniversityHidesDrafts(t *testing.T) {
+	e := echo.New()
+	mockRepo := &mockUniversityRepo{
+		FindByIDFunc: func(_ uint) (*models.University, error) {
+			return &models.University{
+				BaseModel: models.BaseModel{ID: 1},
+				Name:      "大学X",
+				Departments: []models.Department{{
+					Name: "工学部",
+					Majors: []models.Major{{
+						Name: "機械工学科",
+						AdmissionSchedules: []models.AdmissionSchedule{{
+							Name: "前",
+							AdmissionInfos: []models.AdmissionInfo{
+								{AcademicYear: 2024, Status: models.AdmissionStatusPublished},
+								{AcademicYear: 2025, Status: models.AdmissionStatusDraft},
+							},
+						}},
+					}},
+				}},
+			}, nil
+		},
+	}
+	h := NewUniversityHandler(mockRepo, 1*time.Second)
+
+	t.Run("公開中の入試情報のみ返却", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, universitiesPath+"/1", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		require.NoError(t, h.GetUniversity(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), "2024")
+		assert.NotContains(t, rec.Body.String(), models.AdmissionStatusDraft)
+	})
+
+	t.Run("プレビュー時は下書きも返却", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, universitiesPath+"/1", nil)
+		req = req.WithContext(preview.WithDrafts(req.Context()))
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		require.NoError(t, h.GetUniversity(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), models.AdmissionStatusDraft)
+	})
+}
+
 func TestCreateUniversityError(t *testing.T) {
 	e := echo.New()
 	h := NewUniversityHandler(&mockUniversityRepo{}, 1*time.Second)
@@ -332,7 +522,7 @@ func TestGetUniversitiesTimeout(t *testing.T) {
 
 	e := echo.New()
 	mockRepo := &mockUniversityRepo{
-		FindAllFunc: func(ctx context.Context) ([]models.University, error) {
+		FindPageFunc: func(ctx context.Context, _ pagination.Params) (*repositories.UniversityPage, error) {
 			<-ctx.Done()
 			return nil, ctx.Err()
 		},
@@ -359,7 +549,7 @@ func TestGetUniversitiesContextCanceled(t *testing.T) {
 
 	e := echo.New()
 	mockRepo := &mockUniversityRepo{
-		FindAllFunc: func(ctx context.Context) ([]models.University, error) {
+		FindPageFunc: func(ctx context.Context, _ pagination.Params) (*repositories.UniversityPage, error) {
 			select {
 			case <-ctx.Done():
 				return nil, ctx.Err()
@@ -477,6 +667,7 @@ func TestUpdateUniversitySuccess(t *testing.T) {
 	h := NewUniversityHandler(mockRepo, 1*time.Second)
 	body := `{"name":"新大学名"}`
 	req := httptest.NewRequest(http.MethodPut, universitiesPath+"/1", strings.NewReader(body))
+	req.Header.Set(etag.HeaderIfMatch, `"1"`)
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 
 	rec := httptest.NewRecorder()
@@ -489,6 +680,97 @@ func TestUpdateUniversitySuccess(t *testing.T) {
 	assert.Contains(t, rec.Body.String(), "新大学名")
 }
 
+func TestUpdateUniversityPreconditionRequired(t *testing.T) {
+	e := echo.New()
+	mockRepo := &mockUniversityRepo{
+		FindByIDFunc: func(_ uint) (*models.University, error) {
+			return &models.University{
+				BaseModel: models.BaseModel{ID: 1, Version: 1},
+				Name:     "旧大学名",
+			}, nil
+		},
+		UpdateFunc: func(_ *models.University) error {
+			t.Fatal("バージョン未指定の場合は更新してはいけません")
+			return nil
+		},
+	}
+	h := NewUniversityHandler(mockRepo, 1*time.Second)
+	req := httptest.NewRequest(http.MethodPut, universitiesPath+"/1", strings.NewReader(`{"name":"新大学名"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+	err := h.UpdateUniversity(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusPreconditionRequired, rec.Code)
+}
+
+func TestUpdateUniversityVersion(t *testing.T) {
+	e := echo.New()
+
+	var updated *models.University
+
+	mockRepo := &mockUniversityRepo{
+		FindByIDFunc: func(_ uint) (*models.University, error) {
+			return &models.University{
+				BaseModel: models.BaseModel{ID: 1, Version: 3},
+				Name:     "旧大学名",
+			}, nil
+		},
+		UpdateFunc: func(u *models.University) error {
+			updated = u
+			u.Version++
+
+			return nil
+		},
+	}
+	h := NewUniversityHandler(mockRepo, 1*time.Second)
+	req := httptest.NewRequest(http.MethodPut, universitiesPath+"/1", strings.NewReader(`{"name":"新大学名","version":3}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+	err := h.UpdateUniversity(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, 4, updated.Version)
+	assert.Equal(t, `"4"`, rec.Header().Get(etag.HeaderETag))
+}
+
+func TestUpdateUniversityConflict(t *testing.T) {
+	e := echo.New()
+	current := &models.University{
+		BaseModel: models.BaseModel{ID: 1, Version: 2},
+		Name:     "他の管理者の大学名",
+	}
+	mockRepo := &mockUniversityRepo{
+		FindByIDFunc: func(_ uint) (*models.University, error) {
+			return current, nil
+		},
+		UpdateFunc: func(_ *models.University) error {
+			return customErrors.NewConflictError("大学", 1, current.Version, current)
+		},
+	}
+	h := NewUniversityHandler(mockRepo, 1*time.Second)
+	req := httptest.NewRequest(http.MethodPut, universitiesPath+"/1", strings.NewReader(`{"name":"新大学名"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(etag.HeaderIfMatch, `"1"`)
+
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+	err := h.UpdateUniversity(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Equal(t, `"2"`, rec.Header().Get(etag.HeaderETag))
+	assert.Contains(t, rec.Body.String(), "他の管理者の大学名")
+}
+
 func TestUpdateUniversityValidationError(t *testing.T) {
 	e := echo.New()
 	mockRepo := &mockUniversityRepo{
@@ -508,6 +790,7 @@ func TestUpdateUniversityValidationError(t *testing.T) {
 	h := NewUniversityHandler(mockRepo, 1*time.Second)
 	body := `{"name":""}` // 空の名前でバリデーションエラーを発生させる
 	req := httptest.NewRequest(http.MethodPut, universitiesPath+"/1", strings.NewReader(body))
+	req.Header.Set(etag.HeaderIfMatch, `"1"`)
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 
 	rec := httptest.NewRecorder()
@@ -593,8 +876,10 @@ func TestGetUniversitiesEmptyList(t *testing.T) {
 
 	e := echo.New()
 	mockRepo := &mockUniversityRepo{
-		FindAllFunc: func(_ context.Context) ([]models.University, error) {
-			return nil, nil
+		FindPageFunc: func(_ context.Context, params pagination.Params) (*repositories.UniversityPage, error) {
+			return &repositories.UniversityPage{
+				Page: pagination.Page{Page: params.Page, PerPage: params.PerPage},
+			}, nil
 		},
 	}
 	h := NewUniversityHandler(mockRepo, 2*time.Second)
@@ -606,7 +891,9 @@ func TestGetUniversitiesEmptyList(t *testing.T) {
 	err := h.GetUniversities(c)
 	require.NoError(t, err)
 	assert.Equal(t, http.StatusOK, rec.Code)
-	assert.Equal(t, "[]", strings.TrimSpace(rec.Body.String()))
+	assert.Contains(t, rec.Body.String(), `"universities":[]`)
+	assert.Contains(t, rec.Body.String(), `"total":0`)
+	assert.Empty(t, rec.Header().Get("Link"))
 }
 
 func TestUpdateUniversityBindError(t *testing.T) {
@@ -676,6 +963,7 @@ func TestUpdateUniversityInternalError(t *testing.T) {
 	h := NewUniversityHandler(mockRepo, 1*time.Second)
 	body := `{"name":"新大学名"}`
 	req := httptest.NewRequest(http.MethodPut, universitiesPath+"/1", strings.NewReader(body))
+	req.Header.Set(etag.HeaderIfMatch, `"1"`)
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 
 	rec := httptest.NewRecorder()
@@ -704,6 +992,7 @@ func TestUpdateUniversityContextCanceled(t *testing.T) {
 	h := NewUniversityHandler(mockRepo, 1*time.Second)
 	body := `{"name":"新大学名"}`
 	req := httptest.NewRequest(http.MethodPut, universitiesPath+"/1", strings.NewReader(body))
+	req.Header.Set(etag.HeaderIfMatch, `"1"`)
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 
 	rec := httptest.NewRecorder()
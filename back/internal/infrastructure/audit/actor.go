@@ -0,0 +1,51 @@
+// Package audit はエンティティの変更履歴（監査ログ）の記録を提供するパッケージです。
+// このパッケージは以下の機能を提供します：
+// - リクエストの操作者（JWTのsubject）のコンテキストへの格納
+// - GORMのコールバックによる作成・更新・削除の前後差分の記録
+// - 作成者・更新者（CreatedBy・UpdatedBy）の自動設定
+package audit
+
+import (
+	"context"
+	"university-exam-api/internal/domain/models"
+)
+
+// SystemActor は操作者を特定できない場合に記録する操作者です
+const SystemActor = "system"
+
+// actorKey はコンテキストに操作者を格納するためのキーです
+type actorKey struct{}
+
+// WithActor は操作者を格納したコンテキストを返します。空文字の場合は元のコンテキストを返します
+func WithActor(ctx context.Context, actor string) context.Context {
+	if actor == "" {
+		return ctx
+	}
+
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext はコンテキストに格納された操作者を返します。格納されていない場合は空文字を返します
+func ActorFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+
+	actor, _ := ctx.Value(actorKey{}).(string)
+
+	return actor
+}
+
+// StampCreate は作成するモデルの作成者・更新者にコンテキストの操作者を設定します。
+// リクエストボディで指定された値は上書きされます
+func StampCreate(ctx context.Context, base *models.BaseModel) {
+	actor := ActorFromContext(ctx)
+	base.CreatedBy = actor
+	base.UpdatedBy = actor
+}
+
+// StampUpdate は更新するモデルの更新者にコンテキストの操作者を設定します。
+// リクエストボディで指定された値は上書きされます
+func StampUpdate(ctx context.Context, base *models.BaseModel) {
+	base.UpdatedBy = ActorFromContext(ctx)
+}
@@ -0,0 +1,32 @@
+package audit
+
+import (
+	"context"
+	"testing"
+	"university-exam-api/internal/domain/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActorFromContext(t *testing.T) {
+	ctx := context.Background()
+
+	assert.Empty(t, ActorFromContext(ctx))
+	assert.Equal(t, "alice", ActorFromContext(WithActor(ctx, "alice")))
+	assert.Equal(t, ctx, WithActor(ctx, ""), "空の操作者はコンテキストに格納しない")
+}
+
+func TestStamp(t *testing.T) {
+	ctx := WithActor(context.Background(), "alice")
+
+	// リクエストボディで指定された値は上書きされる
+	base := models.BaseModel{CreatedBy: "mallory", UpdatedBy: "mallory"}
+	StampCreate(ctx, &base)
+	assert.Equal(t, "alice", base.CreatedBy)
+	assert.Equal(t, "alice", base.UpdatedBy)
+
+	base = models.BaseModel{CreatedBy: "bob", UpdatedBy: "mallory"}
+	StampUpdate(context.Background(), &base)
+	assert.Equal(t, "bob", base.CreatedBy)
+	assert.Empty(t, base.UpdatedBy)
+}
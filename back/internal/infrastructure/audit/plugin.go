@@ -0,0 +1,552 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+	"university-exam-api/internal/domain/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// pluginName はGORMに登録するプラグイン名です
+	pluginName = "audit"
+	// beforeKey は変更前のスナップショットをステートメントに保持するためのキーです
+	beforeKey = "audit:before"
+)
+
+// entityTypes はテーブル名と監査ログに記録するエンティティ種別の対応です
+var entityTypes = func() map[string]string {
+	types := make(map[string]string, len(models.AuditEntities))
+	for entityType, table := range models.AuditEntities {
+		types[table] = entityType
+	}
+
+	return types
+}()
+
+// ignoredColumns は差分の記録対象外とするカラムです
+var ignoredColumns = map[string]bool{
+	"created_at": true,
+	"updated_at": true,
+	"version":    true,
+	"created_by": true,
+	"updated_by": true,
+}
+
+// snapshot はエンティティIDごとのカラム値です
+type snapshot map[uint]map[string]interface{}
+
+// plugin は監査ログを記録するGORMプラグインです
+type plugin struct{}
+
+// Register は監査ログを記録するコールバックをデータベースに登録します。
+// 登録済みの場合は何もしません。
+// この関数は以下の処理を行います：
+// - 作成・更新・削除の前後でのスナップショットの取得
+// - 作成者・更新者の設定
+// - 変更前後の差分の audit_logs テーブルへの記録
+func Register(db *gorm.DB) error {
+	if _, ok := db.Config.Plugins[pluginName]; ok {
+		return nil
+	}
+
+	return db.Use(plugin{})
+}
+
+// Name はプラグイン名を返します
+func (plugin) Name() string {
+	return pluginName
+}
+
+// Initialize は作成・更新・削除のコールバックを登録します
+func (plugin) Initialize(db *gorm.DB) error {
+	create := db.Callback().Create()
+	if err := create.Before("gorm:create").Register("audit:before_create", beforeCreate); err != nil {
+		return err
+	}
+
+	if err := create.After("gorm:after_create").Before("gorm:commit_or_rollback_transaction").Register("audit:after_create", afterCreate); err != nil {
+		return err
+	}
+
+	update := db.Callback().Update()
+	if err := update.Before("gorm:update").Register("audit:before_update", beforeUpdate); err != nil {
+		return err
+	}
+
+	if err := update.After("gorm:after_update").Before("gorm:commit_or_rollback_transaction").Register("audit:after_update", afterUpdate); err != nil {
+		return err
+	}
+
+	del := db.Callback().Delete()
+	if err := del.Before("gorm:delete").Register("audit:before_delete", beforeDelete); err != nil {
+		return err
+	}
+
+	return del.After("gorm:after_delete").Before("gorm:commit_or_rollback_transaction").Register("audit:after_delete", afterDelete)
+}
+
+// beforeCreate は作成前に作成者・更新者を設定し、既存レコードへの上書き（関連の保存）に備えて変更前の値を取得します
+func beforeCreate(db *gorm.DB) {
+	if !audited(db) {
+		return
+	}
+
+	records := targetRecords(db)
+	actor := resolveActor(db, records, "CreatedBy")
+
+	if actor != SystemActor {
+		for _, rv := range records {
+			stampIfEmpty(db, rv, "CreatedBy", actor)
+			stampIfEmpty(db, rv, "UpdatedBy", actor)
+		}
+	}
+
+	ids := primaryKeys(db, records)
+	if len(ids) == 0 {
+		db.InstanceSet(beforeKey, snapshot{})
+		return
+	}
+
+	before, err := loadRows(db, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where(clause.IN{Column: clause.PrimaryColumn, Values: toValues(ids)})
+	})
+	if err != nil {
+		_ = db.AddError(err)
+		return
+	}
+
+	db.InstanceSet(beforeKey, before)
+}
+
+// afterCreate は作成したレコードの値を監査ログに記録します
+func afterCreate(db *gorm.DB) {
+	if !audited(db) || db.Error != nil {
+		return
+	}
+
+	before, ok := instanceSnapshot(db)
+	if !ok {
+		return
+	}
+
+	ids := primaryKeys(db, targetRecords(db))
+	if len(ids) == 0 {
+		return
+	}
+
+	after, err := loadRows(db, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where(clause.IN{Column: clause.PrimaryColumn, Values: toValues(ids)})
+	})
+	if err != nil {
+		_ = db.AddError(err)
+		return
+	}
+
+	writeLogs(db, before, after)
+}
+
+// beforeUpdate は更新前に更新者を設定し、更新対象のレコードの値を取得します。
+// 作成者は更新の対象から除外します
+func beforeUpdate(db *gorm.DB) {
+	if !audited(db) {
+		return
+	}
+
+	records := targetRecords(db)
+	actor := resolveActor(db, records, "UpdatedBy")
+
+	if actor != SystemActor && db.Statement.Schema.LookUpField("UpdatedBy") != nil {
+		db.Statement.SetColumn("UpdatedBy", actor, true)
+	}
+
+	// 作成者は更新で変更しない
+	if field := db.Statement.Schema.LookUpField("CreatedBy"); field != nil {
+		db.Statement.Omits = append(db.Statement.Omits, field.DBName)
+	}
+
+	before, ok := loadTargets(db, records)
+	if !ok {
+		return
+	}
+
+	db.InstanceSet(beforeKey, before)
+}
+
+// afterUpdate は更新したレコードの変更前後の差分を監査ログに記録します
+func afterUpdate(db *gorm.DB) {
+	if !audited(db) || db.Error != nil {
+		return
+	}
+
+	before, ok := instanceSnapshot(db)
+	if !ok || len(before) == 0 {
+		return
+	}
+
+	ids := make([]uint, 0, len(before))
+	for id := range before {
+		ids = append(ids, id)
+	}
+
+	after, err := loadRows(db, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where(clause.IN{Column: clause.PrimaryColumn, Values: toValues(ids)})
+	})
+	if err != nil {
+		_ = db.AddError(err)
+		return
+	}
+
+	writeLogs(db, before, after)
+}
+
+// beforeDelete は削除対象のレコードの値を取得します
+func beforeDelete(db *gorm.DB) {
+	if !audited(db) {
+		return
+	}
+
+	resolveActor(db, nil, "")
+
+	before, ok := loadTargets(db, targetRecords(db))
+	if !ok {
+		return
+	}
+
+	db.InstanceSet(beforeKey, before)
+}
+
+// afterDelete は削除したレコードの値を監査ログに記録します
+func afterDelete(db *gorm.DB) {
+	if !audited(db) || db.Error != nil || db.RowsAffected == 0 {
+		return
+	}
+
+	before, ok := instanceSnapshot(db)
+	if !ok || len(before) == 0 {
+		return
+	}
+
+	writeLogs(db, before, snapshot{})
+}
+
+// audited は監査対象のテーブルに対する操作かどうかを判定します
+func audited(db *gorm.DB) bool {
+	if db.DryRun || db.Statement.Schema == nil || db.Statement.Schema.PrioritizedPrimaryField == nil {
+		return false
+	}
+
+	_, ok := entityTypes[db.Statement.Schema.Table]
+
+	return ok
+}
+
+// resolveActor は操作者を決定し、関連の保存にも引き継がれるようステートメントのコンテキストに格納します。
+// コンテキストの操作者、レコードに設定された作成者・更新者、system の順に使用します
+func resolveActor(db *gorm.DB, records []reflect.Value, fieldName string) string {
+	ctx := db.Statement.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	actor := ActorFromContext(ctx)
+
+	if actor == "" && fieldName != "" {
+		if field := db.Statement.Schema.LookUpField(fieldName); field != nil {
+			for _, rv := range records {
+				if v, _ := field.ValueOf(ctx, rv); v != nil {
+					if s, ok := v.(string); ok && s != "" {
+						actor = s
+						break
+					}
+				}
+			}
+		}
+	}
+
+	if actor == "" {
+		return SystemActor
+	}
+
+	db.Statement.Context = WithActor(ctx, actor)
+
+	return actor
+}
+
+// stampIfEmpty はフィールドが空の場合に値を設定します
+func stampIfEmpty(db *gorm.DB, rv reflect.Value, fieldName, value string) {
+	field := db.Statement.Schema.LookUpField(fieldName)
+	if field == nil || !rv.CanAddr() {
+		return
+	}
+
+	if _, isZero := field.ValueOf(db.Statement.Context, rv); isZero {
+		_ = db.AddError(field.Set(db.Statement.Context, rv, value))
+	}
+}
+
+// targetRecords はステートメントの対象となる構造体の値を返します
+func targetRecords(db *gorm.DB) []reflect.Value {
+	rv := reflect.Indirect(db.Statement.ReflectValue)
+
+	switch rv.Kind() {
+	case reflect.Struct:
+		return []reflect.Value{rv}
+	case reflect.Slice, reflect.Array:
+		records := make([]reflect.Value, 0, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			if elem := reflect.Indirect(rv.Index(i)); elem.Kind() == reflect.Struct {
+				records = append(records, elem)
+			}
+		}
+
+		return records
+	default:
+		return nil
+	}
+}
+
+// primaryKeys はレコードのうち主キーが設定されているものの主キーを返します
+func primaryKeys(db *gorm.DB, records []reflect.Value) []uint {
+	field := db.Statement.Schema.PrioritizedPrimaryField
+	ids := make([]uint, 0, len(records))
+
+	for _, rv := range records {
+		v, isZero := field.ValueOf(db.Statement.Context, rv)
+		if isZero {
+			continue
+		}
+
+		if id, ok := toUint(v); ok {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// loadTargets は更新・削除の対象となるレコードの値を取得します。
+// レコードの主キーとWHERE句のどちらも指定されていない場合は false を返します
+func loadTargets(db *gorm.DB, records []reflect.Value) (snapshot, bool) {
+	ids := primaryKeys(db, records)
+
+	var where *clause.Where
+
+	if c, ok := db.Statement.Clauses["WHERE"]; ok {
+		if w, ok := c.Expression.(clause.Where); ok && len(w.Exprs) > 0 {
+			where = &w
+		}
+	}
+
+	if len(ids) == 0 && where == nil {
+		return nil, false
+	}
+
+	before, err := loadRows(db, func(tx *gorm.DB) *gorm.DB {
+		if len(ids) > 0 {
+			tx = tx.Where(clause.IN{Column: clause.PrimaryColumn, Values: toValues(ids)})
+		}
+
+		if where != nil {
+			tx = tx.Clauses(*where)
+		}
+
+		return tx
+	})
+	if err != nil {
+		_ = db.AddError(err)
+		return nil, false
+	}
+
+	return before, true
+}
+
+// loadRows は同じトランザクション内でレコードを取得し、IDごとのカラム値に変換します
+func loadRows(db *gorm.DB, scope func(tx *gorm.DB) *gorm.DB) (snapshot, error) {
+	sch := db.Statement.Schema
+	rows := reflect.New(reflect.SliceOf(sch.ModelType))
+
+	tx := db.Session(&gorm.Session{NewDB: true, SkipHooks: true}).Model(reflect.New(sch.ModelType).Interface())
+
+	// 削除済みレコードの復元や完全削除でも対象を取得できるようにする
+	if db.Statement.Unscoped {
+		tx = tx.Unscoped()
+	}
+	if err := scope(tx).Find(rows.Interface()).Error; err != nil {
+		return nil, fmt.Errorf("監査ログ用の%sの取得に失敗しました: %w", sch.Table, err)
+	}
+
+	result := make(snapshot, rows.Elem().Len())
+
+	for i := 0; i < rows.Elem().Len(); i++ {
+		rv := rows.Elem().Index(i)
+
+		pk, _ := sch.PrioritizedPrimaryField.ValueOf(db.Statement.Context, rv)
+
+		id, ok := toUint(pk)
+		if !ok {
+			continue
+		}
+
+		values := make(map[string]interface{}, len(sch.Fields))
+
+		for _, field := range sch.Fields {
+			if field.DBName == "" || ignoredColumns[field.DBName] {
+				continue
+			}
+
+			v, _ := field.ValueOf(db.Statement.Context, rv)
+			values[field.DBName] = normalize(v)
+		}
+
+		result[id] = values
+	}
+
+	return result, nil
+}
+
+// instanceSnapshot はステートメントに保持した変更前のスナップショットを返します
+func instanceSnapshot(db *gorm.DB) (snapshot, bool) {
+	v, ok := db.InstanceGet(beforeKey)
+	if !ok {
+		return nil, false
+	}
+
+	before, ok := v.(snapshot)
+
+	return before, ok
+}
+
+// writeLogs は変更前後のスナップショットから監査ログを作成し、同じトランザクション内で保存します
+func writeLogs(db *gorm.DB, before, after snapshot) {
+	entityType := entityTypes[db.Statement.Schema.Table]
+
+	actor := ActorFromContext(db.Statement.Context)
+	if actor == "" {
+		actor = SystemActor
+	}
+
+	var logs []models.AuditLog
+
+	for id, values := range before {
+		if afterValues, ok := after[id]; ok {
+			if changes := diff(values, afterValues); len(changes) > 0 {
+				logs = append(logs, newLog(entityType, id, models.AuditActionUpdate, actor, changes))
+			}
+
+			continue
+		}
+
+		logs = append(logs, newLog(entityType, id, models.AuditActionDelete, actor, diff(values, nil)))
+	}
+
+	for id, values := range after {
+		if _, ok := before[id]; !ok {
+			logs = append(logs, newLog(entityType, id, models.AuditActionCreate, actor, diff(nil, values)))
+		}
+	}
+
+	if len(logs) == 0 {
+		return
+	}
+
+	if err := db.Session(&gorm.Session{NewDB: true, SkipHooks: true}).Create(&logs).Error; err != nil {
+		_ = db.AddError(fmt.Errorf("監査ログの保存に失敗しました: %w", err))
+	}
+}
+
+// newLog は監査ログを作成します
+func newLog(entityType string, id uint, action, actor string, changes map[string]models.FieldChange) models.AuditLog {
+	return models.AuditLog{
+		EntityType: entityType,
+		EntityID:   id,
+		Action:     action,
+		Actor:      actor,
+		Changes:    changes,
+	}
+}
+
+// diff は変更前後のカラム値を比較し、値が異なるカラムを返します
+func diff(before, after map[string]interface{}) map[string]models.FieldChange {
+	changes := make(map[string]models.FieldChange)
+
+	for column, b := range before {
+		a, ok := after[column]
+		if ok && equal(a, b) {
+			continue
+		}
+
+		changes[column] = models.FieldChange{Before: b, After: a}
+	}
+
+	for column, a := range after {
+		if _, ok := before[column]; !ok {
+			changes[column] = models.FieldChange{Before: nil, After: a}
+		}
+	}
+
+	return changes
+}
+
+// equal は2つの値をJSON表現で比較します
+func equal(a, b interface{}) bool {
+	aj, aErr := json.Marshal(a)
+	bj, bErr := json.Marshal(b)
+
+	return aErr == nil && bErr == nil && string(aj) == string(bj)
+}
+
+// normalize は比較と記録のために値を正規化します。日時はUTCに揃え、未削除の削除日時は nil とします
+func normalize(v interface{}) interface{} {
+	switch t := v.(type) {
+	case time.Time:
+		return t.UTC()
+	case gorm.DeletedAt:
+		if !t.Valid {
+			return nil
+		}
+
+		return t.Time.UTC()
+	case *time.Time:
+		if t == nil {
+			return nil
+		}
+
+		return t.UTC()
+	default:
+		return v
+	}
+}
+
+// toValues はIDを clause.IN の値に変換します
+func toValues(ids []uint) []interface{} {
+	values := make([]interface{}, len(ids))
+	for i, id := range ids {
+		values[i] = id
+	}
+
+	return values
+}
+
+// toUint は主キーの値を uint に変換します
+func toUint(v interface{}) (uint, bool) {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+
+	switch rv.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return uint(rv.Uint()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if rv.Int() < 0 {
+			return 0, false
+		}
+
+		return uint(rv.Int()), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,208 @@
+package audit
+
+import (
+	"context"
+	"testing"
+	"university-exam-api/internal/domain/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// newTestDB は監査プラグインを登録したインメモリDBを作成します
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+
+	require.NoError(t, db.AutoMigrate(
+		&models.University{},
+		&models.Department{},
+		&models.Subject{},
+		&models.Region{},
+		&models.AuditLog{},
+	))
+	require.NoError(t, Register(db))
+	require.NoError(t, Register(db), "二重登録はエラーにならない")
+
+	return db
+}
+
+// findLogs はエンティティの監査ログを古い順に取得します
+func findLogs(t *testing.T, db *gorm.DB, entityType string, id uint) []models.AuditLog {
+	t.Helper()
+
+	var logs []models.AuditLog
+	require.NoError(t, db.Where("entity_type = ? AND entity_id = ?", entityType, id).Order("id").Find(&logs).Error)
+
+	return logs
+}
+
+func TestCreateRecordsAuditLog(t *testing.T) {
+	db := newTestDB(t)
+	ctx := WithActor(context.Background(), "alice")
+
+	university := &models.University{
+		BaseModel:   models.BaseModel{Version: 1},
+		Name:        "テスト大学",
+		Departments: []models.Department{{Name: "理学部"}},
+	}
+	require.NoError(t, db.WithContext(ctx).Create(university).Error)
+
+	assert.Equal(t, "alice", university.CreatedBy)
+	assert.Equal(t, "alice", university.UpdatedBy)
+	assert.Equal(t, "alice", university.Departments[0].CreatedBy, "関連の保存にも操作者が引き継がれる")
+
+	logs := findLogs(t, db, models.AuditEntityUniversity, university.ID)
+	require.Len(t, logs, 1)
+	assert.Equal(t, models.AuditActionCreate, logs[0].Action)
+	assert.Equal(t, "alice", logs[0].Actor)
+	assert.Equal(t, models.FieldChange{Before: nil, After: "テスト大学"}, logs[0].Changes["name"])
+	assert.NotContains(t, logs[0].Changes, "created_by")
+	assert.NotContains(t, logs[0].Changes, "version")
+
+	departmentLogs := findLogs(t, db, models.AuditEntityDepartment, university.Departments[0].ID)
+	require.Len(t, departmentLogs, 1)
+	assert.Equal(t, models.AuditActionCreate, departmentLogs[0].Action)
+	assert.Equal(t, "alice", departmentLogs[0].Actor)
+}
+
+func TestUpdateRecordsDiff(t *testing.T) {
+	db := newTestDB(t)
+
+	university := &models.University{BaseModel: models.BaseModel{Version: 1, CreatedBy: "alice"}, Name: "変更前大学"}
+	require.NoError(t, db.Create(university).Error)
+
+	university.Name = "変更後大学"
+	university.CreatedBy = ""
+	university.UpdatedBy = "bob"
+	require.NoError(t, db.Save(university).Error)
+
+	var saved models.University
+	require.NoError(t, db.First(&saved, university.ID).Error)
+	assert.Equal(t, "alice", saved.CreatedBy, "作成者は更新で変更されない")
+	assert.Equal(t, "bob", saved.UpdatedBy)
+
+	logs := findLogs(t, db, models.AuditEntityUniversity, university.ID)
+	require.Len(t, logs, 2)
+	assert.Equal(t, "alice", logs[0].Actor, "コンテキストに操作者がない場合はレコードの作成者を使用する")
+	assert.Equal(t, models.AuditActionUpdate, logs[1].Action)
+	assert.Equal(t, "bob", logs[1].Actor)
+	assert.Equal(t, map[string]models.FieldChange{
+		"name": {Before: "変更前大学", After: "変更後大学"},
+	}, logs[1].Changes)
+
+	// 値が変わらない更新は記録しない
+	require.NoError(t, db.Save(university).Error)
+	assert.Len(t, findLogs(t, db, models.AuditEntityUniversity, university.ID), 2)
+}
+
+func TestUpdateWithWhereClause(t *testing.T) {
+	db := newTestDB(t)
+	ctx := WithActor(context.Background(), "carol")
+
+	subjects := []models.Subject{
+		{TestTypeID: 1, Name: "数学", Score: 100, DisplayOrder: 1},
+		{TestTypeID: 1, Name: "英語", Score: 100, DisplayOrder: 2},
+	}
+	require.NoError(t, db.Create(&subjects).Error)
+
+	require.NoError(t, db.WithContext(ctx).Model(&models.Subject{}).
+		Where("test_type_id = ?", 1).
+		Updates(map[string]interface{}{"score": 200}).Error)
+
+	for _, s := range subjects {
+		logs := findLogs(t, db, models.AuditEntitySubject, s.ID)
+		require.Len(t, logs, 2)
+		assert.Equal(t, models.AuditActionUpdate, logs[1].Action)
+		assert.Equal(t, "carol", logs[1].Actor)
+		assert.Equal(t, models.FieldChange{Before: float64(100), After: float64(200)}, logs[1].Changes["score"])
+	}
+
+	var updated models.Subject
+	require.NoError(t, db.First(&updated, subjects[0].ID).Error)
+	assert.Equal(t, "carol", updated.UpdatedBy)
+}
+
+func TestDeleteRecordsSnapshot(t *testing.T) {
+	db := newTestDB(t)
+	ctx := WithActor(context.Background(), "dave")
+
+	university := &models.University{BaseModel: models.BaseModel{Version: 1}, Name: "削除大学"}
+	require.NoError(t, db.Create(university).Error)
+
+	require.NoError(t, db.WithContext(ctx).Unscoped().Delete(&models.University{}, university.ID).Error)
+
+	logs := findLogs(t, db, models.AuditEntityUniversity, university.ID)
+	require.Len(t, logs, 2)
+	assert.Equal(t, models.AuditActionDelete, logs[1].Action)
+	assert.Equal(t, "dave", logs[1].Actor)
+	assert.Equal(t, models.FieldChange{Before: "削除大学", After: nil}, logs[1].Changes["name"])
+
+	// 存在しないレコードの削除は記録しない
+	require.NoError(t, db.WithContext(ctx).Delete(&models.University{}, university.ID).Error)
+	assert.Len(t, findLogs(t, db, models.AuditEntityUniversity, university.ID), 2)
+}
+
+func TestSoftDeleteAndRestoreRecordsLogs(t *testing.T) {
+	db := newTestDB(t)
+	ctx := WithActor(context.Background(), "erin")
+
+	university := &models.University{BaseModel: models.BaseModel{Version: 1}, Name: "復元大学"}
+	require.NoError(t, db.Create(university).Error)
+
+	require.NoError(t, db.WithContext(ctx).Delete(&models.University{}, university.ID).Error)
+	require.NoError(t, db.WithContext(ctx).Unscoped().Model(&models.University{}).
+		Where("id = ?", university.ID).UpdateColumn("deleted_at", nil).Error)
+	require.NoError(t, db.WithContext(ctx).Delete(&models.University{}, university.ID).Error)
+	require.NoError(t, db.WithContext(ctx).Unscoped().Delete(&models.University{}, university.ID).Error)
+
+	logs := findLogs(t, db, models.AuditEntityUniversity, university.ID)
+	require.Len(t, logs, 5)
+	assert.Equal(t, models.AuditActionDelete, logs[1].Action)
+	assert.Equal(t, models.AuditActionUpdate, logs[2].Action, "復元は削除日時の更新として記録")
+	assert.NotNil(t, logs[2].Changes["deleted_at"].Before)
+	assert.Nil(t, logs[2].Changes["deleted_at"].After)
+	assert.Equal(t, models.AuditActionDelete, logs[4].Action, "削除済みレコードの完全削除も記録")
+	assert.Equal(t, models.FieldChange{Before: "復元大学", After: nil}, logs[4].Changes["name"])
+}
+
+func TestSystemActor(t *testing.T) {
+	db := newTestDB(t)
+
+	university := &models.University{BaseModel: models.BaseModel{Version: 1}, Name: "システム大学"}
+	require.NoError(t, db.Create(university).Error)
+
+	logs := findLogs(t, db, models.AuditEntityUniversity, university.ID)
+	require.Len(t, logs, 1)
+	assert.Equal(t, SystemActor, logs[0].Actor, "操作者が特定できない場合は system")
+	assert.Empty(t, university.CreatedBy)
+}
+
+func TestFailedOperationIsNotRecorded(t *testing.T) {
+	db := newTestDB(t)
+
+	// 大学名が空のためバリデーションで失敗する
+	require.Error(t, db.Create(&models.University{}).Error)
+
+	var count int64
+	require.NoError(t, db.Model(&models.AuditLog{}).Count(&count).Error)
+	assert.Zero(t, count)
+}
+
+func TestUnauditedTableIsIgnored(t *testing.T) {
+	db := newTestDB(t)
+
+	require.NoError(t, db.Create(&models.Region{UniversityID: 1, Name: "関東"}).Error)
+
+	var count int64
+	require.NoError(t, db.Model(&models.AuditLog{}).Count(&count).Error)
+	assert.Zero(t, count)
+}
@@ -529,6 +529,14 @@ func (cm *Manager) ClearSubjectsCache(testTypeID uint) {
 	cm.cache.Delete(fmt.Sprintf("subjects:%d:*", testTypeID))
 }
 
+// ClearCache は指定されたキーのキャッシュをクリアします
+func (cm *Manager) ClearCache(key string) {
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
+
+	cm.cache.Delete(key)
+}
+
 // GetStats はキャッシュの統計情報を返します
 func (cm *Manager) GetStats() (hits, misses int64) {
 	cm.mutex.RLock()
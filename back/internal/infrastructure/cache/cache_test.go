@@ -710,6 +710,19 @@ func TestCacheManagerStatsAndClear(t *testing.T) {
 	if foundSubj {
 		t.Error("Subjects cache should be cleared by ClearSubjectsCache")
 	}
+
+	// ClearCacheで指定したキーのキャッシュのみをクリア
+	manager.SetCache("test_types:1:2", "testType2")
+	manager.SetCache("test_types:1:3", "testType3")
+	manager.ClearCache("test_types:1:2")
+
+	if _, found := manager.GetFromCache("test_types:1:2"); found {
+		t.Error("test_types:1:2 should be cleared by ClearCache")
+	}
+
+	if _, found := manager.GetFromCache("test_types:1:3"); !found {
+		t.Error("test_types:1:3 should not be cleared by ClearCache")
+	}
 }
 
 // TestCacheConcurrentAccess はキャッシュの並行アクセスをテストします。
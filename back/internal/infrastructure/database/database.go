@@ -14,7 +14,6 @@ import (
 	"os"
 	"strconv"
 	"time"
-	"university-exam-api/internal/domain/models"
 
 	"gorm.io/driver/postgres"
 	"gorm.io/driver/sqlite"
@@ -35,7 +34,6 @@ const (
 	defaultMaxOpenConns     = 100           // 同時接続の最大数
 	defaultConnMaxLifetime  = time.Hour     // 接続の最大生存時間
 	defaultConnMaxIdleTime  = time.Minute * 30 // アイドル接続の最大時間
-	defaultMigrationTimeout = 30 * time.Second // マイグレーションのタイムアウト時間
 	defaultRetryAttempts    = 3            // 接続試行の最大回数
 	defaultRetryDelay       = 5 * time.Second // 接続リトライの待機時間
 
@@ -265,22 +263,6 @@ func CloseDB(db *gorm.DB) error {
 	return sqlDB.Close()
 }
 
-// AutoMigrate はデータベースのマイグレーションを実行します。
-// この関数は以下の処理を行います：
-// - モデルのマイグレーション
-// - エラーハンドリング
-func AutoMigrate(ctx context.Context, db *gorm.DB) error {
-	return db.WithContext(ctx).AutoMigrate(
-		&models.University{},
-		&models.Department{},
-		&models.Major{},
-		&models.AdmissionSchedule{},
-		&models.AdmissionInfo{},
-		&models.TestType{},
-		&models.Subject{},
-	)
-}
-
 // WithTransaction はトランザクションを実行します。
 // この関数は以下の処理を行います：
 // - トランザクションの開始
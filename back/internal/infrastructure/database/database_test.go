@@ -130,8 +130,8 @@ func TestNewDBMissingEnv(t *testing.T) {
 	assert.Error(t, err)
 }
 
-// AutoMigrate, WithTransactionの最低限の動作確認（SQLiteインメモリDB利用）
-func TestAutoMigrateAndTransaction(t *testing.T) {
+// スキーママイグレーション, WithTransactionの最低限の動作確認（SQLiteインメモリDB利用）
+func TestSchemaMigrationAndTransaction(t *testing.T) {
 	if err := os.Setenv("DB_HOST", "localhost"); err != nil {
 		t.Fatalf(errSetenvFmt, err)
 	}
@@ -178,7 +178,7 @@ func TestAutoMigrateAndTransaction(t *testing.T) {
 	assert.NoError(t, err)
 
 	ctx := context.Background()
-	err = AutoMigrate(ctx, db)
+	err = migrateTestDB(ctx, db)
 	assert.NoError(t, err)
 	err = WithTransaction(ctx, db, func(_ *gorm.DB) error {
 		return nil
@@ -191,6 +191,18 @@ func NewTestSQLiteDB() (*gorm.DB, error) {
 	return gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
 }
 
+// migrateTestDB はテスト用DBにバージョン管理されたスキーマを適用します
+func migrateTestDB(ctx context.Context, db *gorm.DB) error {
+	m, err := NewSchemaMigrator(db)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.Up(ctx)
+
+	return err
+}
+
 // CloseDBのテスト
 func TestCloseDB(t *testing.T) {
 	db, err := NewTestSQLiteDB()
@@ -245,18 +257,6 @@ func TestWithTransactionError(t *testing.T) {
 	assert.Error(t, err)
 }
 
-func TestAutoMigrateError(t *testing.T) {
-	db, err := NewTestSQLiteDB()
-	assert.NoError(t, err)
-
-	// コンテキストがキャンセルされたケース
-	ctx, cancel := context.WithCancel(context.Background())
-	cancel()
-
-	err = AutoMigrate(ctx, db)
-	assert.Error(t, err)
-}
-
 func TestSetupConnectionPool(t *testing.T) {
 	db, err := NewTestSQLiteDB()
 	assert.NoError(t, err)
@@ -307,7 +307,7 @@ func TestWithTransactionAdvanced(t *testing.T) {
 	assert.NoError(t, err)
 
 	// マイグレーション実行
-	err = AutoMigrate(context.Background(), db)
+	err = migrateTestDB(context.Background(), db)
 	assert.NoError(t, err)
 
 	tests := []struct {
@@ -0,0 +1,164 @@
+// Package initialschema は初期スキーマ（バージョン1）のテーブル定義を凍結したパッケージです。
+// このパッケージは以下の機能を提供します：
+// - バージョン管理導入時点のモデルの列・索引・制約の定義
+// - 初期スキーマのテーブルの依存関係順の一覧
+//
+// 初期スキーマのマイグレーションはこれらの構造体から作成するため、models パッケージのモデルを変更しても
+// 初期スキーマは変わりません。適用済みのスキーマと一致しなくなるため、このパッケージは変更せず、
+// スキーマの変更は新しいバージョンのマイグレーションとして追加してください。
+package initialschema
+
+import "time"
+
+// Models は初期スキーマのテーブルを依存関係の順に返します
+func Models() []interface{} {
+	return []interface{}{
+		&University{},
+		&Department{},
+		&Major{},
+		&AdmissionSchedule{},
+		&AdmissionInfo{},
+		&TestType{},
+		&Subject{},
+		&Region{},
+		&Prefecture{},
+		&Classification{},
+		&SubClassification{},
+		&AcademicField{},
+		&FilterOption{},
+	}
+}
+
+// BaseModel は初期スキーマの共通列です
+type BaseModel struct {
+	ID        uint `gorm:"primarykey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time `gorm:"index"`
+	Version   int        `gorm:"not null;default:1"`
+	CreatedBy string     `gorm:"size:100"`
+	UpdatedBy string     `gorm:"size:100"`
+}
+
+// University は初期スキーマの大学テーブルです
+type University struct {
+	BaseModel
+	Name            string `gorm:"not null;uniqueIndex:idx_university_name;size:20;check:name <> ''"`
+	Departments     []Department
+	Regions         []Region
+	Classifications []Classification
+}
+
+// Department は初期スキーマの学部テーブルです
+type Department struct {
+	BaseModel
+	UniversityID uint
+	Name         string
+	University   University
+	Majors       []Major
+}
+
+// Major は初期スキーマの学科テーブルです
+type Major struct {
+	BaseModel
+	DepartmentID       uint   `gorm:"not null;index:idx_major_dept"`
+	Name               string `gorm:"not null;index:idx_major_name;size:20;check:name <> ''"`
+	Department         Department
+	AdmissionSchedules []AdmissionSchedule
+	AcademicFields     []AcademicField
+}
+
+// AdmissionSchedule は初期スキーマの入試日程テーブルです
+type AdmissionSchedule struct {
+	BaseModel
+	MajorID        uint   `gorm:"not null;index:idx_schedule_major_year"`
+	Name           string `gorm:"not null;size:6;check:name in ('前','中','後')"`
+	DisplayOrder   int    `gorm:"not null;default:0;index:idx_schedule_display_order"`
+	Major          Major  `gorm:"foreignKey:MajorID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	AdmissionInfos []AdmissionInfo
+	TestTypes      []TestType
+}
+
+// AdmissionInfo は初期スキーマの入試情報テーブルです
+type AdmissionInfo struct {
+	BaseModel
+	AdmissionScheduleID uint              `gorm:"not null;index:idx_info_schedule_year"`
+	Enrollment          int               `gorm:"not null;check:enrollment > 0 AND enrollment <= 9999"`
+	AcademicYear        int               `gorm:"not null;index:idx_info_schedule_year"`
+	Status              string            `gorm:"type:varchar(20);default:'draft';index:idx_info_status"`
+	AdmissionSchedule   AdmissionSchedule `gorm:"foreignKey:AdmissionScheduleID"`
+	TestTypes           []TestType        `gorm:"many2many:admission_info_test_types"`
+}
+
+// TestType は初期スキーマの試験種別テーブルです
+type TestType struct {
+	BaseModel
+	AdmissionScheduleID uint   `gorm:"not null;index:idx_test_type_schedule"`
+	Name                string `gorm:"not null;type:varchar(10);check:name in ('共通','二次')"`
+	AdmissionSchedule   AdmissionSchedule
+	Subjects            []Subject
+}
+
+// Subject は初期スキーマの科目テーブルです
+type Subject struct {
+	BaseModel
+	TestTypeID   uint   `gorm:"not null;index:idx_subject_test_type"`
+	Name         string `gorm:"not null;index:idx_subject_name;size:20;check:name <> ''"`
+	Score        int    `gorm:"not null;check:score >= 0 AND score <= 1000"`
+	Percentage   float64
+	DisplayOrder int
+	TestType     TestType `gorm:"foreignKey:TestTypeID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// Region は初期スキーマの地域テーブルです
+type Region struct {
+	BaseModel
+	UniversityID uint         `gorm:"not null;index:idx_region_univ"`
+	Name         string       `gorm:"not null;index:idx_region_name;size:20;check:name <> ''"`
+	University   University   `gorm:"foreignKey:UniversityID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Prefectures  []Prefecture `gorm:"foreignKey:RegionID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// Prefecture は初期スキーマの都道府県テーブルです
+type Prefecture struct {
+	BaseModel
+	RegionID uint   `gorm:"not null;index:idx_prefecture_region"`
+	Name     string `gorm:"not null;index:idx_prefecture_name;size:20;check:name <> ''"`
+	Region   Region `gorm:"foreignKey:RegionID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// Classification は初期スキーマの設置区分テーブルです
+type Classification struct {
+	BaseModel
+	UniversityID       uint `gorm:"not null;index:idx_classification_univ"`
+	Name               string
+	University         University
+	SubClassifications []SubClassification
+}
+
+// SubClassification は初期スキーマの小分類テーブルです
+type SubClassification struct {
+	BaseModel
+	ClassificationID uint   `gorm:"not null;index:idx_sub_classification_class"`
+	Name             string `gorm:"not null;index:idx_sub_classification_name;size:50;check:name <> ''"`
+	Classification   Classification
+}
+
+// AcademicField は初期スキーマの学問系統テーブルです
+type AcademicField struct {
+	BaseModel
+	MajorID uint   `gorm:"not null;index:idx_academic_field_major"`
+	Name    string `gorm:"not null;index:idx_academic_field_name;size:50;check:name <> ''"`
+	Major   Major  `gorm:"foreignKey:MajorID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// FilterOption は初期スキーマの絞り込み条件テーブルです
+type FilterOption struct {
+	BaseModel
+	Category     string         `gorm:"not null;index:idx_filter_category;size:20"`
+	Name         string         `gorm:"not null;size:50"`
+	DisplayOrder int            `gorm:"not null;default:0;index:idx_filter_display_order"`
+	ParentID     *uint          `gorm:"index:idx_filter_parent"`
+	Parent       *FilterOption  `gorm:"foreignKey:ParentID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Children     []FilterOption `gorm:"foreignKey:ParentID"`
+}
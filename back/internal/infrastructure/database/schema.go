@@ -0,0 +1,320 @@
+package database
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"university-exam-api/internal/domain/models"
+	"university-exam-api/internal/infrastructure/database/initialschema"
+	"university-exam-api/internal/infrastructure/migration"
+
+	"gorm.io/gorm"
+)
+
+// SchemaDir はSQLマイグレーションを配置するディレクトリ（back ディレクトリからの相対パス）です
+const SchemaDir = "internal/infrastructure/database/schema"
+
+// subjectConversionRatioCheck は科目の換算比率のチェック制約名です
+const subjectConversionRatioCheck = "chk_subjects_conversion_ratio"
+
+// testTypeNameCheck は試験種別名を共通・二次に限定していたチェック制約名です
+const testTypeNameCheck = "chk_test_types_name"
+
+// legacyTestType は試験種別名のチェック制約を戻すための、試験種別レジストリ導入前の試験種別の定義です
+type legacyTestType struct {
+	Name string `gorm:"check:chk_test_types_name,name in ('共通','二次')"`
+}
+
+// TableName は試験種別のテーブル名を返します
+func (legacyTestType) TableName() string {
+	return "test_types"
+}
+
+// scheduleNameCheck は日程名を前・中・後に限定していたチェック制約名です
+const scheduleNameCheck = "chk_admission_schedules_name"
+
+// legacyAdmissionSchedule は日程名の列とチェック制約を戻すための、日程レジストリ導入前の入試日程の定義です
+type legacyAdmissionSchedule struct {
+	Name string `gorm:"not null;size:6;check:chk_admission_schedules_name,name in ('前','中','後')"`
+}
+
+// TableName は入試日程のテーブル名を返します
+func (legacyAdmissionSchedule) TableName() string {
+	return "admission_schedules"
+}
+
+// subjectGroupIndex は科目の選択科目群IDの索引名です
+const subjectGroupIndex = "idx_subject_group"
+
+//go:embed schema/*.sql
+var schemaFiles embed.FS
+
+// schemaModels は初期スキーマ（バージョン1）のテーブルを依存関係の順に返します。
+// models パッケージのモデルではなく、initialschema パッケージに凍結したテーブル定義を使用します
+func schemaModels() []interface{} {
+	return initialschema.Models()
+}
+
+// masterDataModels は管理者が編集するマスタデータのモデルを返します
+func masterDataModels() []interface{} {
+	return []interface{}{
+		&models.Region{},
+		&models.Prefecture{},
+		&models.Classification{},
+		&models.SubClassification{},
+		&models.AcademicField{},
+	}
+}
+
+// addSubjectConversionRatio は科目に換算比率の列と範囲のチェック制約を追加します。
+// 列の追加ではチェック制約が作成されず、SQLite で制約を後から作成するとテーブルが再作成されて索引が失われるため、
+// SQLite では列と制約を1つの文で追加します
+func addSubjectConversionRatio(tx *gorm.DB) error {
+	if tx.Dialector.Name() == "sqlite" {
+		return tx.Exec(
+			"ALTER TABLE `subjects` ADD COLUMN `conversion_ratio` real NOT NULL DEFAULT 1 " +
+				"CONSTRAINT `" + subjectConversionRatioCheck + "` CHECK (conversion_ratio > 0 AND conversion_ratio <= 10)",
+		).Error
+	}
+
+	if err := tx.Migrator().AddColumn(&models.Subject{}, "ConversionRatio"); err != nil {
+		return err
+	}
+
+	return tx.Migrator().CreateConstraint(&models.Subject{}, subjectConversionRatioCheck)
+}
+
+// goMigrations はGoで定義したマイグレーションを返します。
+// 適用済みのマイグレーションは変更せず、スキーマの変更は新しいバージョンとして追加してください。
+// やむを得ず処理内容を変更する場合は、適用済みの環境で変更を検出できるよう Revision を更新してください。
+func goMigrations() []migration.Migration {
+	return []migration.Migration{
+		{
+			// AutoMigrate で作成していた既存のスキーマを引き継ぐため、適用済みの環境でも冪等に実行できます。
+			// モデルの変更で初期スキーマが変わらないよう、凍結したテーブル定義から作成します
+			Version:  1,
+			Name:     "create_initial_schema",
+			Revision: "1",
+			Up: func(tx *gorm.DB) error {
+				return tx.AutoMigrate(schemaModels()...)
+			},
+			Down: func(tx *gorm.DB) error {
+				if err := tx.Migrator().DropTable("admission_info_test_types"); err != nil {
+					return err
+				}
+
+				tables := schemaModels()
+				for i := len(tables) - 1; i >= 0; i-- {
+					if err := tx.Migrator().DropTable(tables[i]); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			},
+		},
+		{
+			Version:  3,
+			Name:     "create_audit_logs",
+			Revision: "1",
+			Up: func(tx *gorm.DB) error {
+				return tx.AutoMigrate(&models.AuditLog{})
+			},
+			Down: func(tx *gorm.DB) error {
+				return tx.Migrator().DropTable(&models.AuditLog{})
+			},
+		},
+		{
+			// 初期スキーマの凍結前にモデルから列を作成済みの環境があるため、列の有無を確認して冪等に追加します
+			Version:  4,
+			Name:     "add_master_data_display_order",
+			Revision: "1",
+			Up: func(tx *gorm.DB) error {
+				for _, model := range masterDataModels() {
+					if tx.Migrator().HasColumn(model, "DisplayOrder") {
+						continue
+					}
+					if err := tx.Migrator().AddColumn(model, "DisplayOrder"); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			},
+			Down: func(tx *gorm.DB) error {
+				for _, model := range masterDataModels() {
+					if !tx.Migrator().HasColumn(model, "DisplayOrder") {
+						continue
+					}
+					if err := tx.Migrator().DropColumn(model, "DisplayOrder"); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			},
+		},
+		{
+			// 初期スキーマの凍結前にモデルから列と制約を作成済みの環境があるため、列の有無を確認して冪等に追加します（既存の科目は換算比率1）
+			Version:  5,
+			Name:     "add_subject_conversion_ratio",
+			Revision: "1",
+			Up: func(tx *gorm.DB) error {
+				if tx.Migrator().HasColumn(&models.Subject{}, "ConversionRatio") {
+					return nil
+				}
+
+				return addSubjectConversionRatio(tx)
+			},
+			Down: func(tx *gorm.DB) error {
+				if !tx.Migrator().HasColumn(&models.Subject{}, "ConversionRatio") {
+					return nil
+				}
+
+				// 列を参照するチェック制約が残っていると列を削除できないため、先に制約を削除します
+				if tx.Migrator().HasConstraint(&models.Subject{}, subjectConversionRatioCheck) {
+					if err := tx.Migrator().DropConstraint(&models.Subject{}, subjectConversionRatioCheck); err != nil {
+						return err
+					}
+				}
+
+				return tx.Migrator().DropColumn(&models.Subject{}, "ConversionRatio")
+			},
+		},
+		{
+			// 試験種別名は試験種別レジストリで検証するため、共通・二次に限定するチェック制約を削除します
+			Version:  6,
+			Name:     "drop_test_type_name_check",
+			Revision: "1",
+			Up: func(tx *gorm.DB) error {
+				if !tx.Migrator().HasConstraint(&legacyTestType{}, testTypeNameCheck) {
+					return nil
+				}
+
+				return tx.Migrator().DropConstraint(&legacyTestType{}, testTypeNameCheck)
+			},
+			Down: func(tx *gorm.DB) error {
+				if tx.Migrator().HasConstraint(&legacyTestType{}, testTypeNameCheck) {
+					return nil
+				}
+
+				return tx.Migrator().CreateConstraint(&legacyTestType{}, testTypeNameCheck)
+			},
+		},
+		{
+			Version:  7,
+			Name:     "create_schedule_events",
+			Revision: "1",
+			Up: func(tx *gorm.DB) error {
+				return tx.AutoMigrate(&models.ScheduleEvent{})
+			},
+			Down: func(tx *gorm.DB) error {
+				return tx.Migrator().DropTable(&models.ScheduleEvent{})
+			},
+		},
+		{
+			// 日程名は日程レジストリで検証するため、前・中・後に限定するチェック制約を削除し、
+			// 私立大学の方式・日程の名前を保存できるように列を広げます
+			Version:  8,
+			Name:     "relax_admission_schedule_name",
+			Revision: "1",
+			Up: func(tx *gorm.DB) error {
+				if tx.Migrator().HasConstraint(&legacyAdmissionSchedule{}, scheduleNameCheck) {
+					if err := tx.Migrator().DropConstraint(&legacyAdmissionSchedule{}, scheduleNameCheck); err != nil {
+						return err
+					}
+				}
+
+				return tx.Migrator().AlterColumn(&models.AdmissionSchedule{}, "Name")
+			},
+			Down: func(tx *gorm.DB) error {
+				if err := tx.Migrator().AlterColumn(&legacyAdmissionSchedule{}, "Name"); err != nil {
+					return err
+				}
+
+				if tx.Migrator().HasConstraint(&legacyAdmissionSchedule{}, scheduleNameCheck) {
+					return nil
+				}
+
+				return tx.Migrator().CreateConstraint(&legacyAdmissionSchedule{}, scheduleNameCheck)
+			},
+		},
+		{
+			// 初期スキーマの凍結前にモデルから科目の列を作成済みの環境があるため、列と索引の有無を確認して冪等に追加します
+			Version:  9,
+			Name:     "create_subject_groups",
+			Revision: "1",
+			Up: func(tx *gorm.DB) error {
+				if err := tx.AutoMigrate(&models.SubjectGroup{}); err != nil {
+					return err
+				}
+
+				if !tx.Migrator().HasColumn(&models.Subject{}, "SubjectGroupID") {
+					if err := tx.Migrator().AddColumn(&models.Subject{}, "SubjectGroupID"); err != nil {
+						return err
+					}
+				}
+
+				if tx.Migrator().HasIndex(&models.Subject{}, subjectGroupIndex) {
+					return nil
+				}
+
+				return tx.Migrator().CreateIndex(&models.Subject{}, subjectGroupIndex)
+			},
+			Down: func(tx *gorm.DB) error {
+				if tx.Migrator().HasIndex(&models.Subject{}, subjectGroupIndex) {
+					if err := tx.Migrator().DropIndex(&models.Subject{}, subjectGroupIndex); err != nil {
+						return err
+					}
+				}
+
+				if tx.Migrator().HasColumn(&models.Subject{}, "SubjectGroupID") {
+					if err := tx.Migrator().DropColumn(&models.Subject{}, "SubjectGroupID"); err != nil {
+						return err
+					}
+				}
+
+				return tx.Migrator().DropTable(&models.SubjectGroup{})
+			},
+		},
+		{
+			Version:  10,
+			Name:     "create_admission_difficulties",
+			Revision: "1",
+			Up: func(tx *gorm.DB) error {
+				return tx.AutoMigrate(&models.AdmissionDifficulty{})
+			},
+			Down: func(tx *gorm.DB) error {
+				return tx.Migrator().DropTable(&models.AdmissionDifficulty{})
+			},
+		},
+	}
+}
+
+// Migrations はアプリケーションのスキーママイグレーションを返します。
+// この関数は以下の処理を行います：
+// - Goで定義したマイグレーションの取得
+// - データベースの種類に応じたSQLマイグレーションの読み込み
+func Migrations(dialect string) ([]migration.Migration, error) {
+	dir, err := fs.Sub(schemaFiles, "schema")
+	if err != nil {
+		return nil, fmt.Errorf("SQLマイグレーションの読み込みに失敗しました: %w", err)
+	}
+
+	sqlMigrations, err := migration.LoadSQL(dir, dialect)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(goMigrations(), sqlMigrations...), nil
+}
+
+// NewSchemaMigrator はデータベースの種類に応じたマイグレーション実行器を作成します
+func NewSchemaMigrator(db *gorm.DB) (*migration.Migrator, error) {
+	migrations, err := Migrations(db.Dialector.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	return migration.NewMigrator(db, migrations)
+}
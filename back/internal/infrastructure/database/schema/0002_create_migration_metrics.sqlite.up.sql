@@ -0,0 +1,10 @@
+-- RunMigrations が記録するテーブルごとのマイグレーション所要時間
+CREATE TABLE IF NOT EXISTS migration_metrics (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    table_name VARCHAR(255) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    duration BIGINT NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_migration_metrics_table_name ON migration_metrics (table_name);
@@ -0,0 +1,296 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"university-exam-api/internal/domain/models"
+	"university-exam-api/internal/infrastructure/migration"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// newTestSQLiteDB はテスト用のインメモリSQLiteデータベースを作成します
+func newTestSQLiteDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("インメモリDBの作成に失敗: %v", err)
+	}
+
+	return db
+}
+
+func TestMigrations(t *testing.T) {
+	for _, dialect := range []string{"postgres", "sqlite"} {
+		t.Run(dialect, func(t *testing.T) {
+			migrations, err := Migrations(dialect)
+			require.NoError(t, err)
+
+			for _, m := range migrations {
+				assert.True(t, m.Reversible(), m.ID())
+			}
+		})
+	}
+}
+
+func TestSchemaMigratorUpAndDown(t *testing.T) {
+	db := newTestSQLiteDB(t)
+	ctx := context.Background()
+
+	m, err := NewSchemaMigrator(db)
+	require.NoError(t, err)
+
+	applied, err := m.Up(ctx)
+	require.NoError(t, err)
+	assert.Len(t, applied, len(m.Migrations()))
+
+	for _, table := range []string{"universities", "subjects", "filter_options", "admission_info_test_types", "migration_metrics", "audit_logs"} {
+		assert.True(t, db.Migrator().HasTable(table), table)
+	}
+
+	statuses, err := m.Status(ctx)
+	require.NoError(t, err)
+
+	for _, s := range statuses {
+		assert.Equal(t, migration.StateApplied, s.State, s.Version)
+	}
+
+	_, err = m.To(ctx, 0)
+	require.NoError(t, err)
+
+	tables, err := db.Migrator().GetTables()
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{migration.TableName, "sqlite_sequence"}, tables)
+}
+
+func TestSchemaMigratorAdoptsAutoMigratedDatabase(t *testing.T) {
+	db := newTestSQLiteDB(t)
+	ctx := context.Background()
+
+	// バージョン管理導入前に AutoMigrate で作成されたデータベース
+	require.NoError(t, db.AutoMigrate(schemaModels()...))
+
+	m, err := NewSchemaMigrator(db)
+	require.NoError(t, err)
+
+	_, err = m.Up(ctx)
+	require.NoError(t, err)
+
+	current, err := m.Current(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, m.Migrations()[len(m.Migrations())-1].Version, current)
+}
+
+func TestInitialSchemaIsFrozen(t *testing.T) {
+	db := newTestSQLiteDB(t)
+	ctx := context.Background()
+
+	m, err := NewSchemaMigrator(db)
+	require.NoError(t, err)
+
+	// 初期スキーマはモデルに後から追加した列を含まない
+	_, err = m.To(ctx, 1)
+	require.NoError(t, err)
+
+	assert.False(t, db.Migrator().HasColumn(&models.Subject{}, "ConversionRatio"))
+	assert.False(t, db.Migrator().HasColumn(&models.Subject{}, "SubjectGroupID"))
+	assert.False(t, db.Migrator().HasColumn(&models.Region{}, "DisplayOrder"))
+	assert.True(t, db.Migrator().HasConstraint(&legacyTestType{}, testTypeNameCheck))
+}
+
+func TestMasterDataDisplayOrderMigration(t *testing.T) {
+	db := newTestSQLiteDB(t)
+	ctx := context.Background()
+
+	m, err := NewSchemaMigrator(db)
+	require.NoError(t, err)
+
+	_, err = m.Up(ctx)
+	require.NoError(t, err)
+
+	for _, model := range masterDataModels() {
+		assert.True(t, db.Migrator().HasColumn(model, "DisplayOrder"))
+	}
+
+	_, err = m.To(ctx, 3)
+	require.NoError(t, err)
+
+	for _, model := range masterDataModels() {
+		assert.False(t, db.Migrator().HasColumn(model, "DisplayOrder"))
+	}
+
+	_, err = m.Up(ctx)
+	require.NoError(t, err)
+
+	for _, model := range masterDataModels() {
+		assert.True(t, db.Migrator().HasColumn(model, "DisplayOrder"))
+	}
+}
+
+func TestSubjectConversionRatioMigration(t *testing.T) {
+	db := newTestSQLiteDB(t)
+	ctx := context.Background()
+
+	m, err := NewSchemaMigrator(db)
+	require.NoError(t, err)
+
+	_, err = m.Up(ctx)
+	require.NoError(t, err)
+
+	// 換算比率の範囲の制約が作成され、科目の索引は保持される
+	assert.True(t, db.Migrator().HasConstraint(&models.Subject{}, subjectConversionRatioCheck))
+	assert.True(t, db.Migrator().HasIndex(&models.Subject{}, "idx_subject_test_type"))
+
+	// 換算比率の追加前のスキーマに既存の科目を登録する
+	_, err = m.To(ctx, 4)
+	require.NoError(t, err)
+	assert.False(t, db.Migrator().HasColumn(&models.Subject{}, "ConversionRatio"))
+
+	require.NoError(t, db.Exec(
+		"INSERT INTO subjects (name, score, percentage, display_order, test_type_id, version) VALUES (?, ?, ?, ?, ?, ?)",
+		"英語", 200, 100, 1, 1, 1,
+	).Error)
+
+	_, err = m.Up(ctx)
+	require.NoError(t, err)
+	require.True(t, db.Migrator().HasColumn(&models.Subject{}, "ConversionRatio"))
+
+	// 既存の科目は素点をそのまま使用する換算比率になる
+	var ratio float64
+	require.NoError(t, db.Raw("SELECT conversion_ratio FROM subjects WHERE name = ?", "英語").Scan(&ratio).Error)
+	assert.Equal(t, 1.0, ratio)
+
+	_, err = m.To(ctx, 4)
+	require.NoError(t, err)
+	assert.False(t, db.Migrator().HasColumn(&models.Subject{}, "ConversionRatio"))
+}
+
+func TestDropTestTypeNameCheckMigration(t *testing.T) {
+	db := newTestSQLiteDB(t)
+	ctx := context.Background()
+
+	m, err := NewSchemaMigrator(db)
+	require.NoError(t, err)
+
+	_, err = m.Up(ctx)
+	require.NoError(t, err)
+
+	assert.False(t, db.Migrator().HasConstraint(&legacyTestType{}, testTypeNameCheck))
+
+	// 取り消すと共通・二次のみを許可するチェック制約に戻る
+	_, err = m.To(ctx, 5)
+	require.NoError(t, err)
+	assert.True(t, db.Migrator().HasConstraint(&legacyTestType{}, testTypeNameCheck))
+	assert.Error(t, db.Exec(
+		"INSERT INTO test_types (admission_schedule_id, name, version) VALUES (?, ?, ?)", 1, "総合型選抜", 1,
+	).Error)
+
+	_, err = m.Up(ctx)
+	require.NoError(t, err)
+	assert.False(t, db.Migrator().HasConstraint(&legacyTestType{}, testTypeNameCheck))
+	assert.NoError(t, db.Exec(
+		"INSERT INTO test_types (admission_schedule_id, name, version) VALUES (?, ?, ?)", 1, "総合型選抜", 1,
+	).Error)
+}
+
+func TestCreateScheduleEventsMigration(t *testing.T) {
+	db := newTestSQLiteDB(t)
+	ctx := context.Background()
+
+	m, err := NewSchemaMigrator(db)
+	require.NoError(t, err)
+
+	_, err = m.Up(ctx)
+	require.NoError(t, err)
+	assert.True(t, db.Migrator().HasTable(&models.ScheduleEvent{}))
+	assert.True(t, db.Migrator().HasIndex(&models.ScheduleEvent{}, "idx_schedule_event_year_type"))
+
+	_, err = m.To(ctx, 6)
+	require.NoError(t, err)
+	assert.False(t, db.Migrator().HasTable(&models.ScheduleEvent{}))
+
+	_, err = m.Up(ctx)
+	require.NoError(t, err)
+	assert.True(t, db.Migrator().HasTable(&models.ScheduleEvent{}))
+}
+
+func TestRelaxAdmissionScheduleNameMigration(t *testing.T) {
+	db := newTestSQLiteDB(t)
+	ctx := context.Background()
+
+	m, err := NewSchemaMigrator(db)
+	require.NoError(t, err)
+
+	_, err = m.Up(ctx)
+	require.NoError(t, err)
+	assert.False(t, db.Migrator().HasConstraint(&legacyAdmissionSchedule{}, scheduleNameCheck))
+
+	// 取り消すと前・中・後のみを許可するチェック制約に戻る
+	_, err = m.To(ctx, 7)
+	require.NoError(t, err)
+	assert.True(t, db.Migrator().HasConstraint(&legacyAdmissionSchedule{}, scheduleNameCheck))
+	assert.Error(t, db.Exec(
+		"INSERT INTO admission_schedules (major_id, name, display_order, version) VALUES (?, ?, ?, ?)", 1, "全学部日程", 6, 1,
+	).Error)
+
+	_, err = m.Up(ctx)
+	require.NoError(t, err)
+	assert.False(t, db.Migrator().HasConstraint(&legacyAdmissionSchedule{}, scheduleNameCheck))
+	assert.NoError(t, db.Exec(
+		"INSERT INTO admission_schedules (major_id, name, display_order, version) VALUES (?, ?, ?, ?)", 1, "全学部日程", 6, 1,
+	).Error)
+}
+
+func TestCreateSubjectGroupsMigration(t *testing.T) {
+	db := newTestSQLiteDB(t)
+	ctx := context.Background()
+
+	m, err := NewSchemaMigrator(db)
+	require.NoError(t, err)
+
+	_, err = m.Up(ctx)
+	require.NoError(t, err)
+	assert.True(t, db.Migrator().HasTable(&models.SubjectGroup{}))
+	assert.True(t, db.Migrator().HasColumn(&models.Subject{}, "SubjectGroupID"))
+	assert.True(t, db.Migrator().HasIndex(&models.Subject{}, subjectGroupIndex))
+
+	_, err = m.To(ctx, 8)
+	require.NoError(t, err)
+	assert.False(t, db.Migrator().HasTable(&models.SubjectGroup{}))
+	assert.False(t, db.Migrator().HasColumn(&models.Subject{}, "SubjectGroupID"))
+
+	// 選択科目群の導入前に登録した科目は選択科目群に属さない
+	require.NoError(t, db.Exec(
+		"INSERT INTO subjects (name, score, conversion_ratio, percentage, display_order, test_type_id, version) VALUES (?, ?, ?, ?, ?, ?, ?)",
+		"物理", 100, 1, 50, 1, 1, 1,
+	).Error)
+
+	_, err = m.Up(ctx)
+	require.NoError(t, err)
+	assert.True(t, db.Migrator().HasTable(&models.SubjectGroup{}))
+	assert.True(t, db.Migrator().HasIndex(&models.Subject{}, subjectGroupIndex))
+
+	var grouped int64
+	require.NoError(t, db.Table("subjects").Where("subject_group_id IS NOT NULL").Count(&grouped).Error)
+	assert.Zero(t, grouped)
+}
+
+func TestCreateAdmissionDifficultiesMigration(t *testing.T) {
+	db := newTestSQLiteDB(t)
+	ctx := context.Background()
+
+	m, err := NewSchemaMigrator(db)
+	require.NoError(t, err)
+
+	_, err = m.Up(ctx)
+	require.NoError(t, err)
+	assert.True(t, db.Migrator().HasTable(&models.AdmissionDifficulty{}))
+	assert.True(t, db.Migrator().HasIndex(&models.AdmissionDifficulty{}, "idx_admission_difficulty_info"))
+
+	_, err = m.To(ctx, 9)
+	require.NoError(t, err)
+	assert.False(t, db.Migrator().HasTable(&models.AdmissionDifficulty{}))
+	assert.True(t, db.Migrator().HasTable(&models.SubjectGroup{}))
+}
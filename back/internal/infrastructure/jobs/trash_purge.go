@@ -0,0 +1,78 @@
+// Package jobs はサーバーの稼働中にバックグラウンドで定期実行するジョブを提供します。
+// このパッケージは以下の機能を提供します：
+// - 保持期間を過ぎたゴミ箱の要素の完全削除
+package jobs
+
+import (
+	"context"
+	"time"
+	applogger "university-exam-api/internal/logger"
+)
+
+// ExpiredTrashPurger は保持期間を過ぎたゴミ箱の要素を完全削除するインターフェースです
+type ExpiredTrashPurger interface {
+	PurgeExpired(ctx context.Context, retention time.Duration) (int64, error)
+}
+
+// TrashPurgeJob は保持期間を過ぎたゴミ箱の要素を定期的に完全削除するジョブです。
+// この構造体は以下の設定を管理します：
+// - 完全削除の実行者
+// - ゴミ箱の保持期間
+// - 実行間隔
+type TrashPurgeJob struct {
+	purger    ExpiredTrashPurger
+	retention time.Duration
+	interval  time.Duration
+}
+
+// NewTrashPurgeJob は新しいTrashPurgeJobを生成します
+func NewTrashPurgeJob(purger ExpiredTrashPurger, retention, interval time.Duration) *TrashPurgeJob {
+	return &TrashPurgeJob{
+		purger:    purger,
+		retention: retention,
+		interval:  interval,
+	}
+}
+
+// Enabled は保持期間と実行間隔が設定されており、ジョブを実行するかどうかを返します
+func (j *TrashPurgeJob) Enabled() bool {
+	return j.retention > 0 && j.interval > 0
+}
+
+// RunOnce は保持期間を過ぎたゴミ箱の要素を1回完全削除し、削除した件数を返します
+func (j *TrashPurgeJob) RunOnce(ctx context.Context) (int64, error) {
+	purged, err := j.purger.PurgeExpired(ctx, j.retention)
+	if err != nil {
+		applogger.Error(ctx, "ゴミ箱の完全削除に失敗しました: %v", err)
+		return 0, err
+	}
+
+	if purged > 0 {
+		applogger.Info(ctx, "保持期間（%s）を過ぎたゴミ箱の要素を完全削除しました (件数: %d)", j.retention, purged)
+	}
+
+	return purged, nil
+}
+
+// Run はコンテキストがキャンセルされるまで、起動時と実行間隔ごとに完全削除を実行します。
+// 保持期間または実行間隔が0以下の場合は何もしません
+func (j *TrashPurgeJob) Run(ctx context.Context) {
+	if !j.Enabled() {
+		applogger.Info(ctx, "ゴミ箱の自動完全削除は無効です")
+		return
+	}
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		// 失敗した場合は次の実行間隔で再試行する
+		_, _ = j.RunOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
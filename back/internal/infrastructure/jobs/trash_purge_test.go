@@ -0,0 +1,92 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+	applogger "university-exam-api/internal/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakePurger は呼び出しを記録するExpiredTrashPurgerの実装です
+type fakePurger struct {
+	mu         sync.Mutex
+	retentions []time.Duration
+	purged     int64
+	err        error
+}
+
+func (f *fakePurger) PurgeExpired(_ context.Context, retention time.Duration) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.retentions = append(f.retentions, retention)
+
+	return f.purged, f.err
+}
+
+func (f *fakePurger) calls() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return len(f.retentions)
+}
+
+func TestTrashPurgeJobRunOnce(t *testing.T) {
+	applogger.InitTestLogger()
+
+	t.Run("保持期間を指定して完全削除", func(t *testing.T) {
+		purger := &fakePurger{purged: 3}
+
+		purged, err := NewTrashPurgeJob(purger, 72*time.Hour, time.Hour).RunOnce(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), purged)
+		assert.Equal(t, []time.Duration{72 * time.Hour}, purger.retentions)
+	})
+
+	t.Run("失敗した場合はエラーを返す", func(t *testing.T) {
+		purger := &fakePurger{err: errors.New("接続エラー")}
+
+		_, err := NewTrashPurgeJob(purger, time.Hour, time.Hour).RunOnce(context.Background())
+		assert.Error(t, err)
+	})
+}
+
+func TestTrashPurgeJobRun(t *testing.T) {
+	applogger.InitTestLogger()
+
+	t.Run("起動時と実行間隔ごとに実行し、キャンセルで停止", func(t *testing.T) {
+		purger := &fakePurger{}
+		job := NewTrashPurgeJob(purger, time.Hour, 10*time.Millisecond)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+
+		go func() {
+			job.Run(ctx)
+			close(done)
+		}()
+
+		assert.Eventually(t, func() bool { return purger.calls() >= 2 }, time.Second, 5*time.Millisecond)
+
+		cancel()
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("キャンセル後にジョブが停止しませんでした")
+		}
+	})
+
+	t.Run("保持期間が0の場合は実行しない", func(t *testing.T) {
+		purger := &fakePurger{}
+		job := NewTrashPurgeJob(purger, 0, time.Hour)
+
+		assert.False(t, job.Enabled())
+		job.Run(context.Background())
+		assert.Zero(t, purger.calls())
+	})
+}
@@ -0,0 +1,238 @@
+// Package migration はバージョン管理されたスキーママイグレーションを提供するパッケージです。
+// このパッケージは以下の機能を提供します：
+// - 番号付きのup/downマイグレーション（GoまたはSQL）の定義
+// - schema_migrations テーブルによる適用済みバージョンの記録
+// - チェックサムによる適用済みマイグレーションの改変検出
+// - PostgreSQL と SQLite の両方での実行
+package migration
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"gorm.io/gorm"
+)
+
+// sqlFilePattern はSQLマイグレーションのファイル名の形式です。
+// 例: 0002_create_migration_metrics.up.sql, 0002_create_migration_metrics.postgres.up.sql
+var sqlFilePattern = regexp.MustCompile(`^(\d+)_([a-z0-9_]+?)(?:\.(postgres|sqlite))?\.(up|down)\.sql$`)
+
+// namePattern はマイグレーション名として使用できる文字列の形式です
+var namePattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// Migration は1つのバージョンのマイグレーションを表します。
+// Up と UpSQL のいずれか一方を指定します。Down・DownSQL を省略した場合は取り消しできません。
+// Goマイグレーションは関数の内容からチェックサムを計算できないため、Revision の指定を必須とし、
+// 処理内容を変更した場合は Revision を更新して、適用済みの環境で変更を検出できるようにします。
+type Migration struct {
+	Version  int
+	Name     string
+	Revision string
+	Up       func(tx *gorm.DB) error
+	Down     func(tx *gorm.DB) error
+	UpSQL    string
+	DownSQL  string
+}
+
+// ID は「0001_name」形式の識別子を返します
+func (m Migration) ID() string {
+	return fmt.Sprintf("%04d_%s", m.Version, m.Name)
+}
+
+// Checksum はマイグレーションの内容から計算したチェックサムを返します。
+// SQLマイグレーションはSQL本文、Goマイグレーションはバージョン・名前・Revision から計算します。
+func (m Migration) Checksum() string {
+	var content string
+	if m.isSQL() {
+		content = "sql\x00" + m.UpSQL + "\x00" + m.DownSQL
+	} else {
+		content = fmt.Sprintf("go\x00%d\x00%s\x00%s", m.Version, m.Name, m.Revision)
+	}
+
+	sum := sha256.Sum256([]byte(content))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// Reversible はマイグレーションを取り消せるかどうかを返します
+func (m Migration) Reversible() bool {
+	return m.Down != nil || m.DownSQL != ""
+}
+
+// isSQL はSQLで定義されたマイグレーションかどうかを返します
+func (m Migration) isSQL() bool {
+	return m.Up == nil
+}
+
+// up はマイグレーションを適用します
+func (m Migration) up(tx *gorm.DB) error {
+	if m.Up != nil {
+		return m.Up(tx)
+	}
+
+	return tx.Exec(m.UpSQL).Error
+}
+
+// down はマイグレーションを取り消します
+func (m Migration) down(tx *gorm.DB) error {
+	if m.Down != nil {
+		return m.Down(tx)
+	}
+
+	return tx.Exec(m.DownSQL).Error
+}
+
+// validate はマイグレーションの定義を検証します
+func (m Migration) validate() error {
+	if m.Version <= 0 {
+		return fmt.Errorf("マイグレーション %s: バージョンは1以上である必要があります", m.ID())
+	}
+
+	if !namePattern.MatchString(m.Name) {
+		return fmt.Errorf("マイグレーション %s: 名前は英小文字・数字・アンダースコアで指定してください", m.ID())
+	}
+
+	if (m.Up == nil) == (m.UpSQL == "") {
+		return fmt.Errorf("マイグレーション %s: Up と UpSQL のいずれか一方を指定してください", m.ID())
+	}
+
+	if m.Up != nil && m.Revision == "" {
+		return fmt.Errorf("マイグレーション %s: Goマイグレーションには Revision を指定してください", m.ID())
+	}
+
+	if m.Up == nil && m.Revision != "" {
+		return fmt.Errorf("マイグレーション %s: SQLマイグレーションには Revision を指定できません", m.ID())
+	}
+
+	if m.Down != nil && m.DownSQL != "" {
+		return fmt.Errorf("マイグレーション %s: Down と DownSQL は同時に指定できません", m.ID())
+	}
+
+	return nil
+}
+
+// sortMigrations はマイグレーションを検証し、バージョン順に並べた複製を返します
+func sortMigrations(migrations []Migration) ([]Migration, error) {
+	sorted := make([]Migration, len(migrations))
+	copy(sorted, migrations)
+
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Version < sorted[j].Version })
+
+	for i, m := range sorted {
+		if err := m.validate(); err != nil {
+			return nil, err
+		}
+
+		if i > 0 && sorted[i-1].Version == m.Version {
+			return nil, fmt.Errorf("バージョン %d が重複しています（%s, %s）", m.Version, sorted[i-1].ID(), m.ID())
+		}
+	}
+
+	return sorted, nil
+}
+
+// sqlFiles は1つのバージョンのSQLファイルを保持します
+type sqlFiles struct {
+	name        string
+	up, down    string
+	dialectUp   string
+	dialectDown string
+}
+
+// LoadSQL はファイルシステムのルートにあるSQLマイグレーションを読み込みます。
+// この関数は以下の処理を行います：
+// - 「NNNN_name.up.sql」「NNNN_name.down.sql」形式のファイルの読み込み
+// - 「NNNN_name.<dialect>.up.sql」形式のデータベース固有ファイルによる上書き
+// - ファイル名とバージョンの整合性の検証
+func LoadSQL(fsys fs.FS, dialect string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("SQLマイグレーションの読み込みに失敗しました: %w", err)
+	}
+
+	files := make(map[int]*sqlFiles)
+
+	for _, entry := range entries {
+		if entry.IsDir() || path.Ext(entry.Name()) != ".sql" {
+			continue
+		}
+
+		match := sqlFilePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("SQLマイグレーション %s: ファイル名は NNNN_name[.dialect].up|down.sql の形式で指定してください", entry.Name())
+		}
+
+		version, err := strconv.Atoi(match[1])
+		if err != nil {
+			return nil, fmt.Errorf("SQLマイグレーション %s: バージョンが不正です: %w", entry.Name(), err)
+		}
+
+		f, ok := files[version]
+		if !ok {
+			f = &sqlFiles{name: match[2]}
+			files[version] = f
+		}
+
+		if f.name != match[2] {
+			return nil, fmt.Errorf("SQLマイグレーション %s: バージョン %d の名前が %s と一致しません", entry.Name(), version, f.name)
+		}
+
+		// 他のデータベース向けのファイルはバージョンの存在のみを記録する
+		fileDialect, direction := match[3], match[4]
+		if fileDialect != "" && fileDialect != dialect {
+			continue
+		}
+
+		content, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("SQLマイグレーション %s の読み込みに失敗しました: %w", entry.Name(), err)
+		}
+
+		switch {
+		case fileDialect != "" && direction == "up":
+			f.dialectUp = string(content)
+		case fileDialect != "":
+			f.dialectDown = string(content)
+		case direction == "up":
+			f.up = string(content)
+		default:
+			f.down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(files))
+
+	for version, f := range files {
+		m := Migration{
+			Version: version,
+			Name:    f.name,
+			UpSQL:   firstNonEmpty(f.dialectUp, f.up),
+			DownSQL: firstNonEmpty(f.dialectDown, f.down),
+		}
+
+		if m.UpSQL == "" {
+			return nil, fmt.Errorf("SQLマイグレーション %s: %s 向けの up ファイルがありません", m.ID(), dialect)
+		}
+
+		migrations = append(migrations, m)
+	}
+
+	return sortMigrations(migrations)
+}
+
+// firstNonEmpty は空でない最初の文字列を返します
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
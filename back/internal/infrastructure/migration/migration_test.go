@@ -0,0 +1,162 @@
+package migration
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestMigrationChecksum(t *testing.T) {
+	sqlMigration := Migration{Version: 1, Name: "create_items", UpSQL: "CREATE TABLE items (id INTEGER);"}
+	edited := sqlMigration
+	edited.UpSQL = "CREATE TABLE items (id INTEGER, name TEXT);"
+
+	assert.Len(t, sqlMigration.Checksum(), 64)
+	assert.NotEqual(t, sqlMigration.Checksum(), edited.Checksum())
+
+	goMigration := Migration{Version: 1, Name: "create_items", Revision: "1", Up: func(*gorm.DB) error { return nil }}
+	renamed := goMigration
+	renamed.Name = "create_products"
+	revised := goMigration
+	revised.Revision = "2"
+
+	assert.NotEqual(t, goMigration.Checksum(), renamed.Checksum())
+	assert.NotEqual(t, goMigration.Checksum(), revised.Checksum())
+	assert.NotEqual(t, goMigration.Checksum(), sqlMigration.Checksum())
+}
+
+func TestSortMigrationsErrors(t *testing.T) {
+	noop := func(*gorm.DB) error { return nil }
+
+	tests := []struct {
+		name       string
+		migrations []Migration
+		want       string
+	}{
+		{
+			name:       "バージョンが0",
+			migrations: []Migration{{Name: "init", Revision: "1", Up: noop}},
+			want:       "バージョンは1以上",
+		},
+		{
+			name:       "不正な名前",
+			migrations: []Migration{{Version: 1, Name: "Create Items", Revision: "1", Up: noop}},
+			want:       "英小文字・数字・アンダースコア",
+		},
+		{
+			name:       "処理の指定なし",
+			migrations: []Migration{{Version: 1, Name: "init"}},
+			want:       "いずれか一方",
+		},
+		{
+			name:       "GoとSQLの両方を指定",
+			migrations: []Migration{{Version: 1, Name: "init", Up: noop, UpSQL: "SELECT 1"}},
+			want:       "いずれか一方",
+		},
+		{
+			name:       "GoマイグレーションのRevisionの指定なし",
+			migrations: []Migration{{Version: 1, Name: "init", Up: noop}},
+			want:       "Revision を指定してください",
+		},
+		{
+			name:       "SQLマイグレーションにRevisionを指定",
+			migrations: []Migration{{Version: 1, Name: "init", Revision: "1", UpSQL: "SELECT 1"}},
+			want:       "Revision を指定できません",
+		},
+		{
+			name: "バージョンの重複",
+			migrations: []Migration{
+				{Version: 1, Name: "init", Revision: "1", Up: noop},
+				{Version: 1, Name: "other", Revision: "1", Up: noop},
+			},
+			want: "バージョン 1 が重複しています",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := sortMigrations(tt.migrations)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestLoadSQL(t *testing.T) {
+	fsys := fstest.MapFS{
+		"0002_add_index.up.sql":                {Data: []byte("CREATE INDEX idx ON items (name);")},
+		"0002_add_index.down.sql":              {Data: []byte("DROP INDEX idx;")},
+		"0001_create_items.up.sql":             {Data: []byte("CREATE TABLE items (id INTEGER);")},
+		"0001_create_items.postgres.up.sql":    {Data: []byte("CREATE TABLE items (id BIGSERIAL);")},
+		"0001_create_items.down.sql":           {Data: []byte("DROP TABLE items;")},
+		"README.md":                            {Data: []byte("ignored")},
+		"0003_postgres_only.postgres.up.sql":   {Data: []byte("CREATE EXTENSION pg_trgm;")},
+		"0003_postgres_only.sqlite.up.sql":     {Data: []byte("SELECT 1;")},
+		"0003_postgres_only.postgres.down.sql": {Data: []byte("DROP EXTENSION pg_trgm;")},
+	}
+
+	t.Run("SQLite", func(t *testing.T) {
+		migrations, err := LoadSQL(fsys, "sqlite")
+		require.NoError(t, err)
+		require.Len(t, migrations, 3)
+
+		assert.Equal(t, "0001_create_items", migrations[0].ID())
+		assert.Equal(t, "CREATE TABLE items (id INTEGER);", migrations[0].UpSQL)
+		assert.Equal(t, "DROP TABLE items;", migrations[0].DownSQL)
+		assert.True(t, migrations[1].Reversible())
+		assert.False(t, migrations[2].Reversible())
+	})
+
+	t.Run("PostgreSQL固有のファイルを優先する", func(t *testing.T) {
+		migrations, err := LoadSQL(fsys, "postgres")
+		require.NoError(t, err)
+		require.Len(t, migrations, 3)
+
+		assert.Equal(t, "CREATE TABLE items (id BIGSERIAL);", migrations[0].UpSQL)
+		assert.Equal(t, "DROP TABLE items;", migrations[0].DownSQL)
+		assert.Equal(t, "DROP EXTENSION pg_trgm;", migrations[2].DownSQL)
+	})
+}
+
+func TestLoadSQLErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		fsys fstest.MapFS
+		want string
+	}{
+		{
+			name: "不正なファイル名",
+			fsys: fstest.MapFS{"create_items.sql": {Data: []byte("SELECT 1;")}},
+			want: "ファイル名は",
+		},
+		{
+			name: "名前の不一致",
+			fsys: fstest.MapFS{
+				"0001_create_items.up.sql":      {Data: []byte("SELECT 1;")},
+				"0001_create_products.down.sql": {Data: []byte("SELECT 1;")},
+			},
+			want: "名前が",
+		},
+		{
+			name: "upファイルなし",
+			fsys: fstest.MapFS{"0001_create_items.down.sql": {Data: []byte("SELECT 1;")}},
+			want: "up ファイルがありません",
+		},
+		{
+			name: "他のデータベース向けのupファイルのみ",
+			fsys: fstest.MapFS{"0001_create_items.postgres.up.sql": {Data: []byte("SELECT 1;")}},
+			want: "sqlite 向けの up ファイルがありません",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadSQL(tt.fsys, "sqlite")
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
@@ -0,0 +1,398 @@
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// TableName は適用済みマイグレーションを記録するテーブルの名前です
+const TableName = "schema_migrations"
+
+// advisoryLockKey は PostgreSQL で同時実行を防ぐためのアドバイザリロックのキーです
+const advisoryLockKey = 7_306_154_520_240_001
+
+var (
+	// ErrChecksumMismatch は適用済みのマイグレーションが変更されている場合のエラーです
+	ErrChecksumMismatch = errors.New("適用済みのマイグレーションが変更されています")
+	// ErrUnknownVersion は登録されていないバージョンを参照した場合のエラーです
+	ErrUnknownVersion = errors.New("登録されていないバージョンです")
+	// ErrIrreversible は取り消し処理のないマイグレーションを取り消そうとした場合のエラーです
+	ErrIrreversible = errors.New("取り消しできないマイグレーションです")
+)
+
+// Record は schema_migrations テーブルの1行を表します
+type Record struct {
+	Version   int       `gorm:"primaryKey;autoIncrement:false"`
+	Name      string    `gorm:"size:255;not null"`
+	Checksum  string    `gorm:"size:64;not null"`
+	AppliedAt time.Time `gorm:"not null"`
+}
+
+// TableName はテーブル名を返します
+func (Record) TableName() string {
+	return TableName
+}
+
+// State はマイグレーションの適用状態です
+type State string
+
+const (
+	// StatePending は未適用の状態です
+	StatePending State = "pending"
+	// StateApplied は適用済みの状態です
+	StateApplied State = "applied"
+	// StateModified は適用後に内容が変更された状態です
+	StateModified State = "modified"
+	// StateMissing は適用済みだがコードに存在しない状態です
+	StateMissing State = "missing"
+)
+
+// Status はマイグレーションごとの適用状況を表します
+type Status struct {
+	Version   int
+	Name      string
+	State     State
+	AppliedAt *time.Time
+}
+
+// Migrator はバージョン管理されたマイグレーションを実行します
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+}
+
+// NewMigrator は新しいマイグレーション実行器を作成します。
+// この関数は以下の処理を行います：
+// - マイグレーション定義の検証
+// - バージョン順への並べ替え
+func NewMigrator(db *gorm.DB, migrations []Migration) (*Migrator, error) {
+	sorted, err := sortMigrations(migrations)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{db: db, migrations: sorted}, nil
+}
+
+// Migrations は登録されているマイグレーションをバージョン順に返します
+func (m *Migrator) Migrations() []Migration {
+	return m.migrations
+}
+
+// Status はマイグレーションごとの適用状況をバージョン順に返します
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	records, err := m.records(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(m.migrations)+len(records))
+
+	for _, mig := range m.migrations {
+		status := Status{Version: mig.Version, Name: mig.Name, State: StatePending}
+
+		if record, ok := records[mig.Version]; ok {
+			appliedAt := record.AppliedAt
+			status.AppliedAt = &appliedAt
+			status.State = StateApplied
+
+			if record.Checksum != mig.Checksum() {
+				status.State = StateModified
+			}
+
+			delete(records, mig.Version)
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	for _, record := range records {
+		appliedAt := record.AppliedAt
+		statuses = append(statuses, Status{
+			Version:   record.Version,
+			Name:      record.Name,
+			State:     StateMissing,
+			AppliedAt: &appliedAt,
+		})
+	}
+
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Version < statuses[j].Version })
+
+	return statuses, nil
+}
+
+// Current は適用済みの最新バージョンを返します。未適用の場合は0を返します
+func (m *Migrator) Current(ctx context.Context) (int, error) {
+	records, err := m.records(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	current := 0
+
+	for version := range records {
+		if version > current {
+			current = version
+		}
+	}
+
+	return current, nil
+}
+
+// Up は未適用のマイグレーションをバージョン順に全て適用し、適用したマイグレーションを返します
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	return m.migrate(ctx, func(records map[int]Record) ([]Migration, []Migration) {
+		return m.pending(records), nil
+	})
+}
+
+// Down は適用済みのマイグレーションを新しい順に steps 件取り消し、取り消したマイグレーションを返します
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	if steps < 1 {
+		return nil, fmt.Errorf("取り消す件数は1以上である必要があります: %d", steps)
+	}
+
+	return m.migrate(ctx, func(records map[int]Record) ([]Migration, []Migration) {
+		applied := m.applied(records, 0)
+		if len(applied) > steps {
+			applied = applied[:steps]
+		}
+
+		return nil, applied
+	})
+}
+
+// To は指定したバージョンの状態になるようにマイグレーションを適用または取り消します。
+// version に0を指定すると全てのマイグレーションを取り消します。
+func (m *Migrator) To(ctx context.Context, version int) ([]Migration, error) {
+	if version != 0 && m.find(version) == nil {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownVersion, version)
+	}
+
+	return m.migrate(ctx, func(records map[int]Record) ([]Migration, []Migration) {
+		var up []Migration
+
+		for _, mig := range m.pending(records) {
+			if mig.Version <= version {
+				up = append(up, mig)
+			}
+		}
+
+		return up, m.applied(records, version)
+	})
+}
+
+// migrate は適用状況を検証したうえで、plan が返すマイグレーションを取り消し・適用します
+func (m *Migrator) migrate(
+	ctx context.Context,
+	plan func(records map[int]Record) (up []Migration, down []Migration),
+) ([]Migration, error) {
+	records, err := m.records(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.verify(records); err != nil {
+		return nil, err
+	}
+
+	up, down := plan(records)
+
+	for _, mig := range down {
+		if !mig.Reversible() {
+			return nil, fmt.Errorf("%w: %s", ErrIrreversible, mig.ID())
+		}
+	}
+
+	executed := make([]Migration, 0, len(up)+len(down))
+
+	for _, mig := range down {
+		if err := m.revert(ctx, mig); err != nil {
+			return executed, err
+		}
+
+		executed = append(executed, mig)
+	}
+
+	for _, mig := range up {
+		if err := m.apply(ctx, mig); err != nil {
+			return executed, err
+		}
+
+		executed = append(executed, mig)
+	}
+
+	return executed, nil
+}
+
+// verify は適用済みのマイグレーションがコードの定義と一致するか検証します
+func (m *Migrator) verify(records map[int]Record) error {
+	var modified, missing []string
+
+	for version, record := range records {
+		mig := m.find(version)
+
+		switch {
+		case mig == nil:
+			missing = append(missing, fmt.Sprintf("%04d_%s", version, record.Name))
+		case mig.Checksum() != record.Checksum:
+			modified = append(modified, mig.ID())
+		}
+	}
+
+	sort.Strings(missing)
+	sort.Strings(modified)
+
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: 適用済みの %s がコードに存在しません", ErrUnknownVersion, strings.Join(missing, ", "))
+	}
+
+	if len(modified) > 0 {
+		return fmt.Errorf("%w: %s", ErrChecksumMismatch, strings.Join(modified, ", "))
+	}
+
+	return nil
+}
+
+// pending は未適用のマイグレーションをバージョンの昇順で返します
+func (m *Migrator) pending(records map[int]Record) []Migration {
+	var pending []Migration
+
+	for _, mig := range m.migrations {
+		if _, ok := records[mig.Version]; !ok {
+			pending = append(pending, mig)
+		}
+	}
+
+	return pending
+}
+
+// applied は above より大きいバージョンの適用済みマイグレーションを降順で返します
+func (m *Migrator) applied(records map[int]Record, above int) []Migration {
+	var applied []Migration
+
+	for i := len(m.migrations) - 1; i >= 0; i-- {
+		mig := m.migrations[i]
+		if _, ok := records[mig.Version]; ok && mig.Version > above {
+			applied = append(applied, mig)
+		}
+	}
+
+	return applied
+}
+
+// find は指定したバージョンのマイグレーションを返します
+func (m *Migrator) find(version int) *Migration {
+	for i := range m.migrations {
+		if m.migrations[i].Version == version {
+			return &m.migrations[i]
+		}
+	}
+
+	return nil
+}
+
+// records は schema_migrations テーブルを作成し、適用済みのマイグレーションを返します
+func (m *Migrator) records(ctx context.Context) (map[int]Record, error) {
+	db := m.db.WithContext(ctx)
+
+	if err := db.AutoMigrate(&Record{}); err != nil {
+		return nil, fmt.Errorf("%s テーブルの作成に失敗しました: %w", TableName, err)
+	}
+
+	var rows []Record
+	if err := db.Order("version").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("適用済みマイグレーションの取得に失敗しました: %w", err)
+	}
+
+	records := make(map[int]Record, len(rows))
+	for _, row := range rows {
+		records[row.Version] = row
+	}
+
+	return records, nil
+}
+
+// apply は1件のマイグレーションをトランザクション内で適用し、記録します
+func (m *Migrator) apply(ctx context.Context, mig Migration) error {
+	start := time.Now()
+
+	err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		done, err := lock(tx, mig.Version)
+		if err != nil || done {
+			return err
+		}
+
+		if err := mig.up(tx); err != nil {
+			return err
+		}
+
+		return tx.Create(&Record{
+			Version:   mig.Version,
+			Name:      mig.Name,
+			Checksum:  mig.Checksum(),
+			AppliedAt: time.Now(),
+		}).Error
+	})
+	if err != nil {
+		return fmt.Errorf("マイグレーション %s の適用に失敗しました: %w", mig.ID(), err)
+	}
+
+	log.Printf("マイグレーション %s を適用しました（所要時間: %v）", mig.ID(), time.Since(start))
+
+	return nil
+}
+
+// revert は1件のマイグレーションをトランザクション内で取り消し、記録を削除します
+func (m *Migrator) revert(ctx context.Context, mig Migration) error {
+	start := time.Now()
+
+	err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		done, err := lock(tx, mig.Version)
+		if err != nil {
+			return err
+		}
+
+		if !done {
+			return nil
+		}
+
+		if err := mig.down(tx); err != nil {
+			return err
+		}
+
+		return tx.Delete(&Record{}, mig.Version).Error
+	})
+	if err != nil {
+		return fmt.Errorf("マイグレーション %s の取り消しに失敗しました: %w", mig.ID(), err)
+	}
+
+	log.Printf("マイグレーション %s を取り消しました（所要時間: %v）", mig.ID(), time.Since(start))
+
+	return nil
+}
+
+// lock は他のプロセスとの同時実行を防ぐロックを取得し、指定したバージョンが適用済みかどうかを返します。
+// PostgreSQL ではトランザクション終了時に解放されるアドバイザリロックを使用します。
+// SQLite は書き込みトランザクションがデータベース全体をロックするため、追加のロックは不要です。
+func lock(tx *gorm.DB, version int) (bool, error) {
+	if tx.Dialector.Name() == "postgres" {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", advisoryLockKey).Error; err != nil {
+			return false, fmt.Errorf("ロックの取得に失敗しました: %w", err)
+		}
+	}
+
+	var count int64
+	if err := tx.Model(&Record{}).Where("version = ?", version).Count(&count).Error; err != nil {
+		return false, fmt.Errorf("適用状況の確認に失敗しました: %w", err)
+	}
+
+	return count > 0, nil
+}
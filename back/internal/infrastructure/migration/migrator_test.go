@@ -0,0 +1,228 @@
+package migration
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupMigratorTestDB はテスト用のインメモリSQLiteデータベースを作成します
+func setupMigratorTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	return db
+}
+
+// testMigrations はテスト用のマイグレーションを返します
+func testMigrations() []Migration {
+	return []Migration{
+		{
+			Version: 1,
+			Name:    "create_items",
+			UpSQL:   "CREATE TABLE items (id INTEGER PRIMARY KEY, title TEXT NOT NULL);",
+			DownSQL: "DROP TABLE items;",
+		},
+		{
+			Version:  2,
+			Name:     "rename_items_title",
+			Revision: "1",
+			Up: func(tx *gorm.DB) error {
+				return tx.Migrator().RenameColumn("items", "title", "name")
+			},
+			Down: func(tx *gorm.DB) error {
+				return tx.Migrator().RenameColumn("items", "name", "title")
+			},
+		},
+		{
+			Version: 3,
+			Name:    "add_items_index",
+			UpSQL:   "CREATE INDEX idx_items_name ON items (name);",
+			DownSQL: "DROP INDEX idx_items_name;",
+		},
+	}
+}
+
+// versions はマイグレーションのバージョン一覧を返します
+func versions(migrations []Migration) []int {
+	result := make([]int, 0, len(migrations))
+	for _, m := range migrations {
+		result = append(result, m.Version)
+	}
+
+	return result
+}
+
+// states はバージョンごとの適用状態を返します
+func states(t *testing.T, m *Migrator) map[int]State {
+	t.Helper()
+
+	statuses, err := m.Status(context.Background())
+	require.NoError(t, err)
+
+	result := make(map[int]State, len(statuses))
+	for _, s := range statuses {
+		result[s.Version] = s.State
+	}
+
+	return result
+}
+
+func TestMigratorUpAndDown(t *testing.T) {
+	db := setupMigratorTestDB(t)
+	ctx := context.Background()
+
+	m, err := NewMigrator(db, testMigrations())
+	require.NoError(t, err)
+
+	assert.Equal(t, map[int]State{1: StatePending, 2: StatePending, 3: StatePending}, states(t, m))
+
+	applied, err := m.Up(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, versions(applied))
+	assert.True(t, db.Migrator().HasColumn("items", "name"))
+	assert.True(t, db.Migrator().HasIndex("items", "idx_items_name"))
+
+	current, err := m.Current(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, current)
+
+	var record Record
+	require.NoError(t, db.Where("version = ?", 1).Take(&record).Error)
+	assert.Equal(t, "create_items", record.Name)
+	assert.Equal(t, testMigrations()[0].Checksum(), record.Checksum)
+
+	t.Run("再実行しても何もしない", func(t *testing.T) {
+		applied, err := m.Up(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, applied)
+	})
+
+	t.Run("新しい順に取り消す", func(t *testing.T) {
+		reverted, err := m.Down(ctx, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []int{3, 2}, versions(reverted))
+		assert.True(t, db.Migrator().HasColumn("items", "title"))
+		assert.False(t, db.Migrator().HasIndex("items", "idx_items_name"))
+		assert.Equal(t, map[int]State{1: StateApplied, 2: StatePending, 3: StatePending}, states(t, m))
+	})
+
+	t.Run("件数が不正", func(t *testing.T) {
+		_, err := m.Down(ctx, 0)
+		require.Error(t, err)
+	})
+}
+
+func TestMigratorTo(t *testing.T) {
+	db := setupMigratorTestDB(t)
+	ctx := context.Background()
+
+	m, err := NewMigrator(db, testMigrations())
+	require.NoError(t, err)
+
+	executed, err := m.To(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, versions(executed))
+
+	executed, err = m.To(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []int{2}, versions(executed))
+
+	executed, err = m.To(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1}, versions(executed))
+	assert.False(t, db.Migrator().HasTable("items"))
+
+	_, err = m.To(ctx, 9)
+	assert.True(t, errors.Is(err, ErrUnknownVersion))
+}
+
+func TestMigratorDetectsModifiedMigration(t *testing.T) {
+	db := setupMigratorTestDB(t)
+	ctx := context.Background()
+
+	m, err := NewMigrator(db, testMigrations())
+	require.NoError(t, err)
+
+	_, err = m.To(ctx, 1)
+	require.NoError(t, err)
+
+	edited := testMigrations()
+	edited[0].UpSQL = "CREATE TABLE items (id INTEGER PRIMARY KEY, title TEXT);"
+
+	m, err = NewMigrator(db, edited)
+	require.NoError(t, err)
+
+	assert.Equal(t, StateModified, states(t, m)[1])
+
+	_, err = m.Up(ctx)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrChecksumMismatch))
+	assert.Contains(t, err.Error(), "0001_create_items")
+	assert.False(t, db.Migrator().HasColumn("items", "name"))
+}
+
+func TestMigratorDetectsMissingMigration(t *testing.T) {
+	db := setupMigratorTestDB(t)
+	ctx := context.Background()
+
+	m, err := NewMigrator(db, testMigrations())
+	require.NoError(t, err)
+
+	_, err = m.Up(ctx)
+	require.NoError(t, err)
+
+	m, err = NewMigrator(db, testMigrations()[:2])
+	require.NoError(t, err)
+
+	assert.Equal(t, StateMissing, states(t, m)[3])
+
+	_, err = m.Down(ctx, 1)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrUnknownVersion))
+}
+
+func TestMigratorIrreversible(t *testing.T) {
+	db := setupMigratorTestDB(t)
+	ctx := context.Background()
+
+	migrations := testMigrations()
+	migrations[2].DownSQL = ""
+
+	m, err := NewMigrator(db, migrations)
+	require.NoError(t, err)
+
+	_, err = m.Up(ctx)
+	require.NoError(t, err)
+
+	reverted, err := m.Down(ctx, 3)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrIrreversible))
+	assert.Empty(t, reverted)
+	assert.Equal(t, StateApplied, states(t, m)[1])
+}
+
+func TestMigratorRollsBackFailedMigration(t *testing.T) {
+	db := setupMigratorTestDB(t)
+	ctx := context.Background()
+
+	migrations := testMigrations()
+	migrations[2].UpSQL = "CREATE TABLE logs (id INTEGER); CREATE INDEX idx_missing ON missing (name);"
+
+	m, err := NewMigrator(db, migrations)
+	require.NoError(t, err)
+
+	applied, err := m.Up(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "0003_add_items_index")
+	assert.Equal(t, []int{1, 2}, versions(applied))
+	assert.False(t, db.Migrator().HasTable("logs"))
+	assert.Equal(t, StatePending, states(t, m)[3])
+}
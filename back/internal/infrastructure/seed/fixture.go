@@ -0,0 +1,363 @@
+// Package seed はシードデータのフィクスチャファイルの読み込みと投入を提供します。
+// このパッケージは以下の機能を提供します：
+// - YAML・JSON形式のフィクスチャファイルの読み込みとスキーマ検証
+// - 環境プロファイル（dev・test・demo など）ごとのフィクスチャの選択
+// - 自然キーによる冪等な登録・更新（既存データの削除は行わない）
+package seed
+
+import (
+	"fmt"
+	"strings"
+	"university-exam-api/internal/domain/models"
+)
+
+// FixtureVersion はサポートするフィクスチャファイルのスキーマバージョンです
+const FixtureVersion = 1
+
+// 入力値の上限・下限
+const (
+	maxNameBytes            = 20
+	maxSubClassificationLen = 50
+	maxAcademicFieldLen     = 50
+	maxDisplayOrder         = 999
+	maxScore                = 1000
+	minAcademicYear         = 2000
+	maxAcademicYear         = 2100
+	maxEnrollment           = 9999
+)
+
+// 入力値の候補
+var (
+	validClassificationNames = []string{"国公立", "私立"}
+	validStatuses            = []string{"draft", "published", "archived"}
+)
+
+// Fixture は1つのフィクスチャファイルの内容を表現する構造体です
+// フィールド名はJSON APIと同じスネークケースを使用します
+type Fixture struct {
+	Version       int                   `yaml:"version" json:"version"`
+	FilterOptions []FilterOptionFixture `yaml:"filter_options,omitempty" json:"filter_options,omitempty"`
+	Universities  []UniversityFixture   `yaml:"universities,omitempty" json:"universities,omitempty"`
+
+	// Source は読み込み元のファイルパスです（エラーメッセージ用）
+	Source string `yaml:"-" json:"-"`
+}
+
+// FilterOptionFixture はフィルターオプションのフィクスチャです
+type FilterOptionFixture struct {
+	Category     string                `yaml:"category" json:"category"`
+	Name         string                `yaml:"name" json:"name"`
+	DisplayOrder int                   `yaml:"display_order" json:"display_order"`
+	Children     []FilterOptionFixture `yaml:"children,omitempty" json:"children,omitempty"`
+}
+
+// UniversityFixture は大学のフィクスチャです
+type UniversityFixture struct {
+	Name            string                  `yaml:"name" json:"name"`
+	Regions         []RegionFixture         `yaml:"regions,omitempty" json:"regions,omitempty"`
+	Classifications []ClassificationFixture `yaml:"classifications,omitempty" json:"classifications,omitempty"`
+	Departments     []DepartmentFixture     `yaml:"departments,omitempty" json:"departments,omitempty"`
+}
+
+// RegionFixture は地域と都道府県のフィクスチャです
+type RegionFixture struct {
+	Name        string   `yaml:"name" json:"name"`
+	Prefectures []string `yaml:"prefectures,omitempty" json:"prefectures,omitempty"`
+}
+
+// ClassificationFixture は設置区分と小分類のフィクスチャです
+type ClassificationFixture struct {
+	Name               string   `yaml:"name" json:"name"`
+	SubClassifications []string `yaml:"sub_classifications,omitempty" json:"sub_classifications,omitempty"`
+}
+
+// DepartmentFixture は学部のフィクスチャです
+type DepartmentFixture struct {
+	Name   string         `yaml:"name" json:"name"`
+	Majors []MajorFixture `yaml:"majors,omitempty" json:"majors,omitempty"`
+}
+
+// MajorFixture は学科のフィクスチャです
+type MajorFixture struct {
+	Name               string                     `yaml:"name" json:"name"`
+	AcademicFields     []string                   `yaml:"academic_fields,omitempty" json:"academic_fields,omitempty"`
+	AdmissionSchedules []AdmissionScheduleFixture `yaml:"admission_schedules,omitempty" json:"admission_schedules,omitempty"`
+}
+
+// AdmissionScheduleFixture は入試日程のフィクスチャです
+type AdmissionScheduleFixture struct {
+	Name           string                 `yaml:"name" json:"name"`
+	DisplayOrder   int                    `yaml:"display_order" json:"display_order"`
+	AdmissionInfos []AdmissionInfoFixture `yaml:"admission_infos,omitempty" json:"admission_infos,omitempty"`
+	TestTypes      []TestTypeFixture      `yaml:"test_types,omitempty" json:"test_types,omitempty"`
+}
+
+// AdmissionInfoFixture は年度ごとの入試情報のフィクスチャです
+// ステータスを省略した場合は draft として扱います
+type AdmissionInfoFixture struct {
+	AcademicYear int    `yaml:"academic_year" json:"academic_year"`
+	Enrollment   int    `yaml:"enrollment" json:"enrollment"`
+	Status       string `yaml:"status,omitempty" json:"status,omitempty"`
+}
+
+// TestTypeFixture は試験種別のフィクスチャです
+type TestTypeFixture struct {
+	Name     string           `yaml:"name" json:"name"`
+	Subjects []SubjectFixture `yaml:"subjects,omitempty" json:"subjects,omitempty"`
+}
+
+// SubjectFixture は科目のフィクスチャです
+// 配点比率は入試日程ごとの配点の合計から自動で計算します
+type SubjectFixture struct {
+	Name         string `yaml:"name" json:"name"`
+	Score        int    `yaml:"score" json:"score"`
+	DisplayOrder int    `yaml:"display_order" json:"display_order"`
+}
+
+// ValidationError はフィクスチャファイルのスキーマ違反を表現するエラーです
+type ValidationError struct {
+	Source   string
+	Problems []string
+}
+
+// Error はスキーマ違反の一覧を1つのメッセージにまとめて返します
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("フィクスチャ %s の検証に失敗しました:\n  - %s", e.Source, strings.Join(e.Problems, "\n  - "))
+}
+
+// validator はスキーマ違反を収集する構造体です
+type validator struct {
+	problems []string
+}
+
+// addf はスキーマ違反を追加します
+func (v *validator) addf(path, format string, args ...interface{}) {
+	v.problems = append(v.problems, path+": "+fmt.Sprintf(format, args...))
+}
+
+// name は名前の必須・長さをチェックします
+func (v *validator) name(path, name string, maxLen int, inBytes bool) {
+	length := len([]rune(name))
+	unit := "文字"
+
+	if inBytes {
+		length = len(name)
+		unit = "バイト"
+	}
+
+	switch {
+	case strings.TrimSpace(name) == "":
+		v.addf(path, "名前は必須です")
+	case length > maxLen:
+		v.addf(path, "名前は%d%s以下である必要があります", maxLen, unit)
+	}
+}
+
+// oneOf は値が候補に含まれるかをチェックします
+func (v *validator) oneOf(path, value string, candidates []string) {
+	for _, c := range candidates {
+		if value == c {
+			return
+		}
+	}
+
+	v.addf(path, "「%s」は %s のいずれかである必要があります", value, strings.Join(candidates, ", "))
+}
+
+// between は値が範囲内かをチェックします
+func (v *validator) between(path, field string, value, minValue, maxValue int) {
+	if value < minValue || value > maxValue {
+		v.addf(path, "%sは%dから%dの範囲である必要があります", field, minValue, maxValue)
+	}
+}
+
+// unique は兄弟要素の自然キーの重複をチェックします
+func (v *validator) unique(path string, keys []string) {
+	seen := make(map[string]bool, len(keys))
+
+	for _, key := range keys {
+		if seen[key] {
+			v.addf(path, "「%s」が重複しています", key)
+		}
+
+		seen[key] = true
+	}
+}
+
+// Validate はフィクスチャのスキーマ検証を行います
+// 全ての違反を収集し、違反がある場合は *ValidationError を返します
+func (f *Fixture) Validate() error {
+	v := &validator{}
+
+	if f.Version != FixtureVersion {
+		v.addf("version", "サポートしていないバージョンです（%dである必要があります）", FixtureVersion)
+	}
+
+	v.filterOptions("filter_options", f.FilterOptions, "")
+
+	names := make([]string, 0, len(f.Universities))
+	for i, u := range f.Universities {
+		v.university(fmt.Sprintf("universities[%d]", i), u)
+		names = append(names, u.Name)
+	}
+
+	v.unique("universities", names)
+
+	if len(v.problems) > 0 {
+		return &ValidationError{Source: f.Source, Problems: v.problems}
+	}
+
+	return nil
+}
+
+// filterOptions はフィルターオプションを検証します
+// 子要素のカテゴリは親カテゴリとの組み合わせも検証します
+func (v *validator) filterOptions(path string, options []FilterOptionFixture, parentCategory string) {
+	keys := make([]string, 0, len(options))
+
+	for i, o := range options {
+		p := fmt.Sprintf("%s[%d]", path, i)
+
+		// カテゴリ・名前の長さ・表示順はモデルのバリデーションに従う
+		option := models.FilterOption{
+			BaseModel:    models.BaseModel{Version: 1},
+			Category:     o.Category,
+			Name:         o.Name,
+			DisplayOrder: o.DisplayOrder,
+		}
+		if err := option.Validate(); err != nil {
+			v.addf(p, "%v", err)
+		}
+
+		switch {
+		case parentCategory == models.FilterCategoryRegion && o.Category != models.FilterCategoryPrefecture,
+			parentCategory == models.FilterCategoryClassification && o.Category != models.FilterCategorySubClassification:
+			v.addf(p+".category", "%sの子要素に%sは指定できません", parentCategory, o.Category)
+		case parentCategory == "" && (o.Category == models.FilterCategoryPrefecture ||
+			o.Category == models.FilterCategorySubClassification):
+			v.addf(p+".category", "%sは親要素の子として指定する必要があります", o.Category)
+		}
+
+		keys = append(keys, o.Category+"/"+o.Name)
+
+		v.filterOptions(p+".children", o.Children, o.Category)
+	}
+
+	v.unique(path, keys)
+}
+
+// university は大学と配下の要素を検証します
+func (v *validator) university(path string, u UniversityFixture) {
+	v.name(path+".name", u.Name, maxNameBytes, true)
+
+	regions := make([]string, 0, len(u.Regions))
+	for i, r := range u.Regions {
+		p := fmt.Sprintf("%s.regions[%d]", path, i)
+		v.name(p+".name", r.Name, maxNameBytes, true)
+
+		for j, pref := range r.Prefectures {
+			v.name(fmt.Sprintf("%s.prefectures[%d]", p, j), pref, maxNameBytes, true)
+		}
+
+		v.unique(p+".prefectures", r.Prefectures)
+		regions = append(regions, r.Name)
+	}
+
+	v.unique(path+".regions", regions)
+
+	classifications := make([]string, 0, len(u.Classifications))
+	for i, c := range u.Classifications {
+		p := fmt.Sprintf("%s.classifications[%d]", path, i)
+		v.oneOf(p+".name", c.Name, validClassificationNames)
+
+		for j, sub := range c.SubClassifications {
+			v.name(fmt.Sprintf("%s.sub_classifications[%d]", p, j), sub, maxSubClassificationLen, false)
+		}
+
+		v.unique(p+".sub_classifications", c.SubClassifications)
+		classifications = append(classifications, c.Name)
+	}
+
+	v.unique(path+".classifications", classifications)
+
+	departments := make([]string, 0, len(u.Departments))
+	for i, d := range u.Departments {
+		v.department(fmt.Sprintf("%s.departments[%d]", path, i), d)
+		departments = append(departments, d.Name)
+	}
+
+	v.unique(path+".departments", departments)
+}
+
+// department は学部と配下の学科を検証します
+func (v *validator) department(path string, d DepartmentFixture) {
+	v.name(path+".name", d.Name, maxNameBytes, true)
+
+	majors := make([]string, 0, len(d.Majors))
+	for i, m := range d.Majors {
+		v.major(fmt.Sprintf("%s.majors[%d]", path, i), m)
+		majors = append(majors, m.Name)
+	}
+
+	v.unique(path+".majors", majors)
+}
+
+// major は学科と配下の入試日程を検証します
+func (v *validator) major(path string, m MajorFixture) {
+	v.name(path+".name", m.Name, maxNameBytes, true)
+
+	for i, field := range m.AcademicFields {
+		v.name(fmt.Sprintf("%s.academic_fields[%d]", path, i), field, maxAcademicFieldLen, false)
+	}
+
+	v.unique(path+".academic_fields", m.AcademicFields)
+
+	schedules := make([]string, 0, len(m.AdmissionSchedules))
+	for i, s := range m.AdmissionSchedules {
+		v.schedule(fmt.Sprintf("%s.admission_schedules[%d]", path, i), s)
+		schedules = append(schedules, s.Name)
+	}
+
+	v.unique(path+".admission_schedules", schedules)
+}
+
+// schedule は入試日程と配下の入試情報・試験種別を検証します
+func (v *validator) schedule(path string, s AdmissionScheduleFixture) {
+	v.oneOf(path+".name", s.Name, models.Schedules().Names())
+	v.between(path, "表示順", s.DisplayOrder, 0, models.MaxScheduleDisplayOrder)
+
+	years := make([]string, 0, len(s.AdmissionInfos))
+	for i, info := range s.AdmissionInfos {
+		p := fmt.Sprintf("%s.admission_infos[%d]", path, i)
+		v.between(p, "学年度", info.AcademicYear, minAcademicYear, maxAcademicYear)
+		v.between(p, "募集人員", info.Enrollment, 1, maxEnrollment)
+
+		if info.Status != "" {
+			v.oneOf(p+".status", info.Status, validStatuses)
+		}
+
+		years = append(years, fmt.Sprint(info.AcademicYear))
+	}
+
+	v.unique(path+".admission_infos", years)
+
+	testTypes := make([]string, 0, len(s.TestTypes))
+	for i, t := range s.TestTypes {
+		p := fmt.Sprintf("%s.test_types[%d]", path, i)
+		v.oneOf(p+".name", t.Name, models.TestTypes().Names())
+
+		subjects := make([]string, 0, len(t.Subjects))
+		for j, sub := range t.Subjects {
+			sp := fmt.Sprintf("%s.subjects[%d]", p, j)
+			v.name(sp+".name", sub.Name, maxNameBytes, true)
+			v.between(sp, "配点", sub.Score, 0, maxScore)
+			v.between(sp, "表示順", sub.DisplayOrder, 0, maxDisplayOrder)
+			subjects = append(subjects, sub.Name)
+		}
+
+		v.unique(p+".subjects", subjects)
+		testTypes = append(testTypes, t.Name)
+	}
+
+	v.unique(path+".test_types", testTypes)
+}
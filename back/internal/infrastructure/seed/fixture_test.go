@@ -0,0 +1,138 @@
+package seed
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// validFixture は検証を通過するフィクスチャを返します
+func validFixture() *Fixture {
+	return &Fixture{
+		Version: FixtureVersion,
+		Source:  "test.yaml",
+		FilterOptions: []FilterOptionFixture{
+			{Category: "REGION", Name: "関東", DisplayOrder: 1, Children: []FilterOptionFixture{
+				{Category: "PREFECTURE", Name: "東京", DisplayOrder: 1},
+			}},
+		},
+		Universities: []UniversityFixture{{
+			Name:            "一橋大学",
+			Regions:         []RegionFixture{{Name: "関東", Prefectures: []string{"東京"}}},
+			Classifications: []ClassificationFixture{{Name: "国公立", SubClassifications: []string{"東京一工"}}},
+			Departments: []DepartmentFixture{{
+				Name: "経済学部",
+				Majors: []MajorFixture{{
+					Name:           "経済学科",
+					AcademicFields: []string{"経済学"},
+					AdmissionSchedules: []AdmissionScheduleFixture{{
+						Name:           "前",
+						DisplayOrder:   1,
+						AdmissionInfos: []AdmissionInfoFixture{{AcademicYear: 2025, Enrollment: 100, Status: "published"}},
+						TestTypes: []TestTypeFixture{
+							{Name: "共通", Subjects: []SubjectFixture{{Name: "英語", Score: 200, DisplayOrder: 1}}},
+							{Name: "二次", Subjects: []SubjectFixture{{Name: "数学", Score: 300, DisplayOrder: 1}}},
+						},
+					}},
+				}},
+			}},
+		}},
+	}
+}
+
+func TestFixtureValidate(t *testing.T) {
+	require.NoError(t, validFixture().Validate())
+
+	tests := []struct {
+		name    string
+		modify  func(f *Fixture)
+		problem string
+	}{
+		{
+			name:    "未対応のバージョン",
+			modify:  func(f *Fixture) { f.Version = 2 },
+			problem: "version: サポートしていないバージョンです（1である必要があります）",
+		},
+		{
+			name:    "大学名の重複",
+			modify:  func(f *Fixture) { f.Universities = append(f.Universities, f.Universities[0]) },
+			problem: "universities: 「一橋大学」が重複しています",
+		},
+		{
+			name: "大学名の長さ",
+			modify: func(f *Fixture) {
+				f.Universities[0].Name = "とてもとても長い名前の大学"
+			},
+			problem: "universities[0].name: 名前は20バイト以下である必要があります",
+		},
+		{
+			name: "不正な日程",
+			modify: func(f *Fixture) {
+				f.Universities[0].Departments[0].Majors[0].AdmissionSchedules[0].Name = "春"
+			},
+			problem: "universities[0].departments[0].majors[0].admission_schedules[0].name: 「春」は " +
+				"前, 中, 後, A方式, B方式, 全学部日程, 共通テスト利用（前期）, 共通テスト利用（後期） のいずれかである必要があります",
+		},
+		{
+			name: "配点の範囲",
+			modify: func(f *Fixture) {
+				f.Universities[0].Departments[0].Majors[0].AdmissionSchedules[0].TestTypes[0].Subjects[0].Score = 1001
+			},
+			problem: "universities[0].departments[0].majors[0].admission_schedules[0].test_types[0].subjects[0]: " +
+				"配点は0から1000の範囲である必要があります",
+		},
+		{
+			name: "不正なステータス",
+			modify: func(f *Fixture) {
+				f.Universities[0].Departments[0].Majors[0].AdmissionSchedules[0].AdmissionInfos[0].Status = "open"
+			},
+			problem: "universities[0].departments[0].majors[0].admission_schedules[0].admission_infos[0].status: " +
+				"「open」は draft, published, archived のいずれかである必要があります",
+		},
+		{
+			name: "親子カテゴリの不整合",
+			modify: func(f *Fixture) {
+				f.FilterOptions[0].Children[0].Category = "SCHEDULE"
+			},
+			problem: "filter_options[0].children[0].category: REGIONの子要素にSCHEDULEは指定できません",
+		},
+		{
+			name: "親のない都道府県",
+			modify: func(f *Fixture) {
+				f.FilterOptions = append(f.FilterOptions, FilterOptionFixture{Category: "PREFECTURE", Name: "大阪"})
+			},
+			problem: "filter_options[1].category: PREFECTUREは親要素の子として指定する必要があります",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := validFixture()
+			tt.modify(f)
+
+			err := f.Validate()
+			require.Error(t, err)
+
+			var validationErr *ValidationError
+			require.True(t, errors.As(err, &validationErr))
+			assert.Equal(t, "test.yaml", validationErr.Source)
+			assert.Contains(t, validationErr.Problems, tt.problem)
+		})
+	}
+}
+
+func TestFixtureValidateCollectsAllProblems(t *testing.T) {
+	f := validFixture()
+	f.Version = 0
+	f.Universities[0].Departments[0].Name = ""
+	f.Universities[0].Departments[0].Majors[0].AdmissionSchedules[0].TestTypes[1].Name = "面接"
+
+	err := f.Validate()
+
+	var validationErr *ValidationError
+	require.True(t, errors.As(err, &validationErr))
+	assert.Len(t, validationErr.Problems, 3)
+	assert.Contains(t, err.Error(), "フィクスチャ test.yaml の検証に失敗しました")
+}
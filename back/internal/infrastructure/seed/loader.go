@@ -0,0 +1,140 @@
+package seed
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ProfilesFile はフィクスチャのディレクトリに置くプロファイル定義ファイル名です
+const ProfilesFile = "profiles.yaml"
+
+// Profiles はプロファイル定義ファイルの内容を表現する構造体です
+// プロファイル名ごとに、投入するフィクスチャファイルをディレクトリからの相対パスで列挙します
+type Profiles struct {
+	Version  int                 `yaml:"version"`
+	Profiles map[string][]string `yaml:"profiles"`
+}
+
+// Names はプロファイル名の一覧を昇順で返します
+func (p *Profiles) Names() []string {
+	names := make([]string, 0, len(p.Profiles))
+	for name := range p.Profiles {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// LoadProfiles はディレクトリのプロファイル定義ファイルを読み込みます
+func LoadProfiles(dir string) (*Profiles, error) {
+	path := filepath.Join(dir, ProfilesFile)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("プロファイル定義の読み込みに失敗しました: %w", err)
+	}
+
+	var profiles Profiles
+	if err := decodeYAML(data, &profiles); err != nil {
+		return nil, fmt.Errorf("プロファイル定義 %s の形式が不正です: %w", path, err)
+	}
+
+	if profiles.Version != FixtureVersion {
+		return nil, fmt.Errorf("プロファイル定義 %s はサポートしていないバージョンです（%dである必要があります）",
+			path, FixtureVersion)
+	}
+
+	return &profiles, nil
+}
+
+// LoadProfile は指定したプロファイルのフィクスチャファイルを定義順に読み込み、検証します
+// フィクスチャファイルのパスはディレクトリ外を指すことはできません
+func LoadProfile(dir, profile string) ([]*Fixture, error) {
+	profiles, err := LoadProfiles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	files, ok := profiles.Profiles[profile]
+	if !ok {
+		return nil, fmt.Errorf("プロファイル「%s」は定義されていません（%s）", profile, strings.Join(profiles.Names(), ", "))
+	}
+
+	fixtures := make([]*Fixture, 0, len(files))
+
+	for _, file := range files {
+		if !filepath.IsLocal(file) {
+			return nil, fmt.Errorf("プロファイル「%s」のフィクスチャ %s はディレクトリ外を指しています", profile, file)
+		}
+
+		fixture, err := LoadFile(filepath.Join(dir, file))
+		if err != nil {
+			return nil, err
+		}
+
+		fixtures = append(fixtures, fixture)
+	}
+
+	return fixtures, nil
+}
+
+// LoadFile はフィクスチャファイルを読み込み、検証します
+// 拡張子が .json の場合はJSON、.yaml・.yml の場合はYAMLとして読み込み、未知のフィールドはエラーとします
+func LoadFile(path string) (*Fixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("フィクスチャの読み込みに失敗しました: %w", err)
+	}
+
+	var fixture Fixture
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(&fixture)
+	case ".yaml", ".yml":
+		err = decodeYAML(data, &fixture)
+	default:
+		return nil, fmt.Errorf("フィクスチャ %s の形式に対応していません（.yaml・.yml・.jsonのいずれかである必要があります）", path)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("フィクスチャ %s の形式が不正です: %w", path, err)
+	}
+
+	fixture.Source = path
+
+	if err := fixture.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &fixture, nil
+}
+
+// decodeYAML は未知のフィールドを許可せずにYAMLを読み込みます
+func decodeYAML(data []byte, out interface{}) error {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+
+	if err := dec.Decode(out); err != nil {
+		if errors.Is(err, io.EOF) {
+			return errors.New("内容が空です")
+		}
+
+		return err
+	}
+
+	return nil
+}
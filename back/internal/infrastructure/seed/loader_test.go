@@ -0,0 +1,110 @@
+package seed
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// repositoryFixtureDir はリポジトリに含まれるフィクスチャのディレクトリです
+const repositoryFixtureDir = "../../../migrations/seeds/fixtures"
+
+// writeFiles はテスト用のファイルを一時ディレクトリに作成します
+func writeFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+
+	return dir
+}
+
+func TestLoadProfile(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		ProfilesFile: "version: 1\nprofiles:\n  test: [options.yaml, universities/one.json]\n  bad: [../outside.yaml]\n",
+		"options.yaml": "version: 1\nfilter_options:\n" +
+			"  - {category: SCHEDULE, name: 前, display_order: 1}\n",
+		"universities/one.json": `{"version": 1, "universities": [{"name": "一橋大学"}]}`,
+	})
+
+	t.Run("定義順に読み込む", func(t *testing.T) {
+		fixtures, err := LoadProfile(dir, "test")
+		require.NoError(t, err)
+		require.Len(t, fixtures, 2)
+		assert.Equal(t, "前", fixtures[0].FilterOptions[0].Name)
+		assert.Equal(t, "一橋大学", fixtures[1].Universities[0].Name)
+		assert.Equal(t, filepath.Join(dir, "universities/one.json"), fixtures[1].Source)
+	})
+
+	t.Run("未定義のプロファイル", func(t *testing.T) {
+		_, err := LoadProfile(dir, "prod")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "bad, test")
+	})
+
+	t.Run("ディレクトリ外のファイル", func(t *testing.T) {
+		_, err := LoadProfile(dir, "bad")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "ディレクトリ外")
+	})
+}
+
+func TestLoadFileErrors(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"unknown.yaml": "version: 1\nuniversity: []\n",
+		"unknown.json": `{"version": 1, "unknown": true}`,
+		"empty.yaml":   "",
+		"invalid.yaml": "version: 1\nuniversities:\n  - name: 一橋大学\n    departments:\n      - name: ''\n",
+		"data.csv":     "university\n",
+	})
+
+	tests := []struct {
+		file string
+		want string
+	}{
+		{file: "unknown.yaml", want: "field university not found"},
+		{file: "unknown.json", want: "unknown field"},
+		{file: "empty.yaml", want: "内容が空です"},
+		{file: "data.csv", want: "形式に対応していません"},
+		{file: "missing.yaml", want: "読み込みに失敗しました"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			_, err := LoadFile(filepath.Join(dir, tt.file))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+
+	t.Run("スキーマ違反", func(t *testing.T) {
+		_, err := LoadFile(filepath.Join(dir, "invalid.yaml"))
+
+		var validationErr *ValidationError
+		require.True(t, errors.As(err, &validationErr))
+		assert.Equal(t, []string{"universities[0].departments[0].name: 名前は必須です"}, validationErr.Problems)
+	})
+}
+
+func TestRepositoryFixturesAreValid(t *testing.T) {
+	profiles, err := LoadProfiles(repositoryFixtureDir)
+	require.NoError(t, err)
+	assert.Subset(t, profiles.Names(), []string{"demo", "dev", "test"})
+
+	for _, name := range profiles.Names() {
+		t.Run(name, func(t *testing.T) {
+			fixtures, err := LoadProfile(repositoryFixtureDir, name)
+			require.NoError(t, err)
+			assert.NotEmpty(t, fixtures)
+		})
+	}
+}
@@ -0,0 +1,607 @@
+package seed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"university-exam-api/internal/domain/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// seedActor はシードで登録・更新したレコードの作成者・更新者です
+const seedActor = "seed"
+
+// defaultStatus は入試情報のステータスを省略した場合の値です
+const defaultStatus = "draft"
+
+// 集計対象のエンティティ名
+const (
+	EntityFilterOption      = "filter_option"
+	EntityUniversity        = "university"
+	EntityRegion            = "region"
+	EntityPrefecture        = "prefecture"
+	EntityClassification    = "classification"
+	EntitySubClassification = "sub_classification"
+	EntityDepartment        = "department"
+	EntityMajor             = "major"
+	EntityAcademicField     = "academic_field"
+	EntityAdmissionSchedule = "admission_schedule"
+	EntityAdmissionInfo     = "admission_info"
+	EntityTestType          = "test_type"
+	EntitySubject           = "subject"
+)
+
+// Counts はエンティティごとの投入結果の件数です
+type Counts struct {
+	Created   int `json:"created"`
+	Updated   int `json:"updated"`
+	Unchanged int `json:"unchanged"`
+	Skipped   int `json:"skipped"`
+}
+
+// Result はシードの投入結果を表現する構造体です
+type Result struct {
+	Counts map[string]*Counts `json:"counts"`
+}
+
+// count はエンティティの件数を返します（未集計の場合は作成します）
+func (r *Result) count(entity string) *Counts {
+	c, ok := r.Counts[entity]
+	if !ok {
+		c = &Counts{}
+		r.Counts[entity] = c
+	}
+
+	return c
+}
+
+// Entities は集計したエンティティ名の一覧を昇順で返します
+func (r *Result) Entities() []string {
+	entities := make([]string, 0, len(r.Counts))
+	for entity := range r.Counts {
+		entities = append(entities, entity)
+	}
+
+	sort.Strings(entities)
+
+	return entities
+}
+
+// Seeder はフィクスチャをデータベースに投入する構造体です
+type Seeder struct {
+	db *gorm.DB
+}
+
+// NewSeeder は新しいSeederを作成します
+func NewSeeder(db *gorm.DB) *Seeder {
+	return &Seeder{db: db}
+}
+
+// Apply はフィクスチャを1つのトランザクションで投入します。
+// この関数は以下の処理を行います：
+// - 自然キー（親のIDと名前、入試情報は学年度）による既存レコードの検索
+// - 存在しないレコードの作成と、差分のある項目のみの更新
+// - 入試日程ごとの科目の配点比率の再計算
+// フィクスチャに含まれないレコードや、削除済みの大学には変更を加えません
+func (s *Seeder) Apply(ctx context.Context, fixtures []*Fixture) (*Result, error) {
+	result := &Result{Counts: make(map[string]*Counts)}
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		a := &applier{tx: tx, result: result}
+
+		for _, f := range fixtures {
+			if err := a.filterOptions(f.FilterOptions, nil); err != nil {
+				return fmt.Errorf("%s: %w", f.Source, err)
+			}
+
+			for _, u := range f.Universities {
+				if err := a.university(u); err != nil {
+					return fmt.Errorf("%s: 大学「%s」の投入に失敗しました: %w", f.Source, u.Name, err)
+				}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// applier はトランザクション内でフィクスチャを投入する構造体です
+type applier struct {
+	tx     *gorm.DB
+	result *Result
+}
+
+// changes は更新する列名と値です
+type changes map[string]interface{}
+
+// set は現在値と登録内容が異なる場合に更新対象に追加します
+func (c changes) set(column string, current, desired interface{}) changes {
+	if current != desired {
+		c[column] = desired
+	}
+
+	return c
+}
+
+// newBaseModel は新規作成するレコードの基本フィールドを返します
+func newBaseModel() models.BaseModel {
+	return models.BaseModel{Version: 1, CreatedBy: seedActor, UpdatedBy: seedActor}
+}
+
+// find は削除されていないレコードを条件で検索します
+func (a *applier) find(dest interface{}, query string, args ...interface{}) (bool, error) {
+	err := a.tx.Where(query, args...).Where("deleted_at IS NULL").Take(dest).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// create は関連を除いてレコードを作成します
+func (a *applier) create(entity string, record interface{}) error {
+	if err := a.tx.Omit(clause.Associations).Create(record).Error; err != nil {
+		return fmt.Errorf("%sの作成に失敗しました: %w", entity, err)
+	}
+
+	a.result.count(entity).Created++
+
+	return nil
+}
+
+// update は差分のある項目を更新し、バージョンを1つ進めます
+func (a *applier) update(entity string, model interface{}, id uint, c changes) error {
+	if len(c) == 0 {
+		a.result.count(entity).Unchanged++
+		return nil
+	}
+
+	c["version"] = gorm.Expr("version + 1")
+	c["updated_by"] = seedActor
+
+	if err := a.tx.Model(model).Where("id = ?", id).UpdateColumns(map[string]interface{}(c)).Error; err != nil {
+		return fmt.Errorf("%sの更新に失敗しました: %w", entity, err)
+	}
+
+	a.result.count(entity).Updated++
+
+	return nil
+}
+
+// filterOptions はフィルターオプションを親子の順に投入します
+func (a *applier) filterOptions(options []FilterOptionFixture, parentID *uint) error {
+	for _, o := range options {
+		var option models.FilterOption
+
+		query, args := "category = ? AND name = ? AND parent_id IS NULL", []interface{}{o.Category, o.Name}
+		if parentID != nil {
+			query, args = "category = ? AND name = ? AND parent_id = ?", append(args, *parentID)
+		}
+
+		found, err := a.find(&option, query, args...)
+		if err != nil {
+			return err
+		}
+
+		if found {
+			err = a.update(EntityFilterOption, &models.FilterOption{}, option.ID,
+				changes{}.set("display_order", option.DisplayOrder, o.DisplayOrder))
+		} else {
+			option = models.FilterOption{
+				BaseModel:    newBaseModel(),
+				Category:     o.Category,
+				Name:         o.Name,
+				DisplayOrder: o.DisplayOrder,
+				ParentID:     parentID,
+			}
+			err = a.create(EntityFilterOption, &option)
+		}
+
+		if err != nil {
+			return fmt.Errorf("フィルターオプション「%s」: %w", o.Name, err)
+		}
+
+		if err := a.filterOptions(o.Children, &option.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// university は大学と配下の要素を投入します
+// 大学名は削除済みのレコードを含めて一意のため、削除済みの大学はスキップします
+func (a *applier) university(u UniversityFixture) error {
+	var university models.University
+
+	err := a.tx.Unscoped().Where("name = ?", u.Name).Take(&university).Error
+
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		university = models.University{BaseModel: newBaseModel(), Name: u.Name}
+		if err := a.create(EntityUniversity, &university); err != nil {
+			return err
+		}
+	case err != nil:
+		return err
+	case university.DeletedAt.Valid:
+		a.result.count(EntityUniversity).Skipped++
+		return nil
+	default:
+		a.result.count(EntityUniversity).Unchanged++
+	}
+
+	for _, r := range u.Regions {
+		if err := a.region(university.ID, r); err != nil {
+			return err
+		}
+	}
+
+	for _, c := range u.Classifications {
+		if err := a.classification(university.ID, c); err != nil {
+			return err
+		}
+	}
+
+	for _, d := range u.Departments {
+		if err := a.department(university.ID, d); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// region は地域と都道府県を投入します
+func (a *applier) region(universityID uint, r RegionFixture) error {
+	var region models.Region
+
+	found, err := a.find(&region, "university_id = ? AND name = ?", universityID, r.Name)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		a.result.count(EntityRegion).Unchanged++
+	} else {
+		region = models.Region{BaseModel: newBaseModel(), UniversityID: universityID, Name: r.Name}
+		if err := a.create(EntityRegion, &region); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range r.Prefectures {
+		var prefecture models.Prefecture
+
+		found, err := a.find(&prefecture, "region_id = ? AND name = ?", region.ID, name)
+		if err != nil {
+			return err
+		}
+
+		if found {
+			a.result.count(EntityPrefecture).Unchanged++
+			continue
+		}
+
+		prefecture = models.Prefecture{BaseModel: newBaseModel(), RegionID: region.ID, Name: name}
+		if err := a.create(EntityPrefecture, &prefecture); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// classification は設置区分と小分類を投入します
+func (a *applier) classification(universityID uint, c ClassificationFixture) error {
+	var classification models.Classification
+
+	found, err := a.find(&classification, "university_id = ? AND name = ?", universityID, c.Name)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		a.result.count(EntityClassification).Unchanged++
+	} else {
+		classification = models.Classification{BaseModel: newBaseModel(), UniversityID: universityID, Name: c.Name}
+		if err := a.create(EntityClassification, &classification); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range c.SubClassifications {
+		var sub models.SubClassification
+
+		found, err := a.find(&sub, "classification_id = ? AND name = ?", classification.ID, name)
+		if err != nil {
+			return err
+		}
+
+		if found {
+			a.result.count(EntitySubClassification).Unchanged++
+			continue
+		}
+
+		sub = models.SubClassification{BaseModel: newBaseModel(), ClassificationID: classification.ID, Name: name}
+		if err := a.create(EntitySubClassification, &sub); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// department は学部と配下の学科を投入します
+func (a *applier) department(universityID uint, d DepartmentFixture) error {
+	var department models.Department
+
+	found, err := a.find(&department, "university_id = ? AND name = ?", universityID, d.Name)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		a.result.count(EntityDepartment).Unchanged++
+	} else {
+		department = models.Department{BaseModel: newBaseModel(), UniversityID: universityID, Name: d.Name}
+		if err := a.create(EntityDepartment, &department); err != nil {
+			return err
+		}
+	}
+
+	for _, m := range d.Majors {
+		if err := a.major(department.ID, m); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// major は学科と配下の学問系統・入試日程を投入します
+func (a *applier) major(departmentID uint, m MajorFixture) error {
+	var major models.Major
+
+	found, err := a.find(&major, "department_id = ? AND name = ?", departmentID, m.Name)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		a.result.count(EntityMajor).Unchanged++
+	} else {
+		major = models.Major{BaseModel: newBaseModel(), DepartmentID: departmentID, Name: m.Name}
+		if err := a.create(EntityMajor, &major); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range m.AcademicFields {
+		var field models.AcademicField
+
+		found, err := a.find(&field, "major_id = ? AND name = ?", major.ID, name)
+		if err != nil {
+			return err
+		}
+
+		if found {
+			a.result.count(EntityAcademicField).Unchanged++
+			continue
+		}
+
+		field = models.AcademicField{BaseModel: newBaseModel(), MajorID: major.ID, Name: name}
+		if err := a.create(EntityAcademicField, &field); err != nil {
+			return err
+		}
+	}
+
+	for _, s := range m.AdmissionSchedules {
+		if err := a.schedule(major.ID, s); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// schedule は入試日程と配下の入試情報・試験種別・科目を投入し、配点比率を再計算します
+func (a *applier) schedule(majorID uint, s AdmissionScheduleFixture) error {
+	var schedule models.AdmissionSchedule
+
+	found, err := a.find(&schedule, "major_id = ? AND name = ?", majorID, s.Name)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		err = a.update(EntityAdmissionSchedule, &models.AdmissionSchedule{}, schedule.ID,
+			changes{}.set("display_order", schedule.DisplayOrder, s.DisplayOrder))
+	} else {
+		schedule = models.AdmissionSchedule{
+			BaseModel:    newBaseModel(),
+			MajorID:      majorID,
+			Name:         s.Name,
+			DisplayOrder: s.DisplayOrder,
+		}
+		err = a.create(EntityAdmissionSchedule, &schedule)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	for _, info := range s.AdmissionInfos {
+		if err := a.admissionInfo(schedule.ID, info); err != nil {
+			return err
+		}
+	}
+
+	for _, t := range s.TestTypes {
+		if err := a.testType(schedule.ID, t); err != nil {
+			return err
+		}
+	}
+
+	if len(s.TestTypes) == 0 {
+		return nil
+	}
+
+	return a.recalculatePercentages(schedule.ID)
+}
+
+// admissionInfo は年度ごとの入試情報を投入します
+// ステータスを省略した場合、既存の入試情報のステータスは変更しません
+func (a *applier) admissionInfo(scheduleID uint, f AdmissionInfoFixture) error {
+	var info models.AdmissionInfo
+
+	found, err := a.find(&info, "admission_schedule_id = ? AND academic_year = ?", scheduleID, f.AcademicYear)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		c := changes{}.set("enrollment", info.Enrollment, f.Enrollment)
+		if f.Status != "" {
+			c.set("status", info.Status, f.Status)
+		}
+
+		return a.update(EntityAdmissionInfo, &models.AdmissionInfo{}, info.ID, c)
+	}
+
+	status := f.Status
+	if status == "" {
+		status = defaultStatus
+	}
+
+	info = models.AdmissionInfo{
+		BaseModel:           newBaseModel(),
+		AdmissionScheduleID: scheduleID,
+		Enrollment:          f.Enrollment,
+		AcademicYear:        f.AcademicYear,
+		Status:              status,
+	}
+
+	return a.create(EntityAdmissionInfo, &info)
+}
+
+// testType は年度に紐付かない試験種別と科目を投入します
+func (a *applier) testType(scheduleID uint, t TestTypeFixture) error {
+	var testType models.TestType
+
+	found, err := a.find(&testType,
+		"admission_schedule_id = ? AND name = ? AND id NOT IN (SELECT test_type_id FROM admission_info_test_types)",
+		scheduleID, t.Name)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		a.result.count(EntityTestType).Unchanged++
+	} else {
+		testType = models.TestType{BaseModel: newBaseModel(), AdmissionScheduleID: scheduleID, Name: t.Name}
+		if err := a.create(EntityTestType, &testType); err != nil {
+			return err
+		}
+	}
+
+	for _, sub := range t.Subjects {
+		var subject models.Subject
+
+		found, err := a.find(&subject, "test_type_id = ? AND name = ?", testType.ID, sub.Name)
+		if err != nil {
+			return err
+		}
+
+		if found {
+			err = a.update(EntitySubject, &models.Subject{}, subject.ID, changes{}.
+				set("score", subject.Score, sub.Score).
+				set("display_order", subject.DisplayOrder, sub.DisplayOrder))
+		} else {
+			subject = models.Subject{
+				BaseModel:    newBaseModel(),
+				TestTypeID:   testType.ID,
+				Name:         sub.Name,
+				Score:        sub.Score,
+				DisplayOrder: sub.DisplayOrder,
+			}
+			err = a.create(EntitySubject, &subject)
+		}
+
+		if err != nil {
+			return fmt.Errorf("科目「%s」: %w", sub.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// recalculatePercentages は入試日程の年度に紐付かない試験種別の科目の配点比率を再計算します
+// 試験種別レジストリで分母に含める試験種別の換算後の配点の合計を分母とし、小数点以下2桁に丸めます
+// 選択科目群は選択科目群の換算後の配点で1回だけ分母に数えます
+// 分母に含めない試験種別の科目の配点比率は0とします
+func (a *applier) recalculatePercentages(scheduleID uint) error {
+	counted := models.TestTypes().CountedNames()
+
+	scheduleSubjects := func() *gorm.DB {
+		return a.tx.Model(&models.Subject{}).
+			Joins("JOIN test_types ON test_types.id = subjects.test_type_id").
+			Where("test_types.admission_schedule_id = ? AND test_types.deleted_at IS NULL", scheduleID).
+			Where("test_types.id NOT IN (SELECT test_type_id FROM admission_info_test_types)").
+			Where("subjects.deleted_at IS NULL")
+	}
+
+	var subjects []models.Subject
+
+	err := scheduleSubjects().
+		Select("subjects.id, subjects.subject_group_id, subjects.score, subjects.conversion_ratio, subjects.percentage").
+		Where("test_types.name IN ?", counted).
+		Find(&subjects).Error
+	if err != nil {
+		return fmt.Errorf("配点比率の再計算に失敗しました: %w", err)
+	}
+
+	var groups []models.SubjectGroup
+	if ids := models.SubjectGroupIDs(subjects); len(ids) > 0 {
+		if err := a.tx.Where("id IN ?", ids).Find(&groups).Error; err != nil {
+			return fmt.Errorf("配点比率の再計算に失敗しました: %w", err)
+		}
+	}
+
+	total := models.SelectionTotal(subjects, groups)
+
+	for _, s := range subjects {
+		percentage := models.WeightedPercentage(s.EffectiveScore, total)
+		if percentage == s.Percentage {
+			continue
+		}
+
+		if err := a.tx.Model(&models.Subject{}).Where("id = ?", s.ID).
+			UpdateColumn("percentage", percentage).Error; err != nil {
+			return fmt.Errorf("配点比率の再計算に失敗しました: %w", err)
+		}
+	}
+
+	var uncounted []uint
+	if err := scheduleSubjects().Where("test_types.name NOT IN ?", counted).
+		Where("subjects.percentage <> 0").Pluck("subjects.id", &uncounted).Error; err != nil {
+		return fmt.Errorf("配点比率の再計算に失敗しました: %w", err)
+	}
+
+	if len(uncounted) > 0 {
+		if err := a.tx.Model(&models.Subject{}).Where("id IN ?", uncounted).
+			UpdateColumn("percentage", 0).Error; err != nil {
+			return fmt.Errorf("配点比率の再計算に失敗しました: %w", err)
+		}
+	}
+
+	return nil
+}
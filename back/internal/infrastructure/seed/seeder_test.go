@@ -0,0 +1,172 @@
+package seed
+
+import (
+	"context"
+	"testing"
+	"time"
+	"university-exam-api/internal/domain/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupSeedTestDB は全モデルをマイグレーションしたインメモリのSQLiteデータベースを作成します
+func setupSeedTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	require.NoError(t, db.AutoMigrate(
+		&models.University{},
+		&models.Department{},
+		&models.Major{},
+		&models.AdmissionSchedule{},
+		&models.AdmissionInfo{},
+		&models.TestType{},
+		&models.Subject{},
+		&models.Region{},
+		&models.Prefecture{},
+		&models.Classification{},
+		&models.SubClassification{},
+		&models.AcademicField{},
+		&models.FilterOption{},
+	))
+
+	return db
+}
+
+// subjectsByName は科目名ごとの科目を返します
+func subjectsByName(t *testing.T, db *gorm.DB) map[string]models.Subject {
+	t.Helper()
+
+	var subjects []models.Subject
+	require.NoError(t, db.Find(&subjects).Error)
+
+	result := make(map[string]models.Subject, len(subjects))
+	for _, s := range subjects {
+		result[s.Name] = s
+	}
+
+	return result
+}
+
+func TestSeederApply(t *testing.T) {
+	db := setupSeedTestDB(t)
+	seeder := NewSeeder(db)
+	ctx := context.Background()
+
+	result, err := seeder.Apply(ctx, []*Fixture{validFixture()})
+	require.NoError(t, err)
+
+	assert.Equal(t, Counts{Created: 2}, *result.Counts[EntityFilterOption])
+	assert.Equal(t, Counts{Created: 1}, *result.Counts[EntityUniversity])
+	assert.Equal(t, Counts{Created: 2}, *result.Counts[EntitySubject])
+	assert.Contains(t, result.Entities(), EntityAcademicField)
+
+	subjects := subjectsByName(t, db)
+	assert.Equal(t, 40.0, subjects["英語"].Percentage)
+	assert.Equal(t, 60.0, subjects["数学"].Percentage)
+	assert.Equal(t, "seed", subjects["英語"].CreatedBy)
+
+	var child models.FilterOption
+	require.NoError(t, db.Where("name = ?", "東京").Take(&child).Error)
+	require.NotNil(t, child.ParentID)
+
+	t.Run("再実行しても変更しない", func(t *testing.T) {
+		result, err := seeder.Apply(ctx, []*Fixture{validFixture()})
+		require.NoError(t, err)
+
+		for _, entity := range result.Entities() {
+			c := result.Counts[entity]
+			assert.Zero(t, c.Created+c.Updated, entity)
+		}
+
+		var count int64
+		require.NoError(t, db.Model(&models.Subject{}).Count(&count).Error)
+		assert.Equal(t, int64(2), count)
+	})
+
+	t.Run("差分のある項目のみ更新し、既存データは削除しない", func(t *testing.T) {
+		// フィクスチャにない科目を追加しておく
+		var testType models.TestType
+		require.NoError(t, db.Where("name = ?", "二次").Take(&testType).Error)
+		require.NoError(t, db.Create(&models.Subject{
+			BaseModel:  models.BaseModel{Version: 1},
+			TestTypeID: testType.ID,
+			Name:       "小論文",
+			Score:      500,
+		}).Error)
+
+		// 管理画面で公開状態を変更した想定
+		require.NoError(t, db.Model(&models.AdmissionInfo{}).Where("academic_year = ?", 2025).
+			Update("status", "archived").Error)
+
+		f := validFixture()
+		schedule := &f.Universities[0].Departments[0].Majors[0].AdmissionSchedules[0]
+		schedule.TestTypes[1].Subjects[0].Score = 500
+		schedule.AdmissionInfos[0].Status = ""
+		schedule.AdmissionInfos[0].Enrollment = 110
+
+		result, err := seeder.Apply(ctx, []*Fixture{f})
+		require.NoError(t, err)
+		assert.Equal(t, Counts{Updated: 1, Unchanged: 1}, *result.Counts[EntitySubject])
+		assert.Equal(t, Counts{Updated: 1}, *result.Counts[EntityAdmissionInfo])
+
+		subjects := subjectsByName(t, db)
+		assert.Equal(t, 500, subjects["数学"].Score)
+		assert.Equal(t, 2, subjects["数学"].Version)
+		assert.Equal(t, 16.67, subjects["英語"].Percentage)
+		assert.Equal(t, 41.67, subjects["小論文"].Percentage)
+
+		var info models.AdmissionInfo
+		require.NoError(t, db.Take(&info).Error)
+		assert.Equal(t, 110, info.Enrollment)
+		assert.Equal(t, "archived", info.Status)
+	})
+}
+
+func TestSeederApplySkipsDeletedUniversity(t *testing.T) {
+	db := setupSeedTestDB(t)
+
+	require.NoError(t, db.Create(&models.University{
+		BaseModel: models.BaseModel{Version: 1, DeletedAt: gorm.DeletedAt{Time: time.Now(), Valid: true}},
+		Name:      "一橋大学",
+	}).Error)
+
+	result, err := NewSeeder(db).Apply(context.Background(), []*Fixture{validFixture()})
+	require.NoError(t, err)
+	assert.Equal(t, Counts{Skipped: 1}, *result.Counts[EntityUniversity])
+	assert.Nil(t, result.Counts[EntityDepartment])
+}
+
+func TestSeederApplyRollsBackOnError(t *testing.T) {
+	db := setupSeedTestDB(t)
+
+	f := validFixture()
+	f.Universities = append(f.Universities, UniversityFixture{Name: ""})
+
+	_, err := NewSeeder(db).Apply(context.Background(), []*Fixture{f})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "test.yaml")
+
+	var count int64
+	require.NoError(t, db.Model(&models.University{}).Count(&count).Error)
+	assert.Zero(t, count)
+}
+
+func TestSeederApplyRepositoryFixtures(t *testing.T) {
+	db := setupSeedTestDB(t)
+
+	fixtures, err := LoadProfile(repositoryFixtureDir, "demo")
+	require.NoError(t, err)
+
+	_, err = NewSeeder(db).Apply(context.Background(), fixtures)
+	require.NoError(t, err)
+
+	var universities int64
+	require.NoError(t, db.Model(&models.University{}).Count(&universities).Error)
+	assert.Equal(t, int64(4), universities)
+}
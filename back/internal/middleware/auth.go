@@ -3,6 +3,8 @@
 // - JWTトークンによる認証
 // - ロールベースのアクセス制御
 // - 公開パスの管理
+// - 監査ログ用の操作者の設定
+// - URLで指定された要素の親子関係の検証
 package middleware
 
 import (
@@ -11,6 +13,7 @@ import (
 	"os"
 	"strings"
 	"time"
+	"university-exam-api/internal/infrastructure/audit"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/labstack/echo/v4"
@@ -25,6 +28,8 @@ const (
 	RefreshTokenExpiration = 7 * 24 * time.Hour
 	// MinSecretLength はJWTシークレットの最小長です
 	MinSecretLength = 32
+	// RoleAdmin は管理者のロールです
+	RoleAdmin = "admin"
 )
 
 // AuthError は認証関連のエラーを表します。
@@ -98,13 +103,54 @@ func AuthMiddleware() echo.MiddlewareFunc {
 				return handleAuthError(c, err)
 			}
 
-			c.Set("user", user)
+			setUser(c, user)
 
 			return next(c)
 		}
 	}
 }
 
+// IdentifyUser は認証を必須とせずにユーザーを識別するミドルウェアです。
+// このミドルウェアは以下の処理を行います：
+// - Authorizationヘッダーがない場合はそのまま次の処理へ進む
+// - トークンが指定された場合の検証
+// - ユーザー情報と操作者の設定
+func IdentifyUser() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			auth := c.Request().Header.Get("Authorization")
+			if auth == "" {
+				return next(c)
+			}
+
+			token, err := validateAuthHeader(auth)
+			if err != nil {
+				return handleAuthError(c, err)
+			}
+
+			user, err := validateAndGetUser(token)
+			if err != nil {
+				return handleAuthError(c, err)
+			}
+
+			setUser(c, user)
+
+			return next(c)
+		}
+	}
+}
+
+// setUser はユーザー情報をコンテキストに設定します。
+// この関数は以下の処理を行います：
+// - ユーザー情報の設定
+// - 監査ログ用の操作者（JWTのsubject）のリクエストコンテキストへの設定
+func setUser(c echo.Context, user map[string]string) {
+	c.Set("user", user)
+
+	req := c.Request()
+	c.SetRequest(req.WithContext(audit.WithActor(req.Context(), user["id"])))
+}
+
 // validateToken はトークンの署名と有効性を検証します。
 // この関数は以下の処理を行います：
 // - シークレットの検証
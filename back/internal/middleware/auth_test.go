@@ -11,6 +11,7 @@ import (
 	"os"
 	"testing"
 	"time"
+	"university-exam-api/internal/infrastructure/audit"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/labstack/echo/v4"
@@ -241,3 +242,70 @@ func TestIsPublicPath(t *testing.T) {
 		})
 	}
 }
+
+// TestIdentifyUser は任意認証によるユーザーの識別をテストします。
+// このテストは以下のケースを検証します：
+// - Authorizationヘッダーなし
+// - 有効なトークン
+// - 無効なトークン
+func TestIdentifyUser(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test_secret_that_is_long_enough_for_jwt")
+
+	claims := jwt.MapClaims{
+		"sub":  "test_user",
+		"role": "admin",
+		"exp":  time.Now().Add(TokenExpiration).Unix(),
+	}
+	validToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(os.Getenv("JWT_SECRET")))
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		header    string
+		wantCode  int
+		wantActor string
+	}{
+		{
+			name:      "Authorizationヘッダーなし",
+			header:    "",
+			wantCode:  http.StatusOK,
+			wantActor: "",
+		},
+		{
+			name:      "有効なトークン",
+			header:    BearerTokenPrefix + validToken,
+			wantCode:  http.StatusOK,
+			wantActor: "test_user",
+		},
+		{
+			name:     "無効なトークン",
+			header:   BearerTokenPrefix + "invalid_token",
+			wantCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/api/universities", nil)
+
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			var actor string
+
+			handler := IdentifyUser()(func(c echo.Context) error {
+				actor = audit.ActorFromContext(c.Request().Context())
+				return c.NoContent(http.StatusOK)
+			})
+
+			assert.NoError(t, handler(c))
+			assert.Equal(t, tt.wantCode, rec.Code)
+			assert.Equal(t, tt.wantActor, actor)
+		})
+	}
+}
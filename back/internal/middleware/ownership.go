@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"context"
+	"university-exam-api/internal/pkg/errors"
+	"university-exam-api/internal/pkg/validation"
+	"university-exam-api/internal/repositories"
+
+	"github.com/labstack/echo/v4"
+)
+
+// 親子関係の検証に使用するパスパラメータ名
+const (
+	ParamUniversityID    = "universityID"
+	ParamDepartmentID    = "departmentID"
+	ParamMajorID         = "majorID"
+	ParamScheduleID      = "scheduleID"
+	ParamAdmissionInfoID = "infoID"
+	ParamTestTypeID      = "testTypeID"
+	ParamScheduleEventID = "eventID"
+)
+
+// OwnershipVerifier はURLで指定された要素の親子関係を検証するインターフェースです
+type OwnershipVerifier interface {
+	VerifyPath(ctx context.Context, path repositories.ResourcePath) error
+}
+
+// pathParam はパスパラメータと、解析したIDの格納先です
+type pathParam struct {
+	name  string
+	label string
+	dest  *uint
+}
+
+// VerifyOwnership はURLで指定された要素が、URLで指定された親に属していることを検証するミドルウェアです。
+// このミドルウェアは以下の処理を行います：
+// - 大学・学部・学科・入試日程・入試情報・試験種別・日程のIDの解析（形式が不正な場合は400）
+// - 親子関係の検証（存在しない場合や別の親に属する場合は404）
+// ルートに含まれないパラメータは検証しません。
+func VerifyOwnership(verifier OwnershipVerifier) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx := c.Request().Context()
+
+			var path repositories.ResourcePath
+
+			params := []pathParam{
+				{name: ParamUniversityID, label: "大学ID", dest: &path.UniversityID},
+				{name: ParamDepartmentID, label: "学部ID", dest: &path.DepartmentID},
+				{name: ParamMajorID, label: "学科ID", dest: &path.MajorID},
+				{name: ParamScheduleID, label: "入試日程ID", dest: &path.ScheduleID},
+				{name: ParamAdmissionInfoID, label: "入試情報ID", dest: &path.AdmissionInfoID},
+				{name: ParamTestTypeID, label: "試験種別ID", dest: &path.TestTypeID},
+				{name: ParamScheduleEventID, label: "日程ID", dest: &path.ScheduleEventID},
+			}
+
+			for _, param := range params {
+				value := c.Param(param.name)
+				if value == "" {
+					continue
+				}
+
+				id, err := validation.ParseID(ctx, value, param.label+"の形式が不正です: %v", param.label+"の形式が不正です")
+				if err != nil {
+					return errors.HandleError(c, err)
+				}
+
+				*param.dest = id
+			}
+
+			if err := verifier.VerifyPath(ctx, path); err != nil {
+				return errors.HandleError(c, err)
+			}
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	appErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/repositories"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeOwnershipVerifier は検証した親子関係を記録するOwnershipVerifierの実装です
+type fakeOwnershipVerifier struct {
+	paths []repositories.ResourcePath
+	err   error
+}
+
+func (f *fakeOwnershipVerifier) VerifyPath(_ context.Context, path repositories.ResourcePath) error {
+	f.paths = append(f.paths, path)
+	return f.err
+}
+
+// TestVerifyOwnership はURLで指定された要素の親子関係の検証をテストします。
+// このテストは以下のケースを検証します：
+// - 親子関係が一致する場合
+// - 別の親に属する場合
+// - IDの形式が不正な場合
+func TestVerifyOwnership(t *testing.T) {
+	newContext := func(names, values []string) (echo.Context, *httptest.ResponseRecorder) {
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		c.SetParamNames(names...)
+		c.SetParamValues(values...)
+
+		return c, rec
+	}
+
+	next := func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	}
+
+	t.Run("親子関係が一致する場合は次の処理へ進む", func(t *testing.T) {
+		verifier := &fakeOwnershipVerifier{}
+		c, rec := newContext(
+			[]string{ParamUniversityID, ParamDepartmentID, ParamMajorID, ParamScheduleID, ParamTestTypeID},
+			[]string{"1", "2", "3", "4", "5"},
+		)
+
+		require.NoError(t, VerifyOwnership(verifier)(next)(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, []repositories.ResourcePath{{
+			UniversityID: 1, DepartmentID: 2, MajorID: 3, ScheduleID: 4, TestTypeID: 5,
+		}}, verifier.paths)
+	})
+
+	t.Run("別の親に属する場合は404", func(t *testing.T) {
+		verifier := &fakeOwnershipVerifier{err: appErrors.NewNotFoundError("学科", 3, map[string]string{"department_id": "2"})}
+		c, rec := newContext([]string{ParamUniversityID, ParamDepartmentID, ParamMajorID}, []string{"1", "2", "3"})
+
+		require.NoError(t, VerifyOwnership(verifier)(next)(c))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("IDの形式が不正な場合は400", func(t *testing.T) {
+		verifier := &fakeOwnershipVerifier{}
+		c, rec := newContext([]string{ParamUniversityID, ParamDepartmentID}, []string{"1", "abc"})
+
+		require.NoError(t, VerifyOwnership(verifier)(next)(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Empty(t, verifier.paths)
+	})
+}
@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"university-exam-api/internal/pkg/preview"
+
+	"github.com/labstack/echo/v4"
+)
+
+// PreviewMode は下書きプレビューモードを設定するミドルウェアです。
+// このミドルウェアは以下の処理を行います：
+// - previewクエリパラメータの確認（指定がない場合はそのまま次の処理へ進む）
+// - 指定されたロールを持つユーザーかどうかの確認
+// - プレビューモードのリクエストコンテキストへの設定
+// IdentifyUser の後に登録する必要があります。
+func PreviewMode(roles ...string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			value := c.QueryParam(preview.QueryParam)
+			if value == "" {
+				return next(c)
+			}
+
+			enabled, err := strconv.ParseBool(value)
+			if err != nil {
+				return &echo.HTTPError{
+					Code:    http.StatusBadRequest,
+					Message: "previewにはtrueまたはfalseを指定してください",
+				}
+			}
+
+			if !enabled {
+				return next(c)
+			}
+
+			if !hasRole(getUserRole(c.Get("user")), roles) {
+				return &echo.HTTPError{
+					Code:    http.StatusForbidden,
+					Message: "下書きのプレビューには管理者権限が必要です",
+				}
+			}
+
+			req := c.Request()
+			c.SetRequest(req.WithContext(preview.WithDrafts(req.Context())))
+
+			return next(c)
+		}
+	}
+}
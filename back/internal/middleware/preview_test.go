@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"university-exam-api/internal/pkg/preview"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPreviewMode は下書きプレビューモードの設定をテストします。
+// このテストは以下のケースを検証します：
+// - previewの指定なし
+// - 管理者によるプレビュー
+// - 管理者以外によるプレビュー
+// - 不正な値
+func TestPreviewMode(t *testing.T) {
+	tests := []struct {
+		name        string
+		query       string
+		user        map[string]string
+		wantCode    int
+		wantPreview bool
+	}{
+		{name: "previewの指定なし", query: "", wantCode: http.StatusOK},
+		{name: "preview=false", query: "?preview=false", user: map[string]string{"role": RoleAdmin}, wantCode: http.StatusOK},
+		{name: "管理者によるプレビュー", query: "?preview=true", user: map[string]string{"role": RoleAdmin}, wantCode: http.StatusOK, wantPreview: true},
+		{name: "管理者以外によるプレビュー", query: "?preview=true", user: map[string]string{"role": "user"}, wantCode: http.StatusForbidden},
+		{name: "未認証でのプレビュー", query: "?preview=true", wantCode: http.StatusForbidden},
+		{name: "不正な値", query: "?preview=yes", user: map[string]string{"role": RoleAdmin}, wantCode: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/universities"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			if tt.user != nil {
+				c.Set("user", tt.user)
+			}
+
+			var previewed bool
+
+			handler := PreviewMode(RoleAdmin)(func(c echo.Context) error {
+				previewed = preview.IncludeDrafts(c.Request().Context())
+				return c.NoContent(http.StatusOK)
+			})
+
+			err := handler(c)
+			if tt.wantCode != http.StatusOK {
+				var httpErr *echo.HTTPError
+				if assert.ErrorAs(t, err, &httpErr) {
+					assert.Equal(t, tt.wantCode, httpErr.Code)
+				}
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantPreview, previewed)
+		})
+	}
+}
@@ -130,11 +130,13 @@ func SecurityMiddleware(config *SecurityConfig) []echo.MiddlewareFunc {
 				"X-CSRF-Token",
 				"Cache-Control",
 				"Pragma",
+				"If-Match",
 			},
 			ExposeHeaders: []string{
 				"Content-Length",
 				"Content-Type",
 				"X-Total-Count",
+				"ETag",
 			},
 			MaxAge: int(24 * time.Hour.Seconds()),
 			AllowCredentials: true,
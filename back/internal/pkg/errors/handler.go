@@ -7,10 +7,14 @@
 package errors
 
 import (
+	"context"
+	stderrors "errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"university-exam-api/internal/errors"
 	applogger "university-exam-api/internal/logger"
+	"university-exam-api/internal/pkg/etag"
 
 	"github.com/labstack/echo/v4"
 )
@@ -33,6 +37,12 @@ const (
 	RequestTooLarge = "REQUEST_TOO_LARGE"
 	// コンテンツタイプエラー
 	InvalidContentType = "INVALID_CONTENT_TYPE"
+	// 更新の競合エラー
+	Conflict = "CONFLICT"
+	// 更新の前提条件（期待するバージョン）の未指定エラー
+	PreconditionRequired = "PRECONDITION_REQUIRED"
+	// タイムアウト・キャンセルによる処理の中断
+	Timeout = "TIMEOUT_ERROR"
 	// 内部サーバーエラー
 	InternalServerError = "INTERNAL_SERVER_ERROR"
 )
@@ -118,21 +128,45 @@ func WrapError(err error, code string, message string) error {
 // 1. エラータイプの判定
 // 2. 適切なHTTPステータスコードの設定
 // 3. エラーログの記録
-// 4. JSONレスポンスの生成
+// 4. 競合時の現在のバージョン（ETag）と状態の設定
+// 5. タイムアウトによりデータベース処理が中断された場合の408の設定
+// 6. JSONレスポンスの生成
 func HandleError(c echo.Context, err error) error {
 	ctx := c.Request().Context()
 
 	switch e := err.(type) {
 	case *errors.Error:
 		statusCode := getStatusCode(string(e.Code))
+		if stderrors.Is(e, context.DeadlineExceeded) {
+			statusCode = http.StatusRequestTimeout
+		}
 		applogger.Error(ctx, "エラーが発生しました: %v", e)
 
-		return c.JSON(statusCode, map[string]interface{}{
+		body := map[string]interface{}{
 			"code":    e.Code,
 			"message": e.Message,
 			"details": e.Details,
-		})
+		}
+
+		if e.Code == errors.CodeConflict {
+			if version, ok := e.Details.Extra["current_version"]; ok {
+				c.Response().Header().Set(etag.HeaderETag, strconv.Quote(version))
+			}
+
+			body["current"] = e.Current
+		}
+
+		return c.JSON(statusCode, body)
 	default:
+		if stderrors.Is(err, context.DeadlineExceeded) {
+			applogger.Error(ctx, "処理がタイムアウトしました: %v", err)
+
+			return c.JSON(http.StatusRequestTimeout, map[string]interface{}{
+				"code":    Timeout,
+				"message": "処理がタイムアウトしました",
+			})
+		}
+
 		applogger.Error(ctx, "予期せぬエラーが発生しました: %v", err)
 
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
@@ -163,6 +197,12 @@ func getStatusCode(code string) int {
 		return http.StatusRequestEntityTooLarge
 	case InvalidContentType:
 		return http.StatusUnsupportedMediaType
+	case Conflict:
+		return http.StatusConflict
+	case PreconditionRequired:
+		return http.StatusPreconditionRequired
+	case Timeout:
+		return http.StatusRequestTimeout
 	default:
 		return http.StatusInternalServerError
 	}
@@ -6,8 +6,10 @@
 package errors
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -269,6 +271,49 @@ func TestHandleError(t *testing.T) {
 	}
 }
 
+// TestHandleConflictError は競合エラーのレスポンスをテストします。
+// 現在のバージョンがETagヘッダーに、現在の状態がレスポンスボディに含まれることを検証します。
+func TestHandleConflictError(t *testing.T) {
+	applogger.InitTestLogger()
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPut, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := HandleError(c, apperrors.NewConflictError("大学", 1, 3, map[string]interface{}{"name": "最新の大学名"}))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Equal(t, `"3"`, rec.Header().Get("ETag"))
+
+	var response map[string]interface{}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, Conflict, response["code"])
+	assert.Equal(t, map[string]interface{}{"name": "最新の大学名"}, response["current"])
+}
+
+// TestHandleTimeoutError はタイムアウトで中断されたデータベース処理のレスポンスをテストします。
+// タイムアウトをラップしたデータベースエラーや、ラップされたcontext.DeadlineExceededも408を返すことを検証します。
+func TestHandleTimeoutError(t *testing.T) {
+	applogger.InitTestLogger()
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := HandleError(c, apperrors.NewDatabaseError("学科検索処理", context.DeadlineExceeded, nil))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusRequestTimeout, rec.Code)
+
+	rec = httptest.NewRecorder()
+	c = e.NewContext(req, rec)
+
+	err = HandleError(c, fmt.Errorf("トランザクションの開始に失敗: %w", context.DeadlineExceeded))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusRequestTimeout, rec.Code)
+}
+
 // TestGetStatusCode はHTTPステータスコードの取得をテストします。
 func TestGetStatusCode(t *testing.T) {
 	t.Parallel()
@@ -313,6 +358,21 @@ func TestGetStatusCode(t *testing.T) {
 			code:     InvalidContentType,
 			expected: http.StatusUnsupportedMediaType,
 		},
+		{
+			name:     "Timeout",
+			code:     Timeout,
+			expected: http.StatusRequestTimeout,
+		},
+		{
+			name:     "Conflict",
+			code:     Conflict,
+			expected: http.StatusConflict,
+		},
+		{
+			name:     "PreconditionRequired",
+			code:     PreconditionRequired,
+			expected: http.StatusPreconditionRequired,
+		},
 		{
 			name:     "Unknown",
 			code:     "UNKNOWN",
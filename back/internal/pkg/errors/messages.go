@@ -18,6 +18,8 @@ const (
 	ErrInvalidScheduleID      = "INVALID_SCHEDULE_ID"
 	ErrInvalidMajorID         = "INVALID_MAJOR_ID"
 	ErrInvalidAdmissionInfoID = "INVALID_ADMISSION_INFO_ID"
+	ErrInvalidTestTypeID      = "INVALID_TEST_TYPE_ID"
+	ErrInvalidAcademicYear    = "INVALID_ACADEMIC_YEAR"
 
 	// リクエスト関連のエラーコード
 	ErrInvalidRequestBody = "INVALID_REQUEST_BODY"
@@ -39,6 +41,8 @@ const (
 	MsgInvalidScheduleID      = "スケジュールIDの形式が不正です: %v"
 	MsgInvalidMajorID         = "学科IDの形式が不正です: %v"
 	MsgInvalidAdmissionInfoID = "募集情報IDの形式が不正です: %v"
+	MsgInvalidTestTypeID      = "試験種別IDの形式が不正です: %v"
+	MsgInvalidAcademicYear    = "学年度の形式が不正です: %v"
 
 	// リクエスト関連のエラーメッセージ
 	MsgInvalidRequestBody = "リクエストボディが不正です"
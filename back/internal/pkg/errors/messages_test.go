@@ -19,6 +19,7 @@ import (
 // - スケジュールIDのエラーメッセージ
 // - 学科IDのエラーメッセージ
 // - 募集情報IDのエラーメッセージ
+// - 学年度のエラーメッセージ
 // - リクエストボディのエラーメッセージ
 // - データバインドのエラーメッセージ
 // - リクエストバインドのエラーメッセージ
@@ -60,6 +61,16 @@ func TestErrorMessages(t *testing.T) {
 			code:    ErrInvalidAdmissionInfoID,
 			message: MsgInvalidAdmissionInfoID,
 		},
+		{
+			name:    "試験種別IDのエラーメッセージ",
+			code:    ErrInvalidTestTypeID,
+			message: MsgInvalidTestTypeID,
+		},
+		{
+			name:    "学年度のエラーメッセージ",
+			code:    ErrInvalidAcademicYear,
+			message: MsgInvalidAcademicYear,
+		},
 		{
 			name:    "リクエストボディのエラーメッセージ",
 			code:    ErrInvalidRequestBody,
@@ -0,0 +1,90 @@
+// Package etag は楽観的ロックのためのETag・If-Matchヘッダーの処理機能を提供します。
+// このパッケージは以下の機能を提供します：
+// - バージョン番号とETagの相互変換
+// - ETagヘッダーの設定
+// - If-Matchヘッダーまたはリクエストボディからの期待するバージョンの取得
+package etag
+
+import (
+	"strconv"
+	"strings"
+	appErrors "university-exam-api/internal/errors"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ヘッダー名
+const (
+	// HeaderETag はエンティティのバージョンを返すヘッダーです
+	HeaderETag = "ETag"
+	// HeaderIfMatch は更新時に期待するバージョンを指定するヘッダーです
+	HeaderIfMatch = "If-Match"
+)
+
+// Format はバージョン番号を強いETag（例: "3"）に変換します
+func Format(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// Parse はETagをバージョン番号に変換します。
+// この関数は以下の処理を行います：
+// - ワイルドカード・弱いETag・複数指定の拒否
+// - 引用符の除去
+// - 1以上の整数であることの検証
+func Parse(value string) (int, error) {
+	value = strings.TrimSpace(value)
+
+	switch {
+	case value == "*":
+		return 0, appErrors.NewInvalidInputError(HeaderIfMatch, "ワイルドカードは指定できません。エンティティのETagを指定してください", nil)
+	case strings.HasPrefix(value, "W/"):
+		return 0, appErrors.NewInvalidInputError(HeaderIfMatch, "弱いETagは指定できません", nil)
+	case strings.Contains(value, ","):
+		return 0, appErrors.NewInvalidInputError(HeaderIfMatch, "ETagは1つだけ指定してください", nil)
+	}
+
+	version, err := strconv.Atoi(strings.Trim(value, `"`))
+	if err != nil || version < 1 {
+		return 0, appErrors.NewInvalidInputError(HeaderIfMatch, "ETagの形式が不正です", map[string]string{"value": value})
+	}
+
+	return version, nil
+}
+
+// SetHeader はエンティティのバージョンをETagヘッダーに設定します
+func SetHeader(c echo.Context, version int) {
+	c.Response().Header().Set(HeaderETag, Format(version))
+}
+
+// ExpectedVersion は更新リクエストが期待するエンティティのバージョンを取得します。
+// この関数は以下の処理を行います：
+// - If-Matchヘッダーの解析（指定されている場合はボディより優先）
+// - リクエストボディのversionとの整合性の確認
+// - どちらも指定されていない場合の前提条件エラーの生成
+func ExpectedVersion(c echo.Context, bodyVersion int) (int, error) {
+	header := c.Request().Header.Get(HeaderIfMatch)
+	if header == "" {
+		if bodyVersion > 0 {
+			return bodyVersion, nil
+		}
+
+		return 0, appErrors.NewPreconditionRequiredError(
+			"更新には If-Match ヘッダーまたはリクエストボディの version で更新前のバージョンを指定してください",
+			nil,
+		)
+	}
+
+	version, err := Parse(header)
+	if err != nil {
+		return 0, err
+	}
+
+	if bodyVersion > 0 && bodyVersion != version {
+		return 0, appErrors.NewInvalidInputError("version", "If-Match ヘッダーとリクエストボディの version が一致しません", map[string]string{
+			"if_match": header,
+			"version":  strconv.Itoa(bodyVersion),
+		})
+	}
+
+	return version, nil
+}
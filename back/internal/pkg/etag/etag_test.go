@@ -0,0 +1,82 @@
+package etag
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	appErrors "university-exam-api/internal/errors"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestContext はIf-Matchヘッダーを設定したテスト用のコンテキストを生成します
+func newTestContext(ifMatch string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPut, "/", nil)
+	if ifMatch != "" {
+		req.Header.Set(HeaderIfMatch, ifMatch)
+	}
+
+	rec := httptest.NewRecorder()
+
+	return echo.New().NewContext(req, rec), rec
+}
+
+func TestFormatAndParse(t *testing.T) {
+	assert.Equal(t, `"3"`, Format(3))
+
+	version, err := Parse(Format(3))
+	require.NoError(t, err)
+	assert.Equal(t, 3, version)
+
+	version, err = Parse("5")
+	require.NoError(t, err)
+	assert.Equal(t, 5, version)
+
+	for _, value := range []string{"*", `W/"3"`, `"1", "2"`, `"abc"`, `"0"`} {
+		_, err := Parse(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestSetHeader(t *testing.T) {
+	c, rec := newTestContext("")
+	SetHeader(c, 7)
+	assert.Equal(t, `"7"`, rec.Header().Get(HeaderETag))
+}
+
+func TestExpectedVersion(t *testing.T) {
+	tests := []struct {
+		name        string
+		ifMatch     string
+		bodyVersion int
+		expected    int
+		code        appErrors.Code
+	}{
+		{name: "If-Matchのみ", ifMatch: `"2"`, expected: 2},
+		{name: "ボディのみ", bodyVersion: 4, expected: 4},
+		{name: "両方が一致", ifMatch: `"3"`, bodyVersion: 3, expected: 3},
+		{name: "両方が不一致", ifMatch: `"3"`, bodyVersion: 2, code: appErrors.CodeInvalidInput},
+		{name: "不正なIf-Match", ifMatch: "*", code: appErrors.CodeInvalidInput},
+		{name: "未指定", code: appErrors.CodePreconditionRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := newTestContext(tt.ifMatch)
+
+			version, err := ExpectedVersion(c, tt.bodyVersion)
+			if tt.code != "" {
+				var appErr *appErrors.Error
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.code, appErr.Code)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, version)
+		})
+	}
+}
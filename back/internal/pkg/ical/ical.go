@@ -0,0 +1,179 @@
+// Package ical はRFC 5545（iCalendar）形式のカレンダーの出力機能を提供します。
+// このパッケージは以下の機能を提供します：
+// - 終日の予定（VEVENT）を含むカレンダー（VCALENDAR）の出力
+// - TEXT型の値のエスケープ
+// - 75オクテットを超える行の折り返し
+package ical
+
+import (
+	"bytes"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ContentType はiCalendar形式のレスポンスのContent-Typeです
+const ContentType = "text/calendar; charset=utf-8"
+
+const (
+	// crlf はiCalendarの行の区切りです
+	crlf = "\r\n"
+	// maxLineOctets は折り返し前の1行の最大オクテット数です（改行を除く）
+	maxLineOctets = 75
+	// dateLayout は日付（DATE型）の書式です
+	dateLayout = "20060102"
+	// dateTimeLayout はUTCの日時（DATE-TIME型）の書式です
+	dateTimeLayout = "20060102T150405Z"
+)
+
+// Event はカレンダーに出力する終日の予定です
+// 以下のフィールドを含みます：
+// - UID: 予定を一意に識別するID（更新時も同じ値を使用する）
+// - Sequence: 予定の改訂番号（更新のたびに増やす）
+// - Start: 開始日
+// - End: 終了日（終了日を含む）
+// - Summary: 件名
+// - Description: 説明
+// - Categories: 分類
+// - Stamp: 予定の出力日時
+// - LastModified: 予定の最終更新日時
+type Event struct {
+	UID          string
+	Sequence     int
+	Start        time.Time
+	End          time.Time
+	Summary      string
+	Description  string
+	Categories   []string
+	Stamp        time.Time
+	LastModified time.Time
+}
+
+// Calendar はiCalendar形式で出力するカレンダーです
+// 以下のフィールドを含みます：
+// - ProdID: カレンダーを作成した製品の識別子
+// - Name: カレンダーアプリに表示するカレンダー名
+// - Events: 予定一覧
+type Calendar struct {
+	ProdID string
+	Name   string
+	Events []Event
+}
+
+// Encode はカレンダーをiCalendar形式で出力します
+func (c *Calendar) Encode(w io.Writer) error {
+	var buf bytes.Buffer
+
+	writeLine(&buf, "BEGIN:VCALENDAR")
+	writeLine(&buf, "VERSION:2.0")
+	writeLine(&buf, "PRODID:"+c.ProdID)
+	writeLine(&buf, "CALSCALE:GREGORIAN")
+	writeLine(&buf, "METHOD:PUBLISH")
+
+	if c.Name != "" {
+		writeLine(&buf, "X-WR-CALNAME:"+EscapeText(c.Name))
+	}
+
+	for _, e := range c.Events {
+		e.encode(&buf)
+	}
+
+	writeLine(&buf, "END:VCALENDAR")
+
+	_, err := w.Write(buf.Bytes())
+
+	return err
+}
+
+// Bytes はカレンダーをiCalendar形式のバイト列として返します
+func (c *Calendar) Bytes() []byte {
+	var buf bytes.Buffer
+
+	_ = c.Encode(&buf)
+
+	return buf.Bytes()
+}
+
+// encode は予定をVEVENTとして出力します
+// DTENDは終了日の翌日（RFC 5545の終日の予定の終了日は含まない）とします
+func (e *Event) encode(buf *bytes.Buffer) {
+	end := e.End
+	if end.IsZero() || end.Before(e.Start) {
+		end = e.Start
+	}
+
+	writeLine(buf, "BEGIN:VEVENT")
+	writeLine(buf, "UID:"+e.UID)
+	writeLine(buf, "DTSTAMP:"+formatDateTime(e.Stamp))
+
+	if !e.LastModified.IsZero() {
+		writeLine(buf, "LAST-MODIFIED:"+formatDateTime(e.LastModified))
+	}
+
+	writeLine(buf, "SEQUENCE:"+strconv.Itoa(e.Sequence))
+	writeLine(buf, "DTSTART;VALUE=DATE:"+e.Start.Format(dateLayout))
+	writeLine(buf, "DTEND;VALUE=DATE:"+end.AddDate(0, 0, 1).Format(dateLayout))
+	writeLine(buf, "SUMMARY:"+EscapeText(e.Summary))
+
+	if e.Description != "" {
+		writeLine(buf, "DESCRIPTION:"+EscapeText(e.Description))
+	}
+
+	if len(e.Categories) > 0 {
+		categories := make([]string, len(e.Categories))
+		for i, category := range e.Categories {
+			categories[i] = EscapeText(category)
+		}
+
+		writeLine(buf, "CATEGORIES:"+strings.Join(categories, ","))
+	}
+
+	writeLine(buf, "TRANSP:TRANSPARENT")
+	writeLine(buf, "END:VEVENT")
+}
+
+// textEscaper はTEXT型の値で特別な意味を持つ文字をエスケープします
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", `\n`,
+)
+
+// EscapeText はTEXT型の値のバックスラッシュ・セミコロン・カンマ・改行をエスケープします
+func EscapeText(value string) string {
+	return textEscaper.Replace(value)
+}
+
+// formatDateTime は日時をUTCのDATE-TIME型の書式で返します
+func formatDateTime(t time.Time) string {
+	return t.UTC().Format(dateTimeLayout)
+}
+
+// writeLine は1行を出力します。
+// 75オクテットを超える行は、マルチバイト文字を分割しない位置で折り返し、
+// 続きの行の先頭に空白を付与します
+func writeLine(buf *bytes.Buffer, line string) {
+	limit := maxLineOctets
+
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+
+		buf.WriteString(line[:cut])
+		buf.WriteString(crlf + " ")
+		line = line[cut:]
+
+		// 続きの行は先頭の空白を含めて75オクテット以内とします
+		limit = maxLineOctets - 1
+	}
+
+	buf.WriteString(line)
+	buf.WriteString(crlf)
+}
@@ -0,0 +1,87 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEscapeText(t *testing.T) {
+	assert.Equal(t, `a\\b\;c\,d\ne\nf`, EscapeText("a\\b;c,d\ne\r\nf"))
+	assert.Equal(t, "東京大学 前期", EscapeText("東京大学 前期"))
+}
+
+func TestCalendarEncode(t *testing.T) {
+	stamp := time.Date(2025, 10, 1, 9, 30, 0, 0, time.FixedZone("JST", 9*60*60))
+
+	calendar := &Calendar{
+		ProdID: "-//university-exam-api//ja",
+		Name:   "入試日程, 2026",
+		Events: []Event{
+			{
+				UID:          "event-1@example.com",
+				Sequence:     2,
+				Start:        time.Date(2026, 1, 26, 0, 0, 0, 0, time.UTC),
+				End:          time.Date(2026, 2, 4, 0, 0, 0, 0, time.UTC),
+				Summary:      "東京大学 工学部 機械工学科 前期（一般選抜） 出願期間",
+				Description:  "郵送のみ;消印有効",
+				Categories:   []string{"出願期間"},
+				Stamp:        stamp,
+				LastModified: stamp,
+			},
+			{
+				UID:     "event-2@example.com",
+				Start:   time.Date(2026, 2, 25, 0, 0, 0, 0, time.UTC),
+				Summary: "試験日",
+				Stamp:   stamp,
+			},
+		},
+	}
+
+	output := string(calendar.Bytes())
+
+	t.Run("全ての行がCRLFで区切られる", func(t *testing.T) {
+		assert.True(t, strings.HasPrefix(output, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+		assert.True(t, strings.HasSuffix(output, "END:VCALENDAR\r\n"))
+		assert.NotContains(t, strings.ReplaceAll(output, "\r\n", ""), "\n")
+	})
+
+	t.Run("終日の予定は終了日の翌日をDTENDとする", func(t *testing.T) {
+		assert.Contains(t, output, "DTSTART;VALUE=DATE:20260126\r\nDTEND;VALUE=DATE:20260205\r\n")
+		assert.Contains(t, output, "DTSTART;VALUE=DATE:20260225\r\nDTEND;VALUE=DATE:20260226\r\n",
+			"終了日を省略した場合は1日の予定")
+	})
+
+	t.Run("日時はUTCで出力する", func(t *testing.T) {
+		assert.Contains(t, output, "DTSTAMP:20251001T003000Z\r\n")
+		assert.Contains(t, output, "LAST-MODIFIED:20251001T003000Z\r\n")
+		assert.Contains(t, output, "SEQUENCE:2\r\n")
+	})
+
+	t.Run("TEXT型の値をエスケープする", func(t *testing.T) {
+		assert.Contains(t, output, `X-WR-CALNAME:入試日程\, 2026`)
+		assert.Contains(t, output, `DESCRIPTION:郵送のみ\;消印有効`)
+	})
+
+	t.Run("75オクテットを超える行はマルチバイト文字を分割せずに折り返す", func(t *testing.T) {
+		lines := strings.Split(strings.TrimSuffix(output, "\r\n"), "\r\n")
+
+		var unfolded []string
+		for _, line := range lines {
+			assert.LessOrEqual(t, len(line), 75, line)
+			require.True(t, strings.ToValidUTF8(line, "") == line, "行が文字の途中で分割されている: %q", line)
+
+			if strings.HasPrefix(line, " ") {
+				unfolded[len(unfolded)-1] += line[1:]
+				continue
+			}
+
+			unfolded = append(unfolded, line)
+		}
+
+		assert.Contains(t, unfolded, "SUMMARY:東京大学 工学部 機械工学科 前期（一般選抜） 出願期間")
+	})
+}
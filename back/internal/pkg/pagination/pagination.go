@@ -0,0 +1,329 @@
+// Package pagination は一覧・検索APIのページネーション機能を提供します。
+// このパッケージは以下の機能を提供します：
+// - ページ番号・件数・ソート条件の解析
+// - カーソルのエンコードとデコード
+// - next/prevリンクの生成
+// - 一覧・検索で共通のレスポンスの生成
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	appErrors "university-exam-api/internal/errors"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ページネーションの既定値
+const (
+	// DefaultPerPage は1ページあたりの既定件数です
+	DefaultPerPage = 20
+	// MaxPerPage は1ページあたりの最大件数です
+	MaxPerPage = 100
+)
+
+// ソートキー
+const (
+	SortName       = "name"
+	SortUpdatedAt  = "updated_at"
+	SortEnrollment = "enrollment"
+	SortRelevance  = "relevance"
+	// 合格難易度（最新年度の学科ごとの値の最大値）のソートキー
+	SortDeviation      = "deviation"
+	SortCommonTestRate = "common_test_rate"
+	SortBorderScore    = "border_score"
+)
+
+// ソート順
+const (
+	OrderAsc  = "asc"
+	OrderDesc = "desc"
+)
+
+// クエリパラメータ名
+const (
+	ParamPage    = "page"
+	ParamPerPage = "perPage"
+	ParamLimit   = "limit"
+	ParamSort    = "sort"
+	ParamOrder   = "order"
+	ParamCursor  = "cursor"
+)
+
+// SortKeys は指定可能なソートキーの一覧です
+// 関連度（relevance）は検索APIでのみ意味を持ち、ソート順の指定は無視されます
+var SortKeys = []string{
+	SortName, SortUpdatedAt, SortEnrollment, SortRelevance, SortDeviation, SortCommonTestRate, SortBorderScore,
+}
+
+// Cursor はキーセットページネーションの位置を表現する構造体です
+// - Value: 直前ページ端のソートキーの値
+// - ID: ソートキーが同値の場合の順序を安定させるためのID
+// - Backward: 前ページ方向の取得かどうか
+// - Page: 取得対象のページ番号
+type Cursor struct {
+	Sort     string `json:"s"`
+	Order    string `json:"o"`
+	Value    string `json:"v"`
+	ID       uint   `json:"i"`
+	Backward bool   `json:"b,omitempty"`
+	Page     int    `json:"p"`
+}
+
+// Params はページネーションの指定を表現する構造体です
+type Params struct {
+	Page    int
+	PerPage int
+	Sort    string
+	Order   string
+	Cursor  *Cursor
+}
+
+// Page は取得結果のページ情報を表現する構造体です
+type Page struct {
+	Total      int64  `json:"total"`
+	Page       int    `json:"page"`
+	PerPage    int    `json:"perPage"`
+	HasNext    bool   `json:"hasNext"`
+	HasPrev    bool   `json:"hasPrev"`
+	NextCursor string `json:"nextCursor,omitempty"`
+	PrevCursor string `json:"prevCursor,omitempty"`
+}
+
+// Links はnext/prevのリンクを表現する構造体です
+type Links struct {
+	Next string `json:"next,omitempty"`
+	Prev string `json:"prev,omitempty"`
+}
+
+// DefaultParams は既定のページネーション指定を返します
+func DefaultParams() Params {
+	return Params{
+		Page:    1,
+		PerPage: DefaultPerPage,
+		Sort:    SortName,
+		Order:   OrderAsc,
+	}
+}
+
+// Offset はオフセット方式で読み飛ばす件数を返します
+func (p Params) Offset() int {
+	if p.Page <= 1 {
+		return 0
+	}
+
+	return (p.Page - 1) * p.PerPage
+}
+
+// Desc は降順指定かどうかを返します
+func (p Params) Desc() bool {
+	return p.Order == OrderDesc
+}
+
+// IsValidSortKey はソートキーが有効かどうかを検証します
+func IsValidSortKey(sort string) bool {
+	for _, key := range SortKeys {
+		if key == sort {
+			return true
+		}
+	}
+
+	return false
+}
+
+// EncodeCursor はカーソルをURLセーフな文字列に変換します
+func EncodeCursor(cursor Cursor) string {
+	raw, err := json.Marshal(cursor)
+	if err != nil {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodeCursor は文字列からカーソルを復元します。
+// この関数は以下の処理を行います：
+// - Base64のデコード
+// - JSONの解析
+// - ソートキー・ソート順・ページ番号の検証
+func DecodeCursor(encoded string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, appErrors.NewInvalidInputError(ParamCursor, "カーソルの形式が不正です", nil)
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(raw, &cursor); err != nil {
+		return nil, appErrors.NewInvalidInputError(ParamCursor, "カーソルの形式が不正です", nil)
+	}
+
+	if !IsValidSortKey(cursor.Sort) || (cursor.Order != OrderAsc && cursor.Order != OrderDesc) || cursor.Page < 1 {
+		return nil, appErrors.NewInvalidInputError(ParamCursor, "カーソルの内容が不正です", nil)
+	}
+
+	return &cursor, nil
+}
+
+// ParseParams はクエリパラメータからページネーションの指定を解析します。
+// この関数は以下の処理を行います：
+// - page・perPage（limit）の解析と範囲チェック
+// - sort・orderの解析と検証
+// - cursorの解析（指定時はソート条件とページ番号をカーソルから引き継ぎ）
+func ParseParams(c echo.Context) (Params, error) {
+	params := DefaultParams()
+
+	perPage := c.QueryParam(ParamPerPage)
+	if perPage == "" {
+		perPage = c.QueryParam(ParamLimit)
+	}
+
+	if perPage != "" {
+		n, err := strconv.Atoi(perPage)
+		if err != nil || n < 1 || n > MaxPerPage {
+			return Params{}, appErrors.NewInvalidInputError(
+				ParamPerPage,
+				fmt.Sprintf("1ページあたりの件数は1から%dの間で指定してください", MaxPerPage),
+				map[string]string{ParamPerPage: perPage},
+			)
+		}
+
+		params.PerPage = n
+	}
+
+	if encoded := c.QueryParam(ParamCursor); encoded != "" {
+		cursor, err := DecodeCursor(encoded)
+		if err != nil {
+			return Params{}, err
+		}
+
+		params.Cursor = cursor
+		params.Page = cursor.Page
+		params.Sort = cursor.Sort
+		params.Order = cursor.Order
+
+		return params, nil
+	}
+
+	if page := c.QueryParam(ParamPage); page != "" {
+		n, err := strconv.Atoi(page)
+		if err != nil || n < 1 {
+			return Params{}, appErrors.NewInvalidInputError(
+				ParamPage,
+				"ページ番号は1以上の整数で指定してください",
+				map[string]string{ParamPage: page},
+			)
+		}
+
+		params.Page = n
+	}
+
+	if sort := strings.ToLower(strings.TrimSpace(c.QueryParam(ParamSort))); sort != "" {
+		if !IsValidSortKey(sort) {
+			return Params{}, appErrors.NewInvalidInputError(
+				ParamSort,
+				fmt.Sprintf("ソートキーは%sのいずれかで指定してください", strings.Join(SortKeys, ", ")),
+				map[string]string{ParamSort: sort},
+			)
+		}
+
+		params.Sort = sort
+	}
+
+	if order := strings.ToLower(strings.TrimSpace(c.QueryParam(ParamOrder))); order != "" {
+		if order != OrderAsc && order != OrderDesc {
+			return Params{}, appErrors.NewInvalidInputError(
+				ParamOrder,
+				"ソート順はascまたはdescで指定してください",
+				map[string]string{ParamOrder: order},
+			)
+		}
+
+		params.Order = order
+	}
+
+	return params, nil
+}
+
+// BuildResponse はページ単位で取得した結果のレスポンスを生成します。
+// 大学の一覧・検索は、フロントエンドの UniversitiesResponseSchema に合わせ、
+// 結果の配列(key)とページ情報を data に含む共通の形式とします
+func BuildResponse(key string, items interface{}, page Page, links Links) map[string]interface{} {
+	data := map[string]interface{}{
+		key:       items,
+		"total":   page.Total,
+		"page":    page.Page,
+		"perPage": page.PerPage,
+		"hasNext": page.HasNext,
+		"hasPrev": page.HasPrev,
+	}
+
+	if page.NextCursor != "" {
+		data["nextCursor"] = page.NextCursor
+	}
+
+	if page.PrevCursor != "" {
+		data["prevCursor"] = page.PrevCursor
+	}
+
+	return map[string]interface{}{
+		"success":   true,
+		"timestamp": time.Now().Unix(),
+		"data":      data,
+		"links":     links,
+	}
+}
+
+// BuildLinks はリクエストURLを基にnext/prevのリンクを生成します。
+// カーソル指定のリクエストにはカーソルのリンクを、それ以外にはページ番号のリンクを返します
+func BuildLinks(c echo.Context, params Params, page Page) Links {
+	var links Links
+
+	if page.HasNext {
+		if params.Cursor != nil {
+			links.Next = pageURL(c, ParamCursor, page.NextCursor)
+		} else {
+			links.Next = pageURL(c, ParamPage, strconv.Itoa(page.Page+1))
+		}
+	}
+
+	if page.HasPrev {
+		if params.Cursor != nil {
+			links.Prev = pageURL(c, ParamCursor, page.PrevCursor)
+		} else {
+			links.Prev = pageURL(c, ParamPage, strconv.Itoa(page.Page-1))
+		}
+	}
+
+	return links
+}
+
+// pageURL は現在のクエリを維持したまま指定のパラメータを置き換えたURLを生成します
+func pageURL(c echo.Context, key, value string) string {
+	query := c.Request().URL.Query()
+	query.Del(ParamPage)
+	query.Del(ParamCursor)
+	query.Set(key, value)
+
+	return c.Request().URL.Path + "?" + query.Encode()
+}
+
+// SetLinkHeader はRFC 8288形式のLinkヘッダーを設定します
+func SetLinkHeader(c echo.Context, links Links) {
+	var values []string
+
+	if links.Next != "" {
+		values = append(values, fmt.Sprintf(`<%s>; rel="next"`, links.Next))
+	}
+
+	if links.Prev != "" {
+		values = append(values, fmt.Sprintf(`<%s>; rel="prev"`, links.Prev))
+	}
+
+	if len(values) > 0 {
+		c.Response().Header().Set("Link", strings.Join(values, ", "))
+	}
+}
@@ -0,0 +1,151 @@
+package pagination
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestContext はテスト用のechoコンテキストを生成します
+func newTestContext(target string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	rec := httptest.NewRecorder()
+
+	return echo.New().NewContext(req, rec), rec
+}
+
+func TestParseParams(t *testing.T) {
+	cursor := EncodeCursor(Cursor{Sort: SortUpdatedAt, Order: OrderDesc, Value: "v", ID: 3, Page: 4})
+
+	tests := []struct {
+		name    string
+		target  string
+		want    Params
+		wantErr bool
+	}{
+		{
+			name:   "既定値",
+			target: "/universities",
+			want:   DefaultParams(),
+		},
+		{
+			name:   "ページ・件数・ソートの指定",
+			target: "/universities?page=3&perPage=50&sort=enrollment&order=DESC",
+			want:   Params{Page: 3, PerPage: 50, Sort: SortEnrollment, Order: OrderDesc},
+		},
+		{
+			name:   "limitによる件数指定",
+			target: "/universities?limit=5",
+			want:   Params{Page: 1, PerPage: 5, Sort: SortName, Order: OrderAsc},
+		},
+		{
+			name:   "カーソルのソート条件を優先",
+			target: "/universities?cursor=" + cursor + "&sort=name&page=1",
+			want: Params{
+				Page:    4,
+				PerPage: DefaultPerPage,
+				Sort:    SortUpdatedAt,
+				Order:   OrderDesc,
+				Cursor:  &Cursor{Sort: SortUpdatedAt, Order: OrderDesc, Value: "v", ID: 3, Page: 4},
+			},
+		},
+		{name: "ページ番号が0", target: "/universities?page=0", wantErr: true},
+		{name: "件数が上限超過", target: "/universities?perPage=101", wantErr: true},
+		{name: "件数が数値でない", target: "/universities?limit=abc", wantErr: true},
+		{name: "不正なソートキー", target: "/universities?sort=id", wantErr: true},
+		{name: "不正なソート順", target: "/universities?order=up", wantErr: true},
+		{name: "不正なカーソル", target: "/universities?cursor=not-a-cursor!", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := newTestContext(tt.target)
+
+			got, err := ParseParams(c)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDecodeCursor(t *testing.T) {
+	t.Run("エンコードしたカーソルを復元できる", func(t *testing.T) {
+		want := Cursor{Sort: SortName, Order: OrderAsc, Value: "東京大学", ID: 10, Backward: true, Page: 2}
+
+		got, err := DecodeCursor(EncodeCursor(want))
+		require.NoError(t, err)
+		assert.Equal(t, want, *got)
+	})
+
+	t.Run("内容が不正なカーソル", func(t *testing.T) {
+		_, err := DecodeCursor(EncodeCursor(Cursor{Sort: "id", Order: OrderAsc, Page: 1}))
+		assert.Error(t, err)
+
+		_, err = DecodeCursor(EncodeCursor(Cursor{Sort: SortName, Order: OrderAsc, Page: 0}))
+		assert.Error(t, err)
+	})
+}
+
+func TestBuildLinks(t *testing.T) {
+	t.Run("ページ番号のリンク", func(t *testing.T) {
+		c, rec := newTestContext("/api/universities?q=%E5%A4%A7&page=2&perPage=10")
+
+		links := BuildLinks(c, Params{Page: 2, PerPage: 10}, Page{Page: 2, HasNext: true, HasPrev: true})
+		assert.Equal(t, "/api/universities?page=3&perPage=10&q=%E5%A4%A7", links.Next)
+		assert.Equal(t, "/api/universities?page=1&perPage=10&q=%E5%A4%A7", links.Prev)
+
+		SetLinkHeader(c, links)
+		assert.Equal(t,
+			`</api/universities?page=3&perPage=10&q=%E5%A4%A7>; rel="next", `+
+				`</api/universities?page=1&perPage=10&q=%E5%A4%A7>; rel="prev"`,
+			rec.Header().Get("Link"),
+		)
+	})
+
+	t.Run("カーソルのリンク", func(t *testing.T) {
+		c, _ := newTestContext("/api/universities?cursor=old&page=9")
+
+		links := BuildLinks(c, Params{Cursor: &Cursor{}}, Page{HasNext: true, NextCursor: "next"})
+		assert.Equal(t, "/api/universities?cursor=next", links.Next)
+		assert.Empty(t, links.Prev)
+	})
+
+	t.Run("前後のページがない場合", func(t *testing.T) {
+		c, rec := newTestContext("/api/universities")
+
+		links := BuildLinks(c, DefaultParams(), Page{Page: 1})
+		assert.Empty(t, links.Next)
+		assert.Empty(t, links.Prev)
+
+		SetLinkHeader(c, links)
+		assert.Empty(t, rec.Header().Get("Link"))
+	})
+}
+
+func TestBuildResponse(t *testing.T) {
+	links := Links{Next: "/api/universities?page=2"}
+	resp := BuildResponse("universities", []string{"a", "b"}, Page{Total: 12, Page: 1, PerPage: 2, HasNext: true, NextCursor: "next"}, links)
+	assert.Equal(t, true, resp["success"])
+	assert.Contains(t, resp, "timestamp")
+	assert.Equal(t, links, resp["links"])
+
+	data, ok := resp["data"].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, []string{"a", "b"}, data["universities"])
+	assert.Equal(t, int64(12), data["total"])
+	assert.Equal(t, 1, data["page"])
+	assert.Equal(t, 2, data["perPage"])
+	assert.Equal(t, true, data["hasNext"])
+	assert.Equal(t, false, data["hasPrev"])
+	assert.Equal(t, "next", data["nextCursor"])
+	assert.NotContains(t, data, "prevCursor")
+}
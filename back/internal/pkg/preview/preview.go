@@ -0,0 +1,25 @@
+// Package preview は管理者向けの下書きプレビューモードをリクエストのコンテキストで受け渡す機能を提供します。
+// このパッケージは以下の機能を提供します：
+// - プレビューモードのコンテキストへの設定
+// - プレビューモードかどうかの判定
+package preview
+
+import "context"
+
+// QueryParam はプレビューモードを指定するクエリパラメータ名です
+const QueryParam = "preview"
+
+// contextKey はコンテキストのキーの型です
+type contextKey struct{}
+
+// WithDrafts は下書き・アーカイブ済みの入試情報も参照できるコンテキストを返します
+func WithDrafts(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextKey{}, true)
+}
+
+// IncludeDrafts はコンテキストがプレビューモードかどうかを返します
+// プレビューモードでない場合、公開APIは公開中の入試情報のみを返します
+func IncludeDrafts(ctx context.Context) bool {
+	enabled, _ := ctx.Value(contextKey{}).(bool)
+	return enabled
+}
@@ -0,0 +1,14 @@
+package preview
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIncludeDrafts(t *testing.T) {
+	ctx := context.Background()
+	assert.False(t, IncludeDrafts(ctx))
+	assert.True(t, IncludeDrafts(WithDrafts(ctx)))
+}
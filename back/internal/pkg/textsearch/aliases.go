@@ -0,0 +1,60 @@
+package textsearch
+
+// Aliases は大学名の略称・別名と正式名称の対応を表現する辞書です
+// キーは正規化済みの略称、値は正式名称の一覧です
+type Aliases map[string][]string
+
+// defaultAliases は一般的に使われる大学名の略称です
+var defaultAliases = map[string][]string{
+	"東大":   {"東京大学"},
+	"京大":   {"京都大学"},
+	"阪大":   {"大阪大学"},
+	"名大":   {"名古屋大学"},
+	"北大":   {"北海道大学"},
+	"東北大":  {"東北大学"},
+	"九大":   {"九州大学"},
+	"神大":   {"神戸大学"},
+	"広大":   {"広島大学"},
+	"東工大":  {"東京工業大学", "東京科学大学"},
+	"医科歯科": {"東京医科歯科大学", "東京科学大学"},
+	"横国":   {"横浜国立大学"},
+	"東外大":  {"東京外国語大学"},
+	"芸大":   {"東京藝術大学"},
+	"藝大":   {"東京藝術大学"},
+	"早大":   {"早稲田大学"},
+	"慶大":   {"慶應義塾大学"},
+	"慶應":   {"慶應義塾大学"},
+	"慶応":   {"慶應義塾大学"},
+	"明大":   {"明治大学"},
+	"青学":   {"青山学院大学"},
+	"中大":   {"中央大学"},
+	"法大":   {"法政大学"},
+	"理科大":  {"東京理科大学"},
+	"関大":   {"関西大学"},
+	"関学":   {"関西学院大学"},
+}
+
+// DefaultAliases は既定の略称辞書を返します
+func DefaultAliases() Aliases {
+	return NewAliases(defaultAliases)
+}
+
+// NewAliases は略称と正式名称の対応から辞書を生成します
+// 略称は正規化してキーとして登録します
+func NewAliases(entries map[string][]string) Aliases {
+	aliases := make(Aliases, len(entries))
+
+	for alias, names := range entries {
+		key := Normalize(alias)
+		for _, name := range names {
+			aliases[key] = append(aliases[key], Normalize(name))
+		}
+	}
+
+	return aliases
+}
+
+// Expand は正規化済みの検索語を、検索語自身と対応する正式名称の一覧に展開します
+func (a Aliases) Expand(term string) []string {
+	return append([]string{term}, a[term]...)
+}
@@ -0,0 +1,217 @@
+package textsearch
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// 一致箇所ごとの関連度
+// 大学名の完全一致 > 大学名の前方一致 > 大学名の部分一致 > 学部名 > 学科名 の順に高くなります
+const (
+	ScoreExactName   = 100
+	ScorePrefixName  = 80
+	ScorePartialName = 60
+	ScoreDepartment  = 40
+	ScoreMajor       = 20
+	scoreNoMatch     = 0
+)
+
+// 一致箇所
+const (
+	FieldName       = "name"
+	FieldDepartment = "department"
+	FieldMajor      = "major"
+)
+
+// Document は検索インデックスに登録する大学の情報です
+type Document struct {
+	ID          uint
+	Name        string
+	Departments []string
+	Majors      []string
+}
+
+// Hit は検索結果の1件を表現する構造体です
+// - Score: 検索語ごとの関連度の合計
+// - Field: 最も関連度の高い一致箇所（name, department, major）
+type Hit struct {
+	ID    uint
+	Name  string
+	Score int
+	Field string
+}
+
+// Index は大学の全文検索インデックスのインターフェースです
+// 実装を差し替えることで、外部の検索エンジンなどを利用できます
+type Index interface {
+	Rebuild(ctx context.Context, docs []Document) error
+	Search(ctx context.Context, query string) ([]Hit, error)
+}
+
+// indexedDocument は正規化済みの検索対象です
+type indexedDocument struct {
+	id          uint
+	name        string
+	normalized  string
+	departments []string
+	majors      []string
+}
+
+// MemoryIndex はメモリ上に正規化済みの文書を保持する検索インデックスです
+type MemoryIndex struct {
+	mu      sync.RWMutex
+	aliases Aliases
+	docs    []indexedDocument
+}
+
+// NewMemoryIndex は新しいMemoryIndexを作成します
+func NewMemoryIndex(aliases Aliases) *MemoryIndex {
+	return &MemoryIndex{aliases: aliases}
+}
+
+// normalizeAll は文字列の一覧を正規化します
+func normalizeAll(values []string) []string {
+	normalized := make([]string, 0, len(values))
+	for _, v := range values {
+		if v = Normalize(v); v != "" {
+			normalized = append(normalized, v)
+		}
+	}
+
+	return normalized
+}
+
+// Rebuild は文書の一覧でインデックスを再構築します
+func (idx *MemoryIndex) Rebuild(ctx context.Context, docs []Document) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	indexed := make([]indexedDocument, 0, len(docs))
+
+	for _, doc := range docs {
+		indexed = append(indexed, indexedDocument{
+			id:          doc.ID,
+			name:        doc.Name,
+			normalized:  Normalize(doc.Name),
+			departments: normalizeAll(doc.Departments),
+			majors:      normalizeAll(doc.Majors),
+		})
+	}
+
+	idx.mu.Lock()
+	idx.docs = indexed
+	idx.mu.Unlock()
+
+	return nil
+}
+
+// Search は検索クエリに一致する大学を関連度の高い順に返します。
+// この関数は以下の処理を行います：
+// - 検索クエリの正規化と検索語への分割
+// - 略称辞書による検索語の展開
+// - 全ての検索語に一致する大学の抽出（AND検索）
+// - 関連度・大学名・IDによる並び替え
+func (idx *MemoryIndex) Search(ctx context.Context, query string) ([]Hit, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	terms := Tokenize(query)
+	if len(terms) == 0 {
+		return []Hit{}, nil
+	}
+
+	expanded := make([][]string, 0, len(terms))
+	for _, term := range terms {
+		expanded = append(expanded, idx.aliases.Expand(term))
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	hits := make([]Hit, 0)
+
+	for _, doc := range idx.docs {
+		hit := Hit{ID: doc.id, Name: doc.name}
+		best := scoreNoMatch
+
+		for _, variants := range expanded {
+			score, field := doc.match(variants)
+			if score == scoreNoMatch {
+				hit.Score = scoreNoMatch
+				break
+			}
+
+			hit.Score += score
+
+			if score > best {
+				best = score
+				hit.Field = field
+			}
+		}
+
+		if hit.Score > scoreNoMatch {
+			hits = append(hits, hit)
+		}
+	}
+
+	sort.SliceStable(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+
+		if hits[i].Name != hits[j].Name {
+			return hits[i].Name < hits[j].Name
+		}
+
+		return hits[i].ID < hits[j].ID
+	})
+
+	return hits, nil
+}
+
+// match は検索語（略称展開後の候補を含む）に対する最も高い関連度と一致箇所を返します
+func (d indexedDocument) match(variants []string) (int, string) {
+	best, field := scoreNoMatch, ""
+
+	consider := func(score int, f string) {
+		if score > best {
+			best, field = score, f
+		}
+	}
+
+	for _, v := range variants {
+		switch {
+		case d.normalized == v:
+			consider(ScoreExactName, FieldName)
+		case strings.HasPrefix(d.normalized, v):
+			consider(ScorePrefixName, FieldName)
+		case strings.Contains(d.normalized, v):
+			consider(ScorePartialName, FieldName)
+		}
+
+		if containsAny(d.departments, v) {
+			consider(ScoreDepartment, FieldDepartment)
+		}
+
+		if containsAny(d.majors, v) {
+			consider(ScoreMajor, FieldMajor)
+		}
+	}
+
+	return best, field
+}
+
+// containsAny はいずれかの値が検索語を含むかどうかを返します
+func containsAny(values []string, term string) bool {
+	for _, v := range values {
+		if strings.Contains(v, term) {
+			return true
+		}
+	}
+
+	return false
+}
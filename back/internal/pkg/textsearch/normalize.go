@@ -0,0 +1,44 @@
+// Package textsearch は大学名・学部名・学科名の全文検索機能を提供します。
+// このパッケージは以下の機能を提供します：
+// - NFKC正規化とカタカナ・ひらがなの統一による表記ゆれの吸収
+// - 大学名の略称・別名辞書による検索語の展開
+// - 大学名・学部名・学科名の一致箇所による関連度の順位付け
+// - 差し替え可能な検索インデックス
+package textsearch
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// カタカナからひらがなへの変換範囲
+const (
+	katakanaStart  = 'ァ'
+	katakanaEnd    = 'ヶ'
+	katakanaOffset = 'ァ' - 'ぁ'
+)
+
+// Normalize は検索用に文字列を正規化します。
+// この関数は以下の処理を行います：
+// - NFKC正規化（全角英数字・半角カタカナなどの統一）
+// - 英字の小文字化
+// - カタカナのひらがなへの変換
+// - 前後の空白の除去
+func Normalize(s string) string {
+	s = strings.ToLower(norm.NFKC.String(s))
+
+	return strings.TrimSpace(strings.Map(func(r rune) rune {
+		if r >= katakanaStart && r <= katakanaEnd {
+			return r - katakanaOffset
+		}
+
+		return r
+	}, s))
+}
+
+// Tokenize は検索クエリを正規化し、空白で区切られた検索語に分割します
+func Tokenize(query string) []string {
+	return strings.FieldsFunc(Normalize(query), unicode.IsSpace)
+}
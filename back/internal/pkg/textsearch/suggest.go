@@ -0,0 +1,238 @@
+package textsearch
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"unicode/utf8"
+)
+
+// 候補の種別
+const (
+	SuggestTypeUniversity    = "university"
+	SuggestTypeDepartment    = "department"
+	SuggestTypeMajor         = "major"
+	SuggestTypeAcademicField = "academic_field"
+)
+
+// SuggestTypes は候補の種別の一覧です
+var SuggestTypes = []string{
+	SuggestTypeUniversity,
+	SuggestTypeDepartment,
+	SuggestTypeMajor,
+	SuggestTypeAcademicField,
+}
+
+// SuggestEntry は候補インデックスに登録する名称です
+// 同じ種別・同じ正規化後の名称は1件の候補にまとめられます
+type SuggestEntry struct {
+	Type         string
+	Text         string
+	UniversityID uint
+}
+
+// Suggestion は入力補完の候補を表現する構造体です
+// - ID: 大学の候補の場合の大学ID
+// - Count: 同じ名称を持つ大学の数
+type Suggestion struct {
+	Type  string `json:"type"`
+	Text  string `json:"text"`
+	ID    uint   `json:"id,omitempty"`
+	Count int    `json:"count"`
+}
+
+// Suggestions は種別ごとの候補の一覧です
+type Suggestions map[string][]Suggestion
+
+// SuggestIndex は入力補完の候補インデックスのインターフェースです
+type SuggestIndex interface {
+	Rebuild(ctx context.Context, entries []SuggestEntry) error
+	Suggest(ctx context.Context, prefix string, types []string, limit int) (Suggestions, error)
+}
+
+// trieNode はトライ木のノードです
+type trieNode struct {
+	children map[rune]*trieNode
+	items    []int
+}
+
+// child は指定した文字の子ノードを返します。createがtrueの場合は存在しなければ作成します
+func (n *trieNode) child(r rune, create bool) *trieNode {
+	if next, ok := n.children[r]; ok {
+		return next
+	}
+
+	if !create {
+		return nil
+	}
+
+	if n.children == nil {
+		n.children = make(map[rune]*trieNode)
+	}
+
+	next := &trieNode{}
+	n.children[r] = next
+
+	return next
+}
+
+// collect は配下の全ノードの候補を収集します
+func (n *trieNode) collect(seen map[int]bool) {
+	for _, item := range n.items {
+		seen[item] = true
+	}
+
+	for _, next := range n.children {
+		next.collect(seen)
+	}
+}
+
+// TrieSuggestIndex は正規化済みの名称をトライ木で保持する候補インデックスです
+type TrieSuggestIndex struct {
+	mu          sync.RWMutex
+	aliases     Aliases
+	root        *trieNode
+	suggestions []Suggestion
+	keys        []string
+}
+
+// NewTrieSuggestIndex は新しいTrieSuggestIndexを作成します
+func NewTrieSuggestIndex(aliases Aliases) *TrieSuggestIndex {
+	return &TrieSuggestIndex{aliases: aliases, root: &trieNode{}}
+}
+
+// insert は正規化済みのキーで候補を登録します
+func insert(root *trieNode, key string, item int) {
+	node := root
+	for _, r := range key {
+		node = node.child(r, true)
+	}
+
+	node.items = append(node.items, item)
+}
+
+// Rebuild は名称の一覧で候補インデックスを再構築します。
+// この関数は以下の処理を行います：
+// - 種別・正規化後の名称による候補の集約
+// - トライ木への登録
+// - 略称辞書に一致する大学名の略称での登録
+func (idx *TrieSuggestIndex) Rebuild(ctx context.Context, entries []SuggestEntry) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	root := &trieNode{}
+	suggestions := make([]Suggestion, 0, len(entries))
+	keys := make([]string, 0, len(entries))
+	byKey := make(map[string]int, len(entries))
+	universities := make(map[string]int)
+
+	for _, entry := range entries {
+		key := Normalize(entry.Text)
+		if key == "" {
+			continue
+		}
+
+		if item, ok := byKey[entry.Type+"\x00"+key]; ok {
+			suggestions[item].Count++
+			continue
+		}
+
+		suggestion := Suggestion{Type: entry.Type, Text: entry.Text, Count: 1}
+		if entry.Type == SuggestTypeUniversity {
+			suggestion.ID = entry.UniversityID
+			universities[key] = len(suggestions)
+		}
+
+		byKey[entry.Type+"\x00"+key] = len(suggestions)
+		insert(root, key, len(suggestions))
+		suggestions = append(suggestions, suggestion)
+		keys = append(keys, key)
+	}
+
+	for alias, names := range idx.aliases {
+		for _, name := range names {
+			if item, ok := universities[name]; ok {
+				insert(root, alias, item)
+			}
+		}
+	}
+
+	idx.mu.Lock()
+	idx.root = root
+	idx.suggestions = suggestions
+	idx.keys = keys
+	idx.mu.Unlock()
+
+	return nil
+}
+
+// Suggest は入力途中の文字列で始まる候補を種別ごとに返します。
+// この関数は以下の処理を行います：
+// - 入力文字列の正規化
+// - トライ木の探索による前方一致する候補の収集
+// - 指定種別での絞り込み
+// - 該当大学数の多い順・名称の短い順での並び替えと種別ごとの件数制限
+func (idx *TrieSuggestIndex) Suggest(
+	ctx context.Context,
+	prefix string,
+	types []string,
+	limit int,
+) (Suggestions, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	result := make(Suggestions, len(types))
+	for _, t := range types {
+		result[t] = []Suggestion{}
+	}
+
+	key := Normalize(prefix)
+	if key == "" || limit <= 0 {
+		return result, nil
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	node := idx.root
+	for _, r := range key {
+		if node = node.child(r, false); node == nil {
+			return result, nil
+		}
+	}
+
+	seen := make(map[int]bool)
+	node.collect(seen)
+
+	items := make([]int, 0, len(seen))
+	for item := range seen {
+		if _, ok := result[idx.suggestions[item].Type]; ok {
+			items = append(items, item)
+		}
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		a, b := idx.suggestions[items[i]], idx.suggestions[items[j]]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+
+		la, lb := utf8.RuneCountInString(idx.keys[items[i]]), utf8.RuneCountInString(idx.keys[items[j]])
+		if la != lb {
+			return la < lb
+		}
+
+		return idx.keys[items[i]] < idx.keys[items[j]]
+	})
+
+	for _, item := range items {
+		s := idx.suggestions[item]
+		if len(result[s.Type]) < limit {
+			result[s.Type] = append(result[s.Type], s)
+		}
+	}
+
+	return result, nil
+}
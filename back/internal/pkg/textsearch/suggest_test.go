@@ -0,0 +1,93 @@
+package textsearch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestSuggestIndex はテスト用の候補インデックスを生成します
+func newTestSuggestIndex(t *testing.T) *TrieSuggestIndex {
+	t.Helper()
+
+	index := NewTrieSuggestIndex(DefaultAliases())
+	require.NoError(t, index.Rebuild(context.Background(), []SuggestEntry{
+		{Type: SuggestTypeUniversity, Text: "東京大学", UniversityID: 1},
+		{Type: SuggestTypeUniversity, Text: "東京工業大学", UniversityID: 2},
+		{Type: SuggestTypeUniversity, Text: "東北大学", UniversityID: 3},
+		{Type: SuggestTypeDepartment, Text: "工学部", UniversityID: 1},
+		{Type: SuggestTypeDepartment, Text: "工学部", UniversityID: 2},
+		{Type: SuggestTypeDepartment, Text: "工芸学部", UniversityID: 3},
+		{Type: SuggestTypeMajor, Text: "データサイエンス学科", UniversityID: 1},
+		{Type: SuggestTypeAcademicField, Text: "工学", UniversityID: 1},
+	}))
+
+	return index
+}
+
+// suggestionTexts は候補の名称の一覧を返します
+func suggestionTexts(suggestions []Suggestion) []string {
+	texts := make([]string, 0, len(suggestions))
+	for _, s := range suggestions {
+		texts = append(texts, s.Text)
+	}
+
+	return texts
+}
+
+func TestTrieSuggestIndexSuggest(t *testing.T) {
+	index := newTestSuggestIndex(t)
+	ctx := context.Background()
+
+	t.Run("種別ごとの前方一致", func(t *testing.T) {
+		result, err := index.Suggest(ctx, "工", SuggestTypes, 10)
+		require.NoError(t, err)
+
+		assert.Empty(t, result[SuggestTypeUniversity])
+		assert.Equal(t, []string{"工学部", "工芸学部"}, suggestionTexts(result[SuggestTypeDepartment]))
+		assert.Equal(t, 2, result[SuggestTypeDepartment][0].Count)
+		assert.Equal(t, []string{"工学"}, suggestionTexts(result[SuggestTypeAcademicField]))
+	})
+
+	t.Run("種別ごとの件数制限", func(t *testing.T) {
+		result, err := index.Suggest(ctx, "東", SuggestTypes, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"東京大学", "東北大学"}, suggestionTexts(result[SuggestTypeUniversity]))
+		assert.Equal(t, uint(1), result[SuggestTypeUniversity][0].ID)
+	})
+
+	t.Run("種別の絞り込み", func(t *testing.T) {
+		result, err := index.Suggest(ctx, "工", []string{SuggestTypeAcademicField}, 10)
+		require.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, []string{"工学"}, suggestionTexts(result[SuggestTypeAcademicField]))
+	})
+
+	t.Run("略称とカタカナの正規化", func(t *testing.T) {
+		result, err := index.Suggest(ctx, "東大", SuggestTypes, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"東京大学"}, suggestionTexts(result[SuggestTypeUniversity]))
+
+		result, err = index.Suggest(ctx, "ﾃﾞｰﾀ", SuggestTypes, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"データサイエンス学科"}, suggestionTexts(result[SuggestTypeMajor]))
+	})
+
+	t.Run("一致なし", func(t *testing.T) {
+		result, err := index.Suggest(ctx, "存在しない", SuggestTypes, 10)
+		require.NoError(t, err)
+		assert.Empty(t, result[SuggestTypeUniversity])
+		assert.Len(t, result, len(SuggestTypes))
+	})
+
+	t.Run("再構築で古い候補を破棄", func(t *testing.T) {
+		rebuilt := newTestSuggestIndex(t)
+		require.NoError(t, rebuilt.Rebuild(ctx, []SuggestEntry{{Type: SuggestTypeUniversity, Text: "京都大学", UniversityID: 9}}))
+
+		result, err := rebuilt.Suggest(ctx, "東", SuggestTypes, 10)
+		require.NoError(t, err)
+		assert.Empty(t, result[SuggestTypeUniversity])
+	})
+}
@@ -0,0 +1,127 @@
+package textsearch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "全角英数字", input: "ＡＢＣ１２３", want: "abc123"},
+		{name: "半角カタカナ", input: "ｺｳｶﾞｸ", want: "こうがく"},
+		{name: "カタカナ", input: "データサイエンス", want: "でーたさいえんす"},
+		{name: "全角空白の除去", input: "　東京大学　", want: "東京大学"},
+		{name: "全角記号", input: "東京大学（本部）", want: "東京大学(本部)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Normalize(tt.input))
+		})
+	}
+}
+
+func TestTokenize(t *testing.T) {
+	assert.Equal(t, []string{"東京", "工学"}, Tokenize(" 東京　工学 "))
+	assert.Empty(t, Tokenize("　"))
+}
+
+func TestAliasesExpand(t *testing.T) {
+	aliases := NewAliases(map[string][]string{"ＴＵ": {"テスト大学"}})
+
+	assert.Equal(t, []string{"tu", "てすと大学"}, aliases.Expand("tu"))
+	assert.Equal(t, []string{"東京"}, aliases.Expand("東京"))
+}
+
+// newTestIndex はテスト用の検索インデックスを生成します
+func newTestIndex(t *testing.T) *MemoryIndex {
+	t.Helper()
+
+	index := NewMemoryIndex(DefaultAliases())
+	require.NoError(t, index.Rebuild(context.Background(), []Document{
+		{ID: 1, Name: "東京大学", Departments: []string{"工学部"}, Majors: []string{"機械工学科"}},
+		{ID: 2, Name: "東京工業大学", Departments: []string{"理学部"}, Majors: []string{"数学科"}},
+		{ID: 3, Name: "京都大学", Departments: []string{"東京研究所"}, Majors: []string{"情報学科"}},
+		{ID: 4, Name: "新東京大学", Departments: []string{"文学部"}, Majors: []string{"東京史学科"}},
+		{ID: 5, Name: "慶應義塾大学", Departments: []string{"理工学部"}, Majors: []string{"データサイエンス学科"}},
+	}))
+
+	return index
+}
+
+// hitIDs は検索結果のIDの一覧を返します
+func hitIDs(hits []Hit) []uint {
+	ids := make([]uint, 0, len(hits))
+	for _, h := range hits {
+		ids = append(ids, h.ID)
+	}
+
+	return ids
+}
+
+func TestMemoryIndexSearch(t *testing.T) {
+	index := newTestIndex(t)
+
+	tests := []struct {
+		name  string
+		query string
+		want  []uint
+	}{
+		{name: "前方一致・部分一致・学部名の順", query: "東京", want: []uint{1, 2, 4, 3}},
+		{name: "完全一致を最上位", query: "東京大学", want: []uint{1, 4}},
+		{name: "略称の展開", query: "東大", want: []uint{1, 4}},
+		{name: "表記ゆれの略称", query: "慶応", want: []uint{5}},
+		{name: "カタカナとひらがなの同一視", query: "でーたさいえんす", want: []uint{5}},
+		{name: "半角カタカナ", query: "ﾃﾞｰﾀ", want: []uint{5}},
+		{name: "全角空白区切りのAND検索", query: "東京　機械", want: []uint{1}},
+		{name: "ワイルドカード文字は通常の文字として扱う", query: "%", want: []uint{}},
+		{name: "一致なし", query: "存在しない大学", want: []uint{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hits, err := index.Search(context.Background(), tt.query)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, hitIDs(hits))
+		})
+	}
+
+	t.Run("完全一致の関連度", func(t *testing.T) {
+		hits, err := index.Search(context.Background(), "東大")
+		require.NoError(t, err)
+		require.Len(t, hits, 2)
+		assert.Equal(t, ScoreExactName, hits[0].Score)
+		assert.Equal(t, ScorePartialName, hits[1].Score)
+	})
+
+	t.Run("関連度と一致箇所", func(t *testing.T) {
+		hits, err := index.Search(context.Background(), "東京")
+		require.NoError(t, err)
+		require.Len(t, hits, 4)
+
+		assert.Equal(t, ScorePrefixName, hits[0].Score)
+		assert.Equal(t, FieldName, hits[0].Field)
+		assert.Equal(t, ScorePrefixName, hits[1].Score)
+		assert.Equal(t, ScorePartialName, hits[2].Score)
+		assert.Equal(t, ScoreDepartment, hits[3].Score)
+		assert.Equal(t, FieldDepartment, hits[3].Field)
+	})
+}
+
+func TestMemoryIndexCanceledContext(t *testing.T) {
+	index := newTestIndex(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := index.Search(ctx, "東京")
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.ErrorIs(t, index.Rebuild(ctx, nil), context.Canceled)
+}
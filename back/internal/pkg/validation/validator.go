@@ -8,12 +8,21 @@ package validation
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	appErrors "university-exam-api/internal/errors"
 	applogger "university-exam-api/internal/logger"
 	"university-exam-api/internal/pkg/errors"
 )
 
+// 学年度の範囲
+const (
+	// MinAcademicYear は指定可能な最小の学年度です
+	MinAcademicYear = 2000
+	// MaxAcademicYear は指定可能な最大の学年度です
+	MaxAcademicYear = 2100
+)
+
 // ParseID は文字列IDをuintに変換します。
 // この関数は以下の処理を行います：
 // - 文字列をuintに変換
@@ -88,3 +97,35 @@ func ValidateMajorID(ctx context.Context, idStr string) (uint, error) {
 func ValidateAdmissionInfoID(ctx context.Context, idStr string) (uint, error) {
 	return ParseID(ctx, idStr, errors.MsgInvalidAdmissionInfoID, "募集情報IDの形式が不正です")
 }
+
+// ValidateTestTypeID は試験種別IDのバリデーションを行います。
+// この関数は以下の処理を行います：
+// - 試験種別IDの形式チェック
+// - エラーハンドリング
+// - ログ記録
+func ValidateTestTypeID(ctx context.Context, idStr string) (uint, error) {
+	return ParseID(ctx, idStr, errors.MsgInvalidTestTypeID, "試験種別IDの形式が不正です")
+}
+
+// ValidateAcademicYear は学年度のバリデーションを行います。
+// この関数は以下の処理を行います：
+// - 学年度の形式チェック
+// - 学年度の範囲チェック
+// - ログ記録
+func ValidateAcademicYear(ctx context.Context, yearStr string) (int, error) {
+	year, err := strconv.Atoi(yearStr)
+	if err != nil || year < MinAcademicYear || year > MaxAcademicYear {
+		applogger.Error(ctx, errors.MsgInvalidAcademicYear, map[string]interface{}{
+			"error": err,
+			"year":  yearStr,
+		})
+
+		return 0, appErrors.NewInvalidInputError(
+			"academic_year",
+			fmt.Sprintf("学年度は%d-%dの範囲の整数である必要があります", MinAcademicYear, MaxAcademicYear),
+			map[string]string{"academic_year": yearStr},
+		)
+	}
+
+	return year, nil
+}
@@ -373,3 +373,105 @@ func TestValidateAdmissionInfoID(t *testing.T) {
 		})
 	}
 }
+
+// TestValidateTestTypeID は試験種別IDのバリデーションテストを行います。
+// このテストは以下のケースを検証します：
+// - 正常な試験種別ID
+// - 不正な試験種別ID
+// - エラーハンドリング
+func TestValidateTestTypeID(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cases := []struct {
+		name    string
+		idStr   string
+		want    uint
+		wantErr bool
+	}{
+		{
+			name:    "正常な試験種別ID",
+			idStr:   "123",
+			want:    123,
+			wantErr: false,
+		},
+		{
+			name:    "不正な試験種別ID",
+			idStr:   "abc",
+			want:    0,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ValidateTestTypeID(ctx, tt.idStr)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateTestTypeID() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("ValidateTestTypeID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestValidateAcademicYear は学年度のバリデーションテストを行います。
+// このテストは以下のケースを検証します：
+// - 正常な学年度
+// - 不正な学年度（文字列）
+// - 範囲外の学年度
+func TestValidateAcademicYear(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cases := []struct {
+		name    string
+		yearStr string
+		want    int
+		wantErr bool
+	}{
+		{
+			name:    "正常な学年度",
+			yearStr: "2025",
+			want:    2025,
+			wantErr: false,
+		},
+		{
+			name:    "不正な学年度（文字列）",
+			yearStr: "abc",
+			want:    0,
+			wantErr: true,
+		},
+		{
+			name:    "範囲外の学年度",
+			yearStr: "1999",
+			want:    0,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ValidateAcademicYear(ctx, tt.yearStr)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateAcademicYear() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("ValidateAcademicYear() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,235 @@
+// Package xlsx はExcelブック（.xlsx）の最小限の読み書き機能を提供します。
+// このパッケージは以下の機能を提供します：
+// - 先頭シートのセル値の読み込み
+// - 共有文字列・インライン文字列の解決
+package xlsx
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+)
+
+// ブック内の既定のパス
+const (
+	workbookPath      = "xl/workbook.xml"
+	workbookRelsPath  = "xl/_rels/workbook.xml.rels"
+	sharedStringsPath = "xl/sharedStrings.xml"
+	defaultSheetPath  = "xl/worksheets/sheet1.xml"
+)
+
+// ErrNoSheet はブックにシートが含まれていない場合のエラーです
+var ErrNoSheet = errors.New("xlsx: シートが見つかりません")
+
+// xmlText は文字列要素です
+type xmlText struct {
+	Text string `xml:",chardata"`
+}
+
+// xmlRichText は書式付き文字列の要素です
+type xmlRichText struct {
+	T xmlText `xml:"t"`
+}
+
+// xmlStringItem は共有文字列・インライン文字列の要素です
+type xmlStringItem struct {
+	T xmlText       `xml:"t"`
+	R []xmlRichText `xml:"r"`
+}
+
+// text は文字列要素の値を返します
+func (s xmlStringItem) text() string {
+	if len(s.R) == 0 {
+		return s.T.Text
+	}
+
+	var b strings.Builder
+	for _, r := range s.R {
+		b.WriteString(r.T.Text)
+	}
+
+	return b.String()
+}
+
+// xmlSharedStrings は共有文字列テーブルです
+type xmlSharedStrings struct {
+	Items []xmlStringItem `xml:"si"`
+}
+
+// xmlCell はセルの要素です
+type xmlCell struct {
+	Ref    string         `xml:"r,attr"`
+	Type   string         `xml:"t,attr"`
+	Value  string         `xml:"v"`
+	Inline *xmlStringItem `xml:"is"`
+}
+
+// xmlRow は行の要素です
+type xmlRow struct {
+	Cells []xmlCell `xml:"c"`
+}
+
+// xmlWorksheet はワークシートの要素です
+type xmlWorksheet struct {
+	Rows []xmlRow `xml:"sheetData>row"`
+}
+
+// xmlWorkbook はブックの要素です
+type xmlWorkbook struct {
+	Sheets []struct {
+		RID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+// xmlRelationships はブックの関連付けです
+type xmlRelationships struct {
+	Items []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+// ReadRows はブックの先頭シートを読み込み、行ごとのセル値を返します。
+// この関数は以下の処理を行います：
+// - ブック・関連付けからの先頭シートの特定
+// - 共有文字列テーブルの読み込み
+// - セル参照に基づく列位置の復元（空セルは空文字列）
+func ReadRows(r io.ReaderAt, size int64) ([][]string, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("xlsx: ブックを開けません: %w", err)
+	}
+
+	files := make(map[string]*zip.File, len(archive.File))
+	for _, f := range archive.File {
+		files[f.Name] = f
+	}
+
+	var shared xmlSharedStrings
+	if f, ok := files[sharedStringsPath]; ok {
+		if err := decodeFile(f, &shared); err != nil {
+			return nil, err
+		}
+	}
+
+	sheet, ok := files[firstSheetPath(files)]
+	if !ok {
+		return nil, ErrNoSheet
+	}
+
+	var ws xmlWorksheet
+	if err := decodeFile(sheet, &ws); err != nil {
+		return nil, err
+	}
+
+	rows := make([][]string, 0, len(ws.Rows))
+
+	for _, row := range ws.Rows {
+		values := make([]string, 0, len(row.Cells))
+
+		for i, cell := range row.Cells {
+			col := i
+			if cell.Ref != "" {
+				col = columnIndex(cell.Ref)
+			}
+
+			for len(values) < col {
+				values = append(values, "")
+			}
+
+			value, err := cellValue(cell, shared)
+			if err != nil {
+				return nil, err
+			}
+
+			values = append(values, value)
+		}
+
+		rows = append(rows, values)
+	}
+
+	return rows, nil
+}
+
+// firstSheetPath はブックの先頭シートのパスを返します
+func firstSheetPath(files map[string]*zip.File) string {
+	var wb xmlWorkbook
+
+	var rels xmlRelationships
+
+	wbFile, ok := files[workbookPath]
+	relsFile, relsOK := files[workbookRelsPath]
+
+	if !ok || !relsOK || decodeFile(wbFile, &wb) != nil || decodeFile(relsFile, &rels) != nil || len(wb.Sheets) == 0 {
+		return defaultSheetPath
+	}
+
+	for _, rel := range rels.Items {
+		if rel.ID != wb.Sheets[0].RID {
+			continue
+		}
+
+		if strings.HasPrefix(rel.Target, "/") {
+			return strings.TrimPrefix(rel.Target, "/")
+		}
+
+		return path.Join("xl", rel.Target)
+	}
+
+	return defaultSheetPath
+}
+
+// cellValue はセルの種別に応じて値を文字列で返します
+func cellValue(cell xmlCell, shared xmlSharedStrings) (string, error) {
+	switch cell.Type {
+	case "s":
+		var index int
+		if _, err := fmt.Sscanf(cell.Value, "%d", &index); err != nil || index < 0 || index >= len(shared.Items) {
+			return "", fmt.Errorf("xlsx: 共有文字列の参照が不正です: %s", cell.Ref)
+		}
+
+		return shared.Items[index].text(), nil
+	case "inlineStr":
+		if cell.Inline == nil {
+			return "", nil
+		}
+
+		return cell.Inline.text(), nil
+	default:
+		return cell.Value, nil
+	}
+}
+
+// columnIndex はセル参照（例: "AB12"）から0始まりの列番号を返します
+func columnIndex(ref string) int {
+	col := 0
+
+	for _, r := range ref {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+
+		col = col*26 + int(r-'A'+1)
+	}
+
+	return col - 1
+}
+
+// decodeFile はブック内のXMLファイルを読み込みます
+func decodeFile(f *zip.File, v interface{}) error {
+	rc, err := f.Open()
+	if err != nil {
+		return fmt.Errorf("xlsx: %s を開けません: %w", f.Name, err)
+	}
+	defer rc.Close()
+
+	if err := xml.NewDecoder(rc).Decode(v); err != nil {
+		return fmt.Errorf("xlsx: %s の形式が不正です: %w", f.Name, err)
+	}
+
+	return nil
+}
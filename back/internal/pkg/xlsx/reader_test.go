@@ -0,0 +1,72 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// buildBook はテスト用のブックを生成します
+func buildBook(t *testing.T, files map[string]string) *bytes.Reader {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, zw.Close())
+
+	return bytes.NewReader(buf.Bytes())
+}
+
+func TestReadRows(t *testing.T) {
+	book := buildBook(t, map[string]string{
+		"xl/workbook.xml": `<workbook xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+			`<sheets><sheet name="データ" sheetId="1" r:id="rId1"/></sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<Relationships>` +
+			`<Relationship Id="rId1" Target="worksheets/data.xml"/></Relationships>`,
+		"xl/sharedStrings.xml": `<sst><si><t>大学名</t></si><si><r><t>東京</t></r><r><t>大学</t></r></si></sst>`,
+		"xl/worksheets/data.xml": `<worksheet><sheetData>` +
+			`<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="inlineStr"><is><t>配点</t></is></c></row>` +
+			`<row r="2"><c r="A2" t="s"><v>1</v></c><c r="C2"><v>200</v></c></row>` +
+			`</sheetData></worksheet>`,
+	})
+
+	rows, err := ReadRows(book, book.Size())
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"大学名", "配点"},
+		{"東京大学", "", "200"},
+	}, rows)
+}
+
+func TestReadRowsErrors(t *testing.T) {
+	t.Run("ZIPでない", func(t *testing.T) {
+		data := bytes.NewReader([]byte("university,department"))
+		_, err := ReadRows(data, data.Size())
+		assert.Error(t, err)
+	})
+
+	t.Run("シートなし", func(t *testing.T) {
+		book := buildBook(t, map[string]string{"xl/workbook.xml": `<workbook/>`})
+		_, err := ReadRows(book, book.Size())
+		assert.ErrorIs(t, err, ErrNoSheet)
+	})
+
+	t.Run("共有文字列の参照が範囲外", func(t *testing.T) {
+		book := buildBook(t, map[string]string{
+			"xl/worksheets/sheet1.xml": `<worksheet><sheetData><row><c r="A1" t="s"><v>3</v></c></row></sheetData></worksheet>`,
+		})
+		_, err := ReadRows(book, book.Size())
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,189 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// ブックの固定部分
+const (
+	contentTypesXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ` +
+		`ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ` +
+		`ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`</Types>`
+	rootRelsXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" ` +
+		`Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" ` +
+		`Target="xl/workbook.xml"/></Relationships>`
+	workbookRelsXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" ` +
+		`Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" ` +
+		`Target="worksheets/sheet1.xml"/></Relationships>`
+	workbookXMLFormat = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" ` +
+		`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="%s" sheetId="1" r:id="rId1"/></sheets></workbook>`
+	sheetHeaderXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+	sheetFooterXML = `</sheetData></worksheet>`
+)
+
+// Writer は1シートのブックを行単位で書き出す構造体です
+// シートの内容は書き出し先に逐次出力されるため、行数によらずメモリ使用量は一定です
+type Writer struct {
+	zw    *zip.Writer
+	sheet *bufio.Writer
+	rows  int
+}
+
+// NewWriter は指定したシート名のブックを書き出すWriterを生成します
+func NewWriter(w io.Writer, sheetName string) (*Writer, error) {
+	zw := zip.NewWriter(w)
+
+	var name xmlEscaped
+	if err := name.set(sheetName); err != nil {
+		return nil, err
+	}
+
+	parts := []struct {
+		path    string
+		content string
+	}{
+		{"[Content_Types].xml", contentTypesXML},
+		{"_rels/.rels", rootRelsXML},
+		{workbookPath, fmt.Sprintf(workbookXMLFormat, name)},
+		{workbookRelsPath, workbookRelsXML},
+	}
+
+	for _, part := range parts {
+		f, err := zw.Create(part.path)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := io.WriteString(f, part.content); err != nil {
+			return nil, err
+		}
+	}
+
+	f, err := zw.Create(defaultSheetPath)
+	if err != nil {
+		return nil, err
+	}
+
+	sheet := bufio.NewWriter(f)
+	if _, err := sheet.WriteString(sheetHeaderXML); err != nil {
+		return nil, err
+	}
+
+	return &Writer{zw: zw, sheet: sheet}, nil
+}
+
+// WriteRow は1行分のセルを書き出します
+// 文字列は文字列セル、整数・小数は数値セルとして書き出し、nilは空セルとします
+func (w *Writer) WriteRow(values ...interface{}) error {
+	w.rows++
+
+	if _, err := fmt.Fprintf(w.sheet, `<row r="%d">`, w.rows); err != nil {
+		return err
+	}
+
+	for i, v := range values {
+		ref := columnName(i) + strconv.Itoa(w.rows)
+
+		var err error
+
+		switch value := v.(type) {
+		case nil:
+			continue
+		case int:
+			_, err = fmt.Fprintf(w.sheet, `<c r="%s"><v>%d</v></c>`, ref, value)
+		case uint:
+			_, err = fmt.Fprintf(w.sheet, `<c r="%s"><v>%d</v></c>`, ref, value)
+		case float64:
+			_, err = fmt.Fprintf(w.sheet, `<c r="%s"><v>%s</v></c>`, ref, strconv.FormatFloat(value, 'f', -1, 64))
+		default:
+			var text xmlEscaped
+			if err = text.set(fmt.Sprint(value)); err == nil {
+				_, err = fmt.Fprintf(w.sheet, `<c r="%s" t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`,
+					ref, text)
+			}
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := w.sheet.WriteString(`</row>`)
+
+	return err
+}
+
+// Flush はバッファに溜まったシートの内容を書き出し先に出力します
+func (w *Writer) Flush() error {
+	if err := w.sheet.Flush(); err != nil {
+		return err
+	}
+
+	return w.zw.Flush()
+}
+
+// Close はシートを閉じてブックの書き出しを完了します
+func (w *Writer) Close() error {
+	if _, err := w.sheet.WriteString(sheetFooterXML); err != nil {
+		return err
+	}
+
+	if err := w.sheet.Flush(); err != nil {
+		return err
+	}
+
+	return w.zw.Close()
+}
+
+// columnName は0始まりの列番号から列名（例: 27→"AB"）を返します
+func columnName(index int) string {
+	name := ""
+
+	for index++; index > 0; index = (index - 1) / 26 {
+		name = string(rune('A'+(index-1)%26)) + name
+	}
+
+	return name
+}
+
+// xmlEscaped はXMLの文字データとしてエスケープ済みの文字列です
+type xmlEscaped string
+
+// set は文字列をエスケープして設定します
+func (e *xmlEscaped) set(s string) error {
+	var buf stringWriter
+	if err := xml.EscapeText(&buf, []byte(s)); err != nil {
+		return err
+	}
+
+	*e = xmlEscaped(buf)
+
+	return nil
+}
+
+// stringWriter は書き込まれた内容を文字列として保持するio.Writerです
+type stringWriter string
+
+// Write は内容を追記します
+func (s *stringWriter) Write(p []byte) (int, error) {
+	*s += stringWriter(p)
+	return len(p), nil
+}
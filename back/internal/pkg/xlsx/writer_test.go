@@ -0,0 +1,49 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriterRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+
+	w, err := NewWriter(&buf, "大学<一覧>")
+	require.NoError(t, err)
+	require.NoError(t, w.WriteRow("university_name", "score", "percentage", "academic_year"))
+	require.NoError(t, w.Flush())
+	require.NoError(t, w.WriteRow("東京&大学", 200, 33.33, nil))
+	require.NoError(t, w.WriteRow(" 京都大学", uint(5), 0.0, 2025))
+	require.NoError(t, w.Close())
+
+	rows, err := ReadRows(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"university_name", "score", "percentage", "academic_year"},
+		{"東京&大学", "200", "33.33"},
+		{" 京都大学", "5", "0", "2025"},
+	}, rows)
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	names := make([]string, 0, len(zr.File))
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+	}
+
+	assert.Contains(t, names, "[Content_Types].xml")
+	assert.Contains(t, names, "_rels/.rels")
+}
+
+func TestColumnName(t *testing.T) {
+	assert.Equal(t, "A", columnName(0))
+	assert.Equal(t, "Z", columnName(25))
+	assert.Equal(t, "AA", columnName(26))
+	assert.Equal(t, "AB", columnName(27))
+	assert.Equal(t, "BA", columnName(52))
+}
@@ -0,0 +1,234 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+
+	"gorm.io/gorm"
+)
+
+// unlinkedTestTypeCondition は年度の入試情報に紐付いていない入試日程共通の試験種別を取得する条件です
+const unlinkedTestTypeCondition = "deleted_at IS NULL AND id NOT IN (SELECT test_type_id FROM admission_info_test_types)"
+
+// MajorPath は大学・学部・学科の親子関係を表現する構造体です
+type MajorPath struct {
+	UniversityID uint
+	DepartmentID uint
+	MajorID      uint
+}
+
+// YearScopedEntity は年度別データに付与する大学・学部・学科の概要です
+type YearScopedEntity struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+}
+
+// YearScopedSchedule は指定年度の入試日程ごとの配点内訳を表現する構造体です
+// 試験種別は年度の入試情報に紐付くものを優先し、紐付けがない場合は
+// どの年度にも紐付いていない入試日程共通の試験種別を使用します
+// 日程（出願期間・試験日・合格発表・入学手続締切）は指定年度のものを開始日の順に含みます
+type YearScopedSchedule struct {
+	ID            uint                   `json:"id"`
+	Name          string                 `json:"name"`
+	DisplayOrder  int                    `json:"display_order"`
+	AdmissionInfo models.AdmissionInfo   `json:"admission_info"`
+	TestTypes     []models.TestType      `json:"test_types"`
+	Events        []models.ScheduleEvent `json:"events"`
+}
+
+// YearScopedMajor は指定年度の学科→日程→試験種別→科目の内訳を表現する構造体です
+type YearScopedMajor struct {
+	AcademicYear int                  `json:"academic_year"`
+	University   YearScopedEntity     `json:"university"`
+	Department   YearScopedEntity     `json:"department"`
+	Major        YearScopedEntity     `json:"major"`
+	Schedules    []YearScopedSchedule `json:"schedules"`
+}
+
+// AcademicYearRepository は年度別の入試データを取得するリポジトリインターフェースです
+type AcademicYearRepository interface {
+	FindYearsByMajor(ctx context.Context, path MajorPath) ([]int, error)
+	FindMajorByYear(ctx context.Context, path MajorPath, year int) (*YearScopedMajor, error)
+}
+
+// academicYearRepository はAcademicYearRepositoryの実装です
+type academicYearRepository struct {
+	db *gorm.DB
+}
+
+// NewAcademicYearRepository は新しいAcademicYearRepositoryを作成します
+func NewAcademicYearRepository(db *gorm.DB) AcademicYearRepository {
+	return &academicYearRepository{db: db}
+}
+
+// findMajorPath は大学・学部・学科の親子関係を検証し、それぞれの概要を取得します
+func (r *academicYearRepository) findMajorPath(ctx context.Context, path MajorPath) (*YearScopedMajor, error) {
+	var row struct {
+		UniversityName string
+		DepartmentName string
+		MajorName      string
+	}
+
+	err := r.db.WithContext(ctx).Table("majors").
+		Select("universities.name AS university_name, departments.name AS department_name, majors.name AS major_name").
+		Joins("JOIN departments ON departments.id = majors.department_id").
+		Joins("JOIN universities ON universities.id = departments.university_id").
+		Where("majors.id = ? AND departments.id = ? AND universities.id = ?",
+			path.MajorID, path.DepartmentID, path.UniversityID).
+		Where("majors.deleted_at IS NULL AND departments.deleted_at IS NULL AND universities.deleted_at IS NULL").
+		Take(&row).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appErrors.NewNotFoundError("学科", path.MajorID, map[string]string{
+				"university_id": strconv.FormatUint(uint64(path.UniversityID), 10),
+				"department_id": strconv.FormatUint(uint64(path.DepartmentID), 10),
+			})
+		}
+
+		return nil, appErrors.NewDatabaseError("学科検索処理", err, nil)
+	}
+
+	return &YearScopedMajor{
+		University: YearScopedEntity{ID: path.UniversityID, Name: row.UniversityName},
+		Department: YearScopedEntity{ID: path.DepartmentID, Name: row.DepartmentName},
+		Major:      YearScopedEntity{ID: path.MajorID, Name: row.MajorName},
+	}, nil
+}
+
+// FindYearsByMajor は学科に入試情報が登録されている年度を新しい順に取得します。
+// この関数は以下の処理を行います：
+// - 大学・学部・学科の親子関係の検証
+// - 入試情報の年度の重複排除
+// - 年度の降順での並び替え
+func (r *academicYearRepository) FindYearsByMajor(ctx context.Context, path MajorPath) ([]int, error) {
+	if _, err := r.findMajorPath(ctx, path); err != nil {
+		return nil, err
+	}
+
+	years := make([]int, 0)
+
+	query := r.db.WithContext(ctx).Model(&models.AdmissionInfo{}).
+		Distinct("admission_infos.academic_year").
+		Joins("JOIN admission_schedules ON admission_schedules.id = admission_infos.admission_schedule_id").
+		Where("admission_schedules.major_id = ?", path.MajorID).
+		Where("admission_infos.deleted_at IS NULL AND admission_schedules.deleted_at IS NULL")
+
+	err := visibleAdmissionInfos(ctx, query, "admission_infos.status").
+		Order("admission_infos.academic_year DESC").
+		Pluck("admission_infos.academic_year", &years).Error
+	if err != nil {
+		return nil, appErrors.NewDatabaseError("年度一覧取得処理", err, nil)
+	}
+
+	return years, nil
+}
+
+// FindMajorByYear は指定年度の学科の入試日程・試験種別・科目の内訳を取得します。
+// この関数は以下の処理を行います：
+// - 大学・学部・学科の親子関係の検証
+// - 指定年度の入試情報を持つ入試日程の取得
+// - 年度に紐付く試験種別と科目の取得
+// - 指定年度の日程の取得
+func (r *academicYearRepository) FindMajorByYear(
+	ctx context.Context,
+	path MajorPath,
+	year int,
+) (*YearScopedMajor, error) {
+	result, err := r.findMajorPath(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var schedules []models.AdmissionSchedule
+
+	subjectOrder := func(db *gorm.DB) *gorm.DB {
+		return db.Where(notDeletedCondition).Order(displayOrderASC)
+	}
+
+	// 試験種別は試験種別レジストリの表示順に並べます
+	testTypes := func(condition string) func(db *gorm.DB) *gorm.DB {
+		return func(db *gorm.DB) *gorm.DB {
+			return db.Where(condition).Order(testTypeOrder())
+		}
+	}
+
+	yearInfos := func(db *gorm.DB) *gorm.DB {
+		return visibleAdmissionInfos(ctx, db.Where("academic_year = ? AND deleted_at IS NULL", year), "status")
+	}
+
+	err = r.db.WithContext(ctx).
+		Where("major_id = ? AND deleted_at IS NULL", path.MajorID).
+		Where("id IN (?)", yearInfos(r.db.WithContext(ctx).Model(&models.AdmissionInfo{}).
+			Select("admission_schedule_id"))).
+		Preload("AdmissionInfos", yearInfos).
+		Preload("AdmissionInfos.TestTypes", testTypes(notDeletedCondition)).
+		Preload("AdmissionInfos.TestTypes.Subjects", subjectOrder).
+		Preload("AdmissionInfos.TestTypes.SubjectGroups", subjectOrder).
+		Preload("AdmissionInfos.Difficulty", notDeletedCondition).
+		Preload("TestTypes", testTypes(unlinkedTestTypeCondition)).
+		Preload("TestTypes.Subjects", subjectOrder).
+		Preload("TestTypes.SubjectGroups", subjectOrder).
+		Preload("Events", func(db *gorm.DB) *gorm.DB {
+			return db.Where("academic_year = ? AND deleted_at IS NULL", year).Order("start_date ASC").Order("id ASC")
+		}).
+		Order("display_order ASC").
+		Order("id ASC").
+		Find(&schedules).Error
+	if err != nil {
+		return nil, appErrors.NewDatabaseError("年度別入試情報取得処理", err, nil)
+	}
+
+	if len(schedules) == 0 {
+		return nil, appErrors.NewNotFoundError("入試情報", path.MajorID, map[string]string{
+			"academic_year": strconv.Itoa(year),
+		})
+	}
+
+	result.AcademicYear = year
+	result.Schedules = make([]YearScopedSchedule, 0, len(schedules))
+
+	for _, schedule := range schedules {
+		testTypes := scheduleTestTypes(schedule)
+
+		info := schedule.AdmissionInfos[0]
+		info.TestTypes = nil
+
+		events := schedule.Events
+		if events == nil {
+			events = []models.ScheduleEvent{}
+		}
+
+		result.Schedules = append(result.Schedules, YearScopedSchedule{
+			ID:            schedule.ID,
+			Name:          schedule.Name,
+			DisplayOrder:  schedule.DisplayOrder,
+			AdmissionInfo: info,
+			TestTypes:     testTypes,
+			Events:        events,
+		})
+	}
+
+	return result, nil
+}
+
+// scheduleTestTypes は入試日程の試験種別を解決します
+// 読み込み済みの入試情報に紐付く試験種別を優先し、紐付けがない場合は入試日程共通の試験種別を使用します
+func scheduleTestTypes(schedule models.AdmissionSchedule) []models.TestType {
+	var testTypes []models.TestType
+	if len(schedule.AdmissionInfos) > 0 {
+		testTypes = schedule.AdmissionInfos[0].TestTypes
+	}
+
+	if len(testTypes) == 0 {
+		testTypes = schedule.TestTypes
+	}
+
+	if testTypes == nil {
+		testTypes = []models.TestType{}
+	}
+
+	return testTypes
+}
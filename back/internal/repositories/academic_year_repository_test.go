@@ -0,0 +1,202 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// newYearTestTestType はテスト用の試験種別を生成します
+func newYearTestTestType(name string, subjects ...models.Subject) models.TestType {
+	return models.TestType{
+		BaseModel: models.BaseModel{Version: 1},
+		Name:      name,
+		Subjects:  subjects,
+	}
+}
+
+// newYearTestSubject はテスト用の科目を生成します
+func newYearTestSubject(name string, score, order int) models.Subject {
+	return models.Subject{
+		BaseModel:    models.BaseModel{Version: 1},
+		Name:         name,
+		Score:        score,
+		DisplayOrder: order,
+	}
+}
+
+// setupAcademicYearTestData は年度別データのテストデータを作成し、大学・学部・学科のパスを返します
+// - 前期: 2024年度・2025年度の入試情報を持ち、2025年度のみ年度専用の試験種別を持つ
+// - 後期: 2024年度の入試情報のみを持つ
+func setupAcademicYearTestData(t *testing.T, db *gorm.DB) MajorPath {
+	t.Helper()
+
+	university := &models.University{
+		BaseModel: models.BaseModel{Version: 1},
+		Name:      "東京大学",
+		Departments: []models.Department{
+			{
+				Name: "工学部",
+				Majors: []models.Major{
+					{
+						Name: "機械工学科",
+						AdmissionSchedules: []models.AdmissionSchedule{
+							{
+								Name:         "後",
+								DisplayOrder: 2,
+								AdmissionInfos: []models.AdmissionInfo{
+									{Enrollment: 20, AcademicYear: 2024, Status: "published"},
+								},
+								TestTypes: []models.TestType{
+									newYearTestTestType("二次", newYearTestSubject("小論文", 100, 1)),
+								},
+							},
+							{
+								Name:         "前",
+								DisplayOrder: 1,
+								AdmissionInfos: []models.AdmissionInfo{
+									{Enrollment: 100, AcademicYear: 2024, Status: "published"},
+								},
+								TestTypes: []models.TestType{
+									newYearTestTestType("共通",
+										newYearTestSubject("英語", 200, 2),
+										newYearTestSubject("数学", 200, 1),
+									),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	require.NoError(t, db.Create(university).Error)
+
+	department := university.Departments[0]
+	major := department.Majors[0]
+	early := major.AdmissionSchedules[1]
+
+	// 2025年度は年度専用の試験種別を紐付ける
+	yearTestType := newYearTestTestType("二次", newYearTestSubject("数学", 300, 1))
+	yearTestType.AdmissionScheduleID = early.ID
+	require.NoError(t, db.Create(&yearTestType).Error)
+
+	info := models.AdmissionInfo{
+		AdmissionScheduleID: early.ID,
+		Enrollment:          90,
+		AcademicYear:        2025,
+		Status:              "published",
+		TestTypes:           []models.TestType{yearTestType},
+	}
+	require.NoError(t, db.Create(&info).Error)
+
+	return MajorPath{UniversityID: university.ID, DepartmentID: department.ID, MajorID: major.ID}
+}
+
+func TestAcademicYearRepositoryFindYearsByMajor(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	path := setupAcademicYearTestData(t, db)
+
+	repo := NewAcademicYearRepository(db)
+
+	t.Run("年度を新しい順に取得", func(t *testing.T) {
+		years, err := repo.FindYearsByMajor(context.Background(), path)
+		require.NoError(t, err)
+		assert.Equal(t, []int{2025, 2024}, years)
+	})
+
+	t.Run("親子関係が一致しない場合", func(t *testing.T) {
+		invalid := path
+		invalid.DepartmentID += 100
+
+		_, err := repo.FindYearsByMajor(context.Background(), invalid)
+		require.Error(t, err)
+
+		var appErr *appErrors.Error
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.CodeNotFound, appErr.Code)
+	})
+}
+
+func TestAcademicYearRepositoryFindMajorByYear(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	path := setupAcademicYearTestData(t, db)
+
+	repo := NewAcademicYearRepository(db)
+
+	t.Run("入試日程の試験種別を使用する年度", func(t *testing.T) {
+		result, err := repo.FindMajorByYear(context.Background(), path, 2024)
+		require.NoError(t, err)
+
+		assert.Equal(t, 2024, result.AcademicYear)
+		assert.Equal(t, "東京大学", result.University.Name)
+		assert.Equal(t, "工学部", result.Department.Name)
+		assert.Equal(t, "機械工学科", result.Major.Name)
+		require.Len(t, result.Schedules, 2)
+
+		early := result.Schedules[0]
+		assert.Equal(t, "前", early.Name)
+		assert.Equal(t, 100, early.AdmissionInfo.Enrollment)
+		require.Len(t, early.TestTypes, 1)
+		assert.Equal(t, "共通", early.TestTypes[0].Name)
+		require.Len(t, early.TestTypes[0].Subjects, 2)
+		assert.Equal(t, "数学", early.TestTypes[0].Subjects[0].Name)
+		assert.Equal(t, "英語", early.TestTypes[0].Subjects[1].Name)
+
+		assert.Equal(t, "後", result.Schedules[1].Name)
+	})
+
+	t.Run("年度専用の試験種別を優先する年度", func(t *testing.T) {
+		result, err := repo.FindMajorByYear(context.Background(), path, 2025)
+		require.NoError(t, err)
+		require.Len(t, result.Schedules, 1)
+
+		early := result.Schedules[0]
+		assert.Equal(t, 90, early.AdmissionInfo.Enrollment)
+		require.Len(t, early.TestTypes, 1)
+		assert.Equal(t, "二次", early.TestTypes[0].Name)
+		require.Len(t, early.TestTypes[0].Subjects, 1)
+		assert.Equal(t, 300, early.TestTypes[0].Subjects[0].Score)
+	})
+
+	t.Run("指定年度の日程を開始日の順に含む", func(t *testing.T) {
+		var early models.AdmissionSchedule
+		require.NoError(t, db.Where("major_id = ? AND name = ?", path.MajorID, "前").First(&early).Error)
+
+		for _, event := range []models.ScheduleEvent{
+			{AcademicYear: 2024, Type: models.ScheduleEventExam, StartDate: models.NewDate(2024, 2, 25)},
+			{AcademicYear: 2024, Type: models.ScheduleEventApplication, StartDate: models.NewDate(2024, 1, 22)},
+			{AcademicYear: 2025, Type: models.ScheduleEventExam, StartDate: models.NewDate(2025, 2, 25)},
+		} {
+			event.AdmissionScheduleID = early.ID
+			event.Version = 1
+			require.NoError(t, db.Create(&event).Error)
+		}
+
+		result, err := repo.FindMajorByYear(context.Background(), path, 2024)
+		require.NoError(t, err)
+		require.Len(t, result.Schedules, 2)
+
+		events := result.Schedules[0].Events
+		require.Len(t, events, 2)
+		assert.Equal(t, models.ScheduleEventApplication, events[0].Type)
+		assert.Equal(t, "2024-01-22", events[0].StartDate.String())
+		assert.Equal(t, models.ScheduleEventExam, events[1].Type)
+		assert.NotNil(t, result.Schedules[1].Events, "日程のない入試日程は空の一覧")
+		assert.Empty(t, result.Schedules[1].Events)
+	})
+
+	t.Run("入試情報のない年度", func(t *testing.T) {
+		_, err := repo.FindMajorByYear(context.Background(), path, 2020)
+		require.Error(t, err)
+
+		var appErr *appErrors.Error
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.CodeNotFound, appErr.Code)
+	})
+}
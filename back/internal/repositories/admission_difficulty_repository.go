@@ -0,0 +1,220 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// admissionDifficultyLabel はエラーメッセージに使用する合格難易度の名称です
+const admissionDifficultyLabel = "合格難易度"
+
+// admissionInfoIDQuery は入試情報IDで絞り込む条件です
+const admissionInfoIDQuery = "admission_info_id = ?"
+
+// IAdmissionDifficultyManager は入試情報の合格難易度の管理に関するインターフェースを定義します。
+// このインターフェースは以下の機能を提供します：
+// - 合格難易度の取得
+// - 合格難易度の作成
+// - 合格難易度の更新
+// - 合格難易度の削除
+// 合格難易度は入試情報（学年度ごと）に1件のため、入試情報IDで指定します。
+type IAdmissionDifficultyManager interface {
+	FindAdmissionDifficulty(ctx context.Context, infoID uint) (*models.AdmissionDifficulty, error)
+	CreateAdmissionDifficulty(ctx context.Context, difficulty *models.AdmissionDifficulty) error
+	UpdateAdmissionDifficulty(ctx context.Context, difficulty *models.AdmissionDifficulty) error
+	DeleteAdmissionDifficulty(ctx context.Context, infoID uint) error
+}
+
+// validateAdmissionDifficulty は合格難易度のモデルの検証エラーをバリデーションエラーに変換します
+func validateAdmissionDifficulty(difficulty *models.AdmissionDifficulty) error {
+	if err := difficulty.Validate(); err != nil {
+		var validationErr *models.ValidationError
+		if errors.As(err, &validationErr) {
+			return appErrors.NewValidationError(validationErr.Field, validationErr.Message, map[string]string{
+				"code": validationErr.Code,
+			})
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// findDifficultyAdmissionInfo は合格難易度が属する入試情報を所属する大学の学部とともに取得します
+func findDifficultyAdmissionInfo(tx *gorm.DB, infoID uint) (*models.AdmissionInfo, error) {
+	var info models.AdmissionInfo
+
+	err := tx.Preload("AdmissionSchedule.Major.Department").First(&info, infoID).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appErrors.NewNotFoundError("入試情報", infoID, nil)
+		}
+
+		return nil, appErrors.NewDatabaseError("入試情報検索処理", err, nil)
+	}
+
+	return &info, nil
+}
+
+// findStoredAdmissionDifficulty は入試情報に登録済みの合格難易度を取得します
+func findStoredAdmissionDifficulty(tx *gorm.DB, infoID uint) (*models.AdmissionDifficulty, error) {
+	var difficulty models.AdmissionDifficulty
+	if err := tx.Where(admissionInfoIDQuery, infoID).First(&difficulty).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appErrors.NewNotFoundError(admissionDifficultyLabel, infoID, nil)
+		}
+
+		return nil, appErrors.NewDatabaseError(admissionDifficultyLabel+"検索処理", err, nil)
+	}
+
+	return &difficulty, nil
+}
+
+// clearAdmissionDifficultyCache は合格難易度を含む入試情報と所属する大学のキャッシュをクリアします
+func (r *universityRepository) clearAdmissionDifficultyCache(info *models.AdmissionInfo) {
+	r.cache.ClearCache(fmt.Sprintf("admission_infos:%d:%d", info.AdmissionScheduleID, info.ID))
+	r.cache.ClearAllRelatedCache(info.AdmissionSchedule.Major.Department.UniversityID)
+}
+
+// FindAdmissionDifficulty は入試情報の合格難易度を取得します。
+// 入試情報が存在しない場合や、合格難易度が登録されていない場合はNotFoundエラーを返します
+func (r *universityRepository) FindAdmissionDifficulty(
+	ctx context.Context,
+	infoID uint,
+) (*models.AdmissionDifficulty, error) {
+	db := r.db.WithContext(ctx)
+
+	if _, err := findDifficultyAdmissionInfo(db, infoID); err != nil {
+		return nil, err
+	}
+
+	return findStoredAdmissionDifficulty(db, infoID)
+}
+
+// CreateAdmissionDifficulty は入試情報に合格難易度を登録します。
+// この関数は以下の処理を行います：
+// - 得点・得点率・偏差値帯の検証
+// - 入試情報の存在確認
+// - 登録済みの場合の競合エラー（現在の合格難易度を含む）の生成
+// - 合格難易度の作成
+// - キャッシュのクリア
+func (r *universityRepository) CreateAdmissionDifficulty(
+	ctx context.Context,
+	difficulty *models.AdmissionDifficulty,
+) error {
+	if err := validateAdmissionDifficulty(difficulty); err != nil {
+		return err
+	}
+
+	var info *models.AdmissionInfo
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		if info, err = findDifficultyAdmissionInfo(tx, difficulty.AdmissionInfoID); err != nil {
+			return err
+		}
+
+		existing, err := findStoredAdmissionDifficulty(tx, difficulty.AdmissionInfoID)
+		if err == nil {
+			return appErrors.NewConflictError(admissionDifficultyLabel, existing.ID, existing.Version, existing)
+		}
+
+		var appErr *appErrors.Error
+		if !errors.As(err, &appErr) || appErr.Code != appErrors.CodeNotFound {
+			return err
+		}
+
+		if err := tx.Omit(clause.Associations).Create(difficulty).Error; err != nil {
+			return appErrors.NewDatabaseError(admissionDifficultyLabel+"作成処理", err, nil)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	r.clearAdmissionDifficultyCache(info)
+
+	return nil
+}
+
+// UpdateAdmissionDifficulty は入試情報の合格難易度を楽観的ロックで更新します。
+// この関数は以下の処理を行います：
+// - 得点・得点率・偏差値帯の検証
+// - 入試情報と登録済みの合格難易度の存在確認
+// - トランザクションの実行（Versionを期待するバージョンとした楽観的ロック）
+// - キャッシュのクリア
+func (r *universityRepository) UpdateAdmissionDifficulty(
+	ctx context.Context,
+	difficulty *models.AdmissionDifficulty,
+) error {
+	if err := validateAdmissionDifficulty(difficulty); err != nil {
+		return err
+	}
+
+	var info *models.AdmissionInfo
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		if info, err = findDifficultyAdmissionInfo(tx, difficulty.AdmissionInfoID); err != nil {
+			return err
+		}
+
+		existing, err := findStoredAdmissionDifficulty(tx, difficulty.AdmissionInfoID)
+		if err != nil {
+			return err
+		}
+
+		difficulty.ID = existing.ID
+
+		if err := updateWithVersion(tx, difficulty, admissionDifficultyLabel, clause.Associations); err != nil {
+			var appErr *appErrors.Error
+			if errors.As(err, &appErr) {
+				return appErr
+			}
+
+			return appErrors.NewDatabaseError(admissionDifficultyLabel+"更新処理", err, nil)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	r.clearAdmissionDifficultyCache(info)
+
+	return nil
+}
+
+// DeleteAdmissionDifficulty は入試情報の合格難易度をソフトデリートします。
+// 入試情報が存在しない場合や、合格難易度が登録されていない場合はNotFoundエラーを返します
+func (r *universityRepository) DeleteAdmissionDifficulty(ctx context.Context, infoID uint) error {
+	db := r.db.WithContext(ctx)
+
+	info, err := findDifficultyAdmissionInfo(db, infoID)
+	if err != nil {
+		return err
+	}
+
+	existing, err := findStoredAdmissionDifficulty(db, infoID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.softDelete(ctx, "admission_difficulties", existing.ID); err != nil {
+		return appErrors.NewDatabaseError(admissionDifficultyLabel+"削除処理", err, nil)
+	}
+
+	r.clearAdmissionDifficultyCache(info)
+
+	return nil
+}
@@ -0,0 +1,183 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// findTestAdmissionInfo は入試日程の指定年度の入試情報を取得します
+func findTestAdmissionInfo(t *testing.T, db *gorm.DB, scheduleID uint, year int) models.AdmissionInfo {
+	t.Helper()
+
+	var info models.AdmissionInfo
+	require.NoError(t, db.Where("admission_schedule_id = ? AND academic_year = ?", scheduleID, year).First(&info).Error)
+
+	return info
+}
+
+// newTestAdmissionDifficulty はテスト用の合格難易度を生成します
+func newTestAdmissionDifficulty(infoID uint, rate, low, high float64) *models.AdmissionDifficulty {
+	return &models.AdmissionDifficulty{
+		BaseModel:       models.BaseModel{Version: 1},
+		AdmissionInfoID: infoID,
+		CommonTestRate:  &rate,
+		DeviationLow:    &low,
+		DeviationHigh:   &high,
+	}
+}
+
+func TestAdmissionDifficultyRepository(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	path := setupAcademicYearTestData(t, db)
+	early := findTestSchedule(t, db, path.MajorID, "前")
+	info2024 := findTestAdmissionInfo(t, db, early.ID, 2024)
+	info2025 := findTestAdmissionInfo(t, db, early.ID, 2025)
+
+	repo := NewUniversityRepository(db)
+	ctx := context.Background()
+
+	difficulty := newTestAdmissionDifficulty(info2025.ID, 82, 62.5, 65)
+	difficulty.Source = "河合塾"
+
+	t.Run("作成した合格難易度を取得できる", func(t *testing.T) {
+		require.NoError(t, repo.CreateAdmissionDifficulty(ctx, difficulty))
+		assert.NotZero(t, difficulty.ID)
+
+		found, err := repo.FindAdmissionDifficulty(ctx, info2025.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 82.0, *found.CommonTestRate)
+		assert.Nil(t, found.BorderScore)
+		assert.Equal(t, "河合塾", found.Source)
+
+		// 入試情報とともに取得できる
+		info, err := repo.FindAdmissionInfo(ctx, early.ID, info2025.ID)
+		require.NoError(t, err)
+		require.NotNil(t, info.Difficulty)
+		assert.Equal(t, 65.0, *info.Difficulty.DeviationHigh)
+	})
+
+	t.Run("合格難易度は年度ごとの入試情報に登録する", func(t *testing.T) {
+		_, err := repo.FindAdmissionDifficulty(ctx, info2024.ID)
+		requireAppErrorCode(t, err, appErrors.CodeNotFound)
+
+		require.NoError(t, repo.CreateAdmissionDifficulty(ctx, newTestAdmissionDifficulty(info2024.ID, 78, 60, 62.5)))
+
+		major, err := NewAcademicYearRepository(db).FindMajorByYear(ctx, path, 2024)
+		require.NoError(t, err)
+
+		for _, schedule := range major.Schedules {
+			if schedule.ID == early.ID {
+				require.NotNil(t, schedule.AdmissionInfo.Difficulty)
+				assert.Equal(t, 78.0, *schedule.AdmissionInfo.Difficulty.CommonTestRate)
+			}
+		}
+	})
+
+	t.Run("登録済みの入試情報には作成できない", func(t *testing.T) {
+		duplicate := newTestAdmissionDifficulty(info2025.ID, 70, 55, 57.5)
+		appErr := requireAppErrorCode(t, repo.CreateAdmissionDifficulty(ctx, duplicate), appErrors.CodeConflict)
+		assert.Equal(t, difficulty.ID, appErr.Details.ID)
+
+		current, ok := appErr.Current.(*models.AdmissionDifficulty)
+		require.True(t, ok)
+		assert.Equal(t, 82.0, *current.CommonTestRate, "登録済みの合格難易度を返す")
+	})
+
+	t.Run("存在しない入試情報には作成できない", func(t *testing.T) {
+		missing := newTestAdmissionDifficulty(info2025.ID+100, 70, 55, 57.5)
+		requireAppErrorCode(t, repo.CreateAdmissionDifficulty(ctx, missing), appErrors.CodeNotFound)
+	})
+
+	t.Run("不正な値は登録できない", func(t *testing.T) {
+		invalid := newTestAdmissionDifficulty(info2025.ID, 120, 55, 57.5)
+		requireAppErrorCode(t, repo.UpdateAdmissionDifficulty(ctx, invalid), appErrors.CodeValidationError)
+	})
+
+	t.Run("バージョンが一致する場合のみ更新できる", func(t *testing.T) {
+		update := newTestAdmissionDifficulty(info2025.ID, 84, 65, 65)
+		require.NoError(t, repo.UpdateAdmissionDifficulty(ctx, update))
+		assert.Equal(t, difficulty.ID, update.ID)
+		assert.Equal(t, 2, update.Version)
+
+		found, err := repo.FindAdmissionDifficulty(ctx, info2025.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 84.0, *found.CommonTestRate)
+		assert.Equal(t, 65.0, *found.DeviationLow)
+
+		stale := newTestAdmissionDifficulty(info2025.ID, 90, 65, 67.5)
+		requireAppErrorCode(t, repo.UpdateAdmissionDifficulty(ctx, stale), appErrors.CodeConflict)
+	})
+
+	t.Run("削除した合格難易度は取得できず再び登録できる", func(t *testing.T) {
+		require.NoError(t, repo.DeleteAdmissionDifficulty(ctx, info2025.ID))
+
+		_, err := repo.FindAdmissionDifficulty(ctx, info2025.ID)
+		requireAppErrorCode(t, err, appErrors.CodeNotFound)
+		requireAppErrorCode(t, repo.DeleteAdmissionDifficulty(ctx, info2025.ID), appErrors.CodeNotFound)
+
+		info, err := repo.FindAdmissionInfo(ctx, early.ID, info2025.ID)
+		require.NoError(t, err)
+		assert.Nil(t, info.Difficulty)
+
+		require.NoError(t, repo.CreateAdmissionDifficulty(ctx, newTestAdmissionDifficulty(info2025.ID, 80, 60, 62.5)))
+	})
+}
+
+func TestNestedAdmissionDifficultyIsNotSaved(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	path := setupAcademicYearTestData(t, db)
+	early := findTestSchedule(t, db, path.MajorID, "前")
+
+	repo := NewUniversityRepository(db)
+	ctx := context.Background()
+
+	// 範囲外の得点率を含む、検証を通らない合格難易度
+	invalidDifficulty := func() *models.AdmissionDifficulty {
+		return newTestAdmissionDifficulty(0, 120, 55, 57.5)
+	}
+
+	t.Run("入試情報とともに送信した合格難易度は作成しない", func(t *testing.T) {
+		info := &models.AdmissionInfo{
+			BaseModel:           models.BaseModel{Version: 1},
+			AdmissionScheduleID: early.ID,
+			AcademicYear:        2026,
+			Enrollment:          100,
+			Difficulty:          invalidDifficulty(),
+		}
+		require.NoError(t, repo.CreateAdmissionInfo(ctx, info))
+
+		_, err := repo.FindAdmissionDifficulty(ctx, info.ID)
+		requireAppErrorCode(t, err, appErrors.CodeNotFound)
+	})
+
+	t.Run("入試情報の更新で送信した合格難易度は保存しない", func(t *testing.T) {
+		info := findTestAdmissionInfo(t, db, early.ID, 2025)
+		info.Difficulty = invalidDifficulty()
+		require.NoError(t, repo.UpdateAdmissionInfo(ctx, &info))
+
+		_, err := repo.FindAdmissionDifficulty(ctx, info.ID)
+		requireAppErrorCode(t, err, appErrors.CodeNotFound)
+	})
+
+	t.Run("入試日程とともに送信した合格難易度は作成しない", func(t *testing.T) {
+		schedule := &models.AdmissionSchedule{
+			BaseModel:    models.BaseModel{Version: 1},
+			MajorID:      path.MajorID,
+			Name:         "中",
+			DisplayOrder: 3,
+			AdmissionInfos: []models.AdmissionInfo{
+				{BaseModel: models.BaseModel{Version: 1}, AcademicYear: 2025, Enrollment: 50, Difficulty: invalidDifficulty()},
+			},
+		}
+		require.NoError(t, repo.CreateAdmissionSchedule(ctx, schedule))
+
+		_, err := repo.FindAdmissionDifficulty(ctx, schedule.AdmissionInfos[0].ID)
+		requireAppErrorCode(t, err, appErrors.CodeNotFound)
+	})
+}
@@ -0,0 +1,96 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	applogger "university-exam-api/internal/logger"
+
+	"gorm.io/gorm"
+)
+
+// IAdmissionScheduleManager は入試日程の管理に関するインターフェースを定義します。
+// このインターフェースは以下の機能を提供します：
+// - 入試日程の取得
+// - 入試日程の作成
+// - 入試日程の削除
+type IAdmissionScheduleManager interface {
+	FindAdmissionSchedule(ctx context.Context, majorID, scheduleID uint) (*models.AdmissionSchedule, error)
+	CreateAdmissionSchedule(ctx context.Context, schedule *models.AdmissionSchedule) error
+	DeleteAdmissionSchedule(ctx context.Context, id uint) error
+}
+
+// FindAdmissionSchedule は入試日程を取得します。
+// この関数は以下の処理を行います：
+// - キャッシュのチェック
+// - データベースからの取得
+// - キャッシュへの保存
+func (r *universityRepository) FindAdmissionSchedule(ctx context.Context, majorID, scheduleID uint) (*models.AdmissionSchedule, error) {
+	cacheKey := fmt.Sprintf("admission_schedules:%d:%d", majorID, scheduleID)
+
+	// キャッシュをチェック
+	if cached, found := r.cache.GetFromCache(cacheKey); found {
+		applogger.Info(ctx, "入試日程のキャッシュヒット: %d:%d", majorID, scheduleID)
+
+		schedule := cached.(models.AdmissionSchedule)
+
+		return &schedule, nil
+	}
+
+	var schedule models.AdmissionSchedule
+
+	err := r.db.WithContext(ctx).Where("major_id = ? AND id = ?", majorID, scheduleID).
+		First(&schedule).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appErrors.NewNotFoundError("入試日程", scheduleID, nil)
+		}
+
+		return nil, appErrors.NewDatabaseError("入試日程検索処理", err, nil)
+	}
+
+	// キャッシュに保存
+	r.cache.SetCache(cacheKey, schedule)
+	applogger.Info(ctx, "入試日程をキャッシュに保存: %d:%d", majorID, scheduleID)
+
+	return &schedule, nil
+}
+
+// CreateAdmissionSchedule は新しい入試日程を作成します。
+// この関数は以下の処理を行います：
+// - 入試日程の作成
+// - エラーハンドリング
+func (r *universityRepository) CreateAdmissionSchedule(ctx context.Context, schedule *models.AdmissionSchedule) error {
+	if err := createWithNested(r.db.WithContext(ctx), schedule); err != nil {
+		return appErrors.NewDatabaseError("入試日程作成処理", err, nil)
+	}
+
+	return nil
+}
+
+// DeleteAdmissionSchedule は入試日程を削除します。
+// この関数は以下の処理を行います：
+// - 入試情報・試験種別などの配下の要素を含むソフトデリート
+// - キャッシュのクリア
+// - エラーハンドリング
+func (r *universityRepository) DeleteAdmissionSchedule(ctx context.Context, id uint) error {
+	var schedule models.AdmissionSchedule
+	if err := r.db.WithContext(ctx).First(&schedule, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return appErrors.NewNotFoundError("入試日程", id, nil)
+		}
+
+		return appErrors.NewDatabaseError("入試日程検索処理", err, nil)
+	}
+
+	if _, err := r.softDelete(ctx, "admission_schedules", id); err != nil {
+		return appErrors.NewDatabaseError("入試日程削除処理", err, nil)
+	}
+
+	r.cache.ClearCache(fmt.Sprintf("admission_schedules:%d:%d", schedule.MajorID, id))
+	r.cache.ClearAllRelatedCache(0)
+
+	return nil
+}
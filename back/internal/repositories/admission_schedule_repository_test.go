@@ -0,0 +1,55 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdmissionScheduleCRUD(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	existing := setupTestTypeTestData(t, db)
+
+	repo := NewUniversityRepository(db)
+	ctx := context.Background()
+
+	schedule := &models.AdmissionSchedule{
+		BaseModel:    models.BaseModel{Version: 1},
+		MajorID:      existing.MajorID,
+		Name:         "後",
+		DisplayOrder: 3,
+	}
+
+	t.Run("作成した入試日程を取得できる", func(t *testing.T) {
+		require.NoError(t, repo.CreateAdmissionSchedule(ctx, schedule))
+		assert.NotZero(t, schedule.ID)
+
+		found, err := repo.FindAdmissionSchedule(ctx, existing.MajorID, schedule.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "後", found.Name)
+	})
+
+	t.Run("別の学科の入試日程は取得できない", func(t *testing.T) {
+		_, err := repo.FindAdmissionSchedule(ctx, existing.MajorID+1, schedule.ID)
+		requireAppErrorCode(t, err, appErrors.CodeNotFound)
+	})
+
+	t.Run("削除すると配下の試験種別も削除され取得できない", func(t *testing.T) {
+		require.NoError(t, repo.DeleteAdmissionSchedule(ctx, existing.ID))
+
+		_, err := repo.FindAdmissionSchedule(ctx, existing.MajorID, existing.ID)
+		requireAppErrorCode(t, err, appErrors.CodeNotFound)
+
+		var count int64
+		require.NoError(t, db.Model(&models.TestType{}).Where("admission_schedule_id = ?", existing.ID).Count(&count).Error)
+		assert.Zero(t, count)
+	})
+
+	t.Run("存在しない入試日程は削除できない", func(t *testing.T) {
+		requireAppErrorCode(t, repo.DeleteAdmissionSchedule(ctx, existing.ID), appErrors.CodeNotFound)
+	})
+}
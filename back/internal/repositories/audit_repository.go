@@ -0,0 +1,72 @@
+package repositories
+
+import (
+	"context"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/pkg/pagination"
+
+	"gorm.io/gorm"
+)
+
+// AuditPage はページ単位で取得した監査ログを表現する構造体です
+type AuditPage struct {
+	Logs []models.AuditLog `json:"logs"`
+	pagination.Page
+}
+
+// AuditRepository は監査ログを取得するリポジトリインターフェースです
+type AuditRepository interface {
+	FindHistory(ctx context.Context, entityType string, entityID uint, params pagination.Params) (*AuditPage, error)
+}
+
+// auditRepository はAuditRepositoryの実装です
+type auditRepository struct {
+	db *gorm.DB
+}
+
+// NewAuditRepository は新しいAuditRepositoryを作成します
+func NewAuditRepository(db *gorm.DB) AuditRepository {
+	return &auditRepository{db: db}
+}
+
+// FindHistory はエンティティの変更履歴を新しい順に取得します。
+// この関数は以下の処理を行います：
+// - エンティティ種別とIDによる絞り込み
+// - 総件数の取得
+// - オフセット方式でのページ単位の取得
+func (r *auditRepository) FindHistory(
+	ctx context.Context,
+	entityType string,
+	entityID uint,
+	params pagination.Params,
+) (*AuditPage, error) {
+	query := r.db.WithContext(ctx).Model(&models.AuditLog{}).
+		Where("entity_type = ? AND entity_id = ?", entityType, entityID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, appErrors.NewDatabaseError("監査ログ件数取得処理", err, nil)
+	}
+
+	logs := make([]models.AuditLog, 0, params.PerPage)
+
+	err := query.Order("created_at DESC").Order("id DESC").
+		Offset(params.Offset()).
+		Limit(params.PerPage).
+		Find(&logs).Error
+	if err != nil {
+		return nil, appErrors.NewDatabaseError("監査ログ取得処理", err, nil)
+	}
+
+	return &AuditPage{
+		Logs: logs,
+		Page: pagination.Page{
+			Total:   total,
+			Page:    params.Page,
+			PerPage: params.PerPage,
+			HasNext: int64(params.Offset()+len(logs)) < total,
+			HasPrev: params.Page > 1,
+		},
+	}, nil
+}
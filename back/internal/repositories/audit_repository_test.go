@@ -0,0 +1,63 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+	"university-exam-api/internal/domain/models"
+	"university-exam-api/internal/pkg/pagination"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditRepositoryFindHistory(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	require.NoError(t, db.AutoMigrate(&models.AuditLog{}))
+
+	base := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	logs := []models.AuditLog{
+		{EntityType: models.AuditEntityUniversity, EntityID: 1, Action: models.AuditActionCreate, Actor: "alice", CreatedAt: base},
+		{EntityType: models.AuditEntityUniversity, EntityID: 1, Action: models.AuditActionUpdate, Actor: "bob", CreatedAt: base.Add(time.Hour),
+			Changes: map[string]models.FieldChange{"name": {Before: "旧大学", After: "新大学"}}},
+		{EntityType: models.AuditEntityUniversity, EntityID: 1, Action: models.AuditActionDelete, Actor: "carol", CreatedAt: base.Add(2 * time.Hour)},
+		{EntityType: models.AuditEntityUniversity, EntityID: 2, Action: models.AuditActionCreate, Actor: "alice", CreatedAt: base},
+		{EntityType: models.AuditEntityDepartment, EntityID: 1, Action: models.AuditActionCreate, Actor: "alice", CreatedAt: base},
+	}
+	require.NoError(t, db.Create(&logs).Error)
+
+	repo := NewAuditRepository(db)
+	ctx := context.Background()
+
+	t.Run("新しい順に取得", func(t *testing.T) {
+		page, err := repo.FindHistory(ctx, models.AuditEntityUniversity, 1, pagination.Params{Page: 1, PerPage: 2})
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(3), page.Total)
+		assert.True(t, page.HasNext)
+		assert.False(t, page.HasPrev)
+		require.Len(t, page.Logs, 2)
+		assert.Equal(t, models.AuditActionDelete, page.Logs[0].Action)
+		assert.Equal(t, models.AuditActionUpdate, page.Logs[1].Action)
+		assert.Equal(t, models.FieldChange{Before: "旧大学", After: "新大学"}, page.Logs[1].Changes["name"])
+	})
+
+	t.Run("2ページ目", func(t *testing.T) {
+		page, err := repo.FindHistory(ctx, models.AuditEntityUniversity, 1, pagination.Params{Page: 2, PerPage: 2})
+		require.NoError(t, err)
+
+		assert.False(t, page.HasNext)
+		assert.True(t, page.HasPrev)
+		require.Len(t, page.Logs, 1)
+		assert.Equal(t, "alice", page.Logs[0].Actor)
+	})
+
+	t.Run("履歴なし", func(t *testing.T) {
+		page, err := repo.FindHistory(ctx, models.AuditEntityMajor, 1, pagination.DefaultParams())
+		require.NoError(t, err)
+
+		assert.Zero(t, page.Total)
+		assert.Empty(t, page.Logs)
+		assert.NotNil(t, page.Logs)
+	})
+}
@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+	appErrors "university-exam-api/internal/errors"
+)
+
+// checkContext はコンテキストがキャンセル・タイムアウトしていないことを確認します
+// バッチ処理のループの各回で呼び出し、ハンドラーのタイムアウトやクライアントの切断時に残りの処理を中断します。
+// 中断した場合は元のエラー（context.Canceled または context.DeadlineExceeded）をラップしたタイムアウトエラーを返します
+func checkContext(ctx context.Context, operation string) error {
+	if err := ctx.Err(); err != nil {
+		timeoutErr := appErrors.NewTimeoutError(operation, nil)
+		timeoutErr.Err = err
+
+		return timeoutErr
+	}
+
+	return nil
+}
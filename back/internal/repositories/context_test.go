@@ -0,0 +1,66 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckContext(t *testing.T) {
+	t.Run("有効なコンテキストの場合", func(t *testing.T) {
+		assert.NoError(t, checkContext(context.Background(), "テスト処理"))
+	})
+
+	t.Run("キャンセルされた場合", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := checkContext(ctx, "テスト処理")
+		requireAppErrorCode(t, err, appErrors.CodeTimeoutError)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("タイムアウトした場合", func(t *testing.T) {
+		ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+		defer cancel()
+
+		err := checkContext(ctx, "テスト処理")
+		requireAppErrorCode(t, err, appErrors.CodeTimeoutError)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestRepositoryContextCancellation(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	schedule := setupTestTypeTestData(t, db)
+	testType := schedule.TestTypes[0]
+
+	repo := NewUniversityRepository(db)
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	t.Run("キャンセルされたコンテキストでは検索しない", func(t *testing.T) {
+		_, err := repo.FindTestType(canceled, schedule.ID, testType.ID)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("バッチ更新はキャンセルされるとロールバックする", func(t *testing.T) {
+		subject := testType.Subjects[0]
+		subject.Score = 200
+
+		err := repo.UpdateSubjectsBatch(canceled, testType.ID, []models.Subject{subject})
+		require.Error(t, err)
+		assert.ErrorIs(t, err, context.Canceled)
+
+		var stored models.Subject
+		require.NoError(t, db.First(&stored, subject.ID).Error)
+		assert.Equal(t, 100, stored.Score)
+		assert.Equal(t, 1, stored.Version)
+	})
+}
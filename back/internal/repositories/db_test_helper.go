@@ -98,8 +98,22 @@ func SetupTestDB(t *testing.T, config *TestDBConfig) *gorm.DB {
 		t.Fatalf("スキーマの作成に失敗: %v", err)
 	}
 
-	// マイグレーションを実行
-	if _, err := database.RunMigrations(context.Background(), db, nil); err != nil {
+	// バージョン管理されたマイグレーションでスキーマを作成
+	// テスト用スキーマにテーブルを作成するため、検索パスを設定した1つの接続で実行する
+	if err := db.Connection(func(conn *gorm.DB) error {
+		if err := conn.Exec(fmt.Sprintf("SET search_path TO %s", config.Schema)).Error; err != nil {
+			return err
+		}
+
+		migrator, err := database.NewSchemaMigrator(conn)
+		if err != nil {
+			return err
+		}
+
+		_, err = migrator.Up(context.Background())
+
+		return err
+	}); err != nil {
 		t.Fatalf("マイグレーションに失敗: %v", err)
 	}
 
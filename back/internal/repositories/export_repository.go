@@ -0,0 +1,241 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/pkg/preview"
+
+	"gorm.io/gorm"
+)
+
+// errExportFailed はエクスポート失敗時のエラーメッセージです
+const errExportFailed = "エクスポートに失敗しました: %w"
+
+// ExportColumns はエクスポートする列名です
+// JSON APIと同じフィールド名を使用し、ExportRow.Values の並び順と対応します
+var ExportColumns = []string{
+	"university_id",
+	"university_name",
+	"department_id",
+	"department_name",
+	"major_id",
+	"major_name",
+	"admission_schedule_id",
+	"admission_schedule_name",
+	"academic_year",
+	"enrollment",
+	"status",
+	"test_type_id",
+	"test_type_name",
+	"subject_id",
+	"subject_name",
+	"score",
+	"conversion_ratio",
+	"effective_score",
+	"percentage",
+	"display_order",
+}
+
+// ExportCriteria はエクスポート対象の条件を表現する構造体です
+type ExportCriteria struct {
+	FacetSearchCriteria
+	AcademicYear int // 学年度（0の場合は全ての試験種別を対象とする）
+}
+
+// ExportRow はエクスポートの1行（科目単位）を表現する構造体です
+// 年度情報に紐付かない試験種別の行では、年度・募集人員・状態はnullになります
+// 選択科目群に属する科目の配点比率は参考値のため、試験種別内の行の配点比率を合計しても100にはなりません
+type ExportRow struct {
+	UniversityID          uint    `json:"university_id"`
+	UniversityName        string  `json:"university_name"`
+	DepartmentID          uint    `json:"department_id"`
+	DepartmentName        string  `json:"department_name"`
+	MajorID               uint    `json:"major_id"`
+	MajorName             string  `json:"major_name"`
+	AdmissionScheduleID   uint    `json:"admission_schedule_id"`
+	AdmissionScheduleName string  `json:"admission_schedule_name"`
+	AcademicYear          *int    `json:"academic_year"`
+	Enrollment            *int    `json:"enrollment"`
+	Status                *string `json:"status"`
+	TestTypeID            uint    `json:"test_type_id"`
+	TestTypeName          string  `json:"test_type_name"`
+	SubjectID             uint    `json:"subject_id"`
+	SubjectName           string  `json:"subject_name"`
+	Score                 int     `json:"score"`
+	ConversionRatio       float64 `json:"conversion_ratio"`
+	EffectiveScore        float64 `json:"effective_score"`
+	Percentage            float64 `json:"percentage"`
+	DisplayOrder          int     `json:"display_order"`
+}
+
+// Values は ExportColumns の並び順でセル値を返します
+// nullの項目はnilとして返します
+func (r ExportRow) Values() []interface{} {
+	var academicYear, enrollment, status interface{}
+	if r.AcademicYear != nil {
+		academicYear = *r.AcademicYear
+	}
+
+	if r.Enrollment != nil {
+		enrollment = *r.Enrollment
+	}
+
+	if r.Status != nil {
+		status = *r.Status
+	}
+
+	return []interface{}{
+		r.UniversityID,
+		r.UniversityName,
+		r.DepartmentID,
+		r.DepartmentName,
+		r.MajorID,
+		r.MajorName,
+		r.AdmissionScheduleID,
+		r.AdmissionScheduleName,
+		academicYear,
+		enrollment,
+		status,
+		r.TestTypeID,
+		r.TestTypeName,
+		r.SubjectID,
+		r.SubjectName,
+		r.Score,
+		r.ConversionRatio,
+		r.EffectiveScore,
+		r.Percentage,
+		r.DisplayOrder,
+	}
+}
+
+// ExportRepository はデータのエクスポートのリポジトリインターフェースです
+type ExportRepository interface {
+	StreamRows(ctx context.Context, criteria ExportCriteria, fn func(ExportRow) error) error
+}
+
+// exportRepository はExportRepositoryの実装です
+type exportRepository struct {
+	db     *gorm.DB
+	facets *facetSearchRepository
+}
+
+// NewExportRepository は新しいExportRepositoryを作成します
+func NewExportRepository(db *gorm.DB) ExportRepository {
+	return &exportRepository{
+		db:     db,
+		facets: &facetSearchRepository{db: db},
+	}
+}
+
+// StreamRows は条件に一致する科目を1行ずつ読み出してfnに渡します。
+// この関数は以下の処理を行います：
+// - ファセット条件による大学の絞り込み（日程・学問系統は入試日程・学科単位）
+// - 学年度の指定時は、その年度の入試情報に紐付く試験種別（紐付けがない場合は年度に紐付かない試験種別）への限定
+// - カーソルによる逐次読み出し（ツリー全体をメモリに展開しない）
+// fnがエラーを返した場合は読み出しを中断し、そのエラーを返します
+func (r *exportRepository) StreamRows(
+	ctx context.Context,
+	criteria ExportCriteria,
+	fn func(ExportRow) error,
+) error {
+	facetCriteria, err := r.facets.resolveQuery(ctx, criteria.FacetSearchCriteria)
+	if err != nil {
+		return err
+	}
+
+	criteria.FacetSearchCriteria = facetCriteria
+
+	rows, err := r.exportQuery(ctx, criteria).Rows()
+	if err != nil {
+		return appErrors.NewDatabaseError("エクスポート処理", fmt.Errorf(errExportFailed, err), nil)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row ExportRow
+		if err := r.db.ScanRows(rows, &row); err != nil {
+			return appErrors.NewDatabaseError("エクスポート処理", fmt.Errorf(errExportFailed, err), nil)
+		}
+
+		// 換算後の配点は保存しないため、科目の取得時と同じ方法で算出する
+		subject := models.Subject{Score: row.Score, ConversionRatio: row.ConversionRatio}
+		subject.ApplyConversion()
+		row.EffectiveScore = subject.EffectiveScore
+
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return appErrors.NewDatabaseError("エクスポート処理", fmt.Errorf(errExportFailed, err), nil)
+	}
+
+	return nil
+}
+
+// exportQuery はエクスポート対象の科目を取得するクエリを生成します
+func (r *exportRepository) exportQuery(ctx context.Context, criteria ExportCriteria) *gorm.DB {
+	db := r.db.WithContext(ctx)
+
+	query := db.Model(&models.Subject{}).
+		Select(`universities.id AS university_id, universities.name AS university_name,
+			departments.id AS department_id, departments.name AS department_name,
+			majors.id AS major_id, majors.name AS major_name,
+			admission_schedules.id AS admission_schedule_id, admission_schedules.name AS admission_schedule_name,
+			admission_infos.academic_year AS academic_year, admission_infos.enrollment AS enrollment,
+			admission_infos.status AS status,
+			test_types.id AS test_type_id, test_types.name AS test_type_name,
+			subjects.id AS subject_id, subjects.name AS subject_name,
+			subjects.score AS score, subjects.conversion_ratio AS conversion_ratio,
+			subjects.percentage AS percentage, subjects.display_order AS display_order`).
+		Joins("JOIN test_types ON test_types.id = subjects.test_type_id").
+		Joins("JOIN admission_schedules ON admission_schedules.id = test_types.admission_schedule_id").
+		Joins("JOIN majors ON majors.id = admission_schedules.major_id").
+		Joins("JOIN departments ON departments.id = majors.department_id").
+		Joins("JOIN universities ON universities.id = departments.university_id").
+		Where("subjects.deleted_at IS NULL AND test_types.deleted_at IS NULL").
+		Where("admission_schedules.deleted_at IS NULL AND majors.deleted_at IS NULL").
+		Where("departments.deleted_at IS NULL AND universities.deleted_at IS NULL").
+		Where("universities.id IN (?)", r.facets.filteredUniversities(ctx, criteria.FacetSearchCriteria, ""))
+
+	// 日程・学問系統は大学単位ではなく入試日程・学科単位で絞り込む
+	if len(criteria.Schedules) > 0 {
+		query = query.Where("admission_schedules.name IN ?", criteria.Schedules)
+	}
+
+	if len(criteria.AcademicFields) > 0 {
+		query = query.Where("majors.id IN (?)", db.Model(&models.AcademicField{}).
+			Select("major_id").
+			Where("name IN ? AND deleted_at IS NULL", criteria.AcademicFields))
+	}
+
+	// プレビューモードでない場合は公開中の入試情報のみを結合する
+	infoCondition := "admission_infos.deleted_at IS NULL"
+	if !preview.IncludeDrafts(ctx) {
+		infoCondition += " AND admission_infos.status = '" + models.AdmissionStatusPublished + "'"
+	}
+
+	if criteria.AcademicYear != 0 {
+		query = query.
+			Joins(`JOIN admission_infos ON admission_infos.admission_schedule_id = admission_schedules.id
+				AND admission_infos.academic_year = ? AND `+infoCondition, criteria.AcademicYear).
+			Where(`test_types.id IN (SELECT test_type_id FROM admission_info_test_types
+					WHERE admission_info_id = admission_infos.id)
+				OR (test_types.id NOT IN (SELECT test_type_id FROM admission_info_test_types)
+					AND NOT EXISTS (SELECT 1 FROM admission_info_test_types
+						WHERE admission_info_id = admission_infos.id))`)
+	} else {
+		query = query.
+			Joins("LEFT JOIN admission_info_test_types ON admission_info_test_types.test_type_id = test_types.id").
+			Joins(`LEFT JOIN admission_infos ON admission_infos.id = admission_info_test_types.admission_info_id
+				AND ` + infoCondition)
+	}
+
+	return query.Order("universities.id, departments.id, majors.id").
+		Order("admission_schedules.display_order, admission_schedules.id").
+		Order("admission_infos.academic_year, test_types.id").
+		Order("subjects.display_order, subjects.id")
+}
@@ -0,0 +1,198 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"university-exam-api/internal/domain/models"
+	"university-exam-api/internal/pkg/preview"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// setupExportTestData は類似検索用のテストデータに2025年度の入試情報を追加します
+// - 東京大学: 試験種別の紐付けがない入試情報（年度に紐付かない試験種別を使用）
+// - 京都大学: 年度専用の試験種別（二次 物理）を紐付けた入試情報
+func setupExportTestData(t *testing.T, db *gorm.DB) {
+	t.Helper()
+
+	setupSimilarityTestData(t, db)
+
+	schedules := make(map[string]models.AdmissionSchedule)
+
+	for _, name := range []string{"東京大学", "京都大学"} {
+		var schedule models.AdmissionSchedule
+		require.NoError(t, db.
+			Joins("JOIN majors ON majors.id = admission_schedules.major_id").
+			Joins("JOIN departments ON departments.id = majors.department_id").
+			Joins("JOIN universities ON universities.id = departments.university_id").
+			Where("universities.name = ?", name).
+			Take(&schedule).Error)
+
+		schedules[name] = schedule
+	}
+
+	require.NoError(t, db.Create(&models.AdmissionInfo{
+		AdmissionScheduleID: schedules["東京大学"].ID,
+		Enrollment:          100,
+		AcademicYear:        2025,
+		Status:              "published",
+	}).Error)
+
+	yearTestType := models.TestType{
+		BaseModel:           models.BaseModel{Version: 1},
+		AdmissionScheduleID: schedules["京都大学"].ID,
+		Name:                "二次",
+		Subjects: []models.Subject{{
+			BaseModel:  models.BaseModel{Version: 1},
+			Name:       "物理",
+			Score:      200,
+			Percentage: 100,
+		}},
+	}
+	require.NoError(t, db.Create(&yearTestType).Error)
+
+	require.NoError(t, db.Create(&models.AdmissionInfo{
+		AdmissionScheduleID: schedules["京都大学"].ID,
+		Enrollment:          50,
+		AcademicYear:        2025,
+		Status:              "draft",
+		TestTypes:           []models.TestType{yearTestType},
+	}).Error)
+}
+
+// collectExportRows は条件に一致するエクスポート行を全て取得します
+func collectExportRows(
+	t *testing.T,
+	ctx context.Context,
+	repo ExportRepository,
+	criteria ExportCriteria,
+) []ExportRow {
+	t.Helper()
+
+	var rows []ExportRow
+
+	require.NoError(t, repo.StreamRows(ctx, criteria, func(row ExportRow) error {
+		rows = append(rows, row)
+		return nil
+	}))
+
+	return rows
+}
+
+// exportSubjects は「大学名/試験種別/科目名」の一覧を返します
+func exportSubjects(rows []ExportRow) []string {
+	subjects := make([]string, 0, len(rows))
+	for _, row := range rows {
+		subjects = append(subjects, row.UniversityName+"/"+row.TestTypeName+"/"+row.SubjectName)
+	}
+
+	return subjects
+}
+
+func TestExportRepositoryStreamRows(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	setupExportTestData(t, db)
+
+	repo := NewExportRepository(db)
+	previewCtx := preview.WithDrafts(context.Background())
+
+	t.Run("条件なしで全ての科目を取得", func(t *testing.T) {
+		rows := collectExportRows(t, previewCtx, repo, ExportCriteria{})
+
+		assert.Equal(t, []string{
+			"東京大学/共通/英語",
+			"東京大学/二次/数学",
+			"京都大学/共通/英語",
+			"京都大学/二次/数学",
+			"京都大学/二次/物理",
+			"早稲田大学/二次/英語",
+		}, exportSubjects(rows))
+
+		assert.Equal(t, "機械工学科", rows[0].MajorName)
+		assert.Equal(t, "前", rows[0].AdmissionScheduleName)
+		assert.Equal(t, 500, rows[0].Score)
+		assert.Nil(t, rows[0].AcademicYear)
+
+		require.NotNil(t, rows[4].AcademicYear)
+		assert.Equal(t, 2025, *rows[4].AcademicYear)
+		assert.Equal(t, 50, *rows[4].Enrollment)
+		assert.Equal(t, "draft", *rows[4].Status)
+	})
+
+	t.Run("学年度による絞り込み", func(t *testing.T) {
+		rows := collectExportRows(t, previewCtx, repo, ExportCriteria{AcademicYear: 2025})
+
+		assert.Equal(t, []string{
+			"東京大学/共通/英語",
+			"東京大学/二次/数学",
+			"京都大学/二次/物理",
+		}, exportSubjects(rows))
+
+		for _, row := range rows {
+			require.NotNil(t, row.AcademicYear)
+			assert.Equal(t, 2025, *row.AcademicYear)
+		}
+
+		assert.Equal(t, 100, *rows[0].Enrollment)
+	})
+
+	t.Run("プレビューでない場合は下書きの入試情報を除外", func(t *testing.T) {
+		rows := collectExportRows(t, context.Background(), repo, ExportCriteria{AcademicYear: 2025})
+
+		assert.Equal(t, []string{
+			"東京大学/共通/英語",
+			"東京大学/二次/数学",
+		}, exportSubjects(rows))
+
+		rows = collectExportRows(t, context.Background(), repo, ExportCriteria{})
+
+		require.Len(t, rows, 6)
+		assert.Nil(t, rows[4].AcademicYear)
+		assert.Nil(t, rows[4].Status)
+	})
+
+	t.Run("ファセットと日程による絞り込み", func(t *testing.T) {
+		rows := collectExportRows(t, context.Background(), repo, ExportCriteria{
+			FacetSearchCriteria: FacetSearchCriteria{Regions: []string{"関東"}, Schedules: []string{"後"}},
+		})
+
+		assert.Equal(t, []string{"早稲田大学/二次/英語"}, exportSubjects(rows))
+	})
+
+	t.Run("コールバックのエラーで中断", func(t *testing.T) {
+		errStop := errors.New("stop")
+		count := 0
+
+		err := repo.StreamRows(context.Background(), ExportCriteria{}, func(ExportRow) error {
+			count++
+			return errStop
+		})
+
+		assert.ErrorIs(t, err, errStop)
+		assert.Equal(t, 1, count)
+	})
+}
+
+func TestExportRowValues(t *testing.T) {
+	year := 2025
+
+	values := ExportRow{
+		UniversityName:  "東京大学",
+		AcademicYear:    &year,
+		Score:           200,
+		ConversionRatio: 0.5,
+		EffectiveScore:  100,
+	}.Values()
+
+	require.Len(t, values, len(ExportColumns))
+	assert.Equal(t, "東京大学", values[1])
+	assert.Equal(t, 2025, values[8])
+	assert.Nil(t, values[9])
+	assert.Nil(t, values[10])
+	assert.Equal(t, 200, values[15])
+	assert.Equal(t, 0.5, values[16])
+	assert.Equal(t, 100.0, values[17])
+}
@@ -0,0 +1,478 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/pkg/pagination"
+	"university-exam-api/internal/pkg/textsearch"
+
+	"gorm.io/gorm"
+)
+
+// DifficultyRange は合格難易度の値の範囲を表現する構造体です
+// 下限・上限はそれぞれ省略可能で、指定した値を含みます
+type DifficultyRange struct {
+	Min *float64
+	Max *float64
+}
+
+// IsEmpty は下限・上限のいずれも指定されていないかを返します
+func (r DifficultyRange) IsEmpty() bool {
+	return r.Min == nil && r.Max == nil
+}
+
+// FacetSearchCriteria はファセット検索の条件を表現する構造体です
+// 同一カテゴリ内の値はOR条件、カテゴリ間はAND条件として扱います
+// フリーテキスト検索クエリは大学一覧の検索と同じ検索インデックスで大学IDに解決してから絞り込みます
+// 合格難易度の範囲は、大学ごとに合格難易度を登録した最新年度の公開中の入試情報のいずれかが全ての範囲を満たす大学に絞り込みます
+type FacetSearchCriteria struct {
+	Query              string          // フリーテキスト検索クエリ
+	Regions            []string        // 地域名
+	Prefectures        []string        // 都道府県名
+	Classifications    []string        // 設置区分名
+	SubClassifications []string        // 小分類名
+	Schedules          []string        // 日程名
+	AcademicFields     []string        // 学問系統名
+	Deviation          DifficultyRange // 偏差値の範囲（偏差値帯が範囲と重なる入試情報）
+	CommonTestRate     DifficultyRange // 共通テスト得点率の範囲
+	BorderScore        DifficultyRange // ボーダー得点の範囲
+	queryIDs           []uint          // 検索インデックスで解決した検索クエリに一致する大学ID（関連度順）
+}
+
+// IsEmpty は絞り込み条件が1つも指定されていないかを返します
+func (c *FacetSearchCriteria) IsEmpty() bool {
+	return strings.TrimSpace(c.Query) == "" &&
+		len(c.Regions) == 0 &&
+		len(c.Prefectures) == 0 &&
+		len(c.Classifications) == 0 &&
+		len(c.SubClassifications) == 0 &&
+		len(c.Schedules) == 0 &&
+		len(c.AcademicFields) == 0 &&
+		c.Deviation.IsEmpty() &&
+		c.CommonTestRate.IsEmpty() &&
+		c.BorderScore.IsEmpty()
+}
+
+// difficultyConditions は合格難易度の範囲の条件式と値を返します
+// 偏差値は偏差値帯が範囲と重なるものを対象とします
+func (c *FacetSearchCriteria) difficultyConditions() ([]string, []interface{}) {
+	var (
+		conditions []string
+		args       []interface{}
+	)
+
+	bounds := []struct {
+		value     *float64
+		condition string
+	}{
+		{c.Deviation.Min, "admission_difficulties.deviation_high >= ?"},
+		{c.Deviation.Max, "admission_difficulties.deviation_low <= ?"},
+		{c.CommonTestRate.Min, "admission_difficulties.common_test_rate >= ?"},
+		{c.CommonTestRate.Max, "admission_difficulties.common_test_rate <= ?"},
+		{c.BorderScore.Min, "admission_difficulties.border_score >= ?"},
+		{c.BorderScore.Max, "admission_difficulties.border_score <= ?"},
+	}
+
+	for _, b := range bounds {
+		if b.value != nil {
+			conditions = append(conditions, b.condition)
+			args = append(args, *b.value)
+		}
+	}
+
+	return conditions, args
+}
+
+// valuesFor は指定されたカテゴリの検索値を返します
+func (c *FacetSearchCriteria) valuesFor(category string) []string {
+	switch category {
+	case models.FilterCategoryRegion:
+		return c.Regions
+	case models.FilterCategoryPrefecture:
+		return c.Prefectures
+	case models.FilterCategoryClassification:
+		return c.Classifications
+	case models.FilterCategorySubClassification:
+		return c.SubClassifications
+	case models.FilterCategorySchedule:
+		return c.Schedules
+	case models.FilterCategoryAcademicField:
+		return c.AcademicFields
+	default:
+		return nil
+	}
+}
+
+// FacetCount はファセットの値ごとの該当大学数を表現する構造体です
+type FacetCount struct {
+	Name  string `json:"name"`
+	Count int64  `json:"count"`
+}
+
+// FacetSearchResult はファセット検索の結果を表現する構造体です
+type FacetSearchResult struct {
+	Universities []models.University     `json:"universities"`
+	Facets       map[string][]FacetCount `json:"facets"`
+	pagination.Page
+}
+
+// FacetSearchRepository はファセット検索のリポジトリインターフェースです
+type FacetSearchRepository interface {
+	SearchWithFacets(
+		ctx context.Context,
+		criteria FacetSearchCriteria,
+		params pagination.Params,
+	) (*FacetSearchResult, error)
+}
+
+// facetDefinition はファセットカテゴリとテーブル結合の対応を定義します
+// - table: 起点となるテーブル
+// - joins: 名称カラムに到達するための結合
+// - universityColumn: 大学IDを表すカラム
+// - nameColumn: ファセット値となる名称カラム
+type facetDefinition struct {
+	category         string
+	table            string
+	joins            []string
+	universityColumn string
+	nameColumn       string
+	notDeleted       []string
+}
+
+// facetDefinitions はFilterOptionの各カテゴリに対応する結合経路です
+var facetDefinitions = []facetDefinition{
+	{
+		category:         models.FilterCategoryRegion,
+		table:            "regions",
+		universityColumn: "regions.university_id",
+		nameColumn:       "regions.name",
+		notDeleted:       []string{"regions"},
+	},
+	{
+		category:         models.FilterCategoryPrefecture,
+		table:            "regions",
+		joins:            []string{"JOIN prefectures ON prefectures.region_id = regions.id"},
+		universityColumn: "regions.university_id",
+		nameColumn:       "prefectures.name",
+		notDeleted:       []string{"regions", "prefectures"},
+	},
+	{
+		category:         models.FilterCategoryClassification,
+		table:            "classifications",
+		universityColumn: "classifications.university_id",
+		nameColumn:       "classifications.name",
+		notDeleted:       []string{"classifications"},
+	},
+	{
+		category: models.FilterCategorySubClassification,
+		table:    "classifications",
+		joins: []string{
+			"JOIN sub_classifications ON sub_classifications.classification_id = classifications.id",
+		},
+		universityColumn: "classifications.university_id",
+		nameColumn:       "sub_classifications.name",
+		notDeleted:       []string{"classifications", "sub_classifications"},
+	},
+	{
+		category: models.FilterCategorySchedule,
+		table:    "departments",
+		joins: []string{
+			"JOIN majors ON majors.department_id = departments.id",
+			"JOIN admission_schedules ON admission_schedules.major_id = majors.id",
+		},
+		universityColumn: "departments.university_id",
+		nameColumn:       "admission_schedules.name",
+		notDeleted:       []string{"departments", "majors", "admission_schedules"},
+	},
+	{
+		category: models.FilterCategoryAcademicField,
+		table:    "departments",
+		joins: []string{
+			"JOIN majors ON majors.department_id = departments.id",
+			"JOIN academic_fields ON academic_fields.major_id = majors.id",
+		},
+		universityColumn: "departments.university_id",
+		nameColumn:       "academic_fields.name",
+		notDeleted:       []string{"departments", "majors", "academic_fields"},
+	},
+}
+
+// UniversityHitSearcher は検索インデックスから大学を関連度の高い順に検索するインターフェースです
+type UniversityHitSearcher interface {
+	SearchHits(ctx context.Context, query string) ([]textsearch.Hit, error)
+}
+
+// facetSearchRepository はFacetSearchRepositoryの実装です
+type facetSearchRepository struct {
+	db       *gorm.DB
+	searcher UniversityHitSearcher
+}
+
+// NewFacetSearchRepository は新しいFacetSearchRepositoryを作成します
+func NewFacetSearchRepository(db *gorm.DB) FacetSearchRepository {
+	return &facetSearchRepository{db: db}
+}
+
+// NewFacetSearchRepositoryWithSearcher は大学一覧の検索と検索インデックスを共有するFacetSearchRepositoryを作成します
+// 大学・学部・学科の変更による検索インデックスの再構築も大学一覧の検索と共有されます
+func NewFacetSearchRepositoryWithSearcher(db *gorm.DB, searcher UniversityHitSearcher) FacetSearchRepository {
+	return &facetSearchRepository{db: db, searcher: searcher}
+}
+
+// indexSearcher は検索インデックスをUniversityHitSearcherとして使用するための構造体です
+type indexSearcher struct {
+	db    *gorm.DB
+	index *searchIndexState
+}
+
+// SearchHits は必要に応じてインデックスを再構築した上で検索を実行します
+func (s indexSearcher) SearchHits(ctx context.Context, query string) ([]textsearch.Hit, error) {
+	return s.index.search(ctx, s.db, query)
+}
+
+// searcherOrDefault はファセット検索で使用する検索インデックスを返します
+// 共有する検索インデックスが設定されていない場合は都度構築する一時的なインデックスを返します
+func (r *facetSearchRepository) searcherOrDefault() UniversityHitSearcher {
+	if r.searcher == nil {
+		return indexSearcher{db: r.db, index: newDefaultSearchIndexState()}
+	}
+
+	return r.searcher
+}
+
+// resolveQuery はフリーテキスト検索クエリを検索インデックスで大学IDに解決した検索条件を返します
+// 大学一覧の検索と同じ正規化（全角・半角、ひらがな・カタカナ）、略称展開、関連度の順序を使用します
+func (r *facetSearchRepository) resolveQuery(
+	ctx context.Context,
+	criteria FacetSearchCriteria,
+) (FacetSearchCriteria, error) {
+	if strings.TrimSpace(criteria.Query) == "" {
+		return criteria, nil
+	}
+
+	hits, err := r.searcherOrDefault().SearchHits(ctx, criteria.Query)
+	if err != nil {
+		return criteria, appErrors.NewDatabaseError("ファセット検索処理", fmt.Errorf(errSearchFailed, err), nil)
+	}
+
+	criteria.queryIDs = hitIDs(hits)
+
+	return criteria, nil
+}
+
+// facetSource はファセット定義に従って結合済みのクエリを生成します
+func (r *facetSearchRepository) facetSource(ctx context.Context, def facetDefinition) *gorm.DB {
+	query := r.db.WithContext(ctx).Table(def.table)
+	for _, join := range def.joins {
+		query = query.Joins(join)
+	}
+
+	for _, table := range def.notDeleted {
+		query = query.Where(table + ".deleted_at IS NULL")
+	}
+
+	return query
+}
+
+// filteredUniversities は検索条件に一致する大学IDのクエリを生成します
+// excludeCategoryに指定したカテゴリの条件は適用しません（ファセット件数の集計用）
+// 検索クエリを指定する場合は、事前に resolveQuery で大学IDに解決した検索条件を渡します
+func (r *facetSearchRepository) filteredUniversities(
+	ctx context.Context,
+	criteria FacetSearchCriteria,
+	excludeCategory string,
+) *gorm.DB {
+	query := r.db.WithContext(ctx).Model(&models.University{}).
+		Select("universities.id").
+		Where("universities.deleted_at IS NULL")
+
+	if strings.TrimSpace(criteria.Query) != "" {
+		query = query.Where("universities.id IN ?", criteria.queryIDs)
+	}
+
+	for _, def := range facetDefinitions {
+		if def.category == excludeCategory {
+			continue
+		}
+
+		values := criteria.valuesFor(def.category)
+		if len(values) == 0 {
+			continue
+		}
+
+		sub := r.facetSource(ctx, def).
+			Select(def.universityColumn).
+			Where(def.nameColumn+" IN ?", values)
+		query = query.Where("universities.id IN (?)", sub)
+	}
+
+	// 合格難易度の範囲は同じ入試情報の合格難易度が全ての範囲を満たすものに限定する
+	if conditions, args := criteria.difficultyConditions(); len(conditions) > 0 {
+		query = query.Where(
+			"EXISTS (SELECT 1 "+latestDifficultySource+" AND "+strings.Join(conditions, " AND ")+")",
+			args...,
+		)
+	}
+
+	return query
+}
+
+// filteredSchedules は検索条件に一致する入試日程のクエリを、配点の算出に必要な関連とともに生成します
+// 日程・学問系統は大学単位ではなく入試日程・学科単位で絞り込み、学年度を指定した場合はその年度の入試情報がある入試日程に限定します
+func (r *facetSearchRepository) filteredSchedules(
+	ctx context.Context,
+	criteria FacetSearchCriteria,
+	academicYear int,
+) *gorm.DB {
+	subjectOrder := func(db *gorm.DB) *gorm.DB {
+		return db.Where(notDeletedCondition).Order(displayOrderASC)
+	}
+
+	query := r.db.WithContext(ctx).Model(&models.AdmissionSchedule{}).
+		Joins("JOIN majors ON majors.id = admission_schedules.major_id").
+		Joins("JOIN departments ON departments.id = majors.department_id").
+		Where("admission_schedules.deleted_at IS NULL AND majors.deleted_at IS NULL AND departments.deleted_at IS NULL").
+		Where("departments.university_id IN (?)", r.filteredUniversities(ctx, criteria, ""))
+
+	if len(criteria.Schedules) > 0 {
+		query = query.Where("admission_schedules.name IN ?", criteria.Schedules)
+	}
+
+	if len(criteria.AcademicFields) > 0 {
+		query = query.Where("majors.id IN (?)", r.db.WithContext(ctx).Model(&models.AcademicField{}).
+			Select("major_id").
+			Where("name IN ? AND deleted_at IS NULL", criteria.AcademicFields))
+	}
+
+	if academicYear != 0 {
+		yearInfos := func(db *gorm.DB) *gorm.DB {
+			db = db.Where("academic_year = ? AND deleted_at IS NULL", academicYear)
+			return visibleAdmissionInfos(ctx, db, "status")
+		}
+
+		query = query.
+			Where("admission_schedules.id IN (?)", yearInfos(r.db.WithContext(ctx).Model(&models.AdmissionInfo{}).
+				Select("admission_schedule_id"))).
+			Preload("AdmissionInfos", yearInfos).
+			Preload("AdmissionInfos.TestTypes", notDeletedCondition).
+			Preload("AdmissionInfos.TestTypes.Subjects", subjectOrder).
+			Preload("AdmissionInfos.TestTypes.SubjectGroups", subjectOrder)
+	}
+
+	return query.
+		Preload("TestTypes", unlinkedTestTypeCondition).
+		Preload("TestTypes.Subjects", subjectOrder).
+		Preload("TestTypes.SubjectGroups", subjectOrder).
+		Preload("Major.Department.University")
+}
+
+// countFacet は指定されたカテゴリのファセット件数を集計します
+func (r *facetSearchRepository) countFacet(
+	ctx context.Context,
+	def facetDefinition,
+	criteria FacetSearchCriteria,
+) ([]FacetCount, error) {
+	counts := make([]FacetCount, 0)
+
+	err := r.facetSource(ctx, def).
+		Select(fmt.Sprintf("%s AS name, COUNT(DISTINCT %s) AS count", def.nameColumn, def.universityColumn)).
+		Where(def.universityColumn+" IN (?)", r.filteredUniversities(ctx, criteria, def.category)).
+		Group(def.nameColumn).
+		Order("name ASC").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+// paginate は検索条件に一致する大学IDをページ単位で取得します
+// 検索クエリを指定した関連度順では、検索インデックスの関連度の順序を保ったまま絞り込み条件に一致する大学に限定します
+func (r *facetSearchRepository) paginate(
+	ctx context.Context,
+	criteria FacetSearchCriteria,
+	params pagination.Params,
+) ([]uint, pagination.Page, error) {
+	filter := r.filteredUniversities(ctx, criteria, "")
+
+	if strings.TrimSpace(criteria.Query) == "" || params.Sort != pagination.SortRelevance {
+		return paginateUniversities(ctx, r.db, filter, params)
+	}
+
+	var matched []uint
+	if err := filter.Pluck("universities.id", &matched).Error; err != nil {
+		return nil, pagination.Page{}, appErrors.TranslateDBError(err)
+	}
+
+	inFilter := make(map[uint]bool, len(matched))
+	for _, id := range matched {
+		inFilter[id] = true
+	}
+
+	ranked := make([]uint, 0, len(matched))
+
+	for _, id := range criteria.queryIDs {
+		if inFilter[id] {
+			ranked = append(ranked, id)
+		}
+	}
+
+	ids, page := paginateHits(ranked, params)
+
+	return ids, page, nil
+}
+
+// SearchWithFacets は検索条件に一致する大学をページ単位で取得し、ファセットごとの件数を集計します
+func (r *facetSearchRepository) SearchWithFacets(
+	ctx context.Context,
+	criteria FacetSearchCriteria,
+	params pagination.Params,
+) (*FacetSearchResult, error) {
+	criteria, err := r.resolveQuery(ctx, criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, page, err := r.paginate(ctx, criteria, params)
+	if err != nil {
+		return nil, err
+	}
+
+	universities := make([]models.University, 0, len(ids))
+
+	if len(ids) > 0 {
+		err = r.db.WithContext(ctx).
+			Where("id IN ?", ids).
+			Preload("Regions.Prefectures").
+			Preload("Classifications.SubClassifications").
+			Preload("Departments.Majors.AdmissionSchedules").
+			Preload("Departments.Majors.AcademicFields").
+			Find(&universities).Error
+		if err != nil {
+			return nil, appErrors.NewDatabaseError("ファセット検索処理", fmt.Errorf(errSearchFailed, err), nil)
+		}
+	}
+
+	facets := make(map[string][]FacetCount, len(facetDefinitions))
+
+	for _, def := range facetDefinitions {
+		counts, err := r.countFacet(ctx, def, criteria)
+		if err != nil {
+			return nil, appErrors.NewDatabaseError("ファセット件数集計処理", err, map[string]string{
+				"category": def.category,
+			})
+		}
+
+		facets[def.category] = counts
+	}
+
+	return &FacetSearchResult{
+		Universities: orderByIDs(universities, ids),
+		Facets:       facets,
+		Page:         page,
+	}, nil
+}
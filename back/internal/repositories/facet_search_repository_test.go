@@ -0,0 +1,353 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"university-exam-api/internal/domain/models"
+	"university-exam-api/internal/pkg/pagination"
+	"university-exam-api/internal/pkg/textsearch"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupSQLiteTestDB は全モデルをマイグレーションしたインメモリのSQLiteデータベースを作成します
+func setupSQLiteTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open(testDBMemory), &gorm.Config{})
+	require.NoError(t, err)
+
+	err = db.AutoMigrate(
+		&models.University{},
+		&models.Department{},
+		&models.Major{},
+		&models.AdmissionSchedule{},
+		&models.AdmissionInfo{},
+		&models.TestType{},
+		&models.Subject{},
+		&models.Region{},
+		&models.Prefecture{},
+		&models.Classification{},
+		&models.SubClassification{},
+		&models.AcademicField{},
+		&models.FilterOption{},
+		&models.ScheduleEvent{},
+		&models.SubjectGroup{},
+		&models.AdmissionDifficulty{},
+	)
+	require.NoError(t, err)
+
+	return db
+}
+
+// newFacetTestUniversity はファセット検索テスト用の大学データを生成します
+func newFacetTestUniversity(
+	name, region, prefecture, classification, subClassification, department, major, schedule, field string,
+) *models.University {
+	return &models.University{
+		BaseModel: models.BaseModel{Version: 1},
+		Name:      name,
+		Regions: []models.Region{
+			{Name: region, Prefectures: []models.Prefecture{{Name: prefecture}}},
+		},
+		Classifications: []models.Classification{
+			{Name: classification, SubClassifications: []models.SubClassification{{Name: subClassification}}},
+		},
+		Departments: []models.Department{
+			{
+				Name: department,
+				Majors: []models.Major{
+					{
+						Name:               major,
+						AdmissionSchedules: []models.AdmissionSchedule{{Name: schedule}},
+						AcademicFields:     []models.AcademicField{{Name: field}},
+					},
+				},
+			},
+		},
+	}
+}
+
+// setupFacetTestData はファセット検索用のテストデータを作成します
+func setupFacetTestData(t *testing.T, db *gorm.DB) {
+	t.Helper()
+
+	universities := []*models.University{
+		newFacetTestUniversity("東京大学", "関東", "東京", "国公立", "国立", "工学部", "機械工学科", "前", "工学"),
+		newFacetTestUniversity("京都大学", "関西", "京都", "国公立", "国立", "理学部", "数学科", "前", "理学"),
+		newFacetTestUniversity("早稲田大学", "関東", "東京", "私立", "私立大学", "商学部", "商学科", "後", "商学"),
+	}
+
+	for _, u := range universities {
+		require.NoError(t, db.Create(u).Error)
+	}
+}
+
+// universityNames は大学名の一覧を返します
+func universityNames(universities []models.University) []string {
+	names := make([]string, 0, len(universities))
+	for _, u := range universities {
+		names = append(names, u.Name)
+	}
+
+	return names
+}
+
+// facetCount は指定されたファセット値の件数を返します
+func facetCount(counts []FacetCount, name string) int64 {
+	for _, c := range counts {
+		if c.Name == name {
+			return c.Count
+		}
+	}
+
+	return 0
+}
+
+func TestFacetSearchRepositorySearchWithFacets(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	setupFacetTestData(t, db)
+
+	repo := NewFacetSearchRepository(db)
+
+	tests := []struct {
+		name      string
+		criteria  FacetSearchCriteria
+		wantNames []string
+	}{
+		{
+			name:      "条件なしで全件を取得",
+			criteria:  FacetSearchCriteria{},
+			wantNames: []string{"京都大学", "早稲田大学", "東京大学"},
+		},
+		{
+			name:      "地域で絞り込み",
+			criteria:  FacetSearchCriteria{Regions: []string{"関東"}},
+			wantNames: []string{"早稲田大学", "東京大学"},
+		},
+		{
+			name:      "同一カテゴリ内はOR条件",
+			criteria:  FacetSearchCriteria{Prefectures: []string{"東京", "京都"}},
+			wantNames: []string{"京都大学", "早稲田大学", "東京大学"},
+		},
+		{
+			name: "カテゴリ間はAND条件",
+			criteria: FacetSearchCriteria{
+				Regions:         []string{"関東"},
+				Classifications: []string{"国公立"},
+			},
+			wantNames: []string{"東京大学"},
+		},
+		{
+			name:      "小分類で絞り込み",
+			criteria:  FacetSearchCriteria{SubClassifications: []string{"私立大学"}},
+			wantNames: []string{"早稲田大学"},
+		},
+		{
+			name:      "日程で絞り込み",
+			criteria:  FacetSearchCriteria{Schedules: []string{"前"}},
+			wantNames: []string{"京都大学", "東京大学"},
+		},
+		{
+			name:      "学問系統とフリーテキストの組み合わせ",
+			criteria:  FacetSearchCriteria{Query: "数学", AcademicFields: []string{"理学"}},
+			wantNames: []string{"京都大学"},
+		},
+		{
+			name:      "略称のフリーテキストと地域の組み合わせ",
+			criteria:  FacetSearchCriteria{Query: "早大", Regions: []string{"関東"}},
+			wantNames: []string{"早稲田大学"},
+		},
+		{
+			name:      "フリーテキストに一致しない",
+			criteria:  FacetSearchCriteria{Query: "東大", Regions: []string{"関西"}},
+			wantNames: []string{},
+		},
+		{
+			name:      "該当なし",
+			criteria:  FacetSearchCriteria{Regions: []string{"九州"}},
+			wantNames: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := repo.SearchWithFacets(context.Background(), tt.criteria, pagination.DefaultParams())
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantNames, universityNames(result.Universities))
+		})
+	}
+}
+
+// stubHitSearcher は固定の検索結果を返すUniversityHitSearcherのスタブです
+type stubHitSearcher struct {
+	hits []textsearch.Hit
+}
+
+func (s stubHitSearcher) SearchHits(_ context.Context, _ string) ([]textsearch.Hit, error) {
+	return s.hits, nil
+}
+
+func TestFacetSearchRepositoryRelevance(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	setupFacetTestData(t, db)
+
+	var ids = map[string]uint{}
+	for _, name := range []string{"東京大学", "京都大学", "早稲田大学"} {
+		var u models.University
+		require.NoError(t, db.Where("name = ?", name).First(&u).Error)
+		ids[name] = u.ID
+	}
+
+	repo := NewFacetSearchRepositoryWithSearcher(db, stubHitSearcher{hits: []textsearch.Hit{
+		{ID: ids["早稲田大学"], Score: 3},
+		{ID: ids["京都大学"], Score: 2},
+		{ID: ids["東京大学"], Score: 1},
+	}})
+
+	params := pagination.DefaultParams()
+	params.Sort = pagination.SortRelevance
+
+	result, err := repo.SearchWithFacets(context.Background(), FacetSearchCriteria{
+		Query:   "大学",
+		Regions: []string{"関東"},
+	}, params)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"早稲田大学", "東京大学"}, universityNames(result.Universities))
+	assert.Equal(t, int64(2), result.Total)
+	assert.Equal(t, int64(1), facetCount(result.Facets[models.FilterCategoryRegion], "関西"))
+}
+
+func TestFacetSearchRepositoryDifficultyRange(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	setupPaginationDifficultyData(t, db)
+
+	repo := NewFacetSearchRepository(db)
+
+	value := func(v float64) *float64 { return &v }
+
+	tests := []struct {
+		name      string
+		criteria  FacetSearchCriteria
+		wantNames []string
+	}{
+		{
+			name:      "偏差値帯が下限と重なる大学",
+			criteria:  FacetSearchCriteria{Deviation: DifficultyRange{Min: value(60)}},
+			wantNames: []string{"A大学", "B大学", "D大学"},
+		},
+		{
+			name:      "偏差値帯が上限と重なる大学",
+			criteria:  FacetSearchCriteria{Deviation: DifficultyRange{Max: value(58)}},
+			wantNames: []string{"C大学", "D大学"},
+		},
+		{
+			name: "共通テスト得点率の範囲",
+			criteria: FacetSearchCriteria{
+				CommonTestRate: DifficultyRange{Min: value(80), Max: value(88)},
+			},
+			wantNames: []string{"A大学", "D大学"},
+		},
+		{
+			name: "同じ入試の値がすべての条件を満たす",
+			criteria: FacetSearchCriteria{
+				Deviation:      DifficultyRange{Min: value(60)},
+				CommonTestRate: DifficultyRange{Max: value(86)},
+			},
+			wantNames: []string{"A大学"},
+		},
+		{
+			name:      "ボーダー得点が未登録の大学は該当しない",
+			criteria:  FacetSearchCriteria{BorderScore: DifficultyRange{Min: value(0)}},
+			wantNames: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := repo.SearchWithFacets(context.Background(), tt.criteria, pagination.DefaultParams())
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantNames, universityNames(result.Universities))
+		})
+	}
+}
+
+func TestFacetSearchRepositoryFacetCounts(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	setupFacetTestData(t, db)
+
+	repo := NewFacetSearchRepository(db)
+
+	t.Run("条件なしの件数", func(t *testing.T) {
+		result, err := repo.SearchWithFacets(context.Background(), FacetSearchCriteria{}, pagination.DefaultParams())
+		require.NoError(t, err)
+
+		for _, category := range models.FilterCategories {
+			assert.Contains(t, result.Facets, category)
+		}
+
+		assert.Equal(t, int64(2), facetCount(result.Facets[models.FilterCategoryRegion], "関東"))
+		assert.Equal(t, int64(1), facetCount(result.Facets[models.FilterCategoryRegion], "関西"))
+		assert.Equal(t, int64(2), facetCount(result.Facets[models.FilterCategoryClassification], "国公立"))
+		assert.Equal(t, int64(2), facetCount(result.Facets[models.FilterCategorySchedule], "前"))
+		assert.Equal(t, int64(1), facetCount(result.Facets[models.FilterCategoryAcademicField], "商学"))
+	})
+
+	t.Run("自カテゴリの条件は件数に影響しない", func(t *testing.T) {
+		result, err := repo.SearchWithFacets(context.Background(), FacetSearchCriteria{
+			Regions: []string{"関東"},
+		}, pagination.DefaultParams())
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(2), facetCount(result.Facets[models.FilterCategoryRegion], "関東"))
+		assert.Equal(t, int64(1), facetCount(result.Facets[models.FilterCategoryRegion], "関西"))
+		assert.Equal(t, int64(1), facetCount(result.Facets[models.FilterCategoryClassification], "国公立"))
+		assert.Equal(t, int64(1), facetCount(result.Facets[models.FilterCategoryClassification], "私立"))
+		assert.Equal(t, int64(2), facetCount(result.Facets[models.FilterCategoryPrefecture], "東京"))
+		assert.Equal(t, int64(0), facetCount(result.Facets[models.FilterCategoryPrefecture], "京都"))
+	})
+}
+
+func TestFacetSearchRepositoryPrivateSchedule(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	setupFacetTestData(t, db)
+	require.NoError(t, db.Create(newFacetTestUniversity(
+		"明治大学", "関東", "東京", "私立", "私立大学", "法学部", "法律学科", "全学部日程", "法学",
+	)).Error)
+
+	repo := NewFacetSearchRepository(db)
+
+	result, err := repo.SearchWithFacets(context.Background(), FacetSearchCriteria{
+		Schedules: []string{"全学部日程", "後"},
+	}, pagination.DefaultParams())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"早稲田大学", "明治大学"}, universityNames(result.Universities))
+	assert.Equal(t, int64(1), facetCount(result.Facets[models.FilterCategorySchedule], "全学部日程"))
+	assert.Equal(t, int64(2), facetCount(result.Facets[models.FilterCategorySchedule], "前"))
+}
+
+func TestFacetSearchRepositoryDBError(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	require.NoError(t, db.Migrator().DropTable(&models.Region{}))
+
+	repo := NewFacetSearchRepository(db)
+
+	_, err := repo.SearchWithFacets(
+		context.Background(),
+		FacetSearchCriteria{Regions: []string{"関東"}},
+		pagination.DefaultParams(),
+	)
+	assert.Error(t, err)
+}
+
+func TestFacetSearchCriteriaIsEmpty(t *testing.T) {
+	assert.True(t, (&FacetSearchCriteria{Query: " "}).IsEmpty())
+	assert.False(t, (&FacetSearchCriteria{Query: "東京"}).IsEmpty())
+	assert.False(t, (&FacetSearchCriteria{AcademicFields: []string{"工学"}}).IsEmpty())
+
+	rate := 80.0
+	assert.False(t, (&FacetSearchCriteria{CommonTestRate: DifficultyRange{Max: &rate}}).IsEmpty())
+}
@@ -0,0 +1,880 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	applogger "university-exam-api/internal/logger"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 取り込み対象のエンティティ種別
+const (
+	ImportEntityUniversity          = "university"
+	ImportEntityDepartment          = "department"
+	ImportEntityMajor               = "major"
+	ImportEntitySchedule            = "schedule"
+	ImportEntityAdmissionInfo       = "admission_info"
+	ImportEntityTestType            = "test_type"
+	ImportEntitySubject             = "subject"
+	ImportEntityAdmissionDifficulty = "admission_difficulty"
+)
+
+// 取り込み行の列名
+const (
+	ImportFieldUniversity     = "university"
+	ImportFieldDepartment     = "department"
+	ImportFieldMajor          = "major"
+	ImportFieldSchedule       = "schedule"
+	ImportFieldTestType       = "test_type"
+	ImportFieldSubject        = "subject"
+	ImportFieldScore          = "score"
+	ImportFieldDisplayOrder   = "display_order"
+	ImportFieldAcademicYear   = "academic_year"
+	ImportFieldEnrollment     = "enrollment"
+	ImportFieldBorderScore    = "border_score"
+	ImportFieldCommonTestRate = "common_test_rate"
+	ImportFieldDeviationLow   = "deviation_low"
+	ImportFieldDeviationHigh  = "deviation_high"
+)
+
+const (
+	maxImportScore              = 1000
+	minImportYear               = 2000
+	maxImportYear               = 2100
+	maxImportEnrollment         = 9999
+	importDraftStatus           = "draft"
+	errImportRange              = "%sは%dから%dの範囲である必要があります"
+	errImportDuplicated         = "%d行目と重複しています"
+	errImportEnrollment         = "年度を指定する場合は募集人員が必要です"
+	errImportDifficultyYear     = "合格難易度を指定する場合は年度が必要です"
+	errImportDifficultyConflict = "%d行目の合格難易度と異なります"
+)
+
+// errImportRollback はドライラン・行エラー時にトランザクションを取り消すためのエラーです
+var errImportRollback = errors.New("取り込みを取り消します")
+
+// ImportRow は一括取り込みの1行分の入試データです
+// - Line: 元ファイルでの行番号（ヘッダー行を1行目とする）
+// - AcademicYear: 0の場合は年度に紐付かない入試日程共通の試験種別として取り込みます
+// - BorderScore / CommonTestRate / DeviationLow / DeviationHigh: 年度の入試情報の合格難易度（省略可能）
+type ImportRow struct {
+	Line           int
+	University     string
+	Department     string
+	Major          string
+	Schedule       string
+	TestType       string
+	Subject        string
+	Score          int
+	DisplayOrder   int
+	AcademicYear   int
+	Enrollment     int
+	BorderScore    *float64
+	CommonTestRate *float64
+	DeviationLow   *float64
+	DeviationHigh  *float64
+}
+
+// subjectKey は科目を一意に識別するキーを返します
+func (row ImportRow) subjectKey() string {
+	return strings.Join([]string{
+		row.University, row.Department, row.Major, row.Schedule,
+		fmt.Sprint(row.AcademicYear), row.TestType, row.Subject,
+	}, "\x00")
+}
+
+// admissionInfoKey は年度の入試情報を一意に識別するキーを返します
+func (row ImportRow) admissionInfoKey() string {
+	return strings.Join([]string{
+		row.University, row.Department, row.Major, row.Schedule, fmt.Sprint(row.AcademicYear),
+	}, "\x00")
+}
+
+// difficulty は取り込み行の合格難易度を返します
+// 合格難易度の列がいずれも空の場合はnilを返します
+func (row ImportRow) difficulty() *models.AdmissionDifficulty {
+	if row.BorderScore == nil && row.CommonTestRate == nil && row.DeviationLow == nil && row.DeviationHigh == nil {
+		return nil
+	}
+
+	return &models.AdmissionDifficulty{
+		BaseModel:      models.BaseModel{Version: 1},
+		BorderScore:    row.BorderScore,
+		CommonTestRate: row.CommonTestRate,
+		DeviationLow:   row.DeviationLow,
+		DeviationHigh:  row.DeviationHigh,
+	}
+}
+
+// ImportRowError は取り込み行のエラーを表現する構造体です
+type ImportRowError struct {
+	Line    int    `json:"line"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// ImportCounts はエンティティ種別ごとの作成・更新件数です
+type ImportCounts struct {
+	Created int `json:"created"`
+	Updated int `json:"updated"`
+}
+
+// ImportResult は一括取り込みの結果を表現する構造体です
+// エラーが1件でもある場合、またはドライランの場合はデータベースへの変更は取り消されます
+type ImportResult struct {
+	DryRun    bool                    `json:"dry_run"`
+	Committed bool                    `json:"committed"`
+	Rows      int                     `json:"rows"`
+	Summary   map[string]ImportCounts `json:"summary"`
+	Errors    []ImportRowError        `json:"errors"`
+}
+
+// NewImportResult は空の取り込み結果を生成します
+func NewImportResult(dryRun bool) *ImportResult {
+	summary := make(map[string]ImportCounts)
+	for _, entity := range []string{
+		ImportEntityUniversity, ImportEntityDepartment, ImportEntityMajor, ImportEntitySchedule,
+		ImportEntityAdmissionInfo, ImportEntityTestType, ImportEntitySubject, ImportEntityAdmissionDifficulty,
+	} {
+		summary[entity] = ImportCounts{}
+	}
+
+	return &ImportResult{DryRun: dryRun, Summary: summary, Errors: []ImportRowError{}}
+}
+
+// AddError は行エラーを追加します
+func (r *ImportResult) AddError(line int, field, message string) {
+	r.Errors = append(r.Errors, ImportRowError{Line: line, Field: field, Message: message})
+}
+
+// SortErrors は行エラーを行番号順に並べます
+func (r *ImportResult) SortErrors() {
+	sort.SliceStable(r.Errors, func(i, j int) bool { return r.Errors[i].Line < r.Errors[j].Line })
+}
+
+// addRowError はエラーの内容を行エラーとして追加します
+// 列名が空の場合はエラーに含まれるフィールド名を使用します
+func (r *ImportResult) addRowError(line int, field string, err error) {
+	var appErr *appErrors.Error
+	if errors.As(err, &appErr) {
+		if field == "" {
+			field = appErr.Details.Field
+		}
+
+		r.AddError(line, field, strings.TrimPrefix(appErr.Message, appErr.Details.Field+": "))
+
+		return
+	}
+
+	r.AddError(line, field, err.Error())
+}
+
+// count はエンティティ種別ごとの件数を加算します
+func (r *ImportResult) count(entity string, created bool) {
+	c := r.Summary[entity]
+	if created {
+		c.Created++
+	} else {
+		c.Updated++
+	}
+
+	r.Summary[entity] = c
+}
+
+// IUniversityImporter は入試データの一括取り込みに関するインターフェースを定義します。
+// このインターフェースは以下の機能を提供します：
+// - 行単位の検証
+// - 大学から科目までの階層への一括登録・更新
+// - ドライランによる事前検証
+type IUniversityImporter interface {
+	ImportRows(ctx context.Context, rows []ImportRow, dryRun bool) (*ImportResult, error)
+}
+
+// ImportRows は入試データの行を大学・学部・学科・日程・試験種別・科目の階層に一括登録します。
+// この関数は以下の処理を行います：
+// - 行単位の入力値・重複の検証と大学単位の階層の検証
+// - 1つのトランザクション内での名称による検索と作成・更新
+// - 年度の入試情報の合格難易度の作成・更新
+// - 取り込んだ試験種別の配点比率の再計算
+// - ドライラン・行エラー時のロールバック
+func (r *universityRepository) ImportRows(ctx context.Context, rows []ImportRow, dryRun bool) (*ImportResult, error) {
+	result := NewImportResult(dryRun)
+	result.Rows = len(rows)
+
+	for i := range rows {
+		sanitizeImportRow(&rows[i])
+	}
+
+	r.validateImportRows(rows, result)
+
+	if len(result.Errors) > 0 {
+		result.SortErrors()
+		return result, nil
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		importer := newRowImporter(tx, result)
+
+		for _, row := range rows {
+			if err := checkContext(ctx, "一括取り込み処理"); err != nil {
+				return err
+			}
+
+			if err := importer.importRow(row); err != nil {
+				result.addRowError(row.Line, "", appErrors.TranslateDBError(err))
+				return errImportRollback
+			}
+		}
+
+		if err := importer.recalculatePercentages(); err != nil {
+			return err
+		}
+
+		if dryRun {
+			return errImportRollback
+		}
+
+		return nil
+	})
+
+	var appErr *appErrors.Error
+	if errors.As(err, &appErr) {
+		return nil, appErr
+	}
+
+	if err != nil && !errors.Is(err, errImportRollback) {
+		return nil, appErrors.NewDatabaseError("一括取り込み処理", err, nil)
+	}
+
+	result.Committed = err == nil
+
+	if result.Committed {
+		r.cache.ClearAllRelatedCache(0)
+		r.searchIndex.invalidate()
+	}
+
+	applogger.Info(ctx, "入試データを取り込みました: rows=%d, dryRun=%t, committed=%t, errors=%d",
+		len(rows), dryRun, result.Committed, len(result.Errors))
+
+	return result, nil
+}
+
+// sanitizeImportRow は取り込み行の名称の前後の空白・HTMLタグ・制御文字を除去します
+func sanitizeImportRow(row *ImportRow) {
+	for _, name := range []*string{
+		&row.University, &row.Department, &row.Major, &row.Schedule, &row.TestType, &row.Subject,
+	} {
+		*name = strings.TrimSpace(sanitizeName(*name))
+	}
+}
+
+// validateImportRows は取り込み行の入力値と重複、大学単位の階層を検証します
+func (r *universityRepository) validateImportRows(rows []ImportRow, result *ImportResult) {
+	seen := make(map[string]int, len(rows))
+	difficulties := make(map[string]ImportRow)
+	universities := make(map[string]*models.University)
+	firstLines := make(map[string]int)
+
+	var order []string
+
+	for _, row := range rows {
+		if !validateImportRow(row, result) {
+			continue
+		}
+
+		if line, ok := seen[row.subjectKey()]; ok {
+			result.AddError(row.Line, ImportFieldSubject, fmt.Sprintf(errImportDuplicated, line))
+			continue
+		}
+
+		seen[row.subjectKey()] = row.Line
+
+		// 合格難易度は入試情報に1件のため、同じ入試情報の行で異なる値を指定できない
+		if d := row.difficulty(); d != nil {
+			if first, ok := difficulties[row.admissionInfoKey()]; !ok {
+				difficulties[row.admissionInfoKey()] = row
+			} else if !sameDifficulty(first.difficulty(), d) {
+				result.AddError(row.Line, ImportFieldBorderScore, fmt.Sprintf(errImportDifficultyConflict, first.Line))
+				continue
+			}
+		}
+
+		// 年度ごとに試験種別が異なるため、大学と年度の組ごとに階層を検証する
+		key := fmt.Sprintf("%s\x00%d", row.University, row.AcademicYear)
+		if _, ok := universities[key]; !ok {
+			universities[key] = &models.University{
+				BaseModel: models.BaseModel{Version: 1},
+				Name:      row.University,
+			}
+			firstLines[key] = row.Line
+			order = append(order, key)
+		}
+
+		addImportRowToTree(universities[key], row)
+	}
+
+	for _, key := range order {
+		if err := r.validateUniversity(universities[key]); err != nil {
+			result.addRowError(firstLines[key], "", err)
+		}
+	}
+}
+
+// validateImportRow は取り込み行の入力値を検証し、エラーがなければtrueを返します
+func validateImportRow(row ImportRow, result *ImportResult) bool {
+	before := len(result.Errors)
+
+	names := []struct {
+		field string
+		label string
+		value string
+	}{
+		{ImportFieldUniversity, "大学名", row.University},
+		{ImportFieldDepartment, "学部名", row.Department},
+		{ImportFieldMajor, "学科名", row.Major},
+		{ImportFieldSubject, "科目名", row.Subject},
+	}
+
+	for _, n := range names {
+		if err := validateName(n.value, n.label); err != nil {
+			result.addRowError(row.Line, n.field, err)
+		}
+	}
+
+	if _, ok := models.Schedules().Lookup(row.Schedule); !ok {
+		result.AddError(row.Line, ImportFieldSchedule, fmt.Sprintf(errUnregisteredSchedule, row.Schedule))
+	}
+
+	if _, ok := models.TestTypes().Lookup(row.TestType); !ok {
+		result.AddError(row.Line, ImportFieldTestType, fmt.Sprintf(errUnregisteredTestType, row.TestType))
+	}
+
+	if row.Score < 0 || row.Score > maxImportScore {
+		result.AddError(row.Line, ImportFieldScore, fmt.Sprintf(errImportRange, "配点", 0, maxImportScore))
+	}
+
+	if row.DisplayOrder < 0 {
+		result.AddError(row.Line, ImportFieldDisplayOrder, fmt.Sprintf(errNonNegative, "表示順序"))
+	}
+
+	if row.AcademicYear != 0 {
+		if row.AcademicYear < minImportYear || row.AcademicYear > maxImportYear {
+			result.AddError(row.Line, ImportFieldAcademicYear,
+				fmt.Sprintf(errImportRange, "年度", minImportYear, maxImportYear))
+		}
+
+		if row.Enrollment == 0 {
+			result.AddError(row.Line, ImportFieldEnrollment, errImportEnrollment)
+		}
+	}
+
+	if row.Enrollment < 0 || row.Enrollment > maxImportEnrollment {
+		result.AddError(row.Line, ImportFieldEnrollment, fmt.Sprintf(errImportRange, "募集人員", 1, maxImportEnrollment))
+	}
+
+	validateImportDifficulty(row, result)
+
+	return len(result.Errors) == before
+}
+
+// validateImportDifficulty は取り込み行の合格難易度の範囲と、年度の指定を検証します
+func validateImportDifficulty(row ImportRow, result *ImportResult) {
+	difficulty := row.difficulty()
+	if difficulty == nil {
+		return
+	}
+
+	if row.AcademicYear == 0 {
+		result.AddError(row.Line, ImportFieldAcademicYear, errImportDifficultyYear)
+		return
+	}
+
+	// 入試情報は取り込み時に決まるため、仮のIDで範囲を検証する
+	difficulty.AdmissionInfoID = 1
+
+	var validationErr *models.ValidationError
+	if err := difficulty.Validate(); errors.As(err, &validationErr) {
+		result.AddError(row.Line, importDifficultyFields[validationErr.Field], validationErr.Message)
+	}
+}
+
+// importDifficultyFields は合格難易度の検証エラーのフィールド名と取り込み行の列名の対応です
+var importDifficultyFields = map[string]string{
+	"BorderScore":    ImportFieldBorderScore,
+	"CommonTestRate": ImportFieldCommonTestRate,
+	"Deviation":      ImportFieldDeviationLow,
+}
+
+// sameDifficulty は2つの合格難易度の値が等しいかどうかを判定します
+func sameDifficulty(a, b *models.AdmissionDifficulty) bool {
+	equal := func(x, y *float64) bool {
+		return (x == nil && y == nil) || (x != nil && y != nil && *x == *y)
+	}
+
+	return equal(a.BorderScore, b.BorderScore) &&
+		equal(a.CommonTestRate, b.CommonTestRate) &&
+		equal(a.DeviationLow, b.DeviationLow) &&
+		equal(a.DeviationHigh, b.DeviationHigh)
+}
+
+// importScheduleOrder は取り込みで作成する入試日程の表示順として、日程レジストリの表示順を返します
+func importScheduleOrder(name string) int {
+	d, _ := models.Schedules().Lookup(name)
+	return d.DisplayOrder
+}
+
+// addImportRowToTree は検証用の大学の階層に取り込み行を追加します
+func addImportRowToTree(university *models.University, row ImportRow) {
+	base := models.BaseModel{Version: 1}
+
+	dept := findOrAppend(&university.Departments, func(d models.Department) bool { return d.Name == row.Department },
+		models.Department{BaseModel: base, Name: row.Department})
+	major := findOrAppend(&dept.Majors, func(m models.Major) bool { return m.Name == row.Major },
+		models.Major{BaseModel: base, Name: row.Major})
+	schedule := findOrAppend(&major.AdmissionSchedules,
+		func(s models.AdmissionSchedule) bool { return s.Name == row.Schedule },
+		models.AdmissionSchedule{BaseModel: base, Name: row.Schedule, DisplayOrder: importScheduleOrder(row.Schedule)})
+	testType := findOrAppend(&schedule.TestTypes, func(t models.TestType) bool { return t.Name == row.TestType },
+		models.TestType{BaseModel: base, Name: row.TestType})
+
+	testType.Subjects = append(testType.Subjects, models.Subject{
+		BaseModel:    base,
+		Name:         row.Subject,
+		Score:        row.Score,
+		DisplayOrder: row.DisplayOrder,
+	})
+}
+
+// findOrAppend は条件に一致する要素を返し、存在しない場合は追加した要素を返します
+func findOrAppend[T any](items *[]T, match func(T) bool, item T) *T {
+	for i := range *items {
+		if match((*items)[i]) {
+			return &(*items)[i]
+		}
+	}
+
+	*items = append(*items, item)
+
+	return &(*items)[len(*items)-1]
+}
+
+// importGroup は配点比率を再計算する試験種別のまとまり（入試日程と年度の組）です
+type importGroup struct {
+	scheduleID uint
+	infoID     uint
+}
+
+// rowImporter はトランザクション内で取り込み行を登録する構造体です
+// 同じ名称の検索結果を保持し、同一ファイル内での重複した検索・作成を避けます
+type rowImporter struct {
+	tx      *gorm.DB
+	result  *ImportResult
+	ids     map[string]uint
+	groups  map[importGroup]bool
+	ordered []importGroup
+}
+
+// newRowImporter は新しいrowImporterを生成します
+func newRowImporter(tx *gorm.DB, result *ImportResult) *rowImporter {
+	return &rowImporter{
+		tx:     tx,
+		result: result,
+		ids:    make(map[string]uint),
+		groups: make(map[importGroup]bool),
+	}
+}
+
+// findOrCreate は名称で検索したエンティティのIDを返し、存在しない場合は作成します
+func (im *rowImporter) findOrCreate(
+	entity string,
+	key string,
+	find func() *gorm.DB,
+	create func() (uint, error),
+) (uint, error) {
+	cacheKey := entity + "\x00" + key
+	if id, ok := im.ids[cacheKey]; ok {
+		return id, nil
+	}
+
+	var id uint
+
+	err := find().Limit(1).Pluck("id", &id).Error
+	if err != nil {
+		return 0, err
+	}
+
+	if id == 0 {
+		if id, err = create(); err != nil {
+			return 0, err
+		}
+
+		im.result.count(entity, true)
+	}
+
+	im.ids[cacheKey] = id
+
+	return id, nil
+}
+
+// importRow は1行分の大学から科目までの階層を登録します
+func (im *rowImporter) importRow(row ImportRow) error {
+	base := models.BaseModel{Version: 1}
+	tx := im.tx
+
+	universityID, err := im.findOrCreate(ImportEntityUniversity, row.University,
+		func() *gorm.DB {
+			return tx.Model(&models.University{}).Where("name = ? AND deleted_at IS NULL", row.University)
+		},
+		func() (uint, error) {
+			u := models.University{BaseModel: base, Name: row.University}
+			err := createOmitAssociations(tx, &u, &u.ID)
+
+			return u.ID, err
+		})
+	if err != nil {
+		return err
+	}
+
+	departmentID, err := im.findOrCreate(ImportEntityDepartment, fmt.Sprint(universityID, "/", row.Department),
+		func() *gorm.DB {
+			return tx.Model(&models.Department{}).
+				Where("university_id = ? AND name = ? AND deleted_at IS NULL", universityID, row.Department)
+		},
+		func() (uint, error) {
+			d := models.Department{BaseModel: base, UniversityID: universityID, Name: row.Department}
+			err := createOmitAssociations(tx, &d, &d.ID)
+
+			return d.ID, err
+		})
+	if err != nil {
+		return err
+	}
+
+	majorID, err := im.findOrCreate(ImportEntityMajor, fmt.Sprint(departmentID, "/", row.Major),
+		func() *gorm.DB {
+			return tx.Model(&models.Major{}).
+				Where("department_id = ? AND name = ? AND deleted_at IS NULL", departmentID, row.Major)
+		},
+		func() (uint, error) {
+			m := models.Major{BaseModel: base, DepartmentID: departmentID, Name: row.Major}
+			err := createOmitAssociations(tx, &m, &m.ID)
+
+			return m.ID, err
+		})
+	if err != nil {
+		return err
+	}
+
+	scheduleID, err := im.findOrCreate(ImportEntitySchedule, fmt.Sprint(majorID, "/", row.Schedule),
+		func() *gorm.DB {
+			return tx.Model(&models.AdmissionSchedule{}).
+				Where("major_id = ? AND name = ? AND deleted_at IS NULL", majorID, row.Schedule)
+		},
+		func() (uint, error) {
+			s := models.AdmissionSchedule{
+				BaseModel:    base,
+				MajorID:      majorID,
+				Name:         row.Schedule,
+				DisplayOrder: importScheduleOrder(row.Schedule),
+			}
+
+			err := createOmitAssociations(tx, &s, &s.ID)
+
+			return s.ID, err
+		})
+	if err != nil {
+		return err
+	}
+
+	group := importGroup{scheduleID: scheduleID}
+
+	if row.AcademicYear != 0 {
+		if group.infoID, err = im.importAdmissionInfo(scheduleID, row); err != nil {
+			return err
+		}
+
+		if err := im.importDifficulty(group.infoID, row); err != nil {
+			return err
+		}
+	}
+
+	testTypeID, err := im.importTestType(group, row.TestType)
+	if err != nil {
+		return err
+	}
+
+	if !im.groups[group] {
+		im.groups[group] = true
+		im.ordered = append(im.ordered, group)
+	}
+
+	return im.importSubject(testTypeID, row)
+}
+
+// importAdmissionInfo は年度の入試情報を検索・作成し、募集人員が異なる場合は更新します
+func (im *rowImporter) importAdmissionInfo(scheduleID uint, row ImportRow) (uint, error) {
+	key := fmt.Sprint(ImportEntityAdmissionInfo, "\x00", scheduleID, "/", row.AcademicYear)
+	if id, ok := im.ids[key]; ok {
+		return id, nil
+	}
+
+	var info models.AdmissionInfo
+
+	err := im.tx.Where("admission_schedule_id = ? AND academic_year = ? AND deleted_at IS NULL",
+		scheduleID, row.AcademicYear).Limit(1).Find(&info).Error
+	if err != nil {
+		return 0, err
+	}
+
+	switch {
+	case info.ID == 0:
+		info = models.AdmissionInfo{
+			BaseModel:           models.BaseModel{Version: 1},
+			AdmissionScheduleID: scheduleID,
+			AcademicYear:        row.AcademicYear,
+			Enrollment:          row.Enrollment,
+			Status:              importDraftStatus,
+		}
+		if err := createOmitAssociations(im.tx, &info, &info.ID); err != nil {
+			return 0, err
+		}
+
+		im.result.count(ImportEntityAdmissionInfo, true)
+	case info.Enrollment != row.Enrollment:
+		err := im.tx.Model(&models.AdmissionInfo{}).Where("id = ?", info.ID).Updates(map[string]interface{}{
+			"enrollment": row.Enrollment,
+			"version":    gorm.Expr("version + 1"),
+		}).Error
+		if err != nil {
+			return 0, err
+		}
+
+		im.result.count(ImportEntityAdmissionInfo, false)
+	}
+
+	im.ids[key] = info.ID
+
+	return info.ID, nil
+}
+
+// importDifficulty は入試情報の合格難易度を作成し、登録済みの値と異なる場合は更新します
+// 合格難易度の列が空の行では、登録済みの合格難易度を変更しません
+func (im *rowImporter) importDifficulty(infoID uint, row ImportRow) error {
+	difficulty := row.difficulty()
+	if difficulty == nil {
+		return nil
+	}
+
+	key := fmt.Sprint(ImportEntityAdmissionDifficulty, "\x00", infoID)
+	if _, ok := im.ids[key]; ok {
+		return nil
+	}
+
+	var existing models.AdmissionDifficulty
+	if err := im.tx.Where(admissionInfoIDQuery, infoID).Limit(1).Find(&existing).Error; err != nil {
+		return err
+	}
+
+	switch {
+	case existing.ID == 0:
+		difficulty.AdmissionInfoID = infoID
+		if err := createOmitAssociations(im.tx, difficulty, &difficulty.ID); err != nil {
+			return err
+		}
+
+		existing.ID = difficulty.ID
+
+		im.result.count(ImportEntityAdmissionDifficulty, true)
+	case !sameDifficulty(&existing, difficulty):
+		err := im.tx.Model(&models.AdmissionDifficulty{}).Where("id = ?", existing.ID).Updates(map[string]interface{}{
+			"border_score":     difficulty.BorderScore,
+			"common_test_rate": difficulty.CommonTestRate,
+			"deviation_low":    difficulty.DeviationLow,
+			"deviation_high":   difficulty.DeviationHigh,
+			"version":          gorm.Expr("version + 1"),
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		im.result.count(ImportEntityAdmissionDifficulty, false)
+	}
+
+	im.ids[key] = existing.ID
+
+	return nil
+}
+
+// importTestType は試験種別を検索・作成します
+// 年度の入試情報がある場合はその入試情報に紐付く試験種別を、ない場合は入試日程共通の試験種別を対象とします
+func (im *rowImporter) importTestType(group importGroup, name string) (uint, error) {
+	tx := im.tx
+
+	return im.findOrCreate(ImportEntityTestType, fmt.Sprint(group.scheduleID, "/", group.infoID, "/", name),
+		func() *gorm.DB {
+			query := tx.Model(&models.TestType{}).Where("admission_schedule_id = ? AND name = ?", group.scheduleID, name)
+			if group.infoID == 0 {
+				return query.Where(unlinkedTestTypeCondition)
+			}
+
+			return query.Where("deleted_at IS NULL AND id IN (?)", linkedTestTypeIDs(tx, group.infoID))
+		},
+		func() (uint, error) {
+			testType := models.TestType{
+				BaseModel:           models.BaseModel{Version: 1},
+				AdmissionScheduleID: group.scheduleID,
+				Name:                name,
+			}
+			if err := createOmitAssociations(tx, &testType, &testType.ID); err != nil {
+				return 0, err
+			}
+
+			if group.infoID != 0 {
+				info := models.AdmissionInfo{BaseModel: models.BaseModel{ID: group.infoID}}
+				if err := tx.Model(&info).Association("TestTypes").Append(&testType); err != nil {
+					return 0, err
+				}
+			}
+
+			return testType.ID, nil
+		})
+}
+
+// importSubject は科目を作成し、既存の科目は配点・表示順が異なる場合に更新します
+func (im *rowImporter) importSubject(testTypeID uint, row ImportRow) error {
+	var subject models.Subject
+
+	err := im.tx.Where("test_type_id = ? AND name = ? AND deleted_at IS NULL", testTypeID, row.Subject).
+		Limit(1).Find(&subject).Error
+	if err != nil {
+		return err
+	}
+
+	if subject.ID == 0 {
+		subject = models.Subject{
+			BaseModel:    models.BaseModel{Version: 1},
+			TestTypeID:   testTypeID,
+			Name:         row.Subject,
+			Score:        row.Score,
+			DisplayOrder: row.DisplayOrder,
+		}
+		if err := createOmitAssociations(im.tx, &subject, &subject.ID); err != nil {
+			return err
+		}
+
+		im.result.count(ImportEntitySubject, true)
+
+		return nil
+	}
+
+	if subject.Score == row.Score && subject.DisplayOrder == row.DisplayOrder {
+		return nil
+	}
+
+	err = im.tx.Model(&models.Subject{}).Where("id = ?", subject.ID).Updates(map[string]interface{}{
+		"score":         row.Score,
+		"display_order": row.DisplayOrder,
+		"version":       gorm.Expr("version + 1"),
+	}).Error
+	if err != nil {
+		return err
+	}
+
+	im.result.count(ImportEntitySubject, false)
+
+	return nil
+}
+
+// recalculatePercentages は取り込んだ試験種別のまとまりごとに科目の配点比率を再計算します
+// 配点比率は試験種別レジストリで分母に含める試験種別の換算後の合計点に対する各科目の換算後の配点の割合（小数点以下2桁）です
+// 選択科目群は選択科目群の換算後の配点で1回だけ合計点に数えます
+// 分母に含めない試験種別の科目の配点比率は0とします
+func (im *rowImporter) recalculatePercentages() error {
+	counted := models.TestTypes().CountedNames()
+
+	for _, group := range im.ordered {
+		groupSubjects := func() *gorm.DB {
+			query := im.tx.Model(&models.Subject{}).
+				Joins("JOIN test_types ON test_types.id = subjects.test_type_id").
+				Where("subjects.deleted_at IS NULL AND test_types.admission_schedule_id = ?", group.scheduleID)
+
+			if group.infoID == 0 {
+				return query.Where("test_types.deleted_at IS NULL AND test_types.id NOT IN " +
+					"(SELECT test_type_id FROM admission_info_test_types)")
+			}
+
+			return query.Where("test_types.deleted_at IS NULL AND test_types.id IN (?)",
+				linkedTestTypeIDs(im.tx, group.infoID))
+		}
+
+		var subjects []models.Subject
+
+		err := groupSubjects().Where("test_types.name IN ?", counted).
+			Select("subjects.id, subjects.subject_group_id, subjects.score, subjects.conversion_ratio, subjects.percentage").
+			Find(&subjects).Error
+		if err != nil {
+			return err
+		}
+
+		var groups []models.SubjectGroup
+		if ids := models.SubjectGroupIDs(subjects); len(ids) > 0 {
+			if err := im.tx.Where("id IN ?", ids).Find(&groups).Error; err != nil {
+				return err
+			}
+		}
+
+		total := models.SelectionTotal(subjects, groups)
+
+		for _, s := range subjects {
+			percentage := models.WeightedPercentage(s.EffectiveScore, total)
+			if percentage == s.Percentage {
+				continue
+			}
+
+			err := im.tx.Model(&models.Subject{}).Where("id = ?", s.ID).Update("percentage", percentage).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		var uncounted []uint
+		if err := groupSubjects().Where("test_types.name NOT IN ?", counted).
+			Where("subjects.percentage <> 0").Pluck("subjects.id", &uncounted).Error; err != nil {
+			return err
+		}
+
+		if len(uncounted) > 0 {
+			err := im.tx.Model(&models.Subject{}).Where("id IN ?", uncounted).Update("percentage", 0).Error
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// linkedTestTypeIDs は入試情報に紐付く試験種別IDのサブクエリを返します
+func linkedTestTypeIDs(tx *gorm.DB, infoID uint) *gorm.DB {
+	return tx.Table("admission_info_test_types").Select("test_type_id").Where("admission_info_id = ?", infoID)
+}
+
+// createOmitAssociations は関連を保存せずにエンティティを作成し、採番されたIDを返します
+func createOmitAssociations(tx *gorm.DB, value interface{}, id *uint) error {
+	if err := tx.Omit(clause.Associations).Create(value).Error; err != nil {
+		return err
+	}
+
+	if *id == 0 {
+		return fmt.Errorf("IDの採番に失敗しました")
+	}
+
+	return nil
+}
@@ -0,0 +1,257 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"university-exam-api/internal/domain/models"
+	"university-exam-api/internal/pkg/pagination"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// newImportRows はテスト用の取り込み行を生成します
+func newImportRows() []ImportRow {
+	return []ImportRow{
+		{Line: 2, University: "一橋大学", Department: "経済学部", Major: "経済学科", Schedule: "前",
+			TestType: "共通", Subject: "英語", Score: 200, DisplayOrder: 1, AcademicYear: 2025, Enrollment: 100},
+		{Line: 3, University: "一橋大学", Department: "経済学部", Major: "経済学科", Schedule: "前",
+			TestType: "二次", Subject: "数学", Score: 300, DisplayOrder: 2, AcademicYear: 2025, Enrollment: 100},
+		{Line: 4, University: "東京大学", Department: "工学部", Major: "機械工学科", Schedule: "前",
+			TestType: "二次", Subject: "物理", Score: 100, DisplayOrder: 1},
+	}
+}
+
+// countRows はテーブルの行数を返します
+func countRows(t *testing.T, db *gorm.DB, model interface{}) int64 {
+	t.Helper()
+
+	var count int64
+	require.NoError(t, db.Model(model).Count(&count).Error)
+
+	return count
+}
+
+func TestImportRows(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	setupFacetTestData(t, db)
+
+	repo := NewUniversityRepository(db)
+
+	result, err := repo.ImportRows(context.Background(), newImportRows(), false)
+	require.NoError(t, err)
+	require.Empty(t, result.Errors)
+	assert.True(t, result.Committed)
+	assert.Equal(t, 3, result.Rows)
+	assert.Equal(t, ImportCounts{Created: 1}, result.Summary[ImportEntityUniversity])
+	assert.Equal(t, ImportCounts{Created: 1}, result.Summary[ImportEntityAdmissionInfo])
+	assert.Equal(t, ImportCounts{Created: 3}, result.Summary[ImportEntityTestType])
+	assert.Equal(t, ImportCounts{Created: 3}, result.Summary[ImportEntitySubject])
+
+	var math models.Subject
+	require.NoError(t, db.Where("name = ?", "数学").First(&math).Error)
+	assert.Equal(t, 60.0, math.Percentage)
+
+	var linked int64
+	require.NoError(t, db.Table("admission_info_test_types").Count(&linked).Error)
+	assert.Equal(t, int64(2), linked)
+
+	t.Run("再取り込みは更新のみ", func(t *testing.T) {
+		rows := newImportRows()
+		rows[1].Score = 200
+
+		again, err := repo.ImportRows(context.Background(), rows, false)
+		require.NoError(t, err)
+		assert.Equal(t, ImportCounts{}, again.Summary[ImportEntityUniversity])
+		assert.Equal(t, ImportCounts{}, again.Summary[ImportEntityTestType])
+		assert.Equal(t, ImportCounts{Updated: 1}, again.Summary[ImportEntitySubject])
+
+		require.NoError(t, db.Where("name = ?", "数学").First(&math).Error)
+		assert.Equal(t, 200, math.Score)
+		assert.Equal(t, 50.0, math.Percentage)
+	})
+
+	t.Run("分母に含めない試験種別の科目は配点比率を0とする", func(t *testing.T) {
+		rows := newImportRows()
+		rows = append(rows, ImportRow{
+			Line: 5, University: "一橋大学", Department: "経済学部", Major: "経済学科", Schedule: "前",
+			TestType: "英語外部試験", Subject: "英検", Score: 100, DisplayOrder: 3, AcademicYear: 2025, Enrollment: 100,
+		})
+
+		again, err := repo.ImportRows(context.Background(), rows, false)
+		require.NoError(t, err)
+		require.Empty(t, again.Errors)
+
+		var eiken models.Subject
+		require.NoError(t, db.Where("name = ?", "英検").First(&eiken).Error)
+		assert.Equal(t, 0.0, eiken.Percentage)
+
+		require.NoError(t, db.Where("name = ?", "数学").First(&math).Error)
+		assert.Equal(t, 60.0, math.Percentage)
+	})
+
+	t.Run("取り込んだ大学を検索できる", func(t *testing.T) {
+		page, err := repo.SearchPage(context.Background(), "一橋",
+			newPaginationParams(10, pagination.SortRelevance, pagination.OrderAsc))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"一橋大学"}, universityNames(page.Universities))
+	})
+}
+
+func TestImportRowsPrivateSchedule(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	setupFacetTestData(t, db)
+
+	repo := NewUniversityRepository(db)
+
+	result, err := repo.ImportRows(context.Background(), []ImportRow{
+		{Line: 2, University: "早稲田大学", Department: "商学部", Major: "商学科", Schedule: "共通テスト利用（前期）",
+			TestType: "共通", Subject: "英語", Score: 200, DisplayOrder: 1},
+	}, false)
+	require.NoError(t, err)
+	require.Empty(t, result.Errors)
+	assert.True(t, result.Committed)
+
+	var schedule models.AdmissionSchedule
+	require.NoError(t, db.Where("name = ?", "共通テスト利用（前期）").First(&schedule).Error)
+	assert.Equal(t, 7, schedule.DisplayOrder, "日程レジストリの表示順で作成する")
+}
+
+func TestImportRowsDryRun(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	setupFacetTestData(t, db)
+
+	repo := NewUniversityRepository(db)
+	before := countRows(t, db, &models.Subject{})
+
+	result, err := repo.ImportRows(context.Background(), newImportRows(), true)
+	require.NoError(t, err)
+	assert.Empty(t, result.Errors)
+	assert.False(t, result.Committed)
+	assert.Equal(t, ImportCounts{Created: 3}, result.Summary[ImportEntitySubject])
+	assert.Equal(t, before, countRows(t, db, &models.Subject{}))
+	assert.Equal(t, int64(3), countRows(t, db, &models.University{}))
+}
+
+func TestImportRowsRowErrors(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	setupFacetTestData(t, db)
+
+	repo := NewUniversityRepository(db)
+
+	rows := newImportRows()
+	rows[0].Schedule = "追"
+	rows[1].Score = -1
+	rows = append(rows, ImportRow{
+		Line: 5, University: "東京大学", Department: "工学部", Major: "機械工学科", Schedule: "前",
+		TestType: "二次", Subject: "物理", Score: 100,
+	}, ImportRow{
+		Line: 6, University: "東京大学", Department: "", Major: "機械工学科", Schedule: "前",
+		TestType: "共通", Subject: "国語", Score: 100, AcademicYear: 2025,
+	})
+
+	result, err := repo.ImportRows(context.Background(), rows, false)
+	require.NoError(t, err)
+	assert.False(t, result.Committed)
+	assert.Equal(t, []ImportRowError{
+		{Line: 2, Field: ImportFieldSchedule, Message: fmt.Sprintf(errUnregisteredSchedule, "追")},
+		{Line: 3, Field: ImportFieldScore, Message: "配点は0から1000の範囲である必要があります"},
+		{Line: 5, Field: ImportFieldSubject, Message: "4行目と重複しています"},
+		{Line: 6, Field: ImportFieldDepartment, Message: "学部名は1文字以上である必要があります"},
+		{Line: 6, Field: ImportFieldEnrollment, Message: errImportEnrollment},
+	}, result.Errors)
+	assert.Equal(t, int64(3), countRows(t, db, &models.University{}))
+}
+
+func TestImportRowsDifficulty(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	setupFacetTestData(t, db)
+
+	repo := NewUniversityRepository(db)
+
+	value := func(v float64) *float64 { return &v }
+	withDifficulty := func(rate float64) []ImportRow {
+		rows := newImportRows()[:2]
+		for i := range rows {
+			rows[i].CommonTestRate = value(rate)
+			rows[i].DeviationLow = value(62.5)
+			rows[i].DeviationHigh = value(65)
+		}
+
+		return rows
+	}
+
+	result, err := repo.ImportRows(context.Background(), withDifficulty(80), false)
+	require.NoError(t, err)
+	require.Empty(t, result.Errors)
+	assert.Equal(t, ImportCounts{Created: 1}, result.Summary[ImportEntityAdmissionDifficulty])
+
+	var difficulty models.AdmissionDifficulty
+	require.NoError(t, db.First(&difficulty).Error)
+	assert.Equal(t, 80.0, *difficulty.CommonTestRate)
+	assert.Nil(t, difficulty.BorderScore)
+
+	t.Run("値が変わった場合のみ更新する", func(t *testing.T) {
+		again, err := repo.ImportRows(context.Background(), withDifficulty(80), false)
+		require.NoError(t, err)
+		assert.Equal(t, ImportCounts{}, again.Summary[ImportEntityAdmissionDifficulty])
+
+		again, err = repo.ImportRows(context.Background(), withDifficulty(82), false)
+		require.NoError(t, err)
+		assert.Equal(t, ImportCounts{Updated: 1}, again.Summary[ImportEntityAdmissionDifficulty])
+
+		require.NoError(t, db.First(&difficulty, difficulty.ID).Error)
+		assert.Equal(t, 82.0, *difficulty.CommonTestRate)
+		assert.Equal(t, 2, difficulty.Version)
+	})
+
+	t.Run("合格難易度の列が空の行は登録済みの値を変更しない", func(t *testing.T) {
+		again, err := repo.ImportRows(context.Background(), newImportRows(), false)
+		require.NoError(t, err)
+		assert.Equal(t, ImportCounts{}, again.Summary[ImportEntityAdmissionDifficulty])
+		assert.Equal(t, int64(1), countRows(t, db, &models.AdmissionDifficulty{}))
+	})
+
+	t.Run("不正な合格難易度の行はエラーとする", func(t *testing.T) {
+		rows := withDifficulty(80)
+		rows[1].CommonTestRate = value(75)
+		rows = append(rows, ImportRow{
+			Line: 5, University: "東京大学", Department: "工学部", Major: "機械工学科", Schedule: "前",
+			TestType: "二次", Subject: "物理", Score: 100, BorderScore: value(500),
+		}, ImportRow{
+			Line: 6, University: "東京大学", Department: "工学部", Major: "機械工学科", Schedule: "前",
+			TestType: "二次", Subject: "化学", Score: 100, AcademicYear: 2025, Enrollment: 10,
+			DeviationLow: value(70), DeviationHigh: value(65),
+		})
+
+		invalid, err := repo.ImportRows(context.Background(), rows, false)
+		require.NoError(t, err)
+		assert.False(t, invalid.Committed)
+		assert.Equal(t, []ImportRowError{
+			{Line: 3, Field: ImportFieldBorderScore, Message: fmt.Sprintf(errImportDifficultyConflict, 2)},
+			{Line: 5, Field: ImportFieldAcademicYear, Message: errImportDifficultyYear},
+			{Line: 6, Field: ImportFieldDeviationLow, Message: "偏差値の下限は上限以下である必要があります"},
+		}, invalid.Errors)
+	})
+}
+
+func TestImportRowsDatabaseErrorRollsBack(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	setupFacetTestData(t, db)
+
+	repo := NewUniversityRepository(db)
+	before := countRows(t, db, &models.University{})
+
+	rows := newImportRows()
+	// 大学名の制約（20バイト以下）に違反する行で全体が取り消される
+	rows[2].University = "とても長い名前の国立大学"
+
+	result, err := repo.ImportRows(context.Background(), rows, false)
+	require.NoError(t, err)
+	assert.False(t, result.Committed)
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, 4, result.Errors[0].Line)
+	assert.Equal(t, before, countRows(t, db, &models.University{}))
+}
@@ -0,0 +1,272 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// masterDataModel はマスタデータのモデル（BaseModelを埋め込む構造体のポインタ）の型制約です
+type masterDataModel[T any] interface {
+	versionedModel[T]
+	models.MasterData
+}
+
+// MasterDataRepository は地域・都道府県・設置区分・小分類・学問系統のマスタデータを管理するリポジトリインターフェースです。
+// このインターフェースは以下の機能を提供します：
+// - 所属先ごとの表示順での一覧取得
+// - 所属先の名称の取得
+// - 作成・楽観的ロックによる更新
+// - 所属先内での並び替え
+// - 配下の要素を含むソフトデリート
+type MasterDataRepository[T any] interface {
+	List(ctx context.Context, ownerID uint) ([]T, error)
+	Find(ctx context.Context, id uint) (*T, error)
+	OwnerName(ctx context.Context, ownerID uint) (string, error)
+	Create(ctx context.Context, item *T) error
+	Update(ctx context.Context, item *T) error
+	Reorder(ctx context.Context, ownerID uint, ids []uint) ([]T, error)
+	Delete(ctx context.Context, id uint) error
+}
+
+// masterDataRepository はMasterDataRepositoryの実装です。
+// テーブル・所属先・エラーメッセージの名称はソフトデリートの対象テーブルの定義から解決します
+type masterDataRepository[T any, PT masterDataModel[T]] struct {
+	db       *gorm.DB
+	def      softDeleteTable
+	parent   softDeleteTable
+	onChange func()
+}
+
+// NewMasterDataRepository は新しいMasterDataRepositoryを作成します。
+// モデルのテーブルがソフトデリートの対象テーブルとして定義されていない場合はpanicします
+func NewMasterDataRepository[T any, PT masterDataModel[T]](db *gorm.DB) MasterDataRepository[T] {
+	return NewMasterDataRepositoryWithOnChange[T, PT](db, nil)
+}
+
+// NewMasterDataRepositoryWithOnChange は変更時の通知先を指定して新しいMasterDataRepositoryを作成します。
+// onChangeは作成・更新・並び替え・削除に成功した後に呼び出されます（検索インデックスの無効化など）
+func NewMasterDataRepositoryWithOnChange[T any, PT masterDataModel[T]](
+	db *gorm.DB,
+	onChange func(),
+) MasterDataRepository[T] {
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(PT(new(T))); err != nil {
+		panic(err)
+	}
+
+	def, ok := softDeleteTableOf(stmt.Schema.Table)
+	if !ok {
+		panic(fmt.Sprintf("マスタデータの対象外のテーブルです: %s", stmt.Schema.Table))
+	}
+
+	parent, ok := softDeleteTableOf(def.parent)
+	if !ok {
+		panic(fmt.Sprintf("マスタデータの所属先が定義されていません: %s", def.table))
+	}
+
+	return &masterDataRepository[T, PT]{db: db, def: def, parent: parent, onChange: onChange}
+}
+
+// changed はマスタデータの変更を通知先に伝えます
+func (r *masterDataRepository[T, PT]) changed() {
+	if r.onChange != nil {
+		r.onChange()
+	}
+}
+
+// List は所属先のマスタデータを表示順に取得します。
+// 所属先に0を指定した場合は全てのマスタデータを取得します
+func (r *masterDataRepository[T, PT]) List(ctx context.Context, ownerID uint) ([]T, error) {
+	items := make([]T, 0)
+
+	query := r.db.WithContext(ctx)
+	if ownerID != 0 {
+		query = query.Where(r.def.foreignKey+" = ?", ownerID)
+	}
+
+	if err := query.Order(r.def.foreignKey + " ASC").Order(displayOrderASC).Order("id ASC").Find(&items).Error; err != nil {
+		return nil, appErrors.NewDatabaseError(r.def.label+"一覧取得処理", err, nil)
+	}
+
+	return items, nil
+}
+
+// Find は指定されたIDのマスタデータを取得します
+func (r *masterDataRepository[T, PT]) Find(ctx context.Context, id uint) (*T, error) {
+	item := PT(new(T))
+	if err := r.db.WithContext(ctx).First(item, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appErrors.NewNotFoundError(r.def.label, id, nil)
+		}
+
+		return nil, appErrors.NewDatabaseError(r.def.label+"取得処理", err, nil)
+	}
+
+	return (*T)(item), nil
+}
+
+// OwnerName は所属先の名称を取得します。
+// 所属先が存在しない場合や削除済みの場合はNotFoundエラーを返します
+func (r *masterDataRepository[T, PT]) OwnerName(ctx context.Context, ownerID uint) (string, error) {
+	var names []string
+
+	err := r.db.WithContext(ctx).Table(r.parent.table).
+		Where("id = ? AND deleted_at IS NULL", ownerID).
+		Limit(1).
+		Pluck("name", &names).Error
+	if err != nil {
+		return "", appErrors.NewDatabaseError(r.parent.label+"取得処理", err, nil)
+	}
+
+	if len(names) == 0 {
+		return "", appErrors.NewNotFoundError(r.parent.label, ownerID, nil)
+	}
+
+	return names[0], nil
+}
+
+// Create はマスタデータを作成します（配下の要素は作成しません）。
+// 表示順が指定されていない場合は所属先の末尾に追加します
+func (r *masterDataRepository[T, PT]) Create(ctx context.Context, item *T) error {
+	model := PT(item)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if model.ToFilterOption().DisplayOrder == 0 {
+			var last int
+			err := tx.Model(PT(new(T))).
+				Where(r.def.foreignKey+" = ?", model.OwnerID()).
+				Select("COALESCE(MAX(display_order), 0)").
+				Scan(&last).Error
+			if err != nil {
+				return appErrors.NewDatabaseError(r.def.label+"作成処理", err, nil)
+			}
+
+			model.SetDisplayOrder(last + 1)
+		}
+
+		if err := tx.Omit(clause.Associations).Create(model).Error; err != nil {
+			return appErrors.NewDatabaseError(r.def.label+"作成処理", err, nil)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	r.changed()
+
+	return nil
+}
+
+// Update はマスタデータを楽観的ロックにより更新します（配下の要素は更新しません）。
+// 更新に成功した場合、マスタデータのVersionは新しいバージョンになります
+func (r *masterDataRepository[T, PT]) Update(ctx context.Context, item *T) error {
+	err := updateWithVersion[T, PT](r.db.WithContext(ctx), PT(item), r.def.label, clause.Associations)
+	if err != nil {
+		var appErr *appErrors.Error
+		if errors.As(err, &appErr) {
+			return err
+		}
+
+		return appErrors.NewDatabaseError(r.def.label+"更新処理", err, nil)
+	}
+
+	r.changed()
+
+	return nil
+}
+
+// Reorder は所属先のマスタデータを指定されたIDの順に並び替えます。
+// この関数は以下の処理を行います：
+// - 指定されたIDが所属先のマスタデータと過不足なく一致することの検証
+// - 指定された順での表示順の更新（バージョンを1つ進める）
+// - 並び替え後のマスタデータの取得
+func (r *masterDataRepository[T, PT]) Reorder(ctx context.Context, ownerID uint, ids []uint) ([]T, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var current []uint
+		if err := tx.Model(PT(new(T))).Where(r.def.foreignKey+" = ?", ownerID).Pluck("id", &current).Error; err != nil {
+			return appErrors.NewDatabaseError(r.def.label+"並び替え処理", err, nil)
+		}
+
+		if !sameIDs(current, ids) {
+			return appErrors.NewValidationError("ids", r.parent.label+"に属する"+r.def.label+"の全てのIDを指定してください", nil)
+		}
+
+		for i, id := range ids {
+			err := tx.Model(PT(new(T))).
+				Where("id = ?", id).
+				UpdateColumns(map[string]interface{}{
+					"display_order": i + 1,
+					"version":       gorm.Expr("version + 1"),
+					"updated_at":    time.Now(),
+				}).Error
+			if err != nil {
+				return appErrors.NewDatabaseError(r.def.label+"並び替え処理", err, nil)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.changed()
+
+	return r.List(ctx, ownerID)
+}
+
+// Delete はマスタデータと配下の要素をソフトデリートします
+func (r *masterDataRepository[T, PT]) Delete(ctx context.Context, id uint) error {
+	deletedAt := time.Now().UTC().Truncate(time.Microsecond)
+
+	var deleted int64
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		deleted, err = softDeleteCascade(tx, r.def, []uint{id}, deletedAt)
+
+		return err
+	})
+	if err != nil {
+		return appErrors.NewDatabaseError(r.def.label+"削除処理", err, nil)
+	}
+
+	if deleted == 0 {
+		return appErrors.NewNotFoundError(r.def.label, id, nil)
+	}
+
+	r.changed()
+
+	return nil
+}
+
+// sameIDs は2つのIDの集合が重複なく一致するかを判定します
+func sameIDs(current, ids []uint) bool {
+	if len(current) != len(ids) {
+		return false
+	}
+
+	remaining := make(map[uint]bool, len(current))
+	for _, id := range current {
+		remaining[id] = true
+	}
+
+	for _, id := range ids {
+		if !remaining[id] {
+			return false
+		}
+
+		delete(remaining, id)
+	}
+
+	return true
+}
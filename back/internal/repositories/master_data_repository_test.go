@@ -0,0 +1,143 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/pkg/textsearch"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMasterDataRepository(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	setupFacetTestData(t, db)
+
+	regions := NewMasterDataRepository[models.Region](db)
+	prefectures := NewMasterDataRepository[models.Prefecture](db)
+	ctx := context.Background()
+
+	var tokyo models.University
+	require.NoError(t, db.Where("name = ?", "東京大学").First(&tokyo).Error)
+
+	existing, err := regions.List(ctx, tokyo.ID)
+	require.NoError(t, err)
+	require.Len(t, existing, 1)
+	kanto := existing[0]
+
+	t.Run("所属先の名称を取得できる", func(t *testing.T) {
+		name, err := regions.OwnerName(ctx, tokyo.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "東京大学", name)
+
+		name, err = prefectures.OwnerName(ctx, kanto.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "関東", name)
+
+		_, err = regions.OwnerName(ctx, 9999)
+		requireAppErrorCode(t, err, appErrors.CodeNotFound)
+	})
+
+	t.Run("表示順を指定しない場合は所属先の末尾に追加される", func(t *testing.T) {
+		created := &models.Region{UniversityID: tokyo.ID, Name: "近畿"}
+		require.NoError(t, regions.Create(ctx, created))
+		assert.NotZero(t, created.ID)
+		assert.Equal(t, 1, created.DisplayOrder)
+		assert.Equal(t, 1, created.Version)
+
+		items, err := regions.List(ctx, tokyo.ID)
+		require.NoError(t, err)
+		require.Len(t, items, 2)
+		assert.Equal(t, "関東", items[0].Name)
+		assert.Equal(t, "近畿", items[1].Name)
+	})
+
+	t.Run("バージョンが一致する場合のみ更新できる", func(t *testing.T) {
+		prefecture := &models.Prefecture{RegionID: kanto.ID, Name: "千葉"}
+		require.NoError(t, prefectures.Create(ctx, prefecture))
+
+		update := &models.Prefecture{
+			BaseModel:    models.BaseModel{ID: prefecture.ID, Version: prefecture.Version},
+			RegionID:     kanto.ID,
+			Name:         "埼玉",
+			DisplayOrder: prefecture.DisplayOrder,
+		}
+		require.NoError(t, prefectures.Update(ctx, update))
+		assert.Equal(t, prefecture.Version+1, update.Version)
+
+		found, err := prefectures.Find(ctx, prefecture.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "埼玉", found.Name)
+
+		stale := &models.Prefecture{
+			BaseModel: models.BaseModel{ID: prefecture.ID, Version: prefecture.Version},
+			RegionID:  kanto.ID,
+			Name:      "群馬",
+		}
+		requireAppErrorCode(t, prefectures.Update(ctx, stale), appErrors.CodeConflict)
+	})
+
+	t.Run("所属先の全てのIDの順に並び替えられる", func(t *testing.T) {
+		items, err := prefectures.List(ctx, kanto.ID)
+		require.NoError(t, err)
+		require.Len(t, items, 2)
+
+		_, err = prefectures.Reorder(ctx, kanto.ID, []uint{items[0].ID})
+		requireAppErrorCode(t, err, appErrors.CodeValidationError)
+
+		_, err = prefectures.Reorder(ctx, kanto.ID, []uint{items[0].ID, items[0].ID})
+		requireAppErrorCode(t, err, appErrors.CodeValidationError)
+
+		reordered, err := prefectures.Reorder(ctx, kanto.ID, []uint{items[1].ID, items[0].ID})
+		require.NoError(t, err)
+		require.Len(t, reordered, 2)
+		assert.Equal(t, items[1].ID, reordered[0].ID)
+		assert.Equal(t, 1, reordered[0].DisplayOrder)
+		assert.Equal(t, items[1].Version+1, reordered[0].Version)
+		assert.Equal(t, 2, reordered[1].DisplayOrder)
+	})
+
+	t.Run("削除すると配下の要素もソフトデリートされる", func(t *testing.T) {
+		require.NoError(t, regions.Delete(ctx, kanto.ID))
+
+		_, err := regions.Find(ctx, kanto.ID)
+		requireAppErrorCode(t, err, appErrors.CodeNotFound)
+
+		items, err := prefectures.List(ctx, kanto.ID)
+		require.NoError(t, err)
+		assert.Empty(t, items)
+
+		var trashed int64
+		require.NoError(t, db.Unscoped().Model(&models.Prefecture{}).
+			Where("region_id = ? AND deleted_at IS NOT NULL", kanto.ID).Count(&trashed).Error)
+		assert.Equal(t, int64(2), trashed)
+
+		requireAppErrorCode(t, regions.Delete(ctx, kanto.ID), appErrors.CodeNotFound)
+	})
+}
+
+func TestMasterDataRepositoryInvalidatesSuggestions(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	setupFacetTestData(t, db)
+
+	universities := NewUniversityRepository(db)
+	fields := NewMasterDataRepositoryWithOnChange[models.AcademicField](db, universities.InvalidateSearchIndex)
+	ctx := context.Background()
+	require.NoError(t, universities.WarmSearchIndex(ctx))
+
+	var field models.AcademicField
+	require.NoError(t, db.Where("name = ?", "工学").First(&field).Error)
+
+	field.Name = "情報学"
+	require.NoError(t, fields.Update(ctx, &field))
+
+	result, err := universities.Suggest(ctx, "工", []string{textsearch.SuggestTypeAcademicField}, 5)
+	require.NoError(t, err)
+	assert.Empty(t, result[textsearch.SuggestTypeAcademicField])
+
+	result, err = universities.Suggest(ctx, "情報", []string{textsearch.SuggestTypeAcademicField}, 5)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"情報学"}, suggestionTexts(result[textsearch.SuggestTypeAcademicField]))
+}
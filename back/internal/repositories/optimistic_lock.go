@@ -0,0 +1,78 @@
+package repositories
+
+import (
+	"errors"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+
+	"gorm.io/gorm"
+)
+
+// versionedModel は楽観的ロックの対象となるモデル（BaseModelを埋め込む構造体のポインタ）の型制約です
+type versionedModel[T any] interface {
+	*T
+	Base() *models.BaseModel
+}
+
+// updateWithVersion はモデルのバージョンが期待する値と一致する場合のみ更新します。
+// この関数は以下の処理を行います：
+// - 入れ子の入試情報の下書き化（draftNestedAdmissionInfos）
+// - モデルのVersionを期待するバージョンとした条件付き更新（作成日時・作成者と omit の列は更新しない）
+// - 対象が存在しない場合のNotFoundエラーの生成
+// - バージョンが一致しない場合の現在の状態を含む競合エラーの生成
+// 更新に成功した場合、モデルのVersionは新しいバージョンになります。
+func updateWithVersion[T any, PT versionedModel[T]](tx *gorm.DB, model PT, resource string, omit ...string) error {
+	draftNestedAdmissionInfos(model)
+
+	base := model.Base()
+	expected := base.Version
+
+	result := tx.Model(model).
+		Where("version = ?", expected).
+		Select("*").
+		Omit(append([]string{"created_at", "created_by"}, omit...)...).
+		Updates(model)
+	if result.Error != nil {
+		base.Version = expected
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		base.Version = expected
+		return versionConflict[T, PT](tx, base.ID, resource)
+	}
+
+	return nil
+}
+
+// incrementVersion はフィールドを個別に更新するモデルについて、
+// バージョンが期待する値と一致する場合のみバージョンを1つ進めます。
+// 更新対象が存在しない場合や、バージョンが一致しない場合は updateWithVersion と同じエラーを返します。
+func incrementVersion[T any, PT versionedModel[T]](tx *gorm.DB, id uint, expected int, resource string) error {
+	result := tx.Model(PT(new(T))).
+		Where("id = ? AND version = ?", id, expected).
+		UpdateColumn("version", gorm.Expr("version + 1"))
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return versionConflict[T, PT](tx, id, resource)
+	}
+
+	return nil
+}
+
+// versionConflict は条件付き更新で対象が更新されなかった理由に応じたエラーを返します
+func versionConflict[T any, PT versionedModel[T]](tx *gorm.DB, id uint, resource string) error {
+	current := PT(new(T))
+	if err := tx.Session(&gorm.Session{NewDB: true}).First(current, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return appErrors.NewNotFoundError(resource, id, nil)
+		}
+
+		return appErrors.NewDatabaseError(resource+"の取得", err, nil)
+	}
+
+	return appErrors.NewConflictError(resource, id, current.Base().Version, current)
+}
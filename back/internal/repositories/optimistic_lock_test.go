@@ -0,0 +1,84 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateWithVersion(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	repo := NewUniversityRepository(db)
+	ctx := context.Background()
+
+	university := models.University{
+		BaseModel:   models.BaseModel{Version: 1, CreatedBy: "alice"},
+		Name:        "テスト大学",
+		Departments: []models.Department{{BaseModel: models.BaseModel{Version: 1}, Name: "工学部"}},
+	}
+	require.NoError(t, db.Create(&university).Error)
+
+	t.Run("期待するバージョンと一致する場合は更新される", func(t *testing.T) {
+		update := models.University{BaseModel: models.BaseModel{ID: university.ID, Version: 1}, Name: "更新後大学"}
+		require.NoError(t, repo.Update(ctx, &update))
+		assert.Equal(t, 2, update.Version)
+
+		var stored models.University
+		require.NoError(t, db.First(&stored, university.ID).Error)
+		assert.Equal(t, "更新後大学", stored.Name)
+		assert.Equal(t, 2, stored.Version)
+		assert.Equal(t, "alice", stored.CreatedBy)
+		assert.False(t, stored.CreatedAt.IsZero())
+	})
+
+	t.Run("古いバージョンの場合は現在の状態を含む競合エラー", func(t *testing.T) {
+		stale := models.University{BaseModel: models.BaseModel{ID: university.ID, Version: 1}, Name: "古い編集"}
+		err := repo.Update(ctx, &stale)
+
+		var appErr *appErrors.Error
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.CodeConflict, appErr.Code)
+		assert.Equal(t, "2", appErr.Details.Extra["current_version"])
+		assert.Equal(t, 1, stale.Version)
+
+		current, ok := appErr.Current.(*models.University)
+		require.True(t, ok)
+		assert.Equal(t, "更新後大学", current.Name)
+
+		var stored models.University
+		require.NoError(t, db.First(&stored, university.ID).Error)
+		assert.Equal(t, "更新後大学", stored.Name)
+	})
+
+	t.Run("存在しない場合はNotFound", func(t *testing.T) {
+		missing := models.University{BaseModel: models.BaseModel{ID: 999, Version: 1}, Name: "未登録大学"}
+		err := repo.Update(ctx, &missing)
+
+		var appErr *appErrors.Error
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.CodeNotFound, appErr.Code)
+	})
+}
+
+func TestIncrementVersion(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+
+	department := models.Department{BaseModel: models.BaseModel{Version: 1}, Name: "工学部", UniversityID: 1}
+	require.NoError(t, db.Create(&department).Error)
+
+	require.NoError(t, incrementVersion[models.Department](db, department.ID, 1, "学部"))
+
+	var stored models.Department
+	require.NoError(t, db.First(&stored, department.ID).Error)
+	assert.Equal(t, 2, stored.Version)
+
+	err := incrementVersion[models.Department](db, department.ID, 1, "学部")
+
+	var appErr *appErrors.Error
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, appErrors.CodeConflict, appErr.Code)
+}
@@ -0,0 +1,95 @@
+package repositories
+
+import (
+	"context"
+	"strconv"
+	appErrors "university-exam-api/internal/errors"
+
+	"gorm.io/gorm"
+)
+
+// ResourcePath はURLで指定された大学→学部→学科→入試日程→入試情報・試験種別・日程の親子関係を表現する構造体です。
+// 0のIDは指定なしとして扱います
+type ResourcePath struct {
+	UniversityID    uint
+	DepartmentID    uint
+	MajorID         uint
+	ScheduleID      uint
+	AdmissionInfoID uint
+	TestTypeID      uint
+	ScheduleEventID uint
+}
+
+// OwnershipRepository はURLで指定された要素の親子関係を検証するリポジトリインターフェースです
+type OwnershipRepository interface {
+	VerifyPath(ctx context.Context, path ResourcePath) error
+}
+
+// ownershipRepository はOwnershipRepositoryの実装です
+type ownershipRepository struct {
+	db *gorm.DB
+}
+
+// NewOwnershipRepository は新しいOwnershipRepositoryを作成します
+func NewOwnershipRepository(db *gorm.DB) OwnershipRepository {
+	return &ownershipRepository{db: db}
+}
+
+// ownershipLevel は親子関係の1階層分の検証内容です
+// - table: 要素のテーブル名
+// - label: エラーメッセージに使用する名称
+// - parentColumn: 親を参照する外部キー（大学の場合は空）
+type ownershipLevel struct {
+	table        string
+	label        string
+	parentColumn string
+	id           uint
+	parentID     uint
+}
+
+// VerifyPath は指定された要素が存在し、URLで指定された親に属していることを検証します。
+// この関数は以下の処理を行います：
+// - 親から子の順での各階層の検証（削除済みの要素は存在しないものとして扱う）
+// - 最初に一致しなかった階層のNotFoundエラーの生成（親のIDを付与）
+func (r *ownershipRepository) VerifyPath(ctx context.Context, path ResourcePath) error {
+	levels := []ownershipLevel{
+		{table: "universities", label: "大学", id: path.UniversityID},
+		{table: "departments", label: "学部", parentColumn: "university_id", id: path.DepartmentID, parentID: path.UniversityID},
+		{table: "majors", label: "学科", parentColumn: "department_id", id: path.MajorID, parentID: path.DepartmentID},
+		{table: "admission_schedules", label: "入試日程", parentColumn: "major_id", id: path.ScheduleID, parentID: path.MajorID},
+		{table: "admission_infos", label: "入試情報", parentColumn: "admission_schedule_id",
+			id: path.AdmissionInfoID, parentID: path.ScheduleID},
+		{table: "test_types", label: "試験種別", parentColumn: "admission_schedule_id",
+			id: path.TestTypeID, parentID: path.ScheduleID},
+		{table: "schedule_events", label: "入試日程の日程", parentColumn: "admission_schedule_id",
+			id: path.ScheduleEventID, parentID: path.ScheduleID},
+	}
+
+	for _, level := range levels {
+		if level.id == 0 {
+			continue
+		}
+
+		query := r.db.WithContext(ctx).Table(level.table).
+			Where("id = ? AND deleted_at IS NULL", level.id)
+		if level.parentColumn != "" && level.parentID != 0 {
+			query = query.Where(level.parentColumn+" = ?", level.parentID)
+		}
+
+		var count int64
+		if err := query.Count(&count).Error; err != nil {
+			return appErrors.NewDatabaseError(level.label+"の親子関係の検証", err, nil)
+		}
+
+		if count == 0 {
+			var extra map[string]string
+			if level.parentColumn != "" && level.parentID != 0 {
+				extra = map[string]string{level.parentColumn: strconv.FormatUint(uint64(level.parentID), 10)}
+			}
+
+			return appErrors.NewNotFoundError(level.label, level.id, extra)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package repositories
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOwnershipVerifyPath(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	schedule := setupTestTypeTestData(t, db)
+
+	var universityID, departmentID uint
+	require.NoError(t, db.Table("majors").Select("department_id").Where("id = ?", schedule.MajorID).Scan(&departmentID).Error)
+	require.NoError(t, db.Table("departments").Select("university_id").Where("id = ?", departmentID).Scan(&universityID).Error)
+
+	testTypeID := schedule.TestTypes[0].ID
+	repo := NewOwnershipRepository(db)
+	ctx := context.Background()
+
+	t.Run("親子関係が一致する場合", func(t *testing.T) {
+		err := repo.VerifyPath(ctx, ResourcePath{
+			UniversityID: universityID,
+			DepartmentID: departmentID,
+			MajorID:      schedule.MajorID,
+			ScheduleID:   schedule.ID,
+			TestTypeID:   testTypeID,
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("別の大学の学部を指定した場合", func(t *testing.T) {
+		err := repo.VerifyPath(ctx, ResourcePath{UniversityID: universityID + 1, DepartmentID: departmentID})
+		requireAppErrorCode(t, err, appErrors.CodeNotFound)
+	})
+
+	t.Run("別の学部の学科を指定した場合は親のIDを付与する", func(t *testing.T) {
+		err := repo.VerifyPath(ctx, ResourcePath{
+			UniversityID: universityID,
+			DepartmentID: departmentID,
+			MajorID:      schedule.MajorID + 1,
+		})
+		requireAppErrorCode(t, err, appErrors.CodeNotFound)
+
+		var appErr *appErrors.Error
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, "学科", appErr.Details.Resource)
+		assert.Equal(t, map[string]string{"department_id": strconv.FormatUint(uint64(departmentID), 10)}, appErr.Details.Extra)
+	})
+
+	t.Run("別の入試日程の日程を指定した場合", func(t *testing.T) {
+		event := newTestScheduleEvent(schedule.ID, 2025, "exam", models.NewDate(2025, 2, 25))
+		require.NoError(t, db.Create(event).Error)
+
+		assert.NoError(t, repo.VerifyPath(ctx, ResourcePath{ScheduleID: schedule.ID, ScheduleEventID: event.ID}))
+
+		other := &models.AdmissionSchedule{BaseModel: models.BaseModel{Version: 1}, MajorID: schedule.MajorID, Name: "後"}
+		require.NoError(t, db.Create(other).Error)
+
+		err := repo.VerifyPath(ctx, ResourcePath{ScheduleID: other.ID, ScheduleEventID: event.ID})
+		appErr := requireAppErrorCode(t, err, appErrors.CodeNotFound)
+		assert.Equal(t, "入試日程の日程", appErr.Details.Resource)
+	})
+
+	t.Run("削除済みの入試日程を指定した場合", func(t *testing.T) {
+		require.NoError(t, db.Exec("UPDATE admission_schedules SET deleted_at = CURRENT_TIMESTAMP WHERE id = ?", schedule.ID).Error)
+
+		err := repo.VerifyPath(ctx, ResourcePath{MajorID: schedule.MajorID, ScheduleID: schedule.ID, TestTypeID: testTypeID})
+		requireAppErrorCode(t, err, appErrors.CodeNotFound)
+	})
+}
@@ -0,0 +1,402 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	applogger "university-exam-api/internal/logger"
+	"university-exam-api/internal/pkg/pagination"
+
+	"gorm.io/gorm"
+)
+
+// publishedInfoScope は入試情報を大学ごとの公開中のものに限定するJOIN句とWHERE句です
+const publishedInfoScope = "JOIN admission_schedules ON admission_schedules.id = admission_infos.admission_schedule_id " +
+	"JOIN majors ON majors.id = admission_schedules.major_id " +
+	"JOIN departments ON departments.id = majors.department_id " +
+	"WHERE departments.university_id = universities.id " +
+	"AND admission_infos.status = '" + models.AdmissionStatusPublished + "' " +
+	"AND admission_infos.deleted_at IS NULL AND admission_schedules.deleted_at IS NULL " +
+	"AND majors.deleted_at IS NULL AND departments.deleted_at IS NULL"
+
+// enrollmentSource は大学ごとの公開中の入試情報を集計するためのFROM句です
+const enrollmentSource = "FROM admission_infos " + publishedInfoScope
+
+// difficultySource は大学ごとの公開中の入試情報の合格難易度を集計するためのFROM句です
+const difficultySource = "FROM admission_difficulties " +
+	"JOIN admission_infos ON admission_infos.id = admission_difficulties.admission_info_id " +
+	publishedInfoScope + " AND admission_difficulties.deleted_at IS NULL"
+
+// latestDifficultySource は大学ごとに合格難易度を登録した最新年度の合格難易度に限定したFROM句です
+const latestDifficultySource = difficultySource +
+	" AND admission_infos.academic_year = (SELECT MAX(admission_infos.academic_year) " + difficultySource + ")"
+
+// enrollmentExpr は大学ごとの最新年度の募集人員合計を求める式です
+var enrollmentExpr = "(SELECT COALESCE(SUM(admission_infos.enrollment), 0) " + enrollmentSource +
+	" AND admission_infos.academic_year = (SELECT MAX(admission_infos.academic_year) " + enrollmentSource + "))"
+
+// difficultyExpr は合格難易度を登録した最新年度の、大学の学科ごとの値の最大値を求める式です
+// 合格難易度が未登録の大学は0として扱います
+func difficultyExpr(column string) string {
+	return "(SELECT COALESCE(MAX(admission_difficulties." + column + "), 0) " + latestDifficultySource + ")"
+}
+
+// difficultySortColumns は合格難易度のソートキーと集計する列の対応です
+// 偏差値は偏差値帯の上限で比較します
+var difficultySortColumns = map[string]string{
+	pagination.SortDeviation:      "deviation_high",
+	pagination.SortCommonTestRate: "common_test_rate",
+	pagination.SortBorderScore:    "border_score",
+}
+
+// sortExpressions はソートキーとSQL式の対応です
+// 関連度は検索語がない一覧では全件同順位となるため、大学名順として扱います
+var sortExpressions = map[string]string{
+	pagination.SortName:           "universities.name",
+	pagination.SortUpdatedAt:      "universities.updated_at",
+	pagination.SortEnrollment:     enrollmentExpr,
+	pagination.SortRelevance:      "universities.name",
+	pagination.SortDeviation:      difficultyExpr(difficultySortColumns[pagination.SortDeviation]),
+	pagination.SortCommonTestRate: difficultyExpr(difficultySortColumns[pagination.SortCommonTestRate]),
+	pagination.SortBorderScore:    difficultyExpr(difficultySortColumns[pagination.SortBorderScore]),
+}
+
+// UniversityPage はページ単位で取得した大学一覧を表現する構造体です
+type UniversityPage struct {
+	Universities []models.University `json:"universities"`
+	pagination.Page
+}
+
+// pageRow はページ範囲の決定に使用する大学の並び替えキーです
+// Difficulty は合格難易度のソートキーで並び替える場合の値です
+type pageRow struct {
+	ID         uint
+	Name       string
+	UpdatedAt  time.Time
+	Enrollment int64
+	Difficulty float64
+}
+
+// sortValue は並び替えキーの値をカーソル用の文字列に変換します
+func (r pageRow) sortValue(sort string) string {
+	switch sort {
+	case pagination.SortUpdatedAt:
+		return r.UpdatedAt.UTC().Format(time.RFC3339Nano)
+	case pagination.SortEnrollment:
+		return strconv.FormatInt(r.Enrollment, 10)
+	case pagination.SortDeviation, pagination.SortCommonTestRate, pagination.SortBorderScore:
+		return strconv.FormatFloat(r.Difficulty, 'g', -1, 64)
+	default:
+		return r.Name
+	}
+}
+
+// cursorValue はカーソルの文字列をソートキーに応じた型に変換します
+func cursorValue(cursor *pagination.Cursor) (interface{}, error) {
+	switch cursor.Sort {
+	case pagination.SortUpdatedAt:
+		return time.Parse(time.RFC3339Nano, cursor.Value)
+	case pagination.SortEnrollment:
+		return strconv.ParseInt(cursor.Value, 10, 64)
+	case pagination.SortDeviation, pagination.SortCommonTestRate, pagination.SortBorderScore:
+		return strconv.ParseFloat(cursor.Value, 64)
+	default:
+		return cursor.Value, nil
+	}
+}
+
+// keysetCondition はカーソル位置より後ろの行を取得する条件を生成します
+func keysetCondition(query *gorm.DB, expr string, cursor *pagination.Cursor, desc bool) (*gorm.DB, error) {
+	value, err := cursorValue(cursor)
+	if err != nil {
+		return nil, appErrors.NewInvalidInputError(pagination.ParamCursor, "カーソルの値が不正です", nil)
+	}
+
+	op := ">"
+	if desc {
+		op = "<"
+	}
+
+	return query.Where(
+		fmt.Sprintf("(%s %s ? OR (%s = ? AND universities.id %s ?))", expr, op, expr, op),
+		value, value, cursor.ID,
+	), nil
+}
+
+// reverseRows は並び替えキーの順序を反転します
+func reverseRows(rows []pageRow) {
+	for i, j := 0, len(rows)-1; i < j; i, j = i+1, j-1 {
+		rows[i], rows[j] = rows[j], rows[i]
+	}
+}
+
+// paginateUniversities は絞り込み済みの大学IDクエリをページ単位に分割します。
+// この関数は以下の処理を行います：
+// - 総件数の取得
+// - ソートキーとIDによる安定した並び替え
+// - オフセット方式またはカーソル方式による範囲の決定
+// - 前後ページのカーソル生成
+func paginateUniversities(
+	ctx context.Context,
+	db *gorm.DB,
+	filter *gorm.DB,
+	params pagination.Params,
+) ([]uint, pagination.Page, error) {
+	page := pagination.Page{Page: params.Page, PerPage: params.PerPage}
+
+	base := func() *gorm.DB {
+		query := db.WithContext(ctx).Model(&models.University{}).Where("universities.deleted_at IS NULL")
+		if filter != nil {
+			query = query.Where("universities.id IN (?)", filter)
+		}
+
+		return query
+	}
+
+	if err := base().Count(&page.Total).Error; err != nil {
+		return nil, page, appErrors.TranslateDBError(err)
+	}
+
+	expr, ok := sortExpressions[params.Sort]
+	if !ok {
+		return nil, page, appErrors.NewInvalidInputError(pagination.ParamSort, "ソートキーが不正です", nil)
+	}
+
+	backward := params.Cursor != nil && params.Cursor.Backward
+
+	// 前ページ方向の取得では並び順を反転して取得し、後で元に戻す
+	desc := params.Desc() != backward
+
+	direction := "ASC"
+	if desc {
+		direction = "DESC"
+	}
+
+	columns := "universities.id, universities.name, universities.updated_at, " + enrollmentExpr + " AS enrollment"
+	if _, ok := difficultySortColumns[params.Sort]; ok {
+		columns += ", " + expr + " AS difficulty"
+	}
+
+	query := base().
+		Select(columns).
+		Order(fmt.Sprintf("%s %s, universities.id %s", expr, direction, direction)).
+		Limit(params.PerPage + 1)
+
+	if params.Cursor != nil {
+		var err error
+
+		if query, err = keysetCondition(query, expr, params.Cursor, desc); err != nil {
+			return nil, page, err
+		}
+	} else {
+		query = query.Offset(params.Offset())
+	}
+
+	var rows []pageRow
+	if err := query.Scan(&rows).Error; err != nil {
+		return nil, page, appErrors.TranslateDBError(err)
+	}
+
+	hasMore := len(rows) > params.PerPage
+	if hasMore {
+		rows = rows[:params.PerPage]
+	}
+
+	if backward {
+		reverseRows(rows)
+	}
+
+	page.HasNext = hasMore || backward
+	page.HasPrev = params.Page > 1
+
+	ids := make([]uint, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.ID)
+	}
+
+	if len(rows) == 0 {
+		return ids, page, nil
+	}
+
+	if page.HasNext {
+		last := rows[len(rows)-1]
+		page.NextCursor = pagination.EncodeCursor(pagination.Cursor{
+			Sort:  params.Sort,
+			Order: params.Order,
+			Value: last.sortValue(params.Sort),
+			ID:    last.ID,
+			Page:  params.Page + 1,
+		})
+	}
+
+	if page.HasPrev {
+		first := rows[0]
+		page.PrevCursor = pagination.EncodeCursor(pagination.Cursor{
+			Sort:     params.Sort,
+			Order:    params.Order,
+			Value:    first.sortValue(params.Sort),
+			ID:       first.ID,
+			Backward: true,
+			Page:     params.Page - 1,
+		})
+	}
+
+	return ids, page, nil
+}
+
+// paginateHits は関連度順に並んだ大学IDをページ単位に分割します
+// 関連度順ではキーセットを使用できないため、カーソル指定時もページ番号によるオフセット方式で分割します
+func paginateHits(ids []uint, params pagination.Params) ([]uint, pagination.Page) {
+	page := pagination.Page{
+		Total:   int64(len(ids)),
+		Page:    params.Page,
+		PerPage: params.PerPage,
+	}
+
+	start := params.Offset()
+	if start > len(ids) {
+		start = len(ids)
+	}
+
+	end := start + params.PerPage
+	if end > len(ids) {
+		end = len(ids)
+	}
+
+	page.HasNext = end < len(ids)
+	page.HasPrev = params.Page > 1
+
+	if page.HasNext {
+		page.NextCursor = pagination.EncodeCursor(pagination.Cursor{
+			Sort:  params.Sort,
+			Order: params.Order,
+			Page:  params.Page + 1,
+		})
+	}
+
+	if page.HasPrev {
+		page.PrevCursor = pagination.EncodeCursor(pagination.Cursor{
+			Sort:     params.Sort,
+			Order:    params.Order,
+			Backward: true,
+			Page:     params.Page - 1,
+		})
+	}
+
+	return ids[start:end], page
+}
+
+// orderByIDs は取得した大学をページ範囲の順序に並べ替えます
+func orderByIDs(universities []models.University, ids []uint) []models.University {
+	byID := make(map[uint]models.University, len(universities))
+	for _, u := range universities {
+		byID[u.ID] = u
+	}
+
+	ordered := make([]models.University, 0, len(ids))
+
+	for _, id := range ids {
+		if u, ok := byID[id]; ok {
+			ordered = append(ordered, u)
+		}
+	}
+
+	return ordered
+}
+
+// findUniversityPage は絞り込み条件に一致する大学をページ単位でプリロード付きで取得します
+func (r *universityRepository) findUniversityPage(
+	ctx context.Context,
+	filter *gorm.DB,
+	params pagination.Params,
+) (*UniversityPage, error) {
+	ids, page, err := paginateUniversities(ctx, r.db, filter, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.loadUniversityPage(ctx, ids, page)
+}
+
+// loadUniversityPage はページ範囲の大学をプリロード付きで取得し、範囲の順序に並べます
+func (r *universityRepository) loadUniversityPage(
+	ctx context.Context,
+	ids []uint,
+	page pagination.Page,
+) (*UniversityPage, error) {
+	universities := make([]models.University, 0, len(ids))
+
+	if len(ids) > 0 {
+		err := r.applyPreloads(r.db.WithContext(ctx)).
+			Where("id IN ?", ids).
+			Find(&universities).Error
+		if err != nil {
+			return nil, appErrors.TranslateDBError(err)
+		}
+	}
+
+	return &UniversityPage{
+		Universities: orderByIDs(universities, ids),
+		Page:         page,
+	}, nil
+}
+
+// FindPage は大学一覧をページ単位で取得します。
+// この関数は以下の処理を行います：
+// - ページ範囲の決定
+// - プリロード付きでの取得
+// - ページ情報の付与
+func (r *universityRepository) FindPage(ctx context.Context, params pagination.Params) (*UniversityPage, error) {
+	result, err := r.findUniversityPage(ctx, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	applogger.Info(ctx, "大学一覧を取得しました（%d/%d件）", len(result.Universities), result.Total)
+
+	return result, nil
+}
+
+// SearchPage は大学を検索し、結果をページ単位で取得します。
+// この関数は以下の処理を行います：
+// - 検索クエリの検証
+// - 検索インデックスによる正規化・略称展開・関連度の算出
+// - 関連度順またはソートキー順でのページ単位での取得
+func (r *universityRepository) SearchPage(
+	ctx context.Context,
+	query string,
+	params pagination.Params,
+) (*UniversityPage, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, appErrors.NewInvalidInputError("query", errEmptyQuery, nil)
+	}
+
+	hits, err := r.SearchHits(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := hitIDs(hits)
+
+	var result *UniversityPage
+
+	if params.Sort == pagination.SortRelevance {
+		pageIDs, page := paginateHits(ids, params)
+		result, err = r.loadUniversityPage(ctx, pageIDs, page)
+	} else {
+		filter := r.db.WithContext(ctx).Model(&models.University{}).Select("id").Where("id IN ?", ids)
+		result, err = r.findUniversityPage(ctx, filter, params)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	applogger.Info(ctx, "大学を検索しました: query=%s（%d/%d件）", query, len(result.Universities), result.Total)
+
+	return result, nil
+}
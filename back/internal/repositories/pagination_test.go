@@ -0,0 +1,338 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+	"university-exam-api/internal/domain/models"
+	"university-exam-api/internal/pkg/pagination"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// newPaginationTestUniversity は募集人員付きのページネーションテスト用大学データを生成します
+func newPaginationTestUniversity(name string, enrollments map[int]int) *models.University {
+	infos := make([]models.AdmissionInfo, 0, len(enrollments))
+	for year, enrollment := range enrollments {
+		infos = append(infos, models.AdmissionInfo{
+			BaseModel:    models.BaseModel{Version: 1},
+			Enrollment:   enrollment,
+			AcademicYear: year,
+			Status:       "published",
+		})
+	}
+
+	return &models.University{
+		BaseModel: models.BaseModel{Version: 1},
+		Name:      name,
+		Departments: []models.Department{
+			{
+				Name: name + "学部",
+				Majors: []models.Major{
+					{
+						Name: name + "学科",
+						AdmissionSchedules: []models.AdmissionSchedule{
+							{Name: "前", AdmissionInfos: infos},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// setupPaginationTestData はページネーション用のテストデータを作成します
+// 最新年度の募集人員は E大学 > C大学 > A大学 > D大学 > B大学 の順になります
+func setupPaginationTestData(t *testing.T, db *gorm.DB) {
+	t.Helper()
+
+	universities := []*models.University{
+		newPaginationTestUniversity("C大学", map[int]int{2024: 10, 2025: 300}),
+		newPaginationTestUniversity("A大学", map[int]int{2025: 200}),
+		newPaginationTestUniversity("E大学", map[int]int{2025: 400}),
+		newPaginationTestUniversity("B大学", map[int]int{2024: 900, 2025: 50}),
+		newPaginationTestUniversity("D大学", map[int]int{2025: 100}),
+	}
+
+	base := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	for i, u := range universities {
+		require.NoError(t, db.Create(u).Error)
+		require.NoError(t, db.Model(&models.University{}).Where("id = ?", u.ID).
+			UpdateColumn("updated_at", base.Add(time.Duration(i)*time.Hour)).Error)
+	}
+}
+
+// newPaginationParams はテスト用のページネーション指定を生成します
+func newPaginationParams(perPage int, sort, order string) pagination.Params {
+	return pagination.Params{Page: 1, PerPage: perPage, Sort: sort, Order: order}
+}
+
+func TestFindPageOffset(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	setupPaginationTestData(t, db)
+
+	repo := NewUniversityRepository(db)
+
+	tests := []struct {
+		name      string
+		page      int
+		wantNames []string
+		wantNext  bool
+		wantPrev  bool
+	}{
+		{name: "1ページ目", page: 1, wantNames: []string{"A大学", "B大学"}, wantNext: true},
+		{name: "2ページ目", page: 2, wantNames: []string{"C大学", "D大学"}, wantNext: true, wantPrev: true},
+		{name: "最終ページ", page: 3, wantNames: []string{"E大学"}, wantPrev: true},
+		{name: "範囲外", page: 4, wantNames: []string{}, wantPrev: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := newPaginationParams(2, pagination.SortName, pagination.OrderAsc)
+			params.Page = tt.page
+
+			result, err := repo.FindPage(context.Background(), params)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantNames, universityNames(result.Universities))
+			assert.Equal(t, int64(5), result.Total)
+			assert.Equal(t, tt.page, result.Page.Page)
+			assert.Equal(t, 2, result.PerPage)
+			assert.Equal(t, tt.wantNext, result.HasNext)
+			assert.Equal(t, tt.wantPrev, result.HasPrev)
+		})
+	}
+}
+
+func TestFindPageSortKeys(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	setupPaginationTestData(t, db)
+
+	repo := NewUniversityRepository(db)
+
+	tests := []struct {
+		name      string
+		sort      string
+		order     string
+		wantNames []string
+	}{
+		{
+			name:      "名称の降順",
+			sort:      pagination.SortName,
+			order:     pagination.OrderDesc,
+			wantNames: []string{"E大学", "D大学", "C大学", "B大学", "A大学"},
+		},
+		{
+			name:      "更新日時の昇順",
+			sort:      pagination.SortUpdatedAt,
+			order:     pagination.OrderAsc,
+			wantNames: []string{"C大学", "A大学", "E大学", "B大学", "D大学"},
+		},
+		{
+			name:      "最新年度の募集人員の降順",
+			sort:      pagination.SortEnrollment,
+			order:     pagination.OrderDesc,
+			wantNames: []string{"E大学", "C大学", "A大学", "D大学", "B大学"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := repo.FindPage(context.Background(), newPaginationParams(10, tt.sort, tt.order))
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantNames, universityNames(result.Universities))
+		})
+	}
+}
+
+// addPaginationTestDifficulty は大学の指定年度の入試情報に合格難易度を登録します
+func addPaginationTestDifficulty(t *testing.T, db *gorm.DB, name string, year int, rate, low, high float64) {
+	t.Helper()
+
+	var info models.AdmissionInfo
+	require.NoError(t, db.Joins("JOIN admission_schedules ON admission_schedules.id = admission_infos.admission_schedule_id").
+		Joins("JOIN majors ON majors.id = admission_schedules.major_id").
+		Where("majors.name = ? AND admission_infos.academic_year = ?", name+"学科", year).
+		First(&info).Error)
+
+	require.NoError(t, db.Create(newTestAdmissionDifficulty(info.ID, rate, low, high)).Error)
+}
+
+// setupPaginationDifficultyData はページネーション用のテストデータに合格難易度を登録します
+// 合格難易度を登録した最新年度の偏差値帯の上限は B大学 > A大学 > D大学 > C大学 の順、
+// 共通テスト得点率は B大学 > D大学 > A大学 > C大学 の順になり、E大学は未登録です
+func setupPaginationDifficultyData(t *testing.T, db *gorm.DB) {
+	t.Helper()
+
+	setupPaginationTestData(t, db)
+
+	// C大学は2024年度の値が高いが、最新年度の値で比較する
+	addPaginationTestDifficulty(t, db, "C大学", 2024, 95, 67.5, 70)
+	addPaginationTestDifficulty(t, db, "C大学", 2025, 70, 52.5, 55)
+	addPaginationTestDifficulty(t, db, "A大学", 2025, 85, 62.5, 65)
+	// B大学は2025年度の合格難易度が未登録のため、2024年度の値で比較する
+	addPaginationTestDifficulty(t, db, "B大学", 2024, 90, 70, 72.5)
+	addPaginationTestDifficulty(t, db, "D大学", 2025, 88, 57.5, 60)
+}
+
+func TestFindPageDifficultySortKeys(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	setupPaginationDifficultyData(t, db)
+
+	repo := NewUniversityRepository(db)
+
+	tests := []struct {
+		name      string
+		sort      string
+		order     string
+		wantNames []string
+	}{
+		{
+			name:      "偏差値の降順",
+			sort:      pagination.SortDeviation,
+			order:     pagination.OrderDesc,
+			wantNames: []string{"B大学", "A大学", "D大学", "C大学", "E大学"},
+		},
+		{
+			name:      "共通テスト得点率の降順",
+			sort:      pagination.SortCommonTestRate,
+			order:     pagination.OrderDesc,
+			wantNames: []string{"B大学", "D大学", "A大学", "C大学", "E大学"},
+		},
+		{
+			name:      "未登録の大学は0として昇順の先頭に並ぶ",
+			sort:      pagination.SortDeviation,
+			order:     pagination.OrderAsc,
+			wantNames: []string{"E大学", "C大学", "D大学", "A大学", "B大学"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := repo.FindPage(context.Background(), newPaginationParams(10, tt.sort, tt.order))
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantNames, universityNames(result.Universities))
+
+			// 小数を含むカーソルで次ページを取得できる
+			params := newPaginationParams(2, tt.sort, tt.order)
+			first, err := repo.FindPage(context.Background(), params)
+			require.NoError(t, err)
+
+			params.Cursor, err = pagination.DecodeCursor(first.NextCursor)
+			require.NoError(t, err)
+			params.Page = params.Cursor.Page
+
+			next, err := repo.FindPage(context.Background(), params)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantNames[2:4], universityNames(next.Universities))
+		})
+	}
+}
+
+func TestFindPageCursor(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	setupPaginationTestData(t, db)
+
+	repo := NewUniversityRepository(db)
+
+	for _, sort := range pagination.SortKeys {
+		for _, order := range []string{pagination.OrderAsc, pagination.OrderDesc} {
+			t.Run(sort+"_"+order, func(t *testing.T) {
+				all, err := repo.FindPage(context.Background(), newPaginationParams(10, sort, order))
+				require.NoError(t, err)
+
+				// 次ページのカーソルを辿ると全件を重複なく取得できる
+				params := newPaginationParams(2, sort, order)
+
+				var (
+					names []string
+					pages []*UniversityPage
+				)
+
+				for {
+					result, err := repo.FindPage(context.Background(), params)
+					require.NoError(t, err)
+
+					names = append(names, universityNames(result.Universities)...)
+					pages = append(pages, result)
+
+					if !result.HasNext {
+						break
+					}
+
+					params.Cursor, err = pagination.DecodeCursor(result.NextCursor)
+					require.NoError(t, err)
+					params.Page = params.Cursor.Page
+				}
+
+				assert.Equal(t, universityNames(all.Universities), names)
+				require.Len(t, pages, 3)
+
+				// 前ページのカーソルで直前のページに戻れる
+				prev, err := pagination.DecodeCursor(pages[2].PrevCursor)
+				require.NoError(t, err)
+
+				result, err := repo.FindPage(context.Background(), pagination.Params{
+					Page: prev.Page, PerPage: 2, Sort: sort, Order: order, Cursor: prev,
+				})
+				require.NoError(t, err)
+				assert.Equal(t, universityNames(pages[1].Universities), universityNames(result.Universities))
+				assert.Equal(t, 2, result.Page.Page)
+				assert.True(t, result.HasNext)
+				assert.True(t, result.HasPrev)
+			})
+		}
+	}
+}
+
+func TestFindPageInvalidCursorValue(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+
+	repo := NewUniversityRepository(db)
+
+	_, err := repo.FindPage(context.Background(), pagination.Params{
+		Page:    2,
+		PerPage: 2,
+		Sort:    pagination.SortEnrollment,
+		Order:   pagination.OrderAsc,
+		Cursor:  &pagination.Cursor{Sort: pagination.SortEnrollment, Order: pagination.OrderAsc, Value: "abc", Page: 2},
+	})
+	assert.Error(t, err)
+}
+
+func TestSearchPage(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	setupPaginationTestData(t, db)
+
+	repo := NewUniversityRepository(db)
+
+	t.Run("学科名で検索", func(t *testing.T) {
+		result, err := repo.SearchPage(
+			context.Background(),
+			"D大学学科",
+			newPaginationParams(10, pagination.SortName, pagination.OrderAsc),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"D大学"}, universityNames(result.Universities))
+		assert.Equal(t, int64(1), result.Total)
+	})
+
+	t.Run("検索結果のページ分割", func(t *testing.T) {
+		result, err := repo.SearchPage(
+			context.Background(),
+			"大学",
+			newPaginationParams(3, pagination.SortName, pagination.OrderAsc),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"A大学", "B大学", "C大学"}, universityNames(result.Universities))
+		assert.Equal(t, int64(5), result.Total)
+		assert.True(t, result.HasNext)
+	})
+
+	t.Run("空のクエリ", func(t *testing.T) {
+		_, err := repo.SearchPage(context.Background(), " ", pagination.DefaultParams())
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,151 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/infrastructure/audit"
+	"university-exam-api/internal/pkg/preview"
+
+	"gorm.io/gorm"
+)
+
+// IAdmissionPublisher は入試情報の公開ワークフローに関するインターフェースを定義します。
+// このインターフェースは以下の機能を提供します：
+// - 公開前の確認に必要な入試日程・試験種別・科目を含む入試情報の取得
+// - 楽観的ロックによるステータスの変更
+type IAdmissionPublisher interface {
+	FindAdmissionInfoForPublication(ctx context.Context, id uint) (*models.AdmissionInfo, error)
+	UpdateAdmissionInfoStatus(ctx context.Context, info *models.AdmissionInfo, status string) error
+}
+
+// visibleAdmissionInfos はプレビューモードでない場合に、公開中の入試情報のみに絞り込みます
+// column には入試情報のステータス列（例: admission_infos.status）を指定します
+func visibleAdmissionInfos(ctx context.Context, db *gorm.DB, column string) *gorm.DB {
+	if preview.IncludeDrafts(ctx) {
+		return db
+	}
+
+	return db.Where(column+" = ?", models.AdmissionStatusPublished)
+}
+
+// draftNestedAdmissionInfos は大学・学部・学科・入試日程とともに保存する入試情報を下書きにします
+// 入れ子で送信された入試情報が公開前の確認を経ずに公開されることを防ぎます
+// 登録済みの入試情報は関連として保存しても更新されないため、ステータスは変更されません
+// 合格難易度は検証を経て専用のエンドポイントで登録するため、入れ子では保存しません
+func draftNestedAdmissionInfos(value interface{}) {
+	switch v := value.(type) {
+	case *models.University:
+		for i := range v.Departments {
+			draftNestedAdmissionInfos(&v.Departments[i])
+		}
+	case *models.Department:
+		for i := range v.Majors {
+			draftNestedAdmissionInfos(&v.Majors[i])
+		}
+	case *models.Major:
+		for i := range v.AdmissionSchedules {
+			draftNestedAdmissionInfos(&v.AdmissionSchedules[i])
+		}
+	case *models.AdmissionSchedule:
+		for i := range v.AdmissionInfos {
+			v.AdmissionInfos[i].Status = models.AdmissionStatusDraft
+			v.AdmissionInfos[i].Difficulty = nil
+		}
+	}
+}
+
+// createWithNested は大学・学部・学科・入試日程を入れ子の要素とともに作成します
+// 入れ子の入試情報は updateWithVersion による更新と同じく下書きとして保存します
+func createWithNested(tx *gorm.DB, value interface{}) error {
+	draftNestedAdmissionInfos(value)
+
+	return tx.Create(value).Error
+}
+
+// FindAdmissionInfoForPublication は公開前の確認に必要な関連データを含む入試情報を取得します。
+// この関数は以下の処理を行います：
+// - 入試情報の取得
+// - 入試日程・試験種別・科目の読み込み（削除済みを除く）
+// - キャッシュのクリアに使用する所属大学までの読み込み
+func (r *universityRepository) FindAdmissionInfoForPublication(
+	ctx context.Context,
+	id uint,
+) (*models.AdmissionInfo, error) {
+	subjectOrder := func(db *gorm.DB) *gorm.DB {
+		return db.Where(notDeletedCondition).Order(displayOrderASC)
+	}
+
+	var info models.AdmissionInfo
+
+	err := r.db.WithContext(ctx).
+		Where(notDeletedCondition).
+		Preload("TestTypes", notDeletedCondition).
+		Preload("TestTypes.Subjects", subjectOrder).
+		Preload("AdmissionSchedule", notDeletedCondition).
+		Preload("AdmissionSchedule.TestTypes", notDeletedCondition).
+		Preload("AdmissionSchedule.TestTypes.Subjects", subjectOrder).
+		Preload("AdmissionSchedule.Major.Department").
+		First(&info, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appErrors.NewNotFoundError("入試情報", id, nil)
+		}
+
+		return nil, appErrors.NewDatabaseError("入試情報取得処理", err, nil)
+	}
+
+	return &info, nil
+}
+
+// UpdateAdmissionInfoStatus は入試情報のステータスを変更します。
+// この関数は以下の処理を行います：
+// - 取得時のバージョンを期待するバージョンとした条件付き更新
+// - 更新者とバージョンの更新
+// - 所属大学のキャッシュのクリア
+func (r *universityRepository) UpdateAdmissionInfoStatus(
+	ctx context.Context,
+	info *models.AdmissionInfo,
+	status string,
+) error {
+	updates := map[string]interface{}{
+		"status":  status,
+		"version": gorm.Expr("version + 1"),
+	}
+
+	actor := audit.ActorFromContext(ctx)
+	if actor != "" {
+		updates["updated_by"] = actor
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.AdmissionInfo{}).
+			Where("id = ? AND version = ?", info.ID, info.Version).
+			Updates(updates)
+		if result.Error != nil {
+			return appErrors.NewDatabaseError("入試情報ステータス更新処理", result.Error, nil)
+		}
+
+		if result.RowsAffected == 0 {
+			return versionConflict[models.AdmissionInfo](tx, info.ID, "入試情報")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	info.Status = status
+	info.Version++
+
+	if actor != "" {
+		info.UpdatedBy = actor
+	}
+
+	r.cache.ClearAllRelatedCache(info.AdmissionSchedule.Major.Department.UniversityID)
+	r.searchIndex.invalidate()
+
+	return nil
+}
@@ -0,0 +1,218 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/pkg/preview"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// setupDraftAdmissionInfo は年度別データの前期日程に2026年度の下書きの入試情報を追加します
+func setupDraftAdmissionInfo(t *testing.T, db *gorm.DB, path MajorPath) models.AdmissionInfo {
+	t.Helper()
+
+	var schedule models.AdmissionSchedule
+	require.NoError(t, db.Where("major_id = ? AND name = ?", path.MajorID, "前").Take(&schedule).Error)
+
+	info := models.AdmissionInfo{
+		BaseModel:           models.BaseModel{Version: 1},
+		AdmissionScheduleID: schedule.ID,
+		Enrollment:          80,
+		AcademicYear:        2026,
+		Status:              models.AdmissionStatusDraft,
+	}
+	require.NoError(t, db.Create(&info).Error)
+
+	return info
+}
+
+func TestFindAdmissionInfoForPublication(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	path := setupAcademicYearTestData(t, db)
+	draft := setupDraftAdmissionInfo(t, db, path)
+
+	repo := NewUniversityRepository(db)
+
+	t.Run("入試日程・試験種別・科目を含めて取得", func(t *testing.T) {
+		info, err := repo.FindAdmissionInfoForPublication(context.Background(), draft.ID)
+		require.NoError(t, err)
+
+		assert.Equal(t, "前", info.AdmissionSchedule.Name)
+		assert.Equal(t, path.DepartmentID, info.AdmissionSchedule.Major.DepartmentID)
+		assert.Equal(t, path.UniversityID, info.AdmissionSchedule.Major.Department.UniversityID)
+		require.NotEmpty(t, info.AdmissionSchedule.TestTypes)
+		assert.NotEmpty(t, info.AdmissionSchedule.TestTypes[0].Subjects)
+		assert.Empty(t, info.TestTypes)
+	})
+
+	t.Run("存在しない場合はNotFound", func(t *testing.T) {
+		_, err := repo.FindAdmissionInfoForPublication(context.Background(), 999)
+
+		var appErr *appErrors.Error
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.CodeNotFound, appErr.Code)
+	})
+}
+
+func TestUpdateAdmissionInfoStatus(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	path := setupAcademicYearTestData(t, db)
+	draft := setupDraftAdmissionInfo(t, db, path)
+
+	repo := NewUniversityRepository(db)
+	ctx := context.Background()
+
+	t.Run("ステータスを変更してバージョンを進める", func(t *testing.T) {
+		info, err := repo.FindAdmissionInfoForPublication(ctx, draft.ID)
+		require.NoError(t, err)
+
+		require.NoError(t, repo.UpdateAdmissionInfoStatus(ctx, info, models.AdmissionStatusPublished))
+		assert.Equal(t, models.AdmissionStatusPublished, info.Status)
+		assert.Equal(t, 2, info.Version)
+
+		var stored models.AdmissionInfo
+		require.NoError(t, db.First(&stored, draft.ID).Error)
+		assert.Equal(t, models.AdmissionStatusPublished, stored.Status)
+		assert.Equal(t, 2, stored.Version)
+	})
+
+	t.Run("古いバージョンの場合は競合エラー", func(t *testing.T) {
+		stale := draft
+		err := repo.UpdateAdmissionInfoStatus(ctx, &stale, models.AdmissionStatusArchived)
+
+		var appErr *appErrors.Error
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.CodeConflict, appErr.Code)
+		assert.Equal(t, "2", appErr.Details.Extra["current_version"])
+		assert.Equal(t, models.AdmissionStatusDraft, stale.Status)
+	})
+
+	t.Run("通常の更新ではステータスを変更しない", func(t *testing.T) {
+		update := draft
+		update.Version = 2
+		update.Enrollment = 70
+		update.Status = models.AdmissionStatusArchived
+		require.NoError(t, repo.UpdateAdmissionInfo(ctx, &update))
+		assert.Equal(t, models.AdmissionStatusPublished, update.Status)
+
+		var stored models.AdmissionInfo
+		require.NoError(t, db.First(&stored, draft.ID).Error)
+		assert.Equal(t, 70, stored.Enrollment)
+		assert.Equal(t, models.AdmissionStatusPublished, stored.Status)
+	})
+}
+
+func TestCreateNestedAdmissionInfosAsDraft(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+
+	repo := NewUniversityRepository(db)
+	ctx := context.Background()
+
+	publishedInfo := func(year int) []models.AdmissionInfo {
+		return []models.AdmissionInfo{
+			{BaseModel: models.BaseModel{Version: 1}, Enrollment: 100, AcademicYear: year, Status: models.AdmissionStatusPublished},
+		}
+	}
+
+	university := &models.University{
+		BaseModel: models.BaseModel{Version: 1},
+		Name:      "一橋大学",
+		Departments: []models.Department{
+			{
+				BaseModel: models.BaseModel{Version: 1},
+				Name:      "経済学部",
+				Majors: []models.Major{
+					{
+						BaseModel: models.BaseModel{Version: 1},
+						Name:      "経済学科",
+						AdmissionSchedules: []models.AdmissionSchedule{
+							{
+								BaseModel:      models.BaseModel{Version: 1},
+								Name:           "前",
+								DisplayOrder:   1,
+								AdmissionInfos: publishedInfo(2025),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("大学とともに作成した入試情報は下書きとする", func(t *testing.T) {
+		require.NoError(t, repo.Create(ctx, university))
+
+		info := university.Departments[0].Majors[0].AdmissionSchedules[0].AdmissionInfos[0]
+		assert.Equal(t, models.AdmissionStatusDraft, info.Status)
+
+		var stored models.AdmissionInfo
+		require.NoError(t, db.First(&stored, info.ID).Error)
+		assert.Equal(t, models.AdmissionStatusDraft, stored.Status)
+	})
+
+	t.Run("入試日程とともに作成した入試情報は下書きとする", func(t *testing.T) {
+		schedule := &models.AdmissionSchedule{
+			BaseModel:      models.BaseModel{Version: 1},
+			MajorID:        university.Departments[0].Majors[0].ID,
+			Name:           "後",
+			DisplayOrder:   2,
+			AdmissionInfos: publishedInfo(2026),
+		}
+		require.NoError(t, repo.CreateAdmissionSchedule(ctx, schedule))
+
+		var stored models.AdmissionInfo
+		require.NoError(t, db.First(&stored, schedule.AdmissionInfos[0].ID).Error)
+		assert.Equal(t, models.AdmissionStatusDraft, stored.Status)
+	})
+
+	t.Run("入試日程の更新で追加した入試情報は下書きとする", func(t *testing.T) {
+		var schedule models.AdmissionSchedule
+		require.NoError(t, db.Where("name = ?", "後").First(&schedule).Error)
+
+		schedule.AdmissionInfos = publishedInfo(2027)
+		schedule.AdmissionInfos[0].Difficulty = &models.AdmissionDifficulty{}
+		require.NoError(t, repo.UpdateAdmissionSchedule(ctx, &schedule))
+
+		var stored models.AdmissionInfo
+		require.NoError(t, db.Where("academic_year = ?", 2027).First(&stored).Error)
+		assert.Equal(t, models.AdmissionStatusDraft, stored.Status)
+		assert.Nil(t, schedule.AdmissionInfos[0].Difficulty)
+	})
+}
+
+func TestVisibleAdmissionInfos(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	path := setupAcademicYearTestData(t, db)
+	setupDraftAdmissionInfo(t, db, path)
+
+	repo := NewAcademicYearRepository(db)
+
+	t.Run("公開中の年度のみ取得", func(t *testing.T) {
+		years, err := repo.FindYearsByMajor(context.Background(), path)
+		require.NoError(t, err)
+		assert.Equal(t, []int{2025, 2024}, years)
+
+		_, err = repo.FindMajorByYear(context.Background(), path, 2026)
+
+		var appErr *appErrors.Error
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.CodeNotFound, appErr.Code)
+	})
+
+	t.Run("プレビュー時は下書きの年度も取得", func(t *testing.T) {
+		ctx := preview.WithDrafts(context.Background())
+
+		years, err := repo.FindYearsByMajor(ctx, path)
+		require.NoError(t, err)
+		assert.Equal(t, []int{2026, 2025, 2024}, years)
+
+		result, err := repo.FindMajorByYear(ctx, path, 2026)
+		require.NoError(t, err)
+		require.Len(t, result.Schedules, 1)
+	})
+}
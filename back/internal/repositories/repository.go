@@ -12,13 +12,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"math"
 	"strings"
 	"time"
 	"university-exam-api/internal/domain/models"
 	appErrors "university-exam-api/internal/errors"
 	"university-exam-api/internal/infrastructure/cache"
 	applogger "university-exam-api/internal/logger"
+	"university-exam-api/internal/pkg/pagination"
+	"university-exam-api/internal/pkg/textsearch"
 
 	"github.com/microcosm-cc/bluemonday"
 	"gorm.io/gorm"
@@ -46,8 +47,30 @@ const (
 // - 検索クエリによる大学の取得
 type IUniversityFinder interface {
 	FindAll(ctx context.Context) ([]models.University, error)
-	FindByID(id uint) (*models.University, error)
-	Search(query string) ([]models.University, error)
+	FindByID(ctx context.Context, id uint) (*models.University, error)
+	Search(ctx context.Context, query string) ([]models.University, error)
+}
+
+// IUniversityPager は大学一覧のページ単位での取得に関するインターフェースを定義します。
+// このインターフェースは以下の機能を提供します：
+// - 大学一覧のページ取得
+// - 検索結果のページ取得
+type IUniversityPager interface {
+	FindPage(ctx context.Context, params pagination.Params) (*UniversityPage, error)
+	SearchPage(ctx context.Context, query string, params pagination.Params) (*UniversityPage, error)
+}
+
+// IUniversitySuggester は大学検索の入力補完に関するインターフェースを定義します。
+// このインターフェースは以下の機能を提供します：
+// - 種別ごとの入力補完候補の取得
+// - 検索インデックスによる関連度順の大学の検索
+// - 検索・入力補完インデックスの事前構築
+// - 検索・入力補完インデックスの無効化
+type IUniversitySuggester interface {
+	Suggest(ctx context.Context, prefix string, types []string, limit int) (textsearch.Suggestions, error)
+	SearchHits(ctx context.Context, query string) ([]textsearch.Hit, error)
+	WarmSearchIndex(ctx context.Context) error
+	InvalidateSearchIndex()
 }
 
 // IUniversityManager は大学の管理に関するインターフェースを定義します。
@@ -56,9 +79,9 @@ type IUniversityFinder interface {
 // - 大学の更新
 // - 大学の削除
 type IUniversityManager interface {
-	Create(university *models.University) error
-	Update(university *models.University) error
-	Delete(id uint) error
+	Create(ctx context.Context, university *models.University) error
+	Update(ctx context.Context, university *models.University) error
+	Delete(ctx context.Context, id uint) error
 }
 
 // IDepartmentManager は学部の管理に関するインターフェースを定義します。
@@ -67,9 +90,9 @@ type IUniversityManager interface {
 // - 学部の更新
 // - 学部の削除
 type IDepartmentManager interface {
-	CreateDepartment(department *models.Department) error
-	UpdateDepartment(department *models.Department) error
-	DeleteDepartment(id uint) error
+	CreateDepartment(ctx context.Context, department *models.Department) error
+	UpdateDepartment(ctx context.Context, department *models.Department) error
+	DeleteDepartment(ctx context.Context, id uint) error
 }
 
 // ISubjectManager は科目の管理に関するインターフェースを定義します。
@@ -78,9 +101,9 @@ type IDepartmentManager interface {
 // - 科目の更新
 // - 科目の削除
 type ISubjectManager interface {
-	CreateSubject(subject *models.Subject) error
-	UpdateSubject(subject *models.Subject) error
-	DeleteSubject(id uint) error
+	CreateSubject(ctx context.Context, subject *models.Subject) error
+	UpdateSubject(ctx context.Context, subject *models.Subject) error
+	DeleteSubject(ctx context.Context, id uint) error
 }
 
 // IMajorManager は学科の管理に関するインターフェースを定義します。
@@ -89,9 +112,9 @@ type ISubjectManager interface {
 // - 学科の更新
 // - 学科の削除
 type IMajorManager interface {
-	CreateMajor(major *models.Major) error
-	UpdateMajor(major *models.Major) error
-	DeleteMajor(id uint) error
+	CreateMajor(ctx context.Context, major *models.Major) error
+	UpdateMajor(ctx context.Context, major *models.Major) error
+	DeleteMajor(ctx context.Context, id uint) error
 }
 
 // IAdmissionInfoManager は入試情報の管理に関するインターフェースを定義します。
@@ -100,9 +123,9 @@ type IMajorManager interface {
 // - 入試情報の更新
 // - 入試情報の削除
 type IAdmissionInfoManager interface {
-	CreateAdmissionInfo(info *models.AdmissionInfo) error
-	DeleteAdmissionInfo(id uint) error
-	UpdateAdmissionInfo(info *models.AdmissionInfo) error
+	CreateAdmissionInfo(ctx context.Context, info *models.AdmissionInfo) error
+	DeleteAdmissionInfo(ctx context.Context, id uint) error
+	UpdateAdmissionInfo(ctx context.Context, info *models.AdmissionInfo) error
 }
 
 // IUniversityRepository は大学リポジトリのメインインターフェースを定義します。
@@ -112,19 +135,35 @@ type IAdmissionInfoManager interface {
 // - 科目の検索と管理
 // - 学科の検索と管理
 // - 入試情報の検索と管理
+// - 入試日程の検索と管理
+// - 試験種別の検索と管理
+// - 選択科目群の管理
+// - 合格難易度の管理
+// - 入試情報の公開ワークフロー
+// - ゴミ箱（ソフトデリートした要素）の管理
+// 全てのメソッドは第1引数に context.Context を取り、期限・キャンセルをデータベース操作に伝播します。
 type IUniversityRepository interface {
 	IUniversityFinder
+	IUniversityPager
+	IUniversitySuggester
+	IUniversityImporter
 	IUniversityManager
 	IDepartmentManager
 	ISubjectManager
 	IMajorManager
 	IAdmissionInfoManager
-	FindDepartment(universityID, departmentID uint) (*models.Department, error)
-	FindSubject(departmentID, subjectID uint) (*models.Subject, error)
-	FindMajor(departmentID, majorID uint) (*models.Major, error)
-	FindAdmissionInfo(scheduleID, infoID uint) (*models.AdmissionInfo, error)
-	UpdateSubjectsBatch(testTypeID uint, subjects []models.Subject) error
-	UpdateAdmissionSchedule(schedule *models.AdmissionSchedule) error
+	IAdmissionScheduleManager
+	ITestTypeManager
+	ISubjectGroupManager
+	IAdmissionDifficultyManager
+	IAdmissionPublisher
+	ITrashManager
+	FindDepartment(ctx context.Context, universityID, departmentID uint) (*models.Department, error)
+	FindSubject(ctx context.Context, departmentID, subjectID uint) (*models.Subject, error)
+	FindMajor(ctx context.Context, departmentID, majorID uint) (*models.Major, error)
+	FindAdmissionInfo(ctx context.Context, scheduleID, infoID uint) (*models.AdmissionInfo, error)
+	UpdateSubjectsBatch(ctx context.Context, testTypeID uint, subjects []models.Subject) error
+	UpdateAdmissionSchedule(ctx context.Context, schedule *models.AdmissionSchedule) error
 }
 
 // UniversityRepository は大学リポジトリの実装です。
@@ -133,8 +172,9 @@ type IUniversityRepository interface {
 // - キャッシュの管理
 // - トランザクションの管理
 type universityRepository struct {
-	db    *gorm.DB
-	cache *cache.Manager
+	db          *gorm.DB
+	cache       *cache.Manager
+	searchIndex *searchIndexState
 }
 
 // NewUniversityRepository はリポジトリのインスタンスを生成します。
@@ -143,6 +183,25 @@ type universityRepository struct {
 // - キャッシュマネージャーの初期化
 // - リポジトリの生成
 func NewUniversityRepository(db *gorm.DB) IUniversityRepository {
+	aliases := textsearch.DefaultAliases()
+
+	return NewUniversityRepositoryWithSearchIndex(
+		db,
+		textsearch.NewMemoryIndex(aliases),
+		textsearch.NewTrieSuggestIndex(aliases),
+	)
+}
+
+// NewUniversityRepositoryWithSearchIndex は検索・入力補完のインデックスを指定してリポジトリのインスタンスを生成します。
+// この関数は以下の処理を行います：
+// - データベース接続の初期化
+// - キャッシュマネージャーの初期化
+// - 検索・入力補完のインデックスの設定
+func NewUniversityRepositoryWithSearchIndex(
+	db *gorm.DB,
+	index textsearch.Index,
+	suggest textsearch.SuggestIndex,
+) IUniversityRepository {
 	// コネクションプールの設定
 	sqlDB, err := db.DB()
 	if err != nil {
@@ -155,8 +214,9 @@ func NewUniversityRepository(db *gorm.DB) IUniversityRepository {
 	sqlDB.SetConnMaxLifetime(5 * time.Minute)
 
 	return &universityRepository{
-		db:    db,
-		cache: cache.NewCacheManager(),
+		db:          db,
+		cache:       cache.NewCacheManager(),
+		searchIndex: newSearchIndexState(index, suggest),
 	}
 }
 
@@ -196,15 +256,26 @@ func (r *universityRepository) applyPreloads(query *gorm.DB) *gorm.DB {
 				Where(notDeletedCondition).
 				Order("admission_infos.created_at ASC")
 		}).
+		Preload("Departments.Majors.AdmissionSchedules.AdmissionInfos.Difficulty", func(db *gorm.DB) *gorm.DB {
+			return db.
+				Select("id, admission_info_id, border_score, common_test_rate, deviation_low, deviation_high, source, version").
+				Where(notDeletedCondition)
+		}).
 		Preload("Departments.Majors.AdmissionSchedules.TestTypes", func(db *gorm.DB) *gorm.DB {
 			return db.
 				Select("id, admission_schedule_id, name, version").
 				Where(notDeletedCondition).
-				Order("test_types.name ASC")
+				Order(testTypeOrder())
 		}).
 		Preload("Departments.Majors.AdmissionSchedules.TestTypes.Subjects", func(db *gorm.DB) *gorm.DB {
 			return db.
-				Select("id, test_type_id, name, score, percentage, display_order, version").
+				Select("id, test_type_id, subject_group_id, name, score, conversion_ratio, percentage, display_order, version").
+				Where(notDeletedCondition).
+				Order(displayOrderASC)
+		}).
+		Preload("Departments.Majors.AdmissionSchedules.TestTypes.SubjectGroups", func(db *gorm.DB) *gorm.DB {
+			return db.
+				Select("id, test_type_id, name, choose_count, display_order, version").
 				Where(notDeletedCondition).
 				Order(displayOrderASC)
 		})
@@ -246,8 +317,9 @@ func (r *universityRepository) FindAll(ctx context.Context) ([]models.University
 		}).
 		FindInBatches(&universities, batchSize, func(tx *gorm.DB, _ int) error {
 			processedCount += tx.RowsAffected
-			applogger.Info(context.Background(), "バッチ処理進捗: %d/%d レコードを処理", processedCount, totalCount)
-			return nil
+			applogger.Info(ctx, "バッチ処理進捗: %d/%d レコードを処理", processedCount, totalCount)
+
+			return checkContext(ctx, "全大学取得処理")
 		}).Error
 
 	if err != nil {
@@ -285,9 +357,9 @@ func (r *universityRepository) getUniversityFromCache(id uint) (*models.Universi
 // - データベースクエリの実行
 // - エラーハンドリング
 // - データの返却
-func (r *universityRepository) getUniversityFromDB(id uint) (*models.University, error) {
+func (r *universityRepository) getUniversityFromDB(ctx context.Context, id uint) (*models.University, error) {
 	var university models.University
-	if err := r.applyPreloads(r.db).First(&university, id).Error; err != nil {
+	if err := r.applyPreloads(r.db.WithContext(ctx)).First(&university, id).Error; err != nil {
 		return nil, appErrors.TranslateDBError(err)
 	}
 
@@ -299,31 +371,32 @@ func (r *universityRepository) getUniversityFromDB(id uint) (*models.University,
 // - キャッシュのチェック
 // - データベースからの取得
 // - キャッシュへの保存
-func (r *universityRepository) FindByID(id uint) (*models.University, error) {
+func (r *universityRepository) FindByID(ctx context.Context, id uint) (*models.University, error) {
 	if university, found := r.getUniversityFromCache(id); found {
-		applogger.Info(context.Background(), "FindByIDのキャッシュヒット: %d", id)
+		applogger.Info(ctx, "FindByIDのキャッシュヒット: %d", id)
 		return university, nil
 	}
 
-	university, err := r.getUniversityFromDB(id)
+	university, err := r.getUniversityFromDB(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
 	cacheKey := fmt.Sprintf(cache.CacheKeyUniversityFormat, id)
 	r.cache.SetCache(cacheKey, university)
-	applogger.Info(context.Background(), "大学ID: %d をキャッシュしました", id)
+	applogger.Info(ctx, "大学ID: %d をキャッシュしました", id)
 
 	return university, nil
 }
 
-// Search は大学を検索します。
+// Search は大学を関連度の高い順に検索します。
 // この関数は以下の処理を行います：
 // - 検索クエリの検証
 // - キャッシュのチェック
+// - 検索インデックスによる関連度順の大学IDの取得
 // - データベースからの取得
 // - キャッシュへの保存
-func (r *universityRepository) Search(query string) ([]models.University, error) {
+func (r *universityRepository) Search(ctx context.Context, query string) ([]models.University, error) {
 	if query == "" {
 		return nil, appErrors.NewInvalidInputError("query", errEmptyQuery, nil)
 	}
@@ -334,51 +407,92 @@ func (r *universityRepository) Search(query string) ([]models.University, error)
 
 	cacheKey := fmt.Sprintf("universities:search:%s", query)
 	if cached, found := r.cache.GetFromCache(cacheKey); found {
-		applogger.Info(context.Background(), "キャッシュからデータを取得: %d件", len(cached.([]models.University)))
+		applogger.Info(ctx, "キャッシュからデータを取得: %d件", len(cached.([]models.University)))
 		return cached.([]models.University), nil
 	}
 
-	var universities []models.University
-
-	subQuery := r.db.Model(&models.University{}).
-		Select("DISTINCT universities.id").
-		Joins("LEFT JOIN departments ON departments.university_id = universities.id AND departments.deleted_at IS NULL").
-		Joins("LEFT JOIN majors ON majors.department_id = departments.id AND majors.deleted_at IS NULL").
-		Where("universities.deleted_at IS NULL").
-		Where(`
-			LOWER(universities.name) LIKE LOWER(?) OR
-			LOWER(departments.name) LIKE LOWER(?) OR
-			LOWER(majors.name) LIKE LOWER(?)
-		`, "%"+query+"%", "%"+query+"%", "%"+query+"%")
-
-	err := r.db.
-		Where("id IN (?)", subQuery).
-		Select(
-			"DISTINCT universities.id, universities.name, "+
-			"universities.version, universities.created_at, "+
-			"universities.updated_at",
-		).
-		Scopes(func(db *gorm.DB) *gorm.DB {
-			return r.applyPreloads(db)
-		}).
-		Find(&universities).Error
-
+	hits, err := r.SearchHits(ctx, query)
 	if err != nil {
 		return nil, appErrors.NewDatabaseError("大学検索処理", fmt.Errorf(errSearchFailed, err), nil)
 	}
 
+	ids := hitIDs(hits)
+	universities := make([]models.University, 0, len(ids))
+
+	if len(ids) > 0 {
+		err = r.applyPreloads(r.db.WithContext(ctx)).
+			Where("id IN ?", ids).
+			Find(&universities).Error
+		if err != nil {
+			return nil, appErrors.NewDatabaseError("大学検索処理", fmt.Errorf(errSearchFailed, err), nil)
+		}
+	}
+
+	universities = orderByIDs(universities, ids)
+
 	r.cache.SetCache(cacheKey, universities)
-	applogger.Info(context.Background(), "検索結果をキャッシュしました: %d件", len(universities))
+	applogger.Info(ctx, "検索結果をキャッシュしました: %d件", len(universities))
 
 	return universities, nil
 }
 
-func (r *universityRepository) FindDepartment(universityID, departmentID uint) (*models.Department, error) {
+// SearchHits は検索インデックスから検索クエリに一致する大学を関連度の高い順に取得します
+// ファセット検索など、大学一覧の検索と同じ正規化・略称展開・関連度を使用する検索で共有します
+func (r *universityRepository) SearchHits(ctx context.Context, query string) ([]textsearch.Hit, error) {
+	return r.searchIndexOrDefault().search(ctx, r.db, query)
+}
+
+// searchIndexOrDefault はリポジトリの検索インデックスを返します
+// インデックスが設定されていない場合は都度構築する一時的なインデックスを返します
+func (r *universityRepository) searchIndexOrDefault() *searchIndexState {
+	if r.searchIndex == nil {
+		return newDefaultSearchIndexState()
+	}
+
+	return r.searchIndex
+}
+
+// Suggest は入力途中の文字列で始まる大学・学部・学科・学問系統の名称を種別ごとに取得します。
+// この関数は以下の処理を行います：
+// - 必要に応じた候補インデックスの再構築
+// - 前方一致する候補の取得
+// - 種別ごとの件数制限
+func (r *universityRepository) Suggest(
+	ctx context.Context,
+	prefix string,
+	types []string,
+	limit int,
+) (textsearch.Suggestions, error) {
+	suggestions, err := r.searchIndexOrDefault().suggestions(ctx, r.db, prefix, types, limit)
+	if err != nil {
+		return nil, appErrors.NewDatabaseError("入力補完候補取得処理", err, nil)
+	}
+
+	return suggestions, nil
+}
+
+// WarmSearchIndex は検索・入力補完のインデックスを構築します
+// 起動時に呼び出すことで、最初のリクエストでの構築待ちを避けます
+func (r *universityRepository) WarmSearchIndex(ctx context.Context) error {
+	if err := r.searchIndexOrDefault().refresh(ctx, r.db); err != nil {
+		return appErrors.NewDatabaseError("検索インデックス構築処理", err, nil)
+	}
+
+	return nil
+}
+
+// InvalidateSearchIndex は検索・入力補完のインデックスを再構築が必要な状態にします
+// 学問系統などリポジトリの外で更新されるマスタデータの変更時に呼び出します
+func (r *universityRepository) InvalidateSearchIndex() {
+	r.searchIndex.invalidate()
+}
+
+func (r *universityRepository) FindDepartment(ctx context.Context, universityID, departmentID uint) (*models.Department, error) {
 	cacheKey := fmt.Sprintf(cache.CacheKeyDepartmentFormat, universityID, departmentID)
 
 	// キャッシュをチェック
 	if cached, found := r.cache.GetFromCache(cacheKey); found {
-		applogger.Info(context.Background(), "FindDepartmentのキャッシュヒット: 学部 %d:%d", universityID, departmentID)
+		applogger.Info(ctx, "FindDepartmentのキャッシュヒット: 学部 %d:%d", universityID, departmentID)
 
 		department := cached.(models.Department)
 
@@ -386,7 +500,7 @@ func (r *universityRepository) FindDepartment(universityID, departmentID uint) (
 	}
 
 	var department models.Department
-	err := r.db.Where("university_id = ? AND id = ?", universityID, departmentID).
+	err := r.db.WithContext(ctx).Where("university_id = ? AND id = ?", universityID, departmentID).
 		First(&department).Error
 
 	if err != nil {
@@ -399,18 +513,18 @@ func (r *universityRepository) FindDepartment(universityID, departmentID uint) (
 
 	// キャッシュに保存
 	r.cache.SetCache(cacheKey, department)
-	applogger.Info(context.Background(), "学部 %d:%d をキャッシュしました", universityID, departmentID)
+	applogger.Info(ctx, "学部 %d:%d をキャッシュしました", universityID, departmentID)
 
 	return &department, nil
 }
 
 // FindSubject は科目を検索します
-func (r *universityRepository) FindSubject(departmentID, subjectID uint) (*models.Subject, error) {
+func (r *universityRepository) FindSubject(ctx context.Context, departmentID, subjectID uint) (*models.Subject, error) {
 	cacheKey := fmt.Sprintf("subjects:%d:%d", departmentID, subjectID)
 
 	// キャッシュをチェック
 	if cached, found := r.cache.GetFromCache(cacheKey); found {
-		applogger.Info(context.Background(), "FindSubjectのキャッシュヒット: 科目 %d:%d", departmentID, subjectID)
+		applogger.Info(ctx, "FindSubjectのキャッシュヒット: 科目 %d:%d", departmentID, subjectID)
 
 		subject := cached.(models.Subject)
 
@@ -418,7 +532,7 @@ func (r *universityRepository) FindSubject(departmentID, subjectID uint) (*model
 	}
 
 	var subject models.Subject
-	err := r.db.Preload("TestType.AdmissionSchedule.Major.Department.University").
+	err := r.db.WithContext(ctx).Preload("TestType.AdmissionSchedule.Major.Department.University").
 		Joins("JOIN test_types ON subjects.test_type_id = test_types.id").
 		Joins("JOIN admission_schedules ON test_types.admission_schedule_id = admission_schedules.id").
 		Joins("JOIN majors ON admission_schedules.major_id = majors.id").
@@ -435,7 +549,7 @@ func (r *universityRepository) FindSubject(departmentID, subjectID uint) (*model
 
 	// キャッシュに保存
 	r.cache.SetCache(cacheKey, subject)
-	applogger.Info(context.Background(), "科目 %d:%d をキャッシュしました", departmentID, subjectID)
+	applogger.Info(ctx, "科目 %d:%d をキャッシュしました", departmentID, subjectID)
 
 	return &subject, nil
 }
@@ -477,7 +591,7 @@ func sanitizeName(name string) string {
 // - データのサニタイズ
 // - トランザクションの実行
 // - キャッシュのクリア
-func (r *universityRepository) Create(university *models.University) error {
+func (r *universityRepository) Create(ctx context.Context, university *models.University) error {
 	if err := r.validateUniversity(university); err != nil {
 		return err
 	}
@@ -490,8 +604,8 @@ func (r *universityRepository) Create(university *models.University) error {
 		university.Departments[i].Name = sanitizeName(university.Departments[i].Name)
 	}
 
-	err := r.db.Transaction(func(tx *gorm.DB) error {
-		if err := tx.Create(university).Error; err != nil {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := createWithNested(tx, university); err != nil {
 			return err
 		}
 
@@ -504,6 +618,7 @@ func (r *universityRepository) Create(university *models.University) error {
 
 	// キャッシュをクリア
 	r.cache.ClearAllRelatedCache(university.ID)
+	r.searchIndex.invalidate()
 
 	return nil
 }
@@ -511,9 +626,9 @@ func (r *universityRepository) Create(university *models.University) error {
 // Update は既存の大学を更新します。
 // この関数は以下の処理を行います：
 // - データのサニタイズ
-// - トランザクションの実行
+// - トランザクションの実行（Versionを期待するバージョンとした楽観的ロック）
 // - キャッシュのクリア
-func (r *universityRepository) Update(university *models.University) error {
+func (r *universityRepository) Update(ctx context.Context, university *models.University) error {
 	// 大学名をサニタイズ
 	university.Name = sanitizeName(university.Name)
 
@@ -526,8 +641,8 @@ func (r *universityRepository) Update(university *models.University) error {
 		}
 	}
 
-	err := r.db.Transaction(func(tx *gorm.DB) error {
-		if err := tx.Save(university).Error; err != nil {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := updateWithVersion(tx, university, "大学"); err != nil {
 			return err
 		}
 
@@ -540,55 +655,47 @@ func (r *universityRepository) Update(university *models.University) error {
 
 	// 全てのキャッシュをクリア
 	r.cache.ClearAllRelatedCache(university.ID)
+	r.searchIndex.invalidate()
 
 	return nil
 }
 
 // Delete は大学を削除します。
 // この関数は以下の処理を行います：
-// - トランザクションの実行
+// - 学部・学科などの配下の要素を含むソフトデリート（ゴミ箱から復元可能）
 // - キャッシュのクリア
-func (r *universityRepository) Delete(id uint) error {
-	err := r.db.Transaction(func(tx *gorm.DB) error {
-		result := tx.Unscoped().Delete(&models.University{}, id)
-		if result.Error != nil {
-			return result.Error
-		}
-
-		if result.RowsAffected == 0 {
-			return appErrors.NewNotFoundError("大学", id, nil)
-		}
-
-		return nil
-	})
-
+func (r *universityRepository) Delete(ctx context.Context, id uint) error {
+	deleted, err := r.softDelete(ctx, "universities", id)
 	if err != nil {
 		return err
 	}
 
-	// キャッシュをクリア
-	r.cache.ClearAllRelatedCache(id)
+	if deleted == 0 {
+		return appErrors.NewNotFoundError("大学", id, nil)
+	}
 
 	return nil
 }
 
 // CreateDepartment は新しい学部を作成します
-func (r *universityRepository) CreateDepartment(department *models.Department) error {
-	if err := r.db.Create(department).Error; err != nil {
+func (r *universityRepository) CreateDepartment(ctx context.Context, department *models.Department) error {
+	if err := createWithNested(r.db.WithContext(ctx), department); err != nil {
 		return err
 	}
 
+	r.searchIndex.invalidate()
+
 	return nil
 }
 
-// UpdateDepartment は既存の学部を更新します
-func (r *universityRepository) UpdateDepartment(department *models.Department) error {
+// UpdateDepartment は既存の学部を楽観的ロックで更新します
+func (r *universityRepository) UpdateDepartment(ctx context.Context, department *models.Department) error {
 	if strings.TrimSpace(department.Name) == "" {
 		return errors.New("学部名が空です")
 	}
 
-	err := r.db.Transaction(func(tx *gorm.DB) error {
-		if err := tx.Save(department).Error; err != nil {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := updateWithVersion(tx, department, "学部"); err != nil {
 			return err
 		}
 
@@ -601,13 +708,14 @@ func (r *universityRepository) UpdateDepartment(department *models.Department) e
 
 	// 全てのキャッシュをクリア
 	r.cache.ClearAllRelatedCache(department.UniversityID)
+	r.searchIndex.invalidate()
 
 	return nil
 }
 
-// DeleteDepartment は学部を削除します
-func (r *universityRepository) DeleteDepartment(id uint) error {
-	if err := r.db.Delete(&models.Department{}, id).Error; err != nil {
+// DeleteDepartment は学部を配下の学科などとともにソフトデリートします
+func (r *universityRepository) DeleteDepartment(ctx context.Context, id uint) error {
+	if _, err := r.softDelete(ctx, "departments", id); err != nil {
 		return err
 	}
 
@@ -615,8 +723,11 @@ func (r *universityRepository) DeleteDepartment(id uint) error {
 }
 
 // CreateSubject は新しい科目を作成します
-func (r *universityRepository) CreateSubject(subject *models.Subject) error {
-	if err := r.db.Create(subject).Error; err != nil {
+// 選択科目群への所属は選択科目群のエンドポイントで設定するため、作成時は選択科目群に属さない科目とします
+func (r *universityRepository) CreateSubject(ctx context.Context, subject *models.Subject) error {
+	subject.SubjectGroupID = nil
+
+	if err := r.db.WithContext(ctx).Create(subject).Error; err != nil {
 		return err
 	}
 
@@ -637,23 +748,24 @@ func (r *universityRepository) calculateTotalScore(subjects []models.Subject) fl
 } */
 
 // updatePercentages はパーセンテージを更新します。
+// 配点比率は換算比率を適用した換算後の配点から算出します
+// 試験種別レジストリで配点比率の分母に含めない試験種別の科目は0とします
 func (r *universityRepository) updatePercentages(
 	subjects []models.Subject,
-	commonTestTotalScore float64,
-	secondaryTestTotalScore float64,
+	testTypeName string,
+	denominator float64,
 ) {
-	// 共通テストと二次試験の合計点を分母とする
-	denominator := commonTestTotalScore + secondaryTestTotalScore
+	counted := models.TestTypes().CountsTowardTotal(testTypeName)
 
-	if denominator > 0 {
-		for i := range subjects {
-			percentage := float64(subjects[i].Score) / denominator * 100
-			subjects[i].Percentage = math.Round(percentage*100) / 100
-		}
-	} else {
-		for i := range subjects {
+	for i := range subjects {
+		subjects[i].ApplyConversion()
+
+		if !counted {
 			subjects[i].Percentage = 0
+			continue
 		}
+
+		subjects[i].Percentage = models.WeightedPercentage(subjects[i].EffectiveScore, denominator)
 	}
 }
 
@@ -666,6 +778,7 @@ func (r *universityRepository) saveSubjectWithScores(tx *gorm.DB, subject models
 		"test_type_id":   subject.TestTypeID,
 		"name":          subject.Name,
 		"score":         subject.Score,
+		"conversion_ratio": subject.ConversionRatio,
 		"percentage":    subject.Percentage,
 		"display_order": subject.DisplayOrder,
 	}).Error; err != nil {
@@ -675,31 +788,31 @@ func (r *universityRepository) saveSubjectWithScores(tx *gorm.DB, subject models
 	return nil
 }
 
-// getRelevantTestTypeScores は、指定されたTestTypeに関連する共通テストと二次試験の合計点を取得します。
-// 同じAdmissionSchedule内の "共通" と "二次" のTestTypeを検索し、それぞれの合計点を返します。
-// 対象のTestTypeが見つからない場合やエラー時は 0.0 とエラーを返します。
+// getRelevantTestTypeScores は、指定された入試日程の試験種別名ごとの換算後の合計点を取得します。
+// 同じAdmissionSchedule内の全てのTestTypeを検索し、試験種別名をキーとした合計点を返します。
+// 選択科目群は所属する科目の合計ではなく、選択科目群の換算後の配点で1回だけ数えます。
+// 配点比率の分母は試験種別レジストリで分母に含める試験種別の合計点から算出します。
+// 対象のTestTypeが見つからない場合は空の結果を、エラー時はエラーを返します。
 func (r *universityRepository) getRelevantTestTypeScores(
 	tx *gorm.DB,
 	admissionScheduleID uint,
-) (commonTotal float64, secondaryTotal float64, err error) {
+) (map[string]float64, error) {
+	totals := make(map[string]float64)
+
 	if admissionScheduleID == 0 {
 		applogger.Warn(context.Background(),
-			"関連試験種別スコア取得試行時に AdmissionScheduleID がゼロです。0,0 を返します。")
-		return 0.0, 0.0, nil
+			"関連試験種別スコア取得試行時に AdmissionScheduleID がゼロです。空の結果を返します。")
+		return totals, nil
 	}
 
 	var testTypesInSchedule []models.TestType
-	if err := tx.Where(
-		"admission_schedule_id = ? AND name IN (?)",
-		admissionScheduleID,
-		[]string{"共通", "二次"},
-	).Find(&testTypesInSchedule).Error; err != nil {
+	if err := tx.Where("admission_schedule_id = ?", admissionScheduleID).Find(&testTypesInSchedule).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			applogger.Info(context.Background(), "試験日程ID %d に「共通」または「二次」の試験種別が見つかりませんでした", admissionScheduleID)
-			return 0.0, 0.0, nil
+			applogger.Info(context.Background(), "試験日程ID %d に試験種別が見つかりませんでした", admissionScheduleID)
+			return totals, nil
 		}
 
-		return 0.0, 0.0, fmt.Errorf("試験日程ID %d の試験種別検索に失敗しました: %w", admissionScheduleID, err)
+		return nil, fmt.Errorf("試験日程ID %d の試験種別検索に失敗しました: %w", admissionScheduleID, err)
 	}
 
 	for _, tt := range testTypesInSchedule {
@@ -710,24 +823,18 @@ func (r *universityRepository) getRelevantTestTypeScores(
 				continue
 			}
 
-			return 0.0, 0.0, fmt.Errorf("試験種別ID %d (名称: %s) の科目取得に失敗しました: %w", tt.ID, tt.Name, errDb)
+			return nil, fmt.Errorf("試験種別ID %d (名称: %s) の科目取得に失敗しました: %w", tt.ID, tt.Name, errDb)
 		}
 
-		var currentTypeSum float64
-
-		for _, s := range subjectsInTestType {
-			currentTypeSum += float64(s.Score)
+		var groupsInTestType []models.SubjectGroup
+		if errDb := tx.Where(testTypeIDQuery, tt.ID).Find(&groupsInTestType).Error; errDb != nil {
+			return nil, fmt.Errorf("試験種別ID %d (名称: %s) の選択科目群取得に失敗しました: %w", tt.ID, tt.Name, errDb)
 		}
 
-		switch tt.Name {
-		case "共通":
-			commonTotal = currentTypeSum
-		case "二次":
-			secondaryTotal = currentTypeSum
-		}
+		totals[tt.Name] = models.SelectionTotal(subjectsInTestType, groupsInTestType)
 	}
 
-	return commonTotal, secondaryTotal, nil
+	return totals, nil
 }
 
 // updateSubjectScores は科目のスコアを更新します。
@@ -744,7 +851,7 @@ func (r *universityRepository) updateSubjectScores(tx *gorm.DB, subjects []model
 		return fmt.Errorf("試験種別ID %d が見つかりませんでした: %w", testTypeID, err)
 	}
 
-	commonTestTotalScore, secondaryTestTotalScore, err := r.getRelevantTestTypeScores(
+	testTypeTotals, err := r.getRelevantTestTypeScores(
 		tx,
 		currentTestType.AdmissionScheduleID,
 	)
@@ -758,8 +865,8 @@ func (r *universityRepository) updateSubjectScores(tx *gorm.DB, subjects []model
 
 	r.updatePercentages(
 		subjects,
-		commonTestTotalScore,
-		secondaryTestTotalScore,
+		currentTestType.Name,
+		models.TestTypes().Denominator(testTypeTotals),
 	)
 
 	for _, s := range subjects {
@@ -773,23 +880,30 @@ func (r *universityRepository) updateSubjectScores(tx *gorm.DB, subjects []model
 
 // processBatch は科目のバッチを処理します。
 // この関数は以下の処理を行います：
-// - バッチデータの処理
+// - コンテキストのキャンセル・タイムアウトの確認
+// - バッチデータの処理（科目ごとのVersionによる楽観的ロック）
 // - エラーハンドリング
-func (r *universityRepository) processBatch(tx *gorm.DB, batch []models.Subject, testTypeID uint) error {
-	for _, subject := range batch {
+func (r *universityRepository) processBatch(
+	ctx context.Context,
+	tx *gorm.DB,
+	batch []models.Subject,
+	testTypeID uint,
+) error {
+	for i := range batch {
+		if err := checkContext(ctx, "科目のバッチ更新処理"); err != nil {
+			return err
+		}
+
+		subject := &batch[i]
 		subject.TestTypeID = testTypeID
 
-		// 科目が存在するか確認
-		var existingSubject models.Subject
-		if err := tx.First(&existingSubject, subject.ID).Error; err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return fmt.Errorf("科目ID %d が見つかりませんでした", subject.ID)
+		// 選択科目群への所属は選択科目群のエンドポイントで変更するため、更新しません
+		if err := updateWithVersion(tx, subject, "科目", "SubjectGroupID"); err != nil {
+			var appErr *appErrors.Error
+			if errors.As(err, &appErr) {
+				return appErr
 			}
 
-			return fmt.Errorf("科目の検索に失敗: %w", err)
-		}
-
-		if err := tx.Save(&subject).Error; err != nil {
 			return fmt.Errorf("科目の更新に失敗: %w", err)
 		}
 	}
@@ -800,14 +914,14 @@ func (r *universityRepository) processBatch(tx *gorm.DB, batch []models.Subject,
 // UpdateSubjectsBatch は科目のバッチ更新を行います。
 // この関数は以下の処理を行います：
 // - バッチサイズの設定
-// - バッチ処理の実行
+// - バッチ処理の実行（コンテキストがキャンセル・タイムアウトした場合は中断してロールバック）
 // - スコアの再計算
-func (r *universityRepository) UpdateSubjectsBatch(testTypeID uint, subjects []models.Subject) error {
-	applogger.Info(context.Background(), "バッチ更新開始: testTypeID=%d, 科目数=%d", testTypeID, len(subjects))
+func (r *universityRepository) UpdateSubjectsBatch(ctx context.Context, testTypeID uint, subjects []models.Subject) error {
+	applogger.Info(ctx, "バッチ更新開始: testTypeID=%d, 科目数=%d", testTypeID, len(subjects))
 
 	const batchSize = 1000
 
-	return r.db.Transaction(func(tx *gorm.DB) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		// TestTypeの存在チェック
 		var testType models.TestType
 		if err := tx.First(&testType, testTypeID).Error; err != nil {
@@ -825,7 +939,7 @@ func (r *universityRepository) UpdateSubjectsBatch(testTypeID uint, subjects []m
 				end = len(subjects)
 			}
 
-			if err := r.processBatch(tx, subjects[i:end], testTypeID); err != nil {
+			if err := r.processBatch(ctx, tx, subjects[i:end], testTypeID); err != nil {
 				return err
 			}
 		}
@@ -852,7 +966,7 @@ func (r *universityRepository) recalculateScores(tx *gorm.DB, testTypeID uint) e
 		return fmt.Errorf("試験種別ID %d が見つかりませんでした: %w", testTypeID, err)
 	}
 
-	commonTestTotalScore, secondaryTestTotalScore, err := r.getRelevantTestTypeScores(
+	testTypeTotals, err := r.getRelevantTestTypeScores(
 		tx,
 		currentTestType.AdmissionScheduleID,
 	)
@@ -866,8 +980,8 @@ func (r *universityRepository) recalculateScores(tx *gorm.DB, testTypeID uint) e
 
 	r.updatePercentages(
 		subjects,
-		commonTestTotalScore,
-		secondaryTestTotalScore,
+		currentTestType.Name,
+		models.TestTypes().Denominator(testTypeTotals),
 	)
 
 	for i := range subjects {
@@ -918,12 +1032,20 @@ func (r *universityRepository) updateSubjectInList(
 
 // UpdateSubject は科目を更新します。
 // この関数は以下の処理を行います：
+// - バージョンの確認と更新（楽観的ロック）
 // - 既存科目の取得
 // - 科目リストの更新
 // - スコアの更新
 // - キャッシュのクリア
-func (r *universityRepository) UpdateSubject(subject *models.Subject) error {
-	err := r.db.Transaction(func(tx *gorm.DB) error {
+func (r *universityRepository) UpdateSubject(ctx context.Context, subject *models.Subject) error {
+	expected := subject.Version
+	subject.ApplyConversion()
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := incrementVersion[models.Subject](tx, subject.ID, expected, "科目"); err != nil {
+			return err
+		}
+
 		allSubjects, err := r.getExistingSubjects(tx, subject.TestTypeID)
 		if err != nil {
 			return err
@@ -942,6 +1064,8 @@ func (r *universityRepository) UpdateSubject(subject *models.Subject) error {
 		return err
 	}
 
+	subject.Version = expected + 1
+
 	r.cache.ClearSubjectsCache(subject.TestTypeID)
 	r.cache.ClearAllRelatedCache(0)
 
@@ -950,10 +1074,10 @@ func (r *universityRepository) UpdateSubject(subject *models.Subject) error {
 
 // DeleteSubject は科目を削除します。
 // この関数は以下の処理を行います：
-// - 科目の削除
+// - 科目のソフトデリート
 // - エラーハンドリング
-func (r *universityRepository) DeleteSubject(id uint) error {
-	if err := r.db.Delete(&models.Subject{}, id).Error; err != nil {
+func (r *universityRepository) DeleteSubject(ctx context.Context, id uint) error {
+	if _, err := r.softDelete(ctx, "subjects", id); err != nil {
 		return err
 	}
 
@@ -962,11 +1086,11 @@ func (r *universityRepository) DeleteSubject(id uint) error {
 
 // UpdateMajor は既存の学科を更新します。
 // この関数は以下の処理を行います：
-// - トランザクションの実行
+// - トランザクションの実行（Versionを期待するバージョンとした楽観的ロック）
 // - キャッシュのクリア
-func (r *universityRepository) UpdateMajor(major *models.Major) error {
-	err := r.db.Transaction(func(tx *gorm.DB) error {
-		if err := tx.Save(major).Error; err != nil {
+func (r *universityRepository) UpdateMajor(ctx context.Context, major *models.Major) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := updateWithVersion(tx, major, "学科"); err != nil {
 			return err
 		}
 
@@ -979,17 +1103,18 @@ func (r *universityRepository) UpdateMajor(major *models.Major) error {
 
 	// 全てのキャッシュをクリア
 	r.cache.ClearAllRelatedCache(major.DepartmentID)
+	r.searchIndex.invalidate()
 
 	return nil
 }
 
 // UpdateAdmissionSchedule は既存の入試スケジュールを更新します。
 // この関数は以下の処理を行います：
-// - トランザクションの実行
+// - トランザクションの実行（Versionを期待するバージョンとした楽観的ロック）
 // - キャッシュのクリア
-func (r *universityRepository) UpdateAdmissionSchedule(schedule *models.AdmissionSchedule) error {
-	err := r.db.Transaction(func(tx *gorm.DB) error {
-		if err := tx.Save(schedule).Error; err != nil {
+func (r *universityRepository) UpdateAdmissionSchedule(ctx context.Context, schedule *models.AdmissionSchedule) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := updateWithVersion(tx, schedule, "入試日程"); err != nil {
 			return err
 		}
 
@@ -1008,14 +1133,29 @@ func (r *universityRepository) UpdateAdmissionSchedule(schedule *models.Admissio
 
 // UpdateAdmissionInfo は既存の入試情報を更新します。
 // この関数は以下の処理を行います：
-// - トランザクションの実行
+// - トランザクションの実行（Versionを期待するバージョンとした楽観的ロック）
+// - ステータスを除いた項目の更新と、保存済みのステータスの反映
 // - キャッシュのクリア
-func (r *universityRepository) UpdateAdmissionInfo(info *models.AdmissionInfo) error {
-	err := r.db.Transaction(func(tx *gorm.DB) error {
-		if err := tx.Save(info).Error; err != nil {
+func (r *universityRepository) UpdateAdmissionInfo(ctx context.Context, info *models.AdmissionInfo) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// ステータスは公開ワークフロー（UpdateAdmissionInfoStatus）でのみ、
+		// 合格難易度は専用のエンドポイント（UpdateAdmissionDifficulty）でのみ変更する
+		if err := updateWithVersion(tx, info, "入試情報", "status", "Difficulty"); err != nil {
+			var appErr *appErrors.Error
+			if errors.As(err, &appErr) {
+				return appErr
+			}
+
 			return appErrors.NewDatabaseError("入試情報更新処理", err, nil)
 		}
 
+		var stored models.AdmissionInfo
+		if err := tx.Select("status").First(&stored, info.ID).Error; err != nil {
+			return appErrors.NewDatabaseError("入試情報更新処理", err, nil)
+		}
+
+		info.Status = stored.Status
+
 		return nil
 	})
 
@@ -1034,12 +1174,12 @@ func (r *universityRepository) UpdateAdmissionInfo(info *models.AdmissionInfo) e
 // - キャッシュのチェック
 // - データベースからの取得
 // - キャッシュへの保存
-func (r *universityRepository) FindMajor(departmentID, majorID uint) (*models.Major, error) {
+func (r *universityRepository) FindMajor(ctx context.Context, departmentID, majorID uint) (*models.Major, error) {
 	cacheKey := fmt.Sprintf("majors:%d:%d", departmentID, majorID)
 
 	// キャッシュをチェック
 	if cached, found := r.cache.GetFromCache(cacheKey); found {
-		applogger.Info(context.Background(), "学科のキャッシュヒット: %d:%d", departmentID, majorID)
+		applogger.Info(ctx, "学科のキャッシュヒット: %d:%d", departmentID, majorID)
 
 		major := cached.(models.Major)
 
@@ -1047,7 +1187,7 @@ func (r *universityRepository) FindMajor(departmentID, majorID uint) (*models.Ma
 	}
 
 	var major models.Major
-	err := r.db.Where("department_id = ? AND id = ?", departmentID, majorID).
+	err := r.db.WithContext(ctx).Where("department_id = ? AND id = ?", departmentID, majorID).
 		First(&major).Error
 
 	if err != nil {
@@ -1060,7 +1200,7 @@ func (r *universityRepository) FindMajor(departmentID, majorID uint) (*models.Ma
 
 	// キャッシュに保存
 	r.cache.SetCache(cacheKey, major)
-	applogger.Info(context.Background(), "学科をキャッシュに保存: %d:%d", departmentID, majorID)
+	applogger.Info(ctx, "学科をキャッシュに保存: %d:%d", departmentID, majorID)
 
 	return &major, nil
 }
@@ -1069,20 +1209,22 @@ func (r *universityRepository) FindMajor(departmentID, majorID uint) (*models.Ma
 // この関数は以下の処理を行います：
 // - 学科の作成
 // - エラーハンドリング
-func (r *universityRepository) CreateMajor(major *models.Major) error {
-	if err := r.db.Create(major).Error; err != nil {
+func (r *universityRepository) CreateMajor(ctx context.Context, major *models.Major) error {
+	if err := createWithNested(r.db.WithContext(ctx), major); err != nil {
 		return appErrors.NewDatabaseError("学科作成処理", err, nil)
 	}
 
+	r.searchIndex.invalidate()
+
 	return nil
 }
 
 // DeleteMajor は学科を削除します。
 // この関数は以下の処理を行います：
-// - 学科の削除
+// - 入試日程などの配下の要素を含むソフトデリート
 // - エラーハンドリング
-func (r *universityRepository) DeleteMajor(id uint) error {
-	if err := r.db.Delete(&models.Major{}, id).Error; err != nil {
+func (r *universityRepository) DeleteMajor(ctx context.Context, id uint) error {
+	if _, err := r.softDelete(ctx, "majors", id); err != nil {
 		return appErrors.NewDatabaseError("学科削除処理", err, nil)
 	}
 
@@ -1094,12 +1236,12 @@ func (r *universityRepository) DeleteMajor(id uint) error {
 // - キャッシュのチェック
 // - データベースからの取得
 // - キャッシュへの保存
-func (r *universityRepository) FindAdmissionInfo(scheduleID, infoID uint) (*models.AdmissionInfo, error) {
+func (r *universityRepository) FindAdmissionInfo(ctx context.Context, scheduleID, infoID uint) (*models.AdmissionInfo, error) {
 	cacheKey := fmt.Sprintf("admission_infos:%d:%d", scheduleID, infoID)
 
 	// キャッシュをチェック
 	if cached, found := r.cache.GetFromCache(cacheKey); found {
-		applogger.Info(context.Background(), "入試情報のキャッシュヒット: %d:%d", scheduleID, infoID)
+		applogger.Info(ctx, "入試情報のキャッシュヒット: %d:%d", scheduleID, infoID)
 
 		info := cached.(models.AdmissionInfo)
 
@@ -1107,7 +1249,8 @@ func (r *universityRepository) FindAdmissionInfo(scheduleID, infoID uint) (*mode
 	}
 
 	var info models.AdmissionInfo
-	err := r.db.Where("admission_schedule_id = ? AND id = ?", scheduleID, infoID).
+	err := r.db.WithContext(ctx).Where("admission_schedule_id = ? AND id = ?", scheduleID, infoID).
+		Preload("Difficulty", notDeletedCondition).
 		First(&info).Error
 
 	if err != nil {
@@ -1120,7 +1263,7 @@ func (r *universityRepository) FindAdmissionInfo(scheduleID, infoID uint) (*mode
 
 	// キャッシュに保存
 	r.cache.SetCache(cacheKey, info)
-	applogger.Info(context.Background(), "入試情報をキャッシュに保存: %d:%d", scheduleID, infoID)
+	applogger.Info(ctx, "入試情報をキャッシュに保存: %d:%d", scheduleID, infoID)
 
 	return &info, nil
 }
@@ -1129,8 +1272,9 @@ func (r *universityRepository) FindAdmissionInfo(scheduleID, infoID uint) (*mode
 // この関数は以下の処理を行います：
 // - 募集情報の作成
 // - エラーハンドリング
-func (r *universityRepository) CreateAdmissionInfo(info *models.AdmissionInfo) error {
-	if err := r.db.Create(info).Error; err != nil {
+func (r *universityRepository) CreateAdmissionInfo(ctx context.Context, info *models.AdmissionInfo) error {
+	// 合格難易度は専用のエンドポイント（CreateAdmissionDifficulty）でのみ登録する
+	if err := r.db.WithContext(ctx).Omit("Difficulty").Create(info).Error; err != nil {
 		return appErrors.NewDatabaseError("入試情報作成処理", err, nil)
 	}
 
@@ -1139,10 +1283,10 @@ func (r *universityRepository) CreateAdmissionInfo(info *models.AdmissionInfo) e
 
 // DeleteAdmissionInfo は募集情報を削除します。
 // この関数は以下の処理を行います：
-// - 募集情報の削除
+// - 募集情報のソフトデリート
 // - エラーハンドリング
-func (r *universityRepository) DeleteAdmissionInfo(id uint) error {
-	if err := r.db.Delete(&models.AdmissionInfo{}, id).Error; err != nil {
+func (r *universityRepository) DeleteAdmissionInfo(ctx context.Context, id uint) error {
+	if _, err := r.softDelete(ctx, "admission_infos", id); err != nil {
 		return appErrors.NewDatabaseError("入試情報削除処理", err, nil)
 	}
 
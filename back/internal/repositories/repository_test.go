@@ -112,7 +112,7 @@ func TestUniversityCreateAndFind(t *testing.T) {
 
 		// 大学の作成
 		// repo.Create は context を引数に取らないため、ctxなしで呼び出し
-		err := repo.Create(uniToCreate)
+		err := repo.Create(context.Background(), uniToCreate)
 		require.NoError(t, err, errMsgCreateUniversity)
 		require.NotZero(t, uniToCreate.ID, "作成された大学はIDを持つべきです")
 		require.NotEmpty(t, uniToCreate.Departments, "作成された大学は学部を持つべきです")
@@ -123,7 +123,7 @@ func TestUniversityCreateAndFind(t *testing.T) {
 
 		// IDによる大学の取得
 		// repo.FindByID は context を引数に取らないため、ctxなしで呼び出し
-		foundUni, err := repo.FindByID(uniToCreate.ID)
+		foundUni, err := repo.FindByID(context.Background(), uniToCreate.ID)
 		require.NoError(t, err, "IDによる大学の取得中にエラーが発生すべきではありません")
 		require.NotNil(t, foundUni, "取得された大学はnilであってはなりません")
 
@@ -165,7 +165,7 @@ func TestUniversityCreateAndFind(t *testing.T) {
 	t.Run("存在しないIDによる大学の取得", func(t *testing.T) {
 		nonExistentID := uint(99999)
 		// repo.FindByID は context を引数に取らないため、ctxなしで呼び出し
-		notFoundUni, err := repo.FindByID(nonExistentID)
+		notFoundUni, err := repo.FindByID(context.Background(), nonExistentID)
 		assert.Error(t, err, "存在しないIDで検索した場合、エラーが返されるべきです")
 		// appErrors.IsNotFoundError(err) のようなエラータイプのチェックが望ましい
 		assert.Nil(t, notFoundUni, "存在しないIDで検索した場合、大学データはnilであるべきです")
@@ -181,16 +181,16 @@ func TestUniversityUpdate(t *testing.T) {
 		BaseModel: models.BaseModel{Version: 1},
 		Name: "テスト大学",
 	}
-	err := repo.Create(uni)
+	err := repo.Create(context.Background(), uni)
 	require.NoError(t, err, errMsgCreateUniversity)
 
 	// 名前を変更してUpdate
 	uni.Name = "更新後大学"
-	err = repo.Update(uni)
+	err = repo.Update(context.Background(), uni)
 	require.NoError(t, err, "大学の更新に失敗")
 
 	// 再取得して反映を確認
-	updated, err := repo.FindByID(uni.ID)
+	updated, err := repo.FindByID(context.Background(), uni.ID)
 	require.NoError(t, err, "更新後の大学取得に失敗")
 	assert.Equal(t, "更新後大学", updated.Name, "大学名が更新されていない")
 }
@@ -204,15 +204,15 @@ func TestUniversityDelete(t *testing.T) {
 		BaseModel: models.BaseModel{Version: 1},
 		Name: "削除対象大学",
 	}
-	err := repo.Create(uni)
+	err := repo.Create(context.Background(), uni)
 	require.NoError(t, err, errMsgCreateUniversity)
 
 	// 削除
-	err = repo.Delete(uni.ID)
+	err = repo.Delete(context.Background(), uni.ID)
 	require.NoError(t, err, "大学の削除に失敗")
 
 	// 削除後に取得できないことを確認
-	deleted, err := repo.FindByID(uni.ID)
+	deleted, err := repo.FindByID(context.Background(), uni.ID)
 	assert.Error(t, err, "削除済み大学の取得はエラーとなるべき")
 	assert.Nil(t, deleted, "削除済み大学はnilであるべき")
 }
@@ -228,7 +228,7 @@ func TestUniversityFindAll(t *testing.T) {
 			BaseModel: models.BaseModel{Version: 1},
 			Name: name,
 		}
-		err := repo.Create(uni)
+		err := repo.Create(context.Background(), uni)
 		require.NoError(t, err, errMsgCreateUniversity+": %s", name)
 	}
 
@@ -280,11 +280,11 @@ func TestUniversitySearch(t *testing.T) {
 		},
 	}
 
-	require.NoError(t, repo.Create(uni1), errMsgCreateUniversity)
-	require.NoError(t, repo.Create(uni2), errMsgCreateUniversity)
+	require.NoError(t, repo.Create(context.Background(), uni1), errMsgCreateUniversity)
+	require.NoError(t, repo.Create(context.Background(), uni2), errMsgCreateUniversity)
 
 	t.Run("大学名で検索", func(t *testing.T) {
-		results, err := repo.Search("東京")
+		results, err := repo.Search(context.Background(), "東京")
 		require.NoError(t, err)
 		assert.NotEmpty(t, results, "大学名で検索してヒットしない")
 
@@ -300,7 +300,7 @@ func TestUniversitySearch(t *testing.T) {
 	})
 
 	t.Run("学部名で検索", func(t *testing.T) {
-		results, err := repo.Search("工学部")
+		results, err := repo.Search(context.Background(), "工学部")
 		require.NoError(t, err)
 		assert.NotEmpty(t, results, "学部名で検索してヒットしない")
 
@@ -316,7 +316,7 @@ func TestUniversitySearch(t *testing.T) {
 	})
 
 	t.Run("学科名で検索", func(t *testing.T) {
-		results, err := repo.Search("物理")
+		results, err := repo.Search(context.Background(), "物理")
 		require.NoError(t, err)
 		assert.NotEmpty(t, results, "学科名で検索してヒットしない")
 
@@ -341,12 +341,12 @@ func TestUniversityErrorCases(t *testing.T) {
 			BaseModel: models.BaseModel{Version: 1},
 			Name: "",
 		}
-		err := repo.Create(uni)
+		err := repo.Create(context.Background(), uni)
 		assert.Error(t, err, "空の大学名で作成はエラーとなるべき")
 	})
 
 	t.Run("空の検索クエリ", func(t *testing.T) {
-		results, err := repo.Search("")
+		results, err := repo.Search(context.Background(), "")
 		assert.Error(t, err, "空の検索クエリはエラーとなるべき")
 		assert.Nil(t, results, "空の検索クエリの結果はnilであるべき")
 	})
@@ -356,17 +356,17 @@ func TestUniversityErrorCases(t *testing.T) {
 			BaseModel: models.BaseModel{ID: 99999, Version: 1},
 			Name: "存在しない大学",
 		}
-		err := repo.Update(uni)
+		err := repo.Update(context.Background(), uni)
 		assert.Error(t, err, "存在しないIDでUpdateはエラーとなるべき")
 	})
 
 	t.Run("存在しないIDでDelete", func(t *testing.T) {
-		err := repo.Delete(99999)
+		err := repo.Delete(context.Background(), 99999)
 		assert.Error(t, err, "存在しないIDでDeleteはエラーとなるべき")
 	})
 
 	t.Run("存在しないIDでFindByID", func(t *testing.T) {
-		uni, err := repo.FindByID(99999)
+		uni, err := repo.FindByID(context.Background(), 99999)
 		assert.Error(t, err, "存在しないIDでFindByIDはエラーとなるべき")
 		assert.Nil(t, uni, "存在しないIDでFindByIDの結果はnilであるべき")
 	})
@@ -381,25 +381,25 @@ func TestUniversityCacheLogic(t *testing.T) {
 		BaseModel: models.BaseModel{Version: 1},
 		Name: "テスト大学",
 	}
-	err := repo.Create(uni)
+	err := repo.Create(context.Background(), uni)
 	require.NoError(t, err, errMsgCreateUniversity)
 
 	// 1回目のFindByID（キャッシュミス→DBアクセス）
-	found1, err := repo.FindByID(uni.ID)
+	found1, err := repo.FindByID(context.Background(), uni.ID)
 	require.NoError(t, err)
 	assert.Equal(t, uni.Name, found1.Name)
 
 	// 2回目のFindByID（キャッシュヒットを期待）
-	found2, err := repo.FindByID(uni.ID)
+	found2, err := repo.FindByID(context.Background(), uni.ID)
 	require.NoError(t, err)
 	assert.Equal(t, uni.Name, found2.Name)
 
 	// 大学を削除（キャッシュクリアされるはず）
-	err = repo.Delete(uni.ID)
+	err = repo.Delete(context.Background(), uni.ID)
 	require.NoError(t, err)
 
 	// 削除後のFindByID（キャッシュミス＋DBにも存在しない）
-	found3, err := repo.FindByID(uni.ID)
+	found3, err := repo.FindByID(context.Background(), uni.ID)
 	assert.Error(t, err)
 	assert.Nil(t, found3)
 }
@@ -434,12 +434,12 @@ func TestUniversitySearchWithMultipleResults(t *testing.T) {
 	}
 
 	for _, uni := range universities {
-		err := repo.Create(uni)
+		err := repo.Create(context.Background(), uni)
 		require.NoError(t, err, errMsgCreateUniversity)
 	}
 
 	// "東京"で検索
-	results, err := repo.Search("東京")
+	results, err := repo.Search(context.Background(), "東京")
 	require.NoError(t, err)
 	assert.Len(t, results, 2, "検索結果が2件であるべき")
 
@@ -459,7 +459,7 @@ func TestUniversitySearchWithNoResults(t *testing.T) {
 	defer cleanup()
 
 	// 存在しない大学名で検索
-	results, err := repo.Search("存在しない大学")
+	results, err := repo.Search(context.Background(), "存在しない大学")
 	require.NoError(t, err)
 	assert.Empty(t, results, "検索結果が空であるべき")
 }
@@ -470,7 +470,7 @@ func TestUniversitySearchWithSpecialCharacters(t *testing.T) {
 	defer cleanup()
 
 	// 特殊文字を含む大学名で検索
-	results, err := repo.Search("東京大学（本部）")
+	results, err := repo.Search(context.Background(), "東京大学（本部）")
 	require.NoError(t, err)
 	assert.Empty(t, results, "特殊文字を含む検索結果が空であるべき")
 }
@@ -491,19 +491,19 @@ func TestFindDepartment(t *testing.T) {
 			},
 		},
 	}
-	err := repo.Create(uni)
+	err := repo.Create(context.Background(), uni)
 	require.NoError(t, err, errMsgCreateUniversity)
 	require.NotZero(t, uni.ID)
 	require.NotZero(t, uni.Departments[0].ID)
 
 	// 正常系: 取得できる
-	dept, err := repo.FindDepartment(uni.ID, uni.Departments[0].ID)
+	dept, err := repo.FindDepartment(context.Background(), uni.ID, uni.Departments[0].ID)
 	require.NoError(t, err, "FindDepartmentでエラー")
 	require.NotNil(t, dept)
 	assert.Equal(t, testDeptName, dept.Name)
 
 	// 異常系: 存在しないID
-	_, err = repo.FindDepartment(uni.ID, 99999)
+	_, err = repo.FindDepartment(context.Background(), uni.ID, 99999)
 	assert.Error(t, err, "存在しない学部IDでエラーが返るべき")
 }
 
@@ -516,7 +516,7 @@ func TestFindSubject(t *testing.T) {
 		BaseModel: models.BaseModel{Version: 1},
 		Name: "テスト大学",
 	}
-	err := repo.Create(university)
+	err := repo.Create(context.Background(), university)
 	require.NoError(t, err, errMsgCreateUniversity)
 
 	department := &models.Department{
@@ -524,7 +524,7 @@ func TestFindSubject(t *testing.T) {
 		Name:         "テスト学部",
 		UniversityID: university.ID,
 	}
-	err = repo.CreateDepartment(department)
+	err = repo.CreateDepartment(context.Background(), department)
 	require.NoError(t, err, "学部の作成に失敗")
 
 	major := &models.Major{
@@ -532,7 +532,7 @@ func TestFindSubject(t *testing.T) {
 		Name:         "テスト学科",
 		DepartmentID: department.ID,
 	}
-	err = repo.CreateMajor(major)
+	err = repo.CreateMajor(context.Background(), major)
 	require.NoError(t, err, "学科の作成に失敗")
 
 	admissionSchedule := &models.AdmissionSchedule{
@@ -556,11 +556,11 @@ func TestFindSubject(t *testing.T) {
 			},
 		},
 	}
-	err = repo.UpdateAdmissionSchedule(admissionSchedule)
+	err = repo.UpdateAdmissionSchedule(context.Background(), admissionSchedule)
 	require.NoError(t, err, "入試日程の作成に失敗")
 
 	t.Run("正常系 - 存在する科目を取得", func(t *testing.T) {
-		result, err := repo.FindSubject(department.ID, admissionSchedule.TestTypes[0].Subjects[0].ID)
+		result, err := repo.FindSubject(context.Background(), department.ID, admissionSchedule.TestTypes[0].Subjects[0].ID)
 		require.NoError(t, err, "科目の取得に失敗")
 		require.NotNil(t, result, "科目が取得できませんでした")
 		assert.Equal(t, admissionSchedule.TestTypes[0].Subjects[0].ID, result.ID, "取得した科目のIDが一致しません")
@@ -569,14 +569,14 @@ func TestFindSubject(t *testing.T) {
 
 	t.Run("異常系 - 存在しない科目ID", func(t *testing.T) {
 		nonExistentID := uint(999)
-		result, err := repo.FindSubject(department.ID, nonExistentID)
+		result, err := repo.FindSubject(context.Background(), department.ID, nonExistentID)
 		assert.Error(t, err, "存在しない科目IDでエラーが発生しませんでした")
 		assert.Nil(t, result, "存在しない科目IDで結果が返されました")
 	})
 
 	t.Run("異常系 - 存在しない学部ID", func(t *testing.T) {
 		nonExistentID := uint(999)
-		result, err := repo.FindSubject(nonExistentID, admissionSchedule.TestTypes[0].Subjects[0].ID)
+		result, err := repo.FindSubject(context.Background(), nonExistentID, admissionSchedule.TestTypes[0].Subjects[0].ID)
 		assert.Error(t, err, "存在しない学部IDでエラーが発生しませんでした")
 		assert.Nil(t, result, "存在しない学部IDで結果が返されました")
 	})
@@ -597,7 +597,7 @@ func TestUpdateDepartment(t *testing.T) {
 			},
 		},
 	}
-	err := repo.Create(uni)
+	err := repo.Create(context.Background(), uni)
 	require.NoError(t, err, errMsgCreateUniversity)
 	require.NotZero(t, uni.ID)
 	require.NotZero(t, uni.Departments[0].ID)
@@ -605,11 +605,11 @@ func TestUpdateDepartment(t *testing.T) {
 	// 学部名を更新
 	department := uni.Departments[0]
 	department.Name = "更新後学部"
-	err = repo.UpdateDepartment(&department)
+	err = repo.UpdateDepartment(context.Background(), &department)
 	require.NoError(t, err, "学部の更新に失敗")
 
 	// 更新後の学部を取得して確認
-	updated, err := repo.FindDepartment(uni.ID, department.ID)
+	updated, err := repo.FindDepartment(context.Background(), uni.ID, department.ID)
 	require.NoError(t, err, "更新後の学部取得に失敗")
 	assert.Equal(t, "更新後学部", updated.Name, "学部名が更新されていない")
 
@@ -618,12 +618,12 @@ func TestUpdateDepartment(t *testing.T) {
 		BaseModel: models.BaseModel{ID: 99999, Version: 1},
 		Name: "存在しない学部",
 	}
-	err = repo.UpdateDepartment(&nonExistentDept)
+	err = repo.UpdateDepartment(context.Background(), &nonExistentDept)
 	assert.Error(t, err, "存在しない学部IDで更新はエラーとなるべき")
 
 	// 異常系: 空の学部名
 	department.Name = ""
-	err = repo.UpdateDepartment(&department)
+	err = repo.UpdateDepartment(context.Background(), &department)
 	assert.Error(t, err, "空の学部名で更新はエラーとなるべき")
 }
 
@@ -670,7 +670,7 @@ func TestSubjectAndMajorAndAdmissionInfoCRUD(t *testing.T) {
 			},
 		},
 	}
-	require.NoError(t, repo.Create(uni))
+	require.NoError(t, repo.Create(context.Background(), uni))
 	major := uni.Departments[0].Majors[0]
 	schedule := major.AdmissionSchedules[0]
 	testType := schedule.TestTypes[0]
@@ -678,11 +678,11 @@ func TestSubjectAndMajorAndAdmissionInfoCRUD(t *testing.T) {
 
 	// --- UpdateSubject ---
 	subject.Name = "英語（改）"
-	err := repo.UpdateSubject(&subject)
+	err := repo.UpdateSubject(context.Background(), &subject)
 	assert.NoError(t, err, "科目の更新に失敗")
 
 	// --- DeleteSubject ---
-	err = repo.DeleteSubject(subject.ID)
+	err = repo.DeleteSubject(context.Background(), subject.ID)
 	assert.NoError(t, err, "科目の削除に失敗")
 
 	// --- CreateMajor ---
@@ -691,21 +691,21 @@ func TestSubjectAndMajorAndAdmissionInfoCRUD(t *testing.T) {
 		Name: "新規学科",
 		DepartmentID: uni.Departments[0].ID,
 	}
-	err = repo.CreateMajor(newMajor)
+	err = repo.CreateMajor(context.Background(), newMajor)
 	assert.NoError(t, err, "学科の作成に失敗")
 
 	// --- UpdateMajor ---
 	newMajor.Name = "新規学科（改）"
-	err = repo.UpdateMajor(newMajor)
+	err = repo.UpdateMajor(context.Background(), newMajor)
 	assert.NoError(t, err, "学科の更新に失敗")
 
 	// --- FindMajor ---
-	foundMajor, err := repo.FindMajor(uni.Departments[0].ID, newMajor.ID)
+	foundMajor, err := repo.FindMajor(context.Background(), uni.Departments[0].ID, newMajor.ID)
 	assert.NoError(t, err, "学科の取得に失敗")
 	assert.Equal(t, "新規学科（改）", foundMajor.Name)
 
 	// --- DeleteMajor ---
-	err = repo.DeleteMajor(newMajor.ID)
+	err = repo.DeleteMajor(context.Background(), newMajor.ID)
 	assert.NoError(t, err, "学科の削除に失敗")
 
 	// --- CreateAdmissionInfo ---
@@ -716,21 +716,21 @@ func TestSubjectAndMajorAndAdmissionInfoCRUD(t *testing.T) {
 		AcademicYear: 2024,
 		Status: "published",
 	}
-	err = repo.CreateAdmissionInfo(admissionInfo)
+	err = repo.CreateAdmissionInfo(context.Background(), admissionInfo)
 	assert.NoError(t, err, "入試情報の作成に失敗")
 
 	// --- UpdateAdmissionInfo ---
 	admissionInfo.Enrollment = 20
-	err = repo.UpdateAdmissionInfo(admissionInfo)
+	err = repo.UpdateAdmissionInfo(context.Background(), admissionInfo)
 	assert.NoError(t, err, "入試情報の更新に失敗")
 
 	// --- FindAdmissionInfo ---
-	foundInfo, err := repo.FindAdmissionInfo(schedule.ID, admissionInfo.ID)
+	foundInfo, err := repo.FindAdmissionInfo(context.Background(), schedule.ID, admissionInfo.ID)
 	assert.NoError(t, err, "入試情報の取得に失敗")
 	assert.Equal(t, 20, foundInfo.Enrollment)
 
 	// --- DeleteAdmissionInfo ---
-	err = repo.DeleteAdmissionInfo(admissionInfo.ID)
+	err = repo.DeleteAdmissionInfo(context.Background(), admissionInfo.ID)
 	assert.NoError(t, err, "入試情報の削除に失敗")
 }
 
@@ -750,22 +750,22 @@ func TestDeleteDepartment(t *testing.T) {
 			},
 		},
 	}
-	err := repo.Create(uni)
+	err := repo.Create(context.Background(), uni)
 	require.NoError(t, err, errMsgCreateUniversity)
 	require.NotZero(t, uni.ID)
 	require.NotZero(t, uni.Departments[0].ID)
 
 	// 正常系: 削除できる
-	err = repo.DeleteDepartment(uni.Departments[0].ID)
+	err = repo.DeleteDepartment(context.Background(), uni.Departments[0].ID)
 	assert.NoError(t, err, "学部の削除に失敗")
 
 	// 削除後に取得できないことを確認
-	dept, err := repo.FindDepartment(uni.ID, uni.Departments[0].ID)
+	dept, err := repo.FindDepartment(context.Background(), uni.ID, uni.Departments[0].ID)
 	assert.Error(t, err, "削除済み学部の取得はエラーとなるべき")
 	assert.Nil(t, dept, "削除済み学部はnilであるべき")
 
 	// 異常系: 存在しないIDで削除
-	err = repo.DeleteDepartment(99999)
+	err = repo.DeleteDepartment(context.Background(), 99999)
 	// GORMのDeleteは存在しないIDでもエラーを返さないため、エラーはnilであることを確認
 	assert.NoError(t, err, "存在しないIDでDeleteDepartmentはエラーにならない（GORM仕様）")
 }
@@ -805,7 +805,7 @@ func TestCreateSubject(t *testing.T) {
 			},
 		},
 	}
-	err := repo.Create(uni)
+	err := repo.Create(context.Background(), uni)
 	require.NoError(t, err, errMsgCreateUniversity)
 
 	testType := uni.Departments[0].Majors[0].AdmissionSchedules[0].TestTypes[0]
@@ -821,7 +821,7 @@ func TestCreateSubject(t *testing.T) {
 	}
 
 	// 科目の作成をテスト
-	err = repo.CreateSubject(subject)
+	err = repo.CreateSubject(context.Background(), subject)
 	require.NoError(t, err)
 	assert.NotNil(t, subject)
 	assert.Equal(t, "数学", subject.Name)
@@ -839,7 +839,7 @@ func TestCreateSubject(t *testing.T) {
 		DisplayOrder: 1,
 	}
 
-	err = repo.CreateSubject(invalidSubject)
+	err = repo.CreateSubject(context.Background(), invalidSubject)
 	assert.Error(t, err)
 }
 
@@ -878,7 +878,7 @@ func TestUpdateSubjectsBatch(t *testing.T) {
 			},
 		},
 	}
-	err := repo.Create(uni)
+	err := repo.Create(context.Background(), uni)
 	require.NoError(t, err, errMsgCreateUniversity)
 
 	testType := uni.Departments[0].Majors[0].AdmissionSchedules[0].TestTypes[0]
@@ -901,9 +901,9 @@ func TestUpdateSubjectsBatch(t *testing.T) {
 		DisplayOrder: 2,
 	}
 
-	err = repo.CreateSubject(subject1)
+	err = repo.CreateSubject(context.Background(), subject1)
 	require.NoError(t, err)
-	err = repo.CreateSubject(subject2)
+	err = repo.CreateSubject(context.Background(), subject2)
 	require.NoError(t, err)
 
 	// 科目の更新
@@ -911,15 +911,15 @@ func TestUpdateSubjectsBatch(t *testing.T) {
 	subject2.Score = 200
 
 	// 一括更新のテスト
-	err = repo.UpdateSubjectsBatch(testType.ID, []models.Subject{*subject1, *subject2})
+	err = repo.UpdateSubjectsBatch(context.Background(), testType.ID, []models.Subject{*subject1, *subject2})
 	require.NoError(t, err)
 
 	// 更新の確認
-	updatedSubject1, err := repo.FindSubject(uni.Departments[0].ID, subject1.ID)
+	updatedSubject1, err := repo.FindSubject(context.Background(), uni.Departments[0].ID, subject1.ID)
 	require.NoError(t, err)
 	assert.Equal(t, 200, updatedSubject1.Score)
 
-	updatedSubject2, err := repo.FindSubject(uni.Departments[0].ID, subject2.ID)
+	updatedSubject2, err := repo.FindSubject(context.Background(), uni.Departments[0].ID, subject2.ID)
 	require.NoError(t, err)
 	assert.Equal(t, 200, updatedSubject2.Score)
 
@@ -933,7 +933,7 @@ func TestUpdateSubjectsBatch(t *testing.T) {
 		DisplayOrder: 3,
 	}
 
-	err = repo.UpdateSubjectsBatch(testType.ID, []models.Subject{*invalidSubject})
+	err = repo.UpdateSubjectsBatch(context.Background(), testType.ID, []models.Subject{*invalidSubject})
 	assert.Error(t, err)
 }
 
@@ -972,7 +972,7 @@ func TestUpdateSubjectsBatchProcessBatchAndRecalculateScoresErrorCases(t *testin
 			},
 		},
 	}
-	err := repo.Create(uni)
+	err := repo.Create(context.Background(), uni)
 	require.NoError(t, err, errMsgCreateUniversity)
 
 	testType := uni.Departments[0].Majors[0].AdmissionSchedules[0].TestTypes[0]
@@ -986,7 +986,7 @@ func TestUpdateSubjectsBatchProcessBatchAndRecalculateScoresErrorCases(t *testin
 		Percentage: 50.0,
 		DisplayOrder: 1,
 	}
-	err = repo.UpdateSubjectsBatch(testType.ID, []models.Subject{invalidSubject})
+	err = repo.UpdateSubjectsBatch(context.Background(), testType.ID, []models.Subject{invalidSubject})
 	assert.Error(t, err, "存在しないSubject IDを含むバッチはエラーとなるべき")
 
 	// --- recalculateScores: TestTypeに紐づくSubjectが存在しない場合 ---
@@ -1007,7 +1007,7 @@ func TestUpdateSubjectsBatchProcessBatchAndRecalculateScoresErrorCases(t *testin
 	db := ur.db
 	db.Create(&newTestType)
 	// 科目なしのTestTypeで一括更新（recalculateScoresで分岐）
-	err = repo.UpdateSubjectsBatch(newTestType.ID, []models.Subject{})
+	err = repo.UpdateSubjectsBatch(context.Background(), newTestType.ID, []models.Subject{})
 	assert.NoError(t, err, "科目が存在しない場合はエラーにならずスキップされる")
 }
 
@@ -1041,19 +1041,18 @@ func TestGetRelevantTestTypeScores(t *testing.T) {
 			},
 		},
 	}
-	err := repo.Create(uni)
+	err := repo.Create(context.Background(), uni)
 	require.NoError(t, err)
 
 	majorID := uni.Departments[0].Majors[0].ID
 
-	t.Run("admissionScheduleID==0の場合は0,0,nilを返す", func(t *testing.T) {
-		common, secondary, err := ur.getRelevantTestTypeScores(db, 0)
+	t.Run("admissionScheduleID==0の場合は空の結果を返す", func(t *testing.T) {
+		totals, err := ur.getRelevantTestTypeScores(db, 0)
 		assert.NoError(t, err)
-		assert.Equal(t, 0.0, common)
-		assert.Equal(t, 0.0, secondary)
+		assert.Empty(t, totals)
 	})
 
-	t.Run("該当するTestTypeが存在しない場合は0,0,nilを返す", func(t *testing.T) {
+	t.Run("該当するTestTypeが存在しない場合は空の結果を返す", func(t *testing.T) {
 		// 新規AdmissionScheduleを作成
 		schedule := models.AdmissionSchedule{
 			BaseModel: models.BaseModel{Version: 1},
@@ -1062,10 +1061,9 @@ func TestGetRelevantTestTypeScores(t *testing.T) {
 		}
 		err := db.Create(&schedule).Error
 		require.NoError(t, err)
-		common, secondary, err := ur.getRelevantTestTypeScores(db, schedule.ID)
+		totals, err := ur.getRelevantTestTypeScores(db, schedule.ID)
 		assert.NoError(t, err)
-		assert.Equal(t, 0.0, common)
-		assert.Equal(t, 0.0, secondary)
+		assert.Empty(t, totals)
 	})
 
 	t.Run("共通のみ存在する場合", func(t *testing.T) {
@@ -1094,10 +1092,10 @@ func TestGetRelevantTestTypeScores(t *testing.T) {
 		err = db.Create(&subject).Error
 		require.NoError(t, err)
 
-		common, secondary, err := ur.getRelevantTestTypeScores(db, schedule.ID)
+		totals, err := ur.getRelevantTestTypeScores(db, schedule.ID)
 		assert.NoError(t, err)
-		assert.Equal(t, 80.0, common)
-		assert.Equal(t, 0.0, secondary)
+		assert.Equal(t, 80.0, totals["共通"])
+		assert.Equal(t, 0.0, totals["二次"])
 	})
 
 	t.Run("二次のみ存在する場合", func(t *testing.T) {
@@ -1126,10 +1124,10 @@ func TestGetRelevantTestTypeScores(t *testing.T) {
 		err = db.Create(&subject).Error
 		require.NoError(t, err)
 
-		common, secondary, err := ur.getRelevantTestTypeScores(db, schedule.ID)
+		totals, err := ur.getRelevantTestTypeScores(db, schedule.ID)
 		assert.NoError(t, err)
-		assert.Equal(t, 0.0, common)
-		assert.Equal(t, 90.0, secondary)
+		assert.Equal(t, 0.0, totals["共通"])
+		assert.Equal(t, 90.0, totals["二次"])
 	})
 
 	t.Run("共通・二次両方存在する場合", func(t *testing.T) {
@@ -1175,10 +1173,10 @@ func TestGetRelevantTestTypeScores(t *testing.T) {
 		err = db.Create(&secondarySubject).Error
 		require.NoError(t, err)
 
-		common, secondary, err := ur.getRelevantTestTypeScores(db, schedule.ID)
+		totals, err := ur.getRelevantTestTypeScores(db, schedule.ID)
 		assert.NoError(t, err)
-		assert.Equal(t, 70.0, common)
-		assert.Equal(t, 60.0, secondary)
+		assert.Equal(t, 70.0, totals["共通"])
+		assert.Equal(t, 60.0, totals["二次"])
 	})
 
 	t.Run("DBエラー時はエラーを返す", func(t *testing.T) {
@@ -1188,7 +1186,7 @@ func TestGetRelevantTestTypeScores(t *testing.T) {
 		err = sqlDB.Close() // 明示的にクローズ
 		require.NoError(t, err)
 
-		_, _, err = ur.getRelevantTestTypeScores(db, 12345)
+		_, err = ur.getRelevantTestTypeScores(db, 12345)
 		assert.Error(t, err)
 	})
 }
@@ -1217,7 +1215,7 @@ func TestCreateTestUniversity(t *testing.T) {
 		require.NotEmpty(t, uni.Departments, "学部が空")
 
 		// 作成されたデータをDBから取得して検証
-		found, err := repo.FindByID(uni.ID)
+		found, err := repo.FindByID(context.Background(), uni.ID)
 		require.NoError(t, err, "作成した大学の取得に失敗")
 		require.NotNil(t, found, "取得した大学データがnil")
 		assert.Equal(t, uni.Name, found.Name, "大学名が一致しない")
@@ -1256,14 +1254,14 @@ func TestFindMajor(t *testing.T) {
 			},
 		},
 	}
-	err := repo.Create(uni)
+	err := repo.Create(context.Background(), uni)
 	require.NoError(t, err, errMsgCreateUniversity)
 
 	departmentID := uni.Departments[0].ID
 	majorID := uni.Departments[0].Majors[0].ID
 
 	t.Run("正常系：存在する学科を取得", func(t *testing.T) {
-		major, err := repo.FindMajor(departmentID, majorID)
+		major, err := repo.FindMajor(context.Background(), departmentID, majorID)
 		require.NoError(t, err)
 		assert.NotNil(t, major)
 		assert.Equal(t, "テスト学科", major.Name)
@@ -1271,14 +1269,14 @@ func TestFindMajor(t *testing.T) {
 
 	t.Run("異常系：存在しない学科ID", func(t *testing.T) {
 		nonExistentID := uint(99999)
-		major, err := repo.FindMajor(departmentID, nonExistentID)
+		major, err := repo.FindMajor(context.Background(), departmentID, nonExistentID)
 		assert.Error(t, err)
 		assert.Nil(t, major)
 	})
 
 	t.Run("異常系：存在しない学部ID", func(t *testing.T) {
 		nonExistentDeptID := uint(99999)
-		major, err := repo.FindMajor(nonExistentDeptID, majorID)
+		major, err := repo.FindMajor(context.Background(), nonExistentDeptID, majorID)
 		assert.Error(t, err)
 		assert.Nil(t, major)
 	})
@@ -1290,7 +1288,7 @@ func TestFindMajor(t *testing.T) {
 			Name: "別の学部",
 			UniversityID: uni.ID,
 		}
-		err := repo.CreateDepartment(otherDept)
+		err := repo.CreateDepartment(context.Background(), otherDept)
 		require.NoError(t, err)
 
 		otherMajor := &models.Major{
@@ -1298,11 +1296,11 @@ func TestFindMajor(t *testing.T) {
 			Name: "別の学科",
 			DepartmentID: otherDept.ID,
 		}
-		err = repo.CreateMajor(otherMajor)
+		err = repo.CreateMajor(context.Background(), otherMajor)
 		require.NoError(t, err)
 
 		// 異なる学部の学科IDで検索
-		major, err := repo.FindMajor(departmentID, otherMajor.ID)
+		major, err := repo.FindMajor(context.Background(), departmentID, otherMajor.ID)
 		assert.Error(t, err)
 		assert.Nil(t, major)
 	})
@@ -1344,14 +1342,14 @@ func TestFindAdmissionInfo(t *testing.T) {
 			},
 		},
 	}
-	err := repo.Create(uni)
+	err := repo.Create(context.Background(), uni)
 	require.NoError(t, err, errMsgCreateUniversity)
 
 	scheduleID := uni.Departments[0].Majors[0].AdmissionSchedules[0].ID
 	infoID := uni.Departments[0].Majors[0].AdmissionSchedules[0].AdmissionInfos[0].ID
 
 	t.Run("正常系：存在する入試情報を取得", func(t *testing.T) {
-		info, err := repo.FindAdmissionInfo(scheduleID, infoID)
+		info, err := repo.FindAdmissionInfo(context.Background(), scheduleID, infoID)
 		require.NoError(t, err)
 		assert.NotNil(t, info)
 		assert.Equal(t, 100, info.Enrollment)
@@ -1361,14 +1359,14 @@ func TestFindAdmissionInfo(t *testing.T) {
 
 	t.Run("異常系：存在しない入試情報ID", func(t *testing.T) {
 		nonExistentID := uint(99999)
-		info, err := repo.FindAdmissionInfo(scheduleID, nonExistentID)
+		info, err := repo.FindAdmissionInfo(context.Background(), scheduleID, nonExistentID)
 		assert.Error(t, err)
 		assert.Nil(t, info)
 	})
 
 	t.Run("異常系：存在しない入試日程ID", func(t *testing.T) {
 		nonExistentScheduleID := uint(99999)
-		info, err := repo.FindAdmissionInfo(nonExistentScheduleID, infoID)
+		info, err := repo.FindAdmissionInfo(context.Background(), nonExistentScheduleID, infoID)
 		assert.Error(t, err)
 		assert.Nil(t, info)
 	})
@@ -1389,11 +1387,11 @@ func TestFindAdmissionInfo(t *testing.T) {
 				},
 			},
 		}
-		err := repo.UpdateAdmissionSchedule(otherSchedule)
+		err := repo.UpdateAdmissionSchedule(context.Background(), otherSchedule)
 		require.NoError(t, err)
 
 		// 異なる入試日程の入試情報IDで検索
-		info, err := repo.FindAdmissionInfo(scheduleID, otherSchedule.AdmissionInfos[0].ID)
+		info, err := repo.FindAdmissionInfo(context.Background(), scheduleID, otherSchedule.AdmissionInfos[0].ID)
 		assert.Error(t, err)
 		assert.Nil(t, info)
 	})
@@ -0,0 +1,238 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// scheduleEventLabel はエラーメッセージに使用する入試日程の日程の名称です
+const scheduleEventLabel = "入試日程の日程"
+
+// CalendarEvent はカレンダーに出力する日程と、所属する大学・学部・学科・入試日程の名称です
+type CalendarEvent struct {
+	models.ScheduleEvent
+	UniversityName string
+	DepartmentName string
+	MajorID        uint
+	MajorName      string
+	ScheduleName   string
+}
+
+// ScheduleEventRepository は入試日程の年度ごとの日程を管理するリポジトリインターフェースです。
+// このインターフェースは以下の機能を提供します：
+// - 入試日程の日程の一覧取得・取得
+// - 作成・楽観的ロックによる更新・ソフトデリート
+// - 学科を指定したカレンダーの日程の取得
+type ScheduleEventRepository interface {
+	List(ctx context.Context, scheduleID uint, year int) ([]models.ScheduleEvent, error)
+	Find(ctx context.Context, scheduleID, eventID uint) (*models.ScheduleEvent, error)
+	Create(ctx context.Context, event *models.ScheduleEvent) error
+	Update(ctx context.Context, event *models.ScheduleEvent) error
+	Delete(ctx context.Context, scheduleID, eventID uint) error
+	FindCalendarEvents(ctx context.Context, majorIDs []uint, year int) ([]CalendarEvent, error)
+}
+
+// scheduleEventRepository はScheduleEventRepositoryの実装です
+type scheduleEventRepository struct {
+	db *gorm.DB
+}
+
+// NewScheduleEventRepository は新しいScheduleEventRepositoryを作成します
+func NewScheduleEventRepository(db *gorm.DB) ScheduleEventRepository {
+	return &scheduleEventRepository{db: db}
+}
+
+// List は入試日程の日程を年度・開始日の順に取得します。
+// 学年度に0を指定した場合は全ての年度の日程を取得します
+func (r *scheduleEventRepository) List(ctx context.Context, scheduleID uint, year int) ([]models.ScheduleEvent, error) {
+	events := make([]models.ScheduleEvent, 0)
+
+	query := r.db.WithContext(ctx).Where("admission_schedule_id = ?", scheduleID)
+	if year != 0 {
+		query = query.Where("academic_year = ?", year)
+	}
+
+	err := query.Order("academic_year DESC").Order("start_date ASC").Order("id ASC").Find(&events).Error
+	if err != nil {
+		return nil, appErrors.NewDatabaseError(scheduleEventLabel+"一覧取得処理", err, nil)
+	}
+
+	return events, nil
+}
+
+// Find は入試日程に属する日程を取得します
+func (r *scheduleEventRepository) Find(ctx context.Context, scheduleID, eventID uint) (*models.ScheduleEvent, error) {
+	var event models.ScheduleEvent
+
+	err := r.db.WithContext(ctx).Where("admission_schedule_id = ?", scheduleID).First(&event, eventID).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appErrors.NewNotFoundError(scheduleEventLabel, eventID, map[string]string{
+				"admission_schedule_id": strconv.FormatUint(uint64(scheduleID), 10),
+			})
+		}
+
+		return nil, appErrors.NewDatabaseError(scheduleEventLabel+"取得処理", err, nil)
+	}
+
+	return &event, nil
+}
+
+// Create は入試日程の日程を作成します。
+// 同じ入試日程・学年度・イベント種別の日程が登録済みの場合はバリデーションエラーを返します
+func (r *scheduleEventRepository) Create(ctx context.Context, event *models.ScheduleEvent) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := ensureUniqueScheduleEvent(tx, event); err != nil {
+			return err
+		}
+
+		if err := tx.Omit(clause.Associations).Create(event).Error; err != nil {
+			return appErrors.NewDatabaseError(scheduleEventLabel+"作成処理", err, nil)
+		}
+
+		return nil
+	})
+}
+
+// Update は入試日程の日程を楽観的ロックにより更新します。
+// 更新に成功した場合、日程のVersionは新しいバージョンになります
+func (r *scheduleEventRepository) Update(ctx context.Context, event *models.ScheduleEvent) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := ensureUniqueScheduleEvent(tx, event); err != nil {
+			return err
+		}
+
+		err := updateWithVersion[models.ScheduleEvent](tx, event, scheduleEventLabel, clause.Associations)
+		if err != nil {
+			var appErr *appErrors.Error
+			if errors.As(err, &appErr) {
+				return err
+			}
+
+			return appErrors.NewDatabaseError(scheduleEventLabel+"更新処理", err, nil)
+		}
+
+		return nil
+	})
+}
+
+// Delete は入試日程に属する日程をソフトデリートします
+func (r *scheduleEventRepository) Delete(ctx context.Context, scheduleID, eventID uint) error {
+	result := r.db.WithContext(ctx).
+		Where("admission_schedule_id = ?", scheduleID).
+		Delete(&models.ScheduleEvent{}, eventID)
+	if result.Error != nil {
+		return appErrors.NewDatabaseError(scheduleEventLabel+"削除処理", result.Error, nil)
+	}
+
+	if result.RowsAffected == 0 {
+		return appErrors.NewNotFoundError(scheduleEventLabel, eventID, nil)
+	}
+
+	return nil
+}
+
+// FindCalendarEvents は指定された学科の日程を、カレンダーに出力する名称とともに開始日の順に取得します。
+// この関数は以下の処理を行います：
+// - 学科の存在確認（存在しない場合や削除済みの場合はNotFoundエラー）
+// - 同じ学年度の入試情報が閲覧可能（公開中、またはプレビュー中）な日程の絞り込み
+// - 大学・学部・学科・入試日程の名称の取得
+// 学年度に0を指定した場合は全ての年度の日程を取得します
+func (r *scheduleEventRepository) FindCalendarEvents(
+	ctx context.Context,
+	majorIDs []uint,
+	year int,
+) ([]CalendarEvent, error) {
+	if err := r.ensureMajorsExist(ctx, majorIDs); err != nil {
+		return nil, err
+	}
+
+	visibleInfos := visibleAdmissionInfos(ctx, r.db.WithContext(ctx).Model(&models.AdmissionInfo{}).
+		Select("1").
+		Where("admission_infos.admission_schedule_id = schedule_events.admission_schedule_id").
+		Where("admission_infos.academic_year = schedule_events.academic_year"), "admission_infos.status")
+
+	query := r.db.WithContext(ctx).Table("schedule_events").
+		Select("schedule_events.*, universities.name AS university_name, departments.name AS department_name, "+
+			"majors.id AS major_id, majors.name AS major_name, admission_schedules.name AS schedule_name").
+		Joins("JOIN admission_schedules ON admission_schedules.id = schedule_events.admission_schedule_id").
+		Joins("JOIN majors ON majors.id = admission_schedules.major_id").
+		Joins("JOIN departments ON departments.id = majors.department_id").
+		Joins("JOIN universities ON universities.id = departments.university_id").
+		Where("majors.id IN ?", majorIDs).
+		Where("schedule_events.deleted_at IS NULL AND admission_schedules.deleted_at IS NULL").
+		Where("majors.deleted_at IS NULL AND departments.deleted_at IS NULL AND universities.deleted_at IS NULL").
+		Where("EXISTS (?)", visibleInfos)
+	if year != 0 {
+		query = query.Where("schedule_events.academic_year = ?", year)
+	}
+
+	events := make([]CalendarEvent, 0)
+
+	err := query.
+		Order("schedule_events.start_date ASC").
+		Order("majors.id ASC").
+		Order("admission_schedules.display_order ASC").
+		Order("schedule_events.id ASC").
+		Scan(&events).Error
+	if err != nil {
+		return nil, appErrors.NewDatabaseError("カレンダーの日程取得処理", err, nil)
+	}
+
+	return events, nil
+}
+
+// ensureMajorsExist は指定された学科が全て存在することを検証します
+func (r *scheduleEventRepository) ensureMajorsExist(ctx context.Context, majorIDs []uint) error {
+	var found []uint
+
+	err := r.db.WithContext(ctx).Model(&models.Major{}).
+		Where("majors.id IN ?", majorIDs).
+		Joins("JOIN departments ON departments.id = majors.department_id AND departments.deleted_at IS NULL").
+		Joins("JOIN universities ON universities.id = departments.university_id AND universities.deleted_at IS NULL").
+		Pluck("majors.id", &found).Error
+	if err != nil {
+		return appErrors.NewDatabaseError("学科検索処理", err, nil)
+	}
+
+	exists := make(map[uint]bool, len(found))
+	for _, id := range found {
+		exists[id] = true
+	}
+
+	for _, id := range majorIDs {
+		if !exists[id] {
+			return appErrors.NewNotFoundError("学科", id, nil)
+		}
+	}
+
+	return nil
+}
+
+// ensureUniqueScheduleEvent は同じ入試日程・学年度・イベント種別の日程が他に登録されていないことを検証します
+func ensureUniqueScheduleEvent(tx *gorm.DB, event *models.ScheduleEvent) error {
+	var count int64
+
+	err := tx.Model(&models.ScheduleEvent{}).
+		Where("admission_schedule_id = ? AND academic_year = ? AND type = ? AND id <> ?",
+			event.AdmissionScheduleID, event.AcademicYear, event.Type, event.ID).
+		Count(&count).Error
+	if err != nil {
+		return appErrors.NewDatabaseError(scheduleEventLabel+"の重複確認", err, nil)
+	}
+
+	if count > 0 {
+		return appErrors.NewValidationError("type", models.ScheduleEventLabel(event.Type)+"は既に登録されています", map[string]string{
+			"academic_year": strconv.Itoa(event.AcademicYear),
+			"type":          event.Type,
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,204 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/pkg/preview"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// findTestSchedule は学科の日程名が一致する入試日程を取得します
+func findTestSchedule(t *testing.T, db *gorm.DB, majorID uint, name string) models.AdmissionSchedule {
+	t.Helper()
+
+	var schedule models.AdmissionSchedule
+	require.NoError(t, db.Where("major_id = ? AND name = ?", majorID, name).First(&schedule).Error)
+
+	return schedule
+}
+
+// newTestScheduleEvent はテスト用の日程を生成します
+func newTestScheduleEvent(scheduleID uint, year int, eventType string, start models.Date) *models.ScheduleEvent {
+	return &models.ScheduleEvent{
+		BaseModel:           models.BaseModel{Version: 1},
+		AdmissionScheduleID: scheduleID,
+		AcademicYear:        year,
+		Type:                eventType,
+		StartDate:           start,
+	}
+}
+
+func TestScheduleEventRepository(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	path := setupAcademicYearTestData(t, db)
+	early := findTestSchedule(t, db, path.MajorID, "前")
+
+	repo := NewScheduleEventRepository(db)
+	ctx := context.Background()
+
+	end := models.NewDate(2024, 2, 2)
+	application := newTestScheduleEvent(early.ID, 2024, models.ScheduleEventApplication, models.NewDate(2024, 1, 22))
+	application.EndDate = &end
+	application.Note = "インターネット出願"
+
+	t.Run("作成した日程を取得できる", func(t *testing.T) {
+		require.NoError(t, repo.Create(ctx, application))
+		assert.NotZero(t, application.ID)
+
+		found, err := repo.Find(ctx, early.ID, application.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "2024-01-22", found.StartDate.String())
+		require.NotNil(t, found.EndDate)
+		assert.Equal(t, "2024-02-02", found.EndDate.String())
+		assert.Equal(t, "インターネット出願", found.Note)
+
+		_, err = repo.Find(ctx, early.ID+100, application.ID)
+		requireAppErrorCode(t, err, appErrors.CodeNotFound)
+	})
+
+	t.Run("同じ学年度・イベント種別の日程は登録できない", func(t *testing.T) {
+		duplicate := newTestScheduleEvent(early.ID, 2024, models.ScheduleEventApplication, models.NewDate(2024, 1, 23))
+		appErr := requireAppErrorCode(t, repo.Create(ctx, duplicate), appErrors.CodeValidationError)
+		assert.Equal(t, "application", appErr.Details.Extra["type"])
+
+		// 別の学年度であれば登録できる
+		nextYear := newTestScheduleEvent(early.ID, 2025, models.ScheduleEventApplication, models.NewDate(2025, 1, 20))
+		require.NoError(t, repo.Create(ctx, nextYear))
+	})
+
+	t.Run("学年度で絞り込んで開始日の順に取得できる", func(t *testing.T) {
+		exam := newTestScheduleEvent(early.ID, 2024, models.ScheduleEventExam, models.NewDate(2024, 2, 25))
+		require.NoError(t, repo.Create(ctx, exam))
+
+		events, err := repo.List(ctx, early.ID, 2024)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		assert.Equal(t, models.ScheduleEventApplication, events[0].Type)
+		assert.Equal(t, models.ScheduleEventExam, events[1].Type)
+
+		all, err := repo.List(ctx, early.ID, 0)
+		require.NoError(t, err)
+		require.Len(t, all, 3)
+		assert.Equal(t, 2025, all[0].AcademicYear, "新しい年度から順に並ぶ")
+	})
+
+	t.Run("バージョンが一致する場合のみ更新できる", func(t *testing.T) {
+		update := *application
+		update.StartDate = models.NewDate(2024, 1, 24)
+		require.NoError(t, repo.Update(ctx, &update))
+		assert.Equal(t, application.Version+1, update.Version)
+
+		found, err := repo.Find(ctx, early.ID, application.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "2024-01-24", found.StartDate.String())
+
+		stale := *application
+		requireAppErrorCode(t, repo.Update(ctx, &stale), appErrors.CodeConflict)
+	})
+
+	t.Run("更新で他の日程と同じイベント種別にはできない", func(t *testing.T) {
+		found, err := repo.Find(ctx, early.ID, application.ID)
+		require.NoError(t, err)
+
+		found.Type = models.ScheduleEventExam
+		requireAppErrorCode(t, repo.Update(ctx, found), appErrors.CodeValidationError)
+	})
+
+	t.Run("削除した日程は取得できない", func(t *testing.T) {
+		events, err := repo.List(ctx, early.ID, 2025)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+
+		require.NoError(t, repo.Delete(ctx, early.ID, events[0].ID))
+
+		_, err = repo.Find(ctx, early.ID, events[0].ID)
+		requireAppErrorCode(t, err, appErrors.CodeNotFound)
+		requireAppErrorCode(t, repo.Delete(ctx, early.ID, events[0].ID), appErrors.CodeNotFound)
+
+		// 削除した日程と同じ学年度・イベント種別の日程は再び登録できる
+		recreated := newTestScheduleEvent(early.ID, 2025, models.ScheduleEventApplication, models.NewDate(2025, 1, 21))
+		require.NoError(t, repo.Create(ctx, recreated))
+	})
+}
+
+func TestScheduleEventRepositoryFindCalendarEvents(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	path := setupAcademicYearTestData(t, db)
+	early := findTestSchedule(t, db, path.MajorID, "前")
+	late := findTestSchedule(t, db, path.MajorID, "後")
+
+	// 後期の2026年度の入試情報は下書き
+	require.NoError(t, db.Create(&models.AdmissionInfo{
+		BaseModel:           models.BaseModel{Version: 1},
+		AdmissionScheduleID: late.ID,
+		Enrollment:          10,
+		AcademicYear:        2026,
+		Status:              models.AdmissionStatusDraft,
+	}).Error)
+
+	repo := NewScheduleEventRepository(db)
+	ctx := context.Background()
+
+	for _, event := range []*models.ScheduleEvent{
+		newTestScheduleEvent(late.ID, 2024, models.ScheduleEventExam, models.NewDate(2024, 3, 12)),
+		newTestScheduleEvent(early.ID, 2024, models.ScheduleEventExam, models.NewDate(2024, 2, 25)),
+		newTestScheduleEvent(early.ID, 2025, models.ScheduleEventExam, models.NewDate(2025, 2, 25)),
+		newTestScheduleEvent(late.ID, 2026, models.ScheduleEventExam, models.NewDate(2026, 3, 12)),
+		// 入試情報のない年度の日程は出力しない
+		newTestScheduleEvent(early.ID, 2027, models.ScheduleEventExam, models.NewDate(2027, 2, 25)),
+	} {
+		require.NoError(t, repo.Create(ctx, event))
+	}
+
+	t.Run("公開中の入試情報を持つ年度の日程を開始日の順に取得する", func(t *testing.T) {
+		events, err := repo.FindCalendarEvents(ctx, []uint{path.MajorID}, 0)
+		require.NoError(t, err)
+		require.Len(t, events, 3)
+
+		assert.Equal(t, "2024-02-25", events[0].StartDate.String())
+		assert.Equal(t, "東京大学", events[0].UniversityName)
+		assert.Equal(t, "工学部", events[0].DepartmentName)
+		assert.Equal(t, "機械工学科", events[0].MajorName)
+		assert.Equal(t, path.MajorID, events[0].MajorID)
+		assert.Equal(t, "前", events[0].ScheduleName)
+		assert.Equal(t, "後", events[1].ScheduleName)
+		assert.Equal(t, 2025, events[2].AcademicYear)
+	})
+
+	t.Run("学年度で絞り込める", func(t *testing.T) {
+		events, err := repo.FindCalendarEvents(ctx, []uint{path.MajorID}, 2025)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, early.ID, events[0].AdmissionScheduleID)
+	})
+
+	t.Run("プレビュー中は下書きの入試情報の年度の日程も取得する", func(t *testing.T) {
+		events, err := repo.FindCalendarEvents(preview.WithDrafts(ctx), []uint{path.MajorID}, 2026)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, late.ID, events[0].AdmissionScheduleID)
+	})
+
+	t.Run("存在しない学科を指定した場合はNotFound", func(t *testing.T) {
+		_, err := repo.FindCalendarEvents(ctx, []uint{path.MajorID, 9999}, 0)
+		requireAppErrorCode(t, err, appErrors.CodeNotFound)
+	})
+
+	t.Run("入試日程を削除すると日程もカレンダーから除かれる", func(t *testing.T) {
+		require.NoError(t, NewUniversityRepository(db).DeleteAdmissionSchedule(ctx, late.ID))
+
+		events, err := repo.FindCalendarEvents(ctx, []uint{path.MajorID}, 0)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+
+		var trashed int64
+		require.NoError(t, db.Unscoped().Model(&models.ScheduleEvent{}).
+			Where("admission_schedule_id = ? AND deleted_at IS NOT NULL", late.ID).Count(&trashed).Error)
+		assert.Equal(t, int64(2), trashed)
+	})
+}
@@ -0,0 +1,251 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+
+	"gorm.io/gorm"
+)
+
+// SubjectScore は受験者が入力する試験種別・科目ごとの得点を表現する構造体です
+// 得点は満点に対する得点率として、各学科の換算後の配点に当てはめます
+type SubjectScore struct {
+	TestType string  `json:"test_type"`
+	Subject  string  `json:"subject"`
+	Score    float64 `json:"score"`     // 得点
+	MaxScore float64 `json:"max_score"` // 得点の満点
+}
+
+// key は試験種別・科目名を表すキーを返します
+func (s SubjectScore) key() string {
+	return SubjectWeight{TestType: s.TestType, Subject: s.Subject}.key()
+}
+
+// SimulationCriteria は得点シミュレーションの条件を表現する構造体です
+// 学科IDとファセット検索の条件で候補を絞り込んだ上で、入力した得点から各入試日程の合計点を算出します
+type SimulationCriteria struct {
+	FacetSearchCriteria
+	MajorIDs     []uint         // 対象の学科ID（空の場合はファセット条件のみで絞り込み）
+	Scores       []SubjectScore // 入力した得点
+	AcademicYear int            // 学年度（0の場合は年度に紐付かない試験種別を使用）
+	Limit        int            // 取得件数
+}
+
+// SimulatedSubject は科目ごとの得点の換算結果を表現する構造体です
+type SimulatedSubject struct {
+	TestType string  `json:"test_type"`
+	Subject  string  `json:"subject"`
+	Score    float64 `json:"score"`     // 換算後の得点
+	MaxScore float64 `json:"max_score"` // 換算後の配点
+	Entered  bool    `json:"entered"`   // 得点が入力されたか
+	Selected bool    `json:"selected"`  // 合計点に含めたか（選択科目群で選ばれなかった科目はfalse）
+}
+
+// SimulationResult は得点シミュレーションの結果となる学科・入試日程を表現する構造体です
+type SimulationResult struct {
+	Rank       int                `json:"rank"`
+	University YearScopedEntity   `json:"university"`
+	Department YearScopedEntity   `json:"department"`
+	Major      YearScopedEntity   `json:"major"`
+	Schedule   YearScopedEntity   `json:"schedule"`
+	Score      float64            `json:"score"`      // 換算後の合計点
+	MaxScore   float64            `json:"max_score"`  // 換算後の満点
+	Percentage float64            `json:"percentage"` // 満点に対する得点率
+	Subjects   []SimulatedSubject `json:"subjects"`
+}
+
+// ScoreSimulationRepository は得点シミュレーションのリポジトリインターフェースです
+type ScoreSimulationRepository interface {
+	SimulateScores(ctx context.Context, criteria SimulationCriteria) ([]SimulationResult, error)
+}
+
+// scoreSimulationRepository はScoreSimulationRepositoryの実装です
+type scoreSimulationRepository struct {
+	db     *gorm.DB
+	facets *facetSearchRepository
+}
+
+// NewScoreSimulationRepository は新しいScoreSimulationRepositoryを作成します
+func NewScoreSimulationRepository(db *gorm.DB) ScoreSimulationRepository {
+	return &scoreSimulationRepository{
+		db:     db,
+		facets: &facetSearchRepository{db: db},
+	}
+}
+
+// SimulateScores は入力した得点から学科・入試日程ごとの合計点を算出し、得点率の降順で取得します。
+// この関数は以下の処理を行います：
+// - 学科ID・ファセット条件による候補の入試日程の絞り込み
+// - 試験種別レジストリで分母に含める試験種別の科目への得点の当てはめ
+// - 選択科目群は得点の高い順に選択数の科目を合計
+// - 得点率による並び替えと順位付け
+func (r *scoreSimulationRepository) SimulateScores(
+	ctx context.Context,
+	criteria SimulationCriteria,
+) ([]SimulationResult, error) {
+	facetCriteria, err := r.facets.resolveQuery(ctx, criteria.FacetSearchCriteria)
+	if err != nil {
+		return nil, err
+	}
+
+	query := r.facets.filteredSchedules(ctx, facetCriteria, criteria.AcademicYear)
+	if len(criteria.MajorIDs) > 0 {
+		query = query.Where("majors.id IN ?", criteria.MajorIDs)
+	}
+
+	var schedules []models.AdmissionSchedule
+	if err := query.Find(&schedules).Error; err != nil {
+		return nil, appErrors.NewDatabaseError("得点シミュレーション処理", fmt.Errorf(errSearchFailed, err), nil)
+	}
+
+	rates := scoreRates(criteria.Scores)
+	results := make([]SimulationResult, 0, len(schedules))
+
+	for _, schedule := range schedules {
+		result := simulateSchedule(schedule, rates)
+		if result.MaxScore == 0 {
+			continue
+		}
+
+		major := schedule.Major
+		department := major.Department
+		university := department.University
+
+		result.University = YearScopedEntity{ID: university.ID, Name: university.Name}
+		result.Department = YearScopedEntity{ID: department.ID, Name: department.Name}
+		result.Major = YearScopedEntity{ID: major.ID, Name: major.Name}
+		result.Schedule = YearScopedEntity{ID: schedule.ID, Name: schedule.Name}
+
+		results = append(results, result)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Percentage != results[j].Percentage {
+			return results[i].Percentage > results[j].Percentage
+		}
+
+		return results[i].Schedule.ID < results[j].Schedule.ID
+	})
+
+	// 得点率が同じ場合は同順位とする
+	for i := range results {
+		results[i].Rank = i + 1
+		if i > 0 && results[i].Percentage == results[i-1].Percentage {
+			results[i].Rank = results[i-1].Rank
+		}
+	}
+
+	if criteria.Limit > 0 && len(results) > criteria.Limit {
+		results = results[:criteria.Limit]
+	}
+
+	return results, nil
+}
+
+// scoreRates は入力した得点を試験種別・科目名をキーとする得点率に変換します
+func scoreRates(scores []SubjectScore) map[string]float64 {
+	rates := make(map[string]float64, len(scores))
+	for _, s := range scores {
+		if s.MaxScore > 0 {
+			rates[s.key()] = s.Score / s.MaxScore
+		}
+	}
+
+	return rates
+}
+
+// groupMember は選択科目群に所属する科目の、試験種別の科目と算出結果における位置を表現する構造体です
+type groupMember struct {
+	subject int
+	result  int
+}
+
+// simulateSchedule は入試日程の科目に得点率を当てはめ、合計点と満点を算出します
+// 得点が入力されていない科目は0点とし、選択科目群に属する科目は換算後の得点の高い順に選択数の科目を合計します
+func simulateSchedule(schedule models.AdmissionSchedule, rates map[string]float64) SimulationResult {
+	var result SimulationResult
+
+	for _, testType := range scheduleTestTypes(schedule) {
+		if !models.TestTypes().CountsTowardTotal(testType.Name) {
+			continue
+		}
+
+		groups := make(map[uint]models.SubjectGroup, len(testType.SubjectGroups))
+		for _, g := range testType.SubjectGroups {
+			groups[g.ID] = g
+		}
+
+		members := make(map[uint][]groupMember)
+
+		for i, subject := range testType.Subjects {
+			subject.ApplyConversion()
+
+			rate, entered := rates[SubjectWeight{TestType: testType.Name, Subject: subject.Name}.key()]
+			simulated := SimulatedSubject{
+				TestType: testType.Name,
+				Subject:  subject.Name,
+				Score:    roundScore(rate * subject.EffectiveScore),
+				MaxScore: subject.EffectiveScore,
+				Entered:  entered,
+			}
+
+			result.Subjects = append(result.Subjects, simulated)
+			index := len(result.Subjects) - 1
+
+			if subject.SubjectGroupID != nil {
+				if _, ok := groups[*subject.SubjectGroupID]; ok {
+					members[*subject.SubjectGroupID] = append(members[*subject.SubjectGroupID], groupMember{subject: i, result: index})
+					continue
+				}
+			}
+
+			result.Subjects[index].Selected = true
+			result.Score += simulated.Score
+			result.MaxScore += simulated.MaxScore
+		}
+
+		for _, g := range testType.SubjectGroups {
+			group := members[g.ID]
+			if len(group) == 0 {
+				continue
+			}
+
+			groupSubjects := make([]models.Subject, 0, len(group))
+			for _, m := range group {
+				groupSubjects = append(groupSubjects, testType.Subjects[m.subject])
+			}
+
+			// 受験者に有利になるよう、換算後の得点の高い順に選択数の科目を選ぶ
+			sort.SliceStable(group, func(i, j int) bool {
+				return result.Subjects[group[i].result].Score > result.Subjects[group[j].result].Score
+			})
+
+			for rank, m := range group {
+				if rank < g.ChooseCount {
+					result.Subjects[m.result].Selected = true
+					result.Score += result.Subjects[m.result].Score
+				}
+			}
+
+			result.MaxScore += models.GroupEffectiveScore(groupSubjects, g.ChooseCount)
+		}
+	}
+
+	result.Score = roundScore(result.Score)
+	result.MaxScore = roundScore(result.MaxScore)
+
+	if result.MaxScore > 0 {
+		result.Percentage = roundScore(result.Score / result.MaxScore * 100)
+	}
+
+	return result
+}
+
+// roundScore は得点を小数点以下2桁に丸めます
+func roundScore(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,119 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"university-exam-api/internal/domain/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScoreSimulationRepositorySimulateScores(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	setupSimilarityTestData(t, db)
+
+	repo := NewScoreSimulationRepository(db)
+	// 共通テストの英語は8割、二次試験の数学は3割
+	scores := []SubjectScore{
+		{TestType: "共通", Subject: "英語", Score: 160, MaxScore: 200},
+		{TestType: "二次", Subject: "数学", Score: 30, MaxScore: 100},
+	}
+
+	t.Run("得点率の降順で取得", func(t *testing.T) {
+		results, err := repo.SimulateScores(context.Background(), SimulationCriteria{
+			FacetSearchCriteria: FacetSearchCriteria{Classifications: []string{"国公立", "私立"}},
+			Scores:              scores,
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+
+		// 東京大学: 英語500点×0.8 + 数学500点×0.3
+		assert.Equal(t, 1, results[0].Rank)
+		assert.Equal(t, "東京大学", results[0].University.Name)
+		assert.Equal(t, "機械工学科", results[0].Major.Name)
+		assert.Equal(t, 550.0, results[0].Score)
+		assert.Equal(t, 1000.0, results[0].MaxScore)
+		assert.Equal(t, 55.0, results[0].Percentage)
+		require.Len(t, results[0].Subjects, 2)
+		assert.True(t, results[0].Subjects[0].Entered)
+
+		// 京都大学: 英語200点×0.8 + 数学800点×0.3
+		assert.Equal(t, "京都大学", results[1].University.Name)
+		assert.Equal(t, 40.0, results[1].Percentage)
+
+		// 早稲田大学: 二次試験の英語は未入力のため0点
+		assert.Equal(t, "早稲田大学", results[2].University.Name)
+		assert.Equal(t, 0.0, results[2].Percentage)
+		assert.False(t, results[2].Subjects[0].Entered)
+	})
+
+	t.Run("学科IDによる絞り込みと件数制限", func(t *testing.T) {
+		var major models.Major
+		require.NoError(t, db.
+			Joins("JOIN departments ON departments.id = majors.department_id").
+			Joins("JOIN universities ON universities.id = departments.university_id").
+			Where("universities.name = ?", "京都大学").
+			Take(&major).Error)
+
+		results, err := repo.SimulateScores(context.Background(), SimulationCriteria{
+			MajorIDs: []uint{major.ID},
+			Scores:   scores,
+			Limit:    1,
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, "京都大学", results[0].University.Name)
+	})
+
+	t.Run("入試情報のない年度", func(t *testing.T) {
+		results, err := repo.SimulateScores(context.Background(), SimulationCriteria{
+			Scores:       scores,
+			AcademicYear: 2025,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, results)
+	})
+}
+
+func TestSimulateSchedule(t *testing.T) {
+	groupID := uint(10)
+	schedule := models.AdmissionSchedule{
+		TestTypes: []models.TestType{
+			{
+				Name: "共通",
+				Subjects: []models.Subject{
+					{BaseModel: models.BaseModel{ID: 1}, Name: "英語", Score: 200, ConversionRatio: 0.5},
+					{BaseModel: models.BaseModel{ID: 2}, Name: "物理", Score: 100, SubjectGroupID: &groupID},
+					{BaseModel: models.BaseModel{ID: 3}, Name: "化学", Score: 100, SubjectGroupID: &groupID},
+					{BaseModel: models.BaseModel{ID: 4}, Name: "生物", Score: 100, SubjectGroupID: &groupID},
+				},
+				SubjectGroups: []models.SubjectGroup{{BaseModel: models.BaseModel{ID: groupID}, ChooseCount: 2}},
+			},
+			{
+				Name:     "英語外部試験",
+				Subjects: []models.Subject{{Name: "英検", Score: 100}},
+			},
+		},
+	}
+
+	result := simulateSchedule(schedule, scoreRates([]SubjectScore{
+		{TestType: "共通", Subject: "英語", Score: 150, MaxScore: 200},
+		{TestType: "共通", Subject: "物理", Score: 40, MaxScore: 100},
+		{TestType: "共通", Subject: "化学", Score: 90, MaxScore: 100},
+		{TestType: "共通", Subject: "生物", Score: 70, MaxScore: 100},
+		{TestType: "英語外部試験", Subject: "英検", Score: 100, MaxScore: 100},
+	}))
+
+	// 英語100点×0.75 + 理科は得点の高い化学・生物を選ぶ（分母に含めない試験種別は除外）
+	assert.Equal(t, 235.0, result.Score)
+	assert.Equal(t, 300.0, result.MaxScore)
+	assert.Equal(t, 78.33, result.Percentage)
+
+	require.Len(t, result.Subjects, 4)
+	assert.Equal(t, 75.0, result.Subjects[0].Score)
+	assert.Equal(t, 100.0, result.Subjects[0].MaxScore)
+	assert.False(t, result.Subjects[1].Selected, "得点の低い物理は合計点に含めない")
+	assert.True(t, result.Subjects[2].Selected)
+	assert.True(t, result.Subjects[3].Selected)
+}
@@ -0,0 +1,214 @@
+package repositories
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"university-exam-api/internal/pkg/textsearch"
+
+	"gorm.io/gorm"
+)
+
+// searchIndexState は大学検索・入力補完のインデックスとデータベースの同期状態を管理する構造体です
+// 大学・学部・学科の変更時に世代を進め、次回の検索時にインデックスを再構築します
+type searchIndexState struct {
+	mu         sync.Mutex
+	index      textsearch.Index
+	suggest    textsearch.SuggestIndex
+	generation atomic.Uint64
+	built      uint64
+}
+
+// newSearchIndexState は未構築状態の検索インデックスを生成します
+func newSearchIndexState(index textsearch.Index, suggest textsearch.SuggestIndex) *searchIndexState {
+	state := &searchIndexState{index: index, suggest: suggest}
+	state.generation.Store(1)
+
+	return state
+}
+
+// newDefaultSearchIndexState は既定の略称辞書を使用するメモリ上のインデックスを生成します
+func newDefaultSearchIndexState() *searchIndexState {
+	aliases := textsearch.DefaultAliases()
+
+	return newSearchIndexState(textsearch.NewMemoryIndex(aliases), textsearch.NewTrieSuggestIndex(aliases))
+}
+
+// invalidate は検索インデックスを再構築が必要な状態にします
+func (s *searchIndexState) invalidate() {
+	if s == nil {
+		return
+	}
+
+	s.generation.Add(1)
+}
+
+// refresh はインデックスが古くなっている場合にデータベースから再構築します
+func (s *searchIndexState) refresh(ctx context.Context, db *gorm.DB) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	generation := s.generation.Load()
+	if s.built == generation {
+		return nil
+	}
+
+	docs, err := loadSearchDocuments(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	if err := s.index.Rebuild(ctx, docs); err != nil {
+		return err
+	}
+
+	fields, err := loadAcademicFieldEntries(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	if err := s.suggest.Rebuild(ctx, suggestEntries(docs, fields)); err != nil {
+		return err
+	}
+
+	s.built = generation
+
+	return nil
+}
+
+// search は必要に応じてインデックスを再構築した上で検索を実行します
+func (s *searchIndexState) search(ctx context.Context, db *gorm.DB, query string) ([]textsearch.Hit, error) {
+	if err := s.refresh(ctx, db); err != nil {
+		return nil, err
+	}
+
+	return s.index.Search(ctx, query)
+}
+
+// suggestions は必要に応じてインデックスを再構築した上で入力補完の候補を取得します
+func (s *searchIndexState) suggestions(
+	ctx context.Context,
+	db *gorm.DB,
+	prefix string,
+	types []string,
+	limit int,
+) (textsearch.Suggestions, error) {
+	if err := s.refresh(ctx, db); err != nil {
+		return nil, err
+	}
+
+	return s.suggest.Suggest(ctx, prefix, types, limit)
+}
+
+// searchDocumentRow は検索インデックスの構築に使用する大学・学部・学科名の行です
+type searchDocumentRow struct {
+	UniversityID   uint
+	UniversityName string
+	DepartmentName *string
+	MajorName      *string
+}
+
+// loadSearchDocuments は削除されていない大学・学部・学科名から検索対象の文書を生成します
+func loadSearchDocuments(ctx context.Context, db *gorm.DB) ([]textsearch.Document, error) {
+	var rows []searchDocumentRow
+
+	err := db.WithContext(ctx).Table("universities").
+		Select("universities.id AS university_id, universities.name AS university_name, " +
+			"departments.name AS department_name, majors.name AS major_name").
+		Joins("LEFT JOIN departments ON departments.university_id = universities.id AND departments.deleted_at IS NULL").
+		Joins("LEFT JOIN majors ON majors.department_id = departments.id AND majors.deleted_at IS NULL").
+		Where("universities.deleted_at IS NULL").
+		Order("universities.id ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	docs := make([]textsearch.Document, 0)
+	seen := make(map[string]bool)
+
+	for _, row := range rows {
+		if len(docs) == 0 || docs[len(docs)-1].ID != row.UniversityID {
+			docs = append(docs, textsearch.Document{ID: row.UniversityID, Name: row.UniversityName})
+			seen = make(map[string]bool)
+		}
+
+		doc := &docs[len(docs)-1]
+
+		if row.DepartmentName != nil && !seen["d:"+*row.DepartmentName] {
+			seen["d:"+*row.DepartmentName] = true
+			doc.Departments = append(doc.Departments, *row.DepartmentName)
+		}
+
+		if row.MajorName != nil && !seen["m:"+*row.MajorName] {
+			seen["m:"+*row.MajorName] = true
+			doc.Majors = append(doc.Majors, *row.MajorName)
+		}
+	}
+
+	return docs, nil
+}
+
+// loadAcademicFieldEntries は削除されていない学問系統名を入力補完の候補として取得します
+func loadAcademicFieldEntries(ctx context.Context, db *gorm.DB) ([]textsearch.SuggestEntry, error) {
+	entries := make([]textsearch.SuggestEntry, 0)
+
+	err := db.WithContext(ctx).Table("academic_fields").
+		Select("departments.university_id AS university_id, academic_fields.name AS text").
+		Joins("JOIN majors ON majors.id = academic_fields.major_id").
+		Joins("JOIN departments ON departments.id = majors.department_id").
+		Joins("JOIN universities ON universities.id = departments.university_id").
+		Where("academic_fields.deleted_at IS NULL AND majors.deleted_at IS NULL").
+		Where("departments.deleted_at IS NULL AND universities.deleted_at IS NULL").
+		Scan(&entries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range entries {
+		entries[i].Type = textsearch.SuggestTypeAcademicField
+	}
+
+	return entries, nil
+}
+
+// suggestEntries は検索対象の文書と学問系統から入力補完の候補を生成します
+func suggestEntries(docs []textsearch.Document, fields []textsearch.SuggestEntry) []textsearch.SuggestEntry {
+	entries := make([]textsearch.SuggestEntry, 0, len(docs)+len(fields))
+
+	for _, doc := range docs {
+		entries = append(entries, textsearch.SuggestEntry{
+			Type:         textsearch.SuggestTypeUniversity,
+			Text:         doc.Name,
+			UniversityID: doc.ID,
+		})
+
+		for _, name := range doc.Departments {
+			entries = append(entries, textsearch.SuggestEntry{
+				Type:         textsearch.SuggestTypeDepartment,
+				Text:         name,
+				UniversityID: doc.ID,
+			})
+		}
+
+		for _, name := range doc.Majors {
+			entries = append(entries, textsearch.SuggestEntry{
+				Type:         textsearch.SuggestTypeMajor,
+				Text:         name,
+				UniversityID: doc.ID,
+			})
+		}
+	}
+
+	return append(entries, fields...)
+}
+
+// hitIDs は検索結果の大学IDを関連度の順に返します
+func hitIDs(hits []textsearch.Hit) []uint {
+	ids := make([]uint, 0, len(hits))
+	for _, hit := range hits {
+		ids = append(ids, hit.ID)
+	}
+
+	return ids
+}
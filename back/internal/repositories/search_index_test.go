@@ -0,0 +1,142 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"university-exam-api/internal/domain/models"
+	"university-exam-api/internal/pkg/pagination"
+	"university-exam-api/internal/pkg/textsearch"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadSearchDocuments(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	setupFacetTestData(t, db)
+
+	docs, err := loadSearchDocuments(context.Background(), db)
+	require.NoError(t, err)
+	require.Len(t, docs, 3)
+
+	assert.Equal(t, "東京大学", docs[0].Name)
+	assert.Equal(t, []string{"工学部"}, docs[0].Departments)
+	assert.Equal(t, []string{"機械工学科"}, docs[0].Majors)
+}
+
+func TestSearchPageRelevance(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	setupFacetTestData(t, db)
+
+	repo := NewUniversityRepository(db)
+	relevance := newPaginationParams(10, pagination.SortRelevance, pagination.OrderAsc)
+
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{name: "略称", query: "東大", want: []string{"東京大学"}},
+		{name: "全角英数字・空白の表記ゆれ", query: "　早稲田　", want: []string{"早稲田大学"}},
+		{name: "大学名を学部名より上位", query: "京", want: []string{"京都大学", "東京大学"}},
+		{name: "ワイルドカード文字", query: "100%", want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := repo.SearchPage(context.Background(), tt.query, relevance)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, universityNames(result.Universities))
+		})
+	}
+
+	t.Run("関連度順のページ分割", func(t *testing.T) {
+		result, err := repo.SearchPage(
+			context.Background(),
+			"大学",
+			newPaginationParams(2, pagination.SortRelevance, pagination.OrderAsc),
+		)
+		require.NoError(t, err)
+		assert.Len(t, result.Universities, 2)
+		assert.Equal(t, int64(3), result.Total)
+		assert.True(t, result.HasNext)
+		require.NotEmpty(t, result.NextCursor)
+
+		cursor, err := pagination.DecodeCursor(result.NextCursor)
+		require.NoError(t, err)
+
+		params := newPaginationParams(2, pagination.SortRelevance, pagination.OrderAsc)
+		params.Page = cursor.Page
+		params.Cursor = cursor
+
+		next, err := repo.SearchPage(context.Background(), "大学", params)
+		require.NoError(t, err)
+		assert.Len(t, next.Universities, 1)
+		assert.False(t, next.HasNext)
+		assert.True(t, next.HasPrev)
+	})
+}
+
+func TestSearchIndexRefreshOnCreate(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	setupFacetTestData(t, db)
+
+	repo := NewUniversityRepository(db)
+	params := newPaginationParams(10, pagination.SortRelevance, pagination.OrderAsc)
+
+	result, err := repo.SearchPage(context.Background(), "一橋", params)
+	require.NoError(t, err)
+	assert.Empty(t, result.Universities)
+
+	require.NoError(t, repo.Create(context.Background(), &models.University{
+		BaseModel: models.BaseModel{Version: 1},
+		Name:      "一橋大学",
+	}))
+
+	result, err = repo.SearchPage(context.Background(), "一橋", params)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"一橋大学"}, universityNames(result.Universities))
+
+	universities, err := repo.Search(context.Background(), "一橋")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"一橋大学"}, universityNames(universities))
+}
+
+func TestSuggestRefreshOnChange(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	setupFacetTestData(t, db)
+
+	repo := NewUniversityRepository(db)
+	require.NoError(t, repo.WarmSearchIndex(context.Background()))
+
+	result, err := repo.Suggest(context.Background(), "工", textsearch.SuggestTypes, 5)
+	require.NoError(t, err)
+	assert.Len(t, result, len(textsearch.SuggestTypes))
+	assert.Equal(t, []string{"工学部"}, suggestionTexts(result[textsearch.SuggestTypeDepartment]))
+	assert.Equal(t, []string{"工学"}, suggestionTexts(result[textsearch.SuggestTypeAcademicField]))
+
+	result, err = repo.Suggest(context.Background(), "一", []string{textsearch.SuggestTypeUniversity}, 5)
+	require.NoError(t, err)
+	assert.Empty(t, result[textsearch.SuggestTypeUniversity])
+
+	require.NoError(t, repo.Create(context.Background(), &models.University{
+		BaseModel: models.BaseModel{Version: 1},
+		Name:      "一橋大学",
+	}))
+
+	result, err = repo.Suggest(context.Background(), "一", []string{textsearch.SuggestTypeUniversity}, 5)
+	require.NoError(t, err)
+	require.Len(t, result[textsearch.SuggestTypeUniversity], 1)
+	assert.Equal(t, "一橋大学", result[textsearch.SuggestTypeUniversity][0].Text)
+	assert.NotZero(t, result[textsearch.SuggestTypeUniversity][0].ID)
+}
+
+// suggestionTexts は候補の名称の一覧を返します
+func suggestionTexts(suggestions []textsearch.Suggestion) []string {
+	texts := make([]string, 0, len(suggestions))
+	for _, s := range suggestions {
+		texts = append(texts, s.Text)
+	}
+
+	return texts
+}
@@ -0,0 +1,169 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+
+	"gorm.io/gorm"
+)
+
+// SubjectWeight は試験種別・科目ごとの配点比率を表現する構造体です
+type SubjectWeight struct {
+	TestType   string  `json:"test_type"`
+	Subject    string  `json:"subject"`
+	Percentage float64 `json:"percentage"`
+}
+
+// key は配点比率ベクトルの次元を表すキーを返します
+func (w SubjectWeight) key() string {
+	return w.TestType + "/" + w.Subject
+}
+
+// SimilarityCriteria は配点比率の類似検索の条件を表現する構造体です
+// ファセット検索の条件で候補を絞り込んだ上で、目標の配点比率との距離で並び替えます
+type SimilarityCriteria struct {
+	FacetSearchCriteria
+	Target       []SubjectWeight // 目標の配点比率
+	AcademicYear int             // 学年度（0の場合は年度に紐付かない試験種別を使用）
+	Limit        int             // 取得件数
+}
+
+// SimilarMajor は類似検索の結果となる学科・入試日程を表現する構造体です
+type SimilarMajor struct {
+	University YearScopedEntity `json:"university"`
+	Department YearScopedEntity `json:"department"`
+	Major      YearScopedEntity `json:"major"`
+	Schedule   YearScopedEntity `json:"schedule"`
+	Distance   float64          `json:"distance"`
+	Weights    []SubjectWeight  `json:"weights"`
+}
+
+// SimilarityRepository は配点比率の類似検索のリポジトリインターフェースです
+type SimilarityRepository interface {
+	FindSimilarMajors(ctx context.Context, criteria SimilarityCriteria) ([]SimilarMajor, error)
+}
+
+// similarityRepository はSimilarityRepositoryの実装です
+type similarityRepository struct {
+	db     *gorm.DB
+	facets *facetSearchRepository
+}
+
+// NewSimilarityRepository は新しいSimilarityRepositoryを作成します
+func NewSimilarityRepository(db *gorm.DB) SimilarityRepository {
+	return &similarityRepository{
+		db:     db,
+		facets: &facetSearchRepository{db: db},
+	}
+}
+
+// FindSimilarMajors は目標の配点比率に近い学科・入試日程を距離の昇順で取得します。
+// この関数は以下の処理を行います：
+// - ファセット条件による候補の入試日程の絞り込み
+// - 入試日程ごとの配点比率ベクトルの生成
+// - 目標とのユークリッド距離の計算と並び替え
+func (r *similarityRepository) FindSimilarMajors(
+	ctx context.Context,
+	criteria SimilarityCriteria,
+) ([]SimilarMajor, error) {
+	facetCriteria, err := r.facets.resolveQuery(ctx, criteria.FacetSearchCriteria)
+	if err != nil {
+		return nil, err
+	}
+
+	var schedules []models.AdmissionSchedule
+
+	err = r.facets.filteredSchedules(ctx, facetCriteria, criteria.AcademicYear).
+		Find(&schedules).Error
+	if err != nil {
+		return nil, appErrors.NewDatabaseError("類似検索処理", fmt.Errorf(errSearchFailed, err), nil)
+	}
+
+	target := weightVector(criteria.Target)
+	results := make([]SimilarMajor, 0, len(schedules))
+
+	for _, schedule := range schedules {
+		weights := scheduleWeights(schedule)
+		if len(weights) == 0 {
+			continue
+		}
+
+		major := schedule.Major
+		department := major.Department
+		university := department.University
+
+		results = append(results, SimilarMajor{
+			University: YearScopedEntity{ID: university.ID, Name: university.Name},
+			Department: YearScopedEntity{ID: department.ID, Name: department.Name},
+			Major:      YearScopedEntity{ID: major.ID, Name: major.Name},
+			Schedule:   YearScopedEntity{ID: schedule.ID, Name: schedule.Name},
+			Distance:   weightDistance(target, weightVector(weights)),
+			Weights:    weights,
+		})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Distance != results[j].Distance {
+			return results[i].Distance < results[j].Distance
+		}
+
+		return results[i].Schedule.ID < results[j].Schedule.ID
+	})
+
+	if criteria.Limit > 0 && len(results) > criteria.Limit {
+		results = results[:criteria.Limit]
+	}
+
+	return results, nil
+}
+
+// scheduleWeights は入試日程の試験種別・科目ごとの配点比率を取得します
+func scheduleWeights(schedule models.AdmissionSchedule) []SubjectWeight {
+	var weights []SubjectWeight
+
+	for _, testType := range scheduleTestTypes(schedule) {
+		for _, subject := range testType.Subjects {
+			weights = append(weights, SubjectWeight{
+				TestType:   testType.Name,
+				Subject:    subject.Name,
+				Percentage: subject.Percentage,
+			})
+		}
+	}
+
+	return weights
+}
+
+// weightVector は配点比率を試験種別・科目名をキーとするベクトルに変換します
+// 同じ試験種別・科目名の配点比率は合算します
+func weightVector(weights []SubjectWeight) map[string]float64 {
+	vector := make(map[string]float64, len(weights))
+	for _, w := range weights {
+		vector[w.key()] += w.Percentage
+	}
+
+	return vector
+}
+
+// weightDistance は2つの配点比率ベクトルのユークリッド距離を計算します
+// 一方にしか存在しない次元は配点比率0として扱い、結果は小数点以下2桁に丸めます
+func weightDistance(a, b map[string]float64) float64 {
+	var sum float64
+
+	for key, va := range a {
+		d := va - b[key]
+		sum += d * d
+	}
+
+	for key, vb := range b {
+		if _, ok := a[key]; !ok {
+			sum += vb * vb
+		}
+	}
+
+	return math.Round(math.Sqrt(sum)*100) / 100
+}
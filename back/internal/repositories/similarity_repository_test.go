@@ -0,0 +1,114 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"university-exam-api/internal/domain/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// setupSimilarityTestData はファセット検索用のテストデータに配点比率を追加します
+// - 東京大学: 共通 英語50 / 二次 数学50
+// - 京都大学: 共通 英語20 / 二次 数学80
+// - 早稲田大学: 二次 英語100
+func setupSimilarityTestData(t *testing.T, db *gorm.DB) {
+	t.Helper()
+
+	setupFacetTestData(t, db)
+
+	weights := map[string][]SubjectWeight{
+		"東京大学":  {{"共通", "英語", 50}, {"二次", "数学", 50}},
+		"京都大学":  {{"共通", "英語", 20}, {"二次", "数学", 80}},
+		"早稲田大学": {{"二次", "英語", 100}},
+	}
+
+	for name, ws := range weights {
+		var schedule models.AdmissionSchedule
+		require.NoError(t, db.
+			Joins("JOIN majors ON majors.id = admission_schedules.major_id").
+			Joins("JOIN departments ON departments.id = majors.department_id").
+			Joins("JOIN universities ON universities.id = departments.university_id").
+			Where("universities.name = ?", name).
+			Take(&schedule).Error)
+
+		for _, w := range ws {
+			testType := models.TestType{
+				BaseModel:           models.BaseModel{Version: 1},
+				AdmissionScheduleID: schedule.ID,
+				Name:                w.TestType,
+				Subjects: []models.Subject{{
+					BaseModel:  models.BaseModel{Version: 1},
+					Name:       w.Subject,
+					Score:      int(w.Percentage) * 10,
+					Percentage: w.Percentage,
+				}},
+			}
+			require.NoError(t, db.Create(&testType).Error)
+		}
+	}
+}
+
+func TestSimilarityRepositoryFindSimilarMajors(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	setupSimilarityTestData(t, db)
+
+	repo := NewSimilarityRepository(db)
+	target := []SubjectWeight{{"共通", "英語", 40}, {"二次", "数学", 60}}
+
+	t.Run("距離の昇順で取得", func(t *testing.T) {
+		results, err := repo.FindSimilarMajors(context.Background(), SimilarityCriteria{Target: target})
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+
+		assert.Equal(t, "東京大学", results[0].University.Name)
+		assert.Equal(t, "機械工学科", results[0].Major.Name)
+		assert.Equal(t, "前", results[0].Schedule.Name)
+		assert.Equal(t, 14.14, results[0].Distance)
+		assert.Len(t, results[0].Weights, 2)
+
+		assert.Equal(t, "京都大学", results[1].University.Name)
+		assert.Equal(t, 28.28, results[1].Distance)
+		assert.Equal(t, "早稲田大学", results[2].University.Name)
+	})
+
+	t.Run("ファセットによる絞り込み", func(t *testing.T) {
+		results, err := repo.FindSimilarMajors(context.Background(), SimilarityCriteria{
+			FacetSearchCriteria: FacetSearchCriteria{Regions: []string{"関西"}},
+			Target:              target,
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, "京都大学", results[0].University.Name)
+	})
+
+	t.Run("日程による絞り込みと件数制限", func(t *testing.T) {
+		results, err := repo.FindSimilarMajors(context.Background(), SimilarityCriteria{
+			FacetSearchCriteria: FacetSearchCriteria{Schedules: []string{"前"}},
+			Target:              target,
+			Limit:               1,
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, "東京大学", results[0].University.Name)
+	})
+
+	t.Run("入試情報のない年度", func(t *testing.T) {
+		results, err := repo.FindSimilarMajors(context.Background(), SimilarityCriteria{
+			Target:       target,
+			AcademicYear: 2025,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, results)
+	})
+}
+
+func TestWeightDistance(t *testing.T) {
+	a := weightVector([]SubjectWeight{{"共通", "英語", 30}, {"共通", "英語", 20}})
+	b := weightVector([]SubjectWeight{{"二次", "数学", 50}})
+
+	assert.Equal(t, 70.71, weightDistance(a, b))
+	assert.Equal(t, 0.0, weightDistance(a, a))
+}
@@ -0,0 +1,317 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// subjectGroupLabel はエラーメッセージに使用する選択科目群の名称です
+const subjectGroupLabel = "選択科目群"
+
+// ISubjectGroupManager は選択科目群の管理に関するインターフェースを定義します。
+// このインターフェースは以下の機能を提供します：
+// - 選択科目群の作成
+// - 選択科目群の更新
+// - 選択科目群の削除
+// 選択科目群の一覧は試験種別とともに取得します（FindTestType）。
+// 作成・更新・削除の後は、同じ入試日程の科目の配点比率を再計算します。
+type ISubjectGroupManager interface {
+	CreateSubjectGroup(ctx context.Context, group *models.SubjectGroup) error
+	UpdateSubjectGroup(ctx context.Context, group *models.SubjectGroup) error
+	DeleteSubjectGroup(ctx context.Context, testTypeID, groupID uint) error
+}
+
+// validateSubjectGroup は選択科目群のモデルの検証エラーをバリデーションエラーに変換します
+func validateSubjectGroup(group *models.SubjectGroup) error {
+	if err := group.Validate(); err != nil {
+		var validationErr *models.ValidationError
+		if errors.As(err, &validationErr) {
+			return appErrors.NewValidationError(validationErr.Field, validationErr.Message, map[string]string{
+				"code": validationErr.Code,
+			})
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// findSubjectGroupTestType は選択科目群が属する試験種別を取得します
+func findSubjectGroupTestType(tx *gorm.DB, testTypeID uint) (*models.TestType, error) {
+	var testType models.TestType
+	if err := tx.First(&testType, testTypeID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appErrors.NewNotFoundError("試験種別", testTypeID, nil)
+		}
+
+		return nil, appErrors.NewDatabaseError("試験種別検索処理", err, nil)
+	}
+
+	return &testType, nil
+}
+
+// ensureSubjectGroupConsistency は選択科目群と所属する科目の整合性を検証します。
+// この関数は以下の処理を行います：
+// - 同じ試験種別での選択科目群名の重複チェック
+// - 指定された科目の存在確認
+// - 科目が選択科目群と同じ試験種別に属することの確認
+// - 科目が別の選択科目群に属していないことの確認
+func ensureSubjectGroupConsistency(tx *gorm.DB, group *models.SubjectGroup) error {
+	var count int64
+
+	err := tx.Model(&models.SubjectGroup{}).
+		Where("test_type_id = ? AND name = ? AND id <> ?", group.TestTypeID, group.Name, group.ID).
+		Count(&count).Error
+	if err != nil {
+		return appErrors.NewDatabaseError(subjectGroupLabel+"検索処理", err, nil)
+	}
+
+	if count > 0 {
+		return appErrors.NewValidationError(
+			"name",
+			fmt.Sprintf("この試験種別には既に%s「%s」があります", subjectGroupLabel, group.Name),
+			map[string]string{"name": group.Name},
+		)
+	}
+
+	var subjects []models.Subject
+	if err := tx.Where("id IN ?", group.SubjectIDs).Find(&subjects).Error; err != nil {
+		return appErrors.NewDatabaseError("科目検索処理", err, nil)
+	}
+
+	found := make(map[uint]models.Subject, len(subjects))
+	for _, s := range subjects {
+		found[s.ID] = s
+	}
+
+	for _, id := range group.SubjectIDs {
+		subject, ok := found[id]
+		if !ok {
+			return appErrors.NewValidationError(
+				"subject_ids",
+				fmt.Sprintf("科目ID %d が見つかりません", id),
+				map[string]string{"subject_id": fmt.Sprint(id)},
+			)
+		}
+
+		if subject.TestTypeID != group.TestTypeID {
+			return appErrors.NewValidationError(
+				"subject_ids",
+				fmt.Sprintf("科目「%s」は別の試験種別の科目です", subject.Name),
+				map[string]string{"subject_id": fmt.Sprint(id)},
+			)
+		}
+
+		if subject.SubjectGroupID != nil && *subject.SubjectGroupID != group.ID {
+			return appErrors.NewValidationError(
+				"subject_ids",
+				fmt.Sprintf("科目「%s」は既に別の%sに属しています", subject.Name, subjectGroupLabel),
+				map[string]string{"subject_id": fmt.Sprint(id)},
+			)
+		}
+	}
+
+	return nil
+}
+
+// assignSubjectGroupMembers は指定された科目を選択科目群に所属させ、指定されなかった科目を選択科目群から外します
+func assignSubjectGroupMembers(tx *gorm.DB, group *models.SubjectGroup) error {
+	err := tx.Model(&models.Subject{}).
+		Where("subject_group_id = ? AND id NOT IN ?", group.ID, group.SubjectIDs).
+		UpdateColumn("subject_group_id", nil).Error
+	if err != nil {
+		return appErrors.NewDatabaseError(subjectGroupLabel+"の科目の更新処理", err, nil)
+	}
+
+	err = tx.Model(&models.Subject{}).
+		Where("id IN ?", group.SubjectIDs).
+		UpdateColumn("subject_group_id", group.ID).Error
+	if err != nil {
+		return appErrors.NewDatabaseError(subjectGroupLabel+"の科目の更新処理", err, nil)
+	}
+
+	return nil
+}
+
+// applySubjectGroupSelection は再計算後の科目から選択科目群の換算後の配点と配点比率を設定します
+func applySubjectGroupSelection(tx *gorm.DB, group *models.SubjectGroup) error {
+	var subjects []models.Subject
+	if err := tx.Where(testTypeIDQuery, group.TestTypeID).Order(displayOrderASC).Find(&subjects).Error; err != nil {
+		return appErrors.NewDatabaseError("科目検索処理", err, nil)
+	}
+
+	groups := []models.SubjectGroup{*group}
+	models.ApplySelection(groups, subjects)
+	*group = groups[0]
+
+	return nil
+}
+
+// CreateSubjectGroup は新しい選択科目群を作成します。
+// この関数は以下の処理を行います：
+// - 選択数と所属する科目の数の検証
+// - 試験種別の存在確認と、所属する科目との整合性の検証
+// - 選択科目群の作成と科目の所属の設定
+// - 同じ入試日程の科目の配点比率の再計算
+// - キャッシュのクリア
+func (r *universityRepository) CreateSubjectGroup(ctx context.Context, group *models.SubjectGroup) error {
+	if err := validateSubjectGroup(group); err != nil {
+		return err
+	}
+
+	var scheduleID uint
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		testType, err := findSubjectGroupTestType(tx, group.TestTypeID)
+		if err != nil {
+			return err
+		}
+
+		scheduleID = testType.AdmissionScheduleID
+
+		if err := ensureSubjectGroupConsistency(tx, group); err != nil {
+			return err
+		}
+
+		if err := tx.Omit(clause.Associations).Create(group).Error; err != nil {
+			return appErrors.NewDatabaseError(subjectGroupLabel+"作成処理", err, nil)
+		}
+
+		if err := assignSubjectGroupMembers(tx, group); err != nil {
+			return err
+		}
+
+		if err := r.recalculateScheduleScores(tx, scheduleID); err != nil {
+			return appErrors.NewDatabaseError("配点比率の再計算処理", err, nil)
+		}
+
+		return applySubjectGroupSelection(tx, group)
+	})
+	if err != nil {
+		return err
+	}
+
+	r.clearTestTypeCache(scheduleID, group.TestTypeID)
+
+	return nil
+}
+
+// UpdateSubjectGroup は既存の選択科目群を楽観的ロックで更新します。
+// この関数は以下の処理を行います：
+// - 選択数と所属する科目の数の検証
+// - 試験種別の存在確認と、所属する科目との整合性の検証
+// - トランザクションの実行（Versionを期待するバージョンとした楽観的ロック）
+// - 科目の所属の入れ替え
+// - 同じ入試日程の科目の配点比率の再計算
+// - キャッシュのクリア
+func (r *universityRepository) UpdateSubjectGroup(ctx context.Context, group *models.SubjectGroup) error {
+	if err := validateSubjectGroup(group); err != nil {
+		return err
+	}
+
+	var scheduleID uint
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		testType, err := findSubjectGroupTestType(tx, group.TestTypeID)
+		if err != nil {
+			return err
+		}
+
+		scheduleID = testType.AdmissionScheduleID
+
+		var existing models.SubjectGroup
+		if err := tx.Where(testTypeIDQuery, group.TestTypeID).First(&existing, group.ID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return appErrors.NewNotFoundError(subjectGroupLabel, group.ID, nil)
+			}
+
+			return appErrors.NewDatabaseError(subjectGroupLabel+"検索処理", err, nil)
+		}
+
+		if err := ensureSubjectGroupConsistency(tx, group); err != nil {
+			return err
+		}
+
+		if err := updateWithVersion(tx, group, subjectGroupLabel, clause.Associations); err != nil {
+			var appErr *appErrors.Error
+			if errors.As(err, &appErr) {
+				return appErr
+			}
+
+			return appErrors.NewDatabaseError(subjectGroupLabel+"更新処理", err, nil)
+		}
+
+		if err := assignSubjectGroupMembers(tx, group); err != nil {
+			return err
+		}
+
+		if err := r.recalculateScheduleScores(tx, scheduleID); err != nil {
+			return appErrors.NewDatabaseError("配点比率の再計算処理", err, nil)
+		}
+
+		return applySubjectGroupSelection(tx, group)
+	})
+	if err != nil {
+		return err
+	}
+
+	r.clearTestTypeCache(scheduleID, group.TestTypeID)
+
+	return nil
+}
+
+// DeleteSubjectGroup は選択科目群を削除します。
+// この関数は以下の処理を行います：
+// - 所属していた科目を選択科目群に属さない科目に戻す
+// - 選択科目群のソフトデリート
+// - 同じ入試日程の科目の配点比率の再計算
+// - キャッシュのクリア
+// 科目は削除しません。
+func (r *universityRepository) DeleteSubjectGroup(ctx context.Context, testTypeID, groupID uint) error {
+	var scheduleID uint
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		testType, err := findSubjectGroupTestType(tx, testTypeID)
+		if err != nil {
+			return err
+		}
+
+		scheduleID = testType.AdmissionScheduleID
+
+		result := tx.Where(testTypeIDQuery, testTypeID).Delete(&models.SubjectGroup{}, groupID)
+		if result.Error != nil {
+			return appErrors.NewDatabaseError(subjectGroupLabel+"削除処理", result.Error, nil)
+		}
+
+		if result.RowsAffected == 0 {
+			return appErrors.NewNotFoundError(subjectGroupLabel, groupID, nil)
+		}
+
+		err = tx.Model(&models.Subject{}).
+			Where("subject_group_id = ?", groupID).
+			UpdateColumn("subject_group_id", nil).Error
+		if err != nil {
+			return appErrors.NewDatabaseError(subjectGroupLabel+"の科目の更新処理", err, nil)
+		}
+
+		if err := r.recalculateScheduleScores(tx, scheduleID); err != nil {
+			return appErrors.NewDatabaseError("配点比率の再計算処理", err, nil)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	r.clearTestTypeCache(scheduleID, testTypeID)
+
+	return nil
+}
@@ -0,0 +1,206 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// subjectIDs は科目名から科目IDを取得します
+func subjectIDs(t *testing.T, db *gorm.DB, names ...string) []uint {
+	t.Helper()
+
+	ids := make([]uint, 0, len(names))
+
+	for _, name := range names {
+		var subject models.Subject
+		require.NoError(t, db.Where("name = ?", name).First(&subject).Error)
+
+		ids = append(ids, subject.ID)
+	}
+
+	return ids
+}
+
+// subjectGroupOf は科目が属する選択科目群のIDを取得します
+func subjectGroupOf(t *testing.T, db *gorm.DB, name string) *uint {
+	t.Helper()
+
+	var subject models.Subject
+	require.NoError(t, db.Where("name = ?", name).First(&subject).Error)
+
+	return subject.SubjectGroupID
+}
+
+func TestSubjectGroupCRUD(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	schedule := setupTestTypeTestData(t, db)
+
+	repo := NewUniversityRepository(db)
+	ctx := context.Background()
+
+	// 共通テストの英語200点と、物理・化学・生物（各100点）に二次試験の小論文100点を加える
+	common := &models.TestType{
+		BaseModel:           models.BaseModel{Version: 1},
+		AdmissionScheduleID: schedule.ID,
+		Name:                "共通",
+		Subjects: []models.Subject{
+			newYearTestSubject("英語", 200, 1),
+			newYearTestSubject("物理", 100, 2),
+			newYearTestSubject("化学", 100, 3),
+			newYearTestSubject("生物", 100, 4),
+		},
+	}
+	require.NoError(t, repo.CreateTestType(ctx, common))
+	assert.Equal(t, 33.33, subjectPercentage(t, db, "英語"))
+	assert.Equal(t, 16.67, subjectPercentage(t, db, "物理"))
+
+	science := &models.SubjectGroup{
+		BaseModel:   models.BaseModel{Version: 1},
+		TestTypeID:  common.ID,
+		Name:        "理科",
+		ChooseCount: 2,
+		SubjectIDs:  subjectIDs(t, db, "物理", "化学", "生物"),
+	}
+
+	t.Run("作成すると選択科目群を1回だけ数えて配点比率を再計算する", func(t *testing.T) {
+		require.NoError(t, repo.CreateSubjectGroup(ctx, science))
+		assert.NotZero(t, science.ID)
+		assert.Equal(t, 200.0, science.EffectiveScore)
+		assert.Equal(t, 40.0, science.Percentage)
+
+		assert.Equal(t, 40.0, subjectPercentage(t, db, "英語"))
+		assert.Equal(t, 20.0, subjectPercentage(t, db, "物理"))
+		assert.Equal(t, 20.0, subjectPercentage(t, db, "小論文"))
+		assert.Equal(t, science.ID, *subjectGroupOf(t, db, "生物"))
+	})
+
+	t.Run("試験種別とともに選択科目群を取得", func(t *testing.T) {
+		found, err := repo.FindTestType(ctx, schedule.ID, common.ID)
+		require.NoError(t, err)
+		require.Len(t, found.SubjectGroups, 1)
+
+		group := found.SubjectGroups[0]
+		assert.Equal(t, "理科", group.Name)
+		assert.Equal(t, science.SubjectIDs, group.SubjectIDs)
+		assert.Equal(t, 200.0, group.EffectiveScore)
+		assert.Equal(t, 40.0, group.Percentage)
+	})
+
+	t.Run("大学の取得でも選択科目群を返す", func(t *testing.T) {
+		var major models.Major
+		require.NoError(t, db.First(&major, schedule.MajorID).Error)
+
+		var department models.Department
+		require.NoError(t, db.First(&department, major.DepartmentID).Error)
+
+		university, err := repo.FindByID(ctx, department.UniversityID)
+		require.NoError(t, err)
+
+		var groups []models.SubjectGroup
+		for _, testType := range university.Departments[0].Majors[0].AdmissionSchedules[0].TestTypes {
+			groups = append(groups, testType.SubjectGroups...)
+		}
+
+		require.Len(t, groups, 1)
+		assert.Equal(t, science.SubjectIDs, groups[0].SubjectIDs)
+		assert.Equal(t, 40.0, groups[0].Percentage)
+	})
+
+	t.Run("不整合な選択科目群は作成できない", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			group models.SubjectGroup
+		}{
+			{
+				name:  "選択数が所属する科目数以上",
+				group: models.SubjectGroup{Name: "社会", ChooseCount: 2, SubjectIDs: subjectIDs(t, db, "英語", "物理")},
+			},
+			{
+				name:  "別の試験種別の科目",
+				group: models.SubjectGroup{Name: "選択", ChooseCount: 1, SubjectIDs: subjectIDs(t, db, "英語", "小論文")},
+			},
+			{
+				name:  "別の選択科目群に属する科目",
+				group: models.SubjectGroup{Name: "選択", ChooseCount: 1, SubjectIDs: subjectIDs(t, db, "英語", "物理")},
+			},
+			{
+				name:  "存在しない科目",
+				group: models.SubjectGroup{Name: "選択", ChooseCount: 1, SubjectIDs: []uint{subjectIDs(t, db, "英語")[0], 9999}},
+			},
+			{
+				name:  "同じ試験種別で名前が重複",
+				group: models.SubjectGroup{Name: "理科", ChooseCount: 1, SubjectIDs: subjectIDs(t, db, "英語", "小論文")},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				group := tt.group
+				group.TestTypeID = common.ID
+				group.Version = 1
+
+				requireAppErrorCode(t, repo.CreateSubjectGroup(ctx, &group), appErrors.CodeValidationError)
+			})
+		}
+
+		var count int64
+		require.NoError(t, db.Model(&models.SubjectGroup{}).Count(&count).Error)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("科目を更新しても選択科目群への所属を変更しない", func(t *testing.T) {
+		var physics models.Subject
+		require.NoError(t, db.Where("name = ?", "物理").First(&physics).Error)
+		physics.SubjectGroupID = nil
+
+		require.NoError(t, repo.UpdateSubjectsBatch(ctx, common.ID, []models.Subject{physics}))
+		assert.Equal(t, science.ID, *subjectGroupOf(t, db, "物理"))
+	})
+
+	t.Run("バージョンが一致しない更新は競合", func(t *testing.T) {
+		stale := *science
+		stale.Version = 5
+
+		requireAppErrorCode(t, repo.UpdateSubjectGroup(ctx, &stale), appErrors.CodeConflict)
+	})
+
+	t.Run("更新すると所属する科目を入れ替えて配点比率を再計算する", func(t *testing.T) {
+		update := &models.SubjectGroup{
+			BaseModel:   models.BaseModel{ID: science.ID, Version: science.Version},
+			TestTypeID:  common.ID,
+			Name:        "理科",
+			ChooseCount: 1,
+			SubjectIDs:  subjectIDs(t, db, "物理", "化学"),
+		}
+
+		require.NoError(t, repo.UpdateSubjectGroup(ctx, update))
+		assert.Equal(t, science.Version+1, update.Version)
+		assert.Equal(t, 100.0, update.EffectiveScore)
+
+		// 英語200点 + 理科100点 + 生物100点 + 小論文100点
+		assert.Nil(t, subjectGroupOf(t, db, "生物"))
+		assert.Equal(t, 40.0, subjectPercentage(t, db, "英語"))
+		assert.Equal(t, 20.0, subjectPercentage(t, db, "生物"))
+	})
+
+	t.Run("削除すると所属していた科目を戻して配点比率を再計算する", func(t *testing.T) {
+		require.NoError(t, repo.DeleteSubjectGroup(ctx, common.ID, science.ID))
+
+		assert.Nil(t, subjectGroupOf(t, db, "物理"))
+		assert.Equal(t, 33.33, subjectPercentage(t, db, "英語"))
+
+		found, err := repo.FindTestType(ctx, schedule.ID, common.ID)
+		require.NoError(t, err)
+		assert.Empty(t, found.SubjectGroups)
+	})
+
+	t.Run("存在しない選択科目群の削除はNotFound", func(t *testing.T) {
+		requireAppErrorCode(t, repo.DeleteSubjectGroup(ctx, common.ID, science.ID), appErrors.CodeNotFound)
+	})
+}
@@ -0,0 +1,253 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	applogger "university-exam-api/internal/logger"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ITestTypeManager は試験種別の管理に関するインターフェースを定義します。
+// このインターフェースは以下の機能を提供します：
+// - 試験種別の取得
+// - 試験種別の作成
+// - 試験種別の更新
+// - 試験種別の削除
+// 作成・更新・削除の後は、同じ入試日程の科目の配点比率を再計算します。
+type ITestTypeManager interface {
+	FindTestType(ctx context.Context, scheduleID, testTypeID uint) (*models.TestType, error)
+	CreateTestType(ctx context.Context, testType *models.TestType) error
+	UpdateTestType(ctx context.Context, testType *models.TestType) error
+	DeleteTestType(ctx context.Context, id uint) error
+}
+
+// FindTestType は試験種別を科目・選択科目群とともに取得します。
+// この関数は以下の処理を行います：
+// - キャッシュのチェック
+// - データベースからの取得（科目・選択科目群は表示順）
+// - キャッシュへの保存
+func (r *universityRepository) FindTestType(ctx context.Context, scheduleID, testTypeID uint) (*models.TestType, error) {
+	cacheKey := fmt.Sprintf("test_types:%d:%d", scheduleID, testTypeID)
+
+	// キャッシュをチェック
+	if cached, found := r.cache.GetFromCache(cacheKey); found {
+		applogger.Info(ctx, "試験種別のキャッシュヒット: %d:%d", scheduleID, testTypeID)
+
+		testType := cached.(models.TestType)
+
+		return &testType, nil
+	}
+
+	var testType models.TestType
+
+	err := r.db.WithContext(ctx).Preload("Subjects", func(db *gorm.DB) *gorm.DB {
+		return db.Order(displayOrderASC)
+	}).
+		Preload("SubjectGroups", func(db *gorm.DB) *gorm.DB {
+			return db.Order(displayOrderASC)
+		}).
+		Where("admission_schedule_id = ? AND id = ?", scheduleID, testTypeID).
+		First(&testType).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appErrors.NewNotFoundError("試験種別", testTypeID, nil)
+		}
+
+		return nil, appErrors.NewDatabaseError("試験種別検索処理", err, nil)
+	}
+
+	// キャッシュに保存
+	r.cache.SetCache(cacheKey, testType)
+	applogger.Info(ctx, "試験種別をキャッシュに保存: %d:%d", scheduleID, testTypeID)
+
+	return &testType, nil
+}
+
+// testTypeOrder は試験種別を試験種別レジストリの表示順に並べるORDER BY句を返します
+// レジストリに登録されていない試験種別は末尾に名前順で並べます
+func testTypeOrder() clause.OrderBy {
+	var sql strings.Builder
+
+	definitions := models.TestTypes().Definitions()
+	vars := make([]interface{}, 0, len(definitions)*2+1)
+
+	sql.WriteString("CASE test_types.name")
+
+	for _, d := range definitions {
+		sql.WriteString(" WHEN ? THEN ?")
+
+		vars = append(vars, d.Name, d.DisplayOrder)
+	}
+
+	sql.WriteString(" ELSE ? END, test_types.name ASC")
+
+	vars = append(vars, math.MaxInt32)
+
+	return clause.OrderBy{Expression: clause.Expr{SQL: sql.String(), Vars: vars, WithoutParentheses: true}}
+}
+
+// ensureUniqueTestTypeName は同じ入試日程に同名の試験種別がないことを確認します。
+// 配点比率は入試日程ごとに試験種別名で集計した合計点から計算するため、同名の試験種別は1つまでとします
+func ensureUniqueTestTypeName(tx *gorm.DB, testType *models.TestType) error {
+	var count int64
+
+	err := tx.Model(&models.TestType{}).
+		Where("admission_schedule_id = ? AND name = ? AND id <> ?", testType.AdmissionScheduleID, testType.Name, testType.ID).
+		Count(&count).Error
+	if err != nil {
+		return appErrors.NewDatabaseError("試験種別検索処理", err, nil)
+	}
+
+	if count > 0 {
+		return appErrors.NewValidationError(
+			"name",
+			fmt.Sprintf("この入試日程には既に%sの試験種別があります", testType.Name),
+			map[string]string{"name": testType.Name},
+		)
+	}
+
+	return nil
+}
+
+// recalculateScheduleScores は入試日程の全ての試験種別について、科目の配点比率を再計算します
+func (r *universityRepository) recalculateScheduleScores(tx *gorm.DB, scheduleID uint) error {
+	var testTypeIDs []uint
+	if err := tx.Model(&models.TestType{}).Where("admission_schedule_id = ?", scheduleID).Pluck("id", &testTypeIDs).Error; err != nil {
+		return fmt.Errorf("入試日程ID %d の試験種別検索に失敗しました: %w", scheduleID, err)
+	}
+
+	for _, testTypeID := range testTypeIDs {
+		if err := checkContext(tx.Statement.Context, "配点比率の再計算処理"); err != nil {
+			return err
+		}
+
+		if err := r.recalculateScores(tx, testTypeID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// clearTestTypeCache は試験種別と科目のキャッシュをクリアします
+func (r *universityRepository) clearTestTypeCache(scheduleID, testTypeID uint) {
+	r.cache.ClearCache(fmt.Sprintf("test_types:%d:%d", scheduleID, testTypeID))
+	r.cache.ClearSubjectsCache(testTypeID)
+	r.cache.ClearAllRelatedCache(0)
+}
+
+// CreateTestType は新しい試験種別を作成します。
+// この関数は以下の処理を行います：
+// - 試験種別レジストリへの登録の確認
+// - 同じ入試日程での試験種別名の重複チェック
+// - 試験種別（と指定された科目）の作成
+// - 同じ入試日程の科目の配点比率の再計算
+// - キャッシュのクリア
+func (r *universityRepository) CreateTestType(ctx context.Context, testType *models.TestType) error {
+	if err := validateTestTypeName(testType.Name, "name"); err != nil {
+		return err
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := ensureUniqueTestTypeName(tx, testType); err != nil {
+			return err
+		}
+
+		if err := tx.Create(testType).Error; err != nil {
+			return appErrors.NewDatabaseError("試験種別作成処理", err, nil)
+		}
+
+		if err := r.recalculateScheduleScores(tx, testType.AdmissionScheduleID); err != nil {
+			return appErrors.NewDatabaseError("配点比率の再計算処理", err, nil)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	r.clearTestTypeCache(testType.AdmissionScheduleID, testType.ID)
+
+	return nil
+}
+
+// UpdateTestType は既存の試験種別を楽観的ロックで更新します。
+// この関数は以下の処理を行います：
+// - 試験種別レジストリへの登録の確認
+// - 同じ入試日程での試験種別名の重複チェック
+// - トランザクションの実行（Versionを期待するバージョンとした楽観的ロック）
+// - 同じ入試日程の科目の配点比率の再計算
+// - キャッシュのクリア
+// 科目・選択科目群は更新しません（科目・選択科目群の更新はそれぞれのエンドポイントで行います）。
+func (r *universityRepository) UpdateTestType(ctx context.Context, testType *models.TestType) error {
+	if err := validateTestTypeName(testType.Name, "name"); err != nil {
+		return err
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := ensureUniqueTestTypeName(tx, testType); err != nil {
+			return err
+		}
+
+		if err := updateWithVersion(tx, testType, "試験種別", "Subjects", "SubjectGroups"); err != nil {
+			var appErr *appErrors.Error
+			if errors.As(err, &appErr) {
+				return appErr
+			}
+
+			return appErrors.NewDatabaseError("試験種別更新処理", err, nil)
+		}
+
+		if err := r.recalculateScheduleScores(tx, testType.AdmissionScheduleID); err != nil {
+			return appErrors.NewDatabaseError("配点比率の再計算処理", err, nil)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	r.clearTestTypeCache(testType.AdmissionScheduleID, testType.ID)
+
+	return nil
+}
+
+// DeleteTestType は試験種別を削除します。
+// この関数は以下の処理を行います：
+// - 科目を含むソフトデリート
+// - 同じ入試日程に残った科目の配点比率の再計算
+// - キャッシュのクリア
+func (r *universityRepository) DeleteTestType(ctx context.Context, id uint) error {
+	var testType models.TestType
+	if err := r.db.WithContext(ctx).First(&testType, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return appErrors.NewNotFoundError("試験種別", id, nil)
+		}
+
+		return appErrors.NewDatabaseError("試験種別検索処理", err, nil)
+	}
+
+	if _, err := r.softDelete(ctx, "test_types", id); err != nil {
+		return appErrors.NewDatabaseError("試験種別削除処理", err, nil)
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return r.recalculateScheduleScores(tx, testType.AdmissionScheduleID)
+	})
+	if err != nil {
+		return appErrors.NewDatabaseError("配点比率の再計算処理", err, nil)
+	}
+
+	r.clearTestTypeCache(testType.AdmissionScheduleID, id)
+
+	return nil
+}
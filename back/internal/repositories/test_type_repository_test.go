@@ -0,0 +1,241 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// setupTestTypeTestData は二次試験（小論文100点）のみを持つ入試日程を作成します
+func setupTestTypeTestData(t *testing.T, db *gorm.DB) models.AdmissionSchedule {
+	t.Helper()
+
+	university := &models.University{
+		BaseModel: models.BaseModel{Version: 1},
+		Name:      "京都大学",
+		Departments: []models.Department{{
+			Name: "文学部",
+			Majors: []models.Major{{
+				Name: "人文学科",
+				AdmissionSchedules: []models.AdmissionSchedule{{
+					Name:         "前",
+					DisplayOrder: 1,
+					TestTypes: []models.TestType{
+						newYearTestTestType("二次", newYearTestSubject("小論文", 100, 1)),
+					},
+				}},
+			}},
+		}},
+	}
+	require.NoError(t, db.Create(university).Error)
+
+	return university.Departments[0].Majors[0].AdmissionSchedules[0]
+}
+
+// subjectPercentage は科目の配点比率を取得します
+func subjectPercentage(t *testing.T, db *gorm.DB, name string) float64 {
+	t.Helper()
+
+	var subject models.Subject
+	require.NoError(t, db.Where("name = ?", name).First(&subject).Error)
+
+	return subject.Percentage
+}
+
+func TestTestTypeCRUD(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	schedule := setupTestTypeTestData(t, db)
+
+	repo := NewUniversityRepository(db)
+	ctx := context.Background()
+
+	common := &models.TestType{
+		BaseModel:           models.BaseModel{Version: 1},
+		AdmissionScheduleID: schedule.ID,
+		Name:                "共通",
+		Subjects:            []models.Subject{newYearTestSubject("英語", 300, 1)},
+	}
+
+	t.Run("作成すると同じ入試日程の配点比率を再計算する", func(t *testing.T) {
+		require.NoError(t, repo.CreateTestType(ctx, common))
+		assert.NotZero(t, common.ID)
+
+		assert.Equal(t, 75.0, subjectPercentage(t, db, "英語"))
+		assert.Equal(t, 25.0, subjectPercentage(t, db, "小論文"))
+	})
+
+	t.Run("同じ入試日程に同名の試験種別は作成できない", func(t *testing.T) {
+		duplicate := &models.TestType{
+			BaseModel:           models.BaseModel{Version: 1},
+			AdmissionScheduleID: schedule.ID,
+			Name:                "二次",
+		}
+
+		requireAppErrorCode(t, repo.CreateTestType(ctx, duplicate), appErrors.CodeValidationError)
+	})
+
+	t.Run("科目とともに取得", func(t *testing.T) {
+		found, err := repo.FindTestType(ctx, schedule.ID, common.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "共通", found.Name)
+		require.Len(t, found.Subjects, 1)
+		assert.Equal(t, "英語", found.Subjects[0].Name)
+	})
+
+	t.Run("別の入試日程の試験種別は取得できない", func(t *testing.T) {
+		_, err := repo.FindTestType(ctx, schedule.ID+100, common.ID)
+		requireAppErrorCode(t, err, appErrors.CodeNotFound)
+	})
+
+	t.Run("バージョンが一致しない更新は競合", func(t *testing.T) {
+		stale := &models.TestType{
+			BaseModel:           models.BaseModel{ID: common.ID, Version: 5},
+			AdmissionScheduleID: schedule.ID,
+			Name:                "共通",
+		}
+
+		requireAppErrorCode(t, repo.UpdateTestType(ctx, stale), appErrors.CodeConflict)
+	})
+
+	t.Run("更新しても科目は変更しない", func(t *testing.T) {
+		update := &models.TestType{
+			BaseModel:           models.BaseModel{ID: common.ID, Version: common.Version},
+			AdmissionScheduleID: schedule.ID,
+			Name:                "共通",
+		}
+
+		require.NoError(t, repo.UpdateTestType(ctx, update))
+		assert.Equal(t, common.Version+1, update.Version)
+
+		var count int64
+		require.NoError(t, db.Model(&models.Subject{}).Where("test_type_id = ?", common.ID).Count(&count).Error)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("削除すると残った科目の配点比率を再計算する", func(t *testing.T) {
+		require.NoError(t, repo.DeleteTestType(ctx, common.ID))
+
+		_, err := repo.FindTestType(ctx, schedule.ID, common.ID)
+		requireAppErrorCode(t, err, appErrors.CodeNotFound)
+		assert.Equal(t, 100.0, subjectPercentage(t, db, "小論文"))
+	})
+
+	t.Run("存在しない試験種別の削除はNotFound", func(t *testing.T) {
+		requireAppErrorCode(t, repo.DeleteTestType(ctx, common.ID), appErrors.CodeNotFound)
+	})
+}
+
+func TestWeightedSubjectPercentages(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	schedule := setupTestTypeTestData(t, db)
+
+	repo := NewUniversityRepository(db)
+	ctx := context.Background()
+
+	// 共通テストの英語300点を150点に圧縮し、二次試験の小論文100点と合算する
+	english := newYearTestSubject("英語", 300, 1)
+	english.ConversionRatio = 0.5
+
+	common := &models.TestType{
+		BaseModel:           models.BaseModel{Version: 1},
+		AdmissionScheduleID: schedule.ID,
+		Name:                "共通",
+		Subjects:            []models.Subject{english},
+	}
+	require.NoError(t, repo.CreateTestType(ctx, common))
+
+	assert.Equal(t, 60.0, subjectPercentage(t, db, "英語"))
+	assert.Equal(t, 40.0, subjectPercentage(t, db, "小論文"))
+
+	found, err := repo.FindTestType(ctx, schedule.ID, common.ID)
+	require.NoError(t, err)
+	require.Len(t, found.Subjects, 1)
+	assert.Equal(t, 300, found.Subjects[0].Score)
+	assert.Equal(t, 0.5, found.Subjects[0].ConversionRatio)
+	assert.Equal(t, 150.0, found.Subjects[0].EffectiveScore)
+
+	var essay models.Subject
+	require.NoError(t, db.Where("name = ?", "小論文").First(&essay).Error)
+	assert.Equal(t, models.DefaultConversionRatio, essay.ConversionRatio)
+	assert.Equal(t, 100.0, essay.EffectiveScore)
+
+	t.Run("大学の取得でも換算比率と換算後の配点を返す", func(t *testing.T) {
+		var major models.Major
+		require.NoError(t, db.First(&major, schedule.MajorID).Error)
+
+		var department models.Department
+		require.NoError(t, db.First(&department, major.DepartmentID).Error)
+
+		university, err := repo.FindByID(ctx, department.UniversityID)
+		require.NoError(t, err)
+
+		for _, testType := range university.Departments[0].Majors[0].AdmissionSchedules[0].TestTypes {
+			if testType.Name != "共通" {
+				continue
+			}
+
+			require.Len(t, testType.Subjects, 1)
+			assert.Equal(t, 0.5, testType.Subjects[0].ConversionRatio)
+			assert.Equal(t, 150.0, testType.Subjects[0].EffectiveScore)
+		}
+	})
+}
+
+func TestTestTypeRegistryPercentages(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	schedule := setupTestTypeTestData(t, db)
+
+	repo := NewUniversityRepository(db)
+	ctx := context.Background()
+
+	newTestType := func(name string, subjects ...models.Subject) *models.TestType {
+		return &models.TestType{
+			BaseModel:           models.BaseModel{Version: 1},
+			AdmissionScheduleID: schedule.ID,
+			Name:                name,
+			Subjects:            subjects,
+		}
+	}
+
+	t.Run("登録されていない試験種別は作成できない", func(t *testing.T) {
+		requireAppErrorCode(t, repo.CreateTestType(ctx, newTestType("前期")), appErrors.CodeValidationError)
+	})
+
+	t.Run("分母に含める試験種別の合計点から配点比率を算出する", func(t *testing.T) {
+		require.NoError(t, repo.CreateTestType(ctx, newTestType("総合型選抜", newYearTestSubject("面接", 100, 1))))
+
+		assert.Equal(t, 50.0, subjectPercentage(t, db, "面接"))
+		assert.Equal(t, 50.0, subjectPercentage(t, db, "小論文"))
+	})
+
+	t.Run("分母に含めない試験種別の科目は配点比率を0とする", func(t *testing.T) {
+		require.NoError(t, repo.CreateTestType(ctx, newTestType("英語外部試験", newYearTestSubject("英検", 100, 1))))
+
+		assert.Equal(t, 0.0, subjectPercentage(t, db, "英検"))
+		assert.Equal(t, 50.0, subjectPercentage(t, db, "面接"))
+		assert.Equal(t, 50.0, subjectPercentage(t, db, "小論文"))
+	})
+
+	t.Run("試験種別はレジストリの表示順に並ぶ", func(t *testing.T) {
+		var major models.Major
+		require.NoError(t, db.First(&major, schedule.MajorID).Error)
+
+		var department models.Department
+		require.NoError(t, db.First(&department, major.DepartmentID).Error)
+
+		university, err := repo.FindByID(ctx, department.UniversityID)
+		require.NoError(t, err)
+
+		var names []string
+		for _, testType := range university.Departments[0].Majors[0].AdmissionSchedules[0].TestTypes {
+			names = append(names, testType.Name)
+		}
+
+		assert.Equal(t, []string{"二次", "総合型選抜", "英語外部試験"}, names)
+	})
+}
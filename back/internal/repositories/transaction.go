@@ -182,9 +182,17 @@ func (r *universityRepository) TransactionWithOption(
 		})
 	}
 
-	return backoff.RetryNotify(operation, opt.RetryPolicy, func(err error, duration time.Duration) {
+	err := backoff.RetryNotify(operation, opt.RetryPolicy, func(err error, duration time.Duration) {
 		applogger.Error(context.Background(), "トランザクションの再試行: %v後 エラー: %v", duration, err)
 	})
+	if err != nil {
+		return err
+	}
+
+	// コミット前に再構築された検索インデックスを確実に更新する
+	r.searchIndex.invalidate()
+
+	return nil
 }
 
 // handleTransactionError はトランザクションエラーの処理を行います。
@@ -272,7 +280,8 @@ func (r *universityRepository) RollbackTo(tx *gorm.DB, name string) error {
 // - リポジトリの生成
 func (r *universityRepository) WithTx(tx *gorm.DB) IUniversityRepository {
 	return &universityRepository{
-		db:    tx,
-		cache: r.cache,
+		db:          tx,
+		cache:       r.cache,
+		searchIndex: r.searchIndex,
 	}
 }
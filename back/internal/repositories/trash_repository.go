@@ -0,0 +1,600 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/pkg/pagination"
+
+	"gorm.io/gorm"
+)
+
+// TrashPage はページ単位で取得したゴミ箱の要素を表現する構造体です
+type TrashPage struct {
+	Items []models.TrashItem `json:"items"`
+	pagination.Page
+}
+
+// ITrashManager はソフトデリートした要素の管理に関するインターフェースを定義します。
+// このインターフェースは以下の機能を提供します：
+// - ゴミ箱の要素の一覧取得
+// - 配下の要素を含む復元
+// - 配下の要素を含む完全削除
+// - 指定日時より前に削除された要素の一括完全削除
+type ITrashManager interface {
+	FindTrash(ctx context.Context, entityType string, params pagination.Params) (*TrashPage, error)
+	RestoreFromTrash(ctx context.Context, entityType string, id uint) error
+	PurgeFromTrash(ctx context.Context, entityType string, id uint) error
+	PurgeTrashBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+// softDeleteTable はソフトデリートの対象テーブルの定義です
+// - entityType: ゴミ箱で扱うエンティティ種別（ゴミ箱で扱わないテーブルは空）
+// - label: エラーメッセージに使用する名称
+// - parent: 親テーブル名（大学の場合は空）
+// - foreignKey: 親を参照する外部キー
+// - nameColumn: ゴミ箱の一覧に表示する名称のカラム
+// - joinTables: 完全削除時に行を削除する中間テーブルと参照カラム
+type softDeleteTable struct {
+	table      string
+	entityType string
+	label      string
+	model      func() interface{}
+	parent     string
+	foreignKey string
+	nameColumn string
+	joinTables map[string]string
+}
+
+// softDeleteTables は大学を頂点とするソフトデリートの対象テーブルです（親から子の順）
+var softDeleteTables = []softDeleteTable{
+	{
+		table:      "universities",
+		entityType: models.AuditEntityUniversity,
+		label:      "大学",
+		model:      func() interface{} { return &models.University{} },
+		nameColumn: "name",
+	},
+	{
+		table:      "regions",
+		label:      "地域",
+		model:      func() interface{} { return &models.Region{} },
+		parent:     "universities",
+		foreignKey: "university_id",
+	},
+	{
+		table:      "prefectures",
+		label:      "都道府県",
+		model:      func() interface{} { return &models.Prefecture{} },
+		parent:     "regions",
+		foreignKey: "region_id",
+	},
+	{
+		table:      "classifications",
+		label:      "設置区分",
+		model:      func() interface{} { return &models.Classification{} },
+		parent:     "universities",
+		foreignKey: "university_id",
+	},
+	{
+		table:      "sub_classifications",
+		label:      "小分類",
+		model:      func() interface{} { return &models.SubClassification{} },
+		parent:     "classifications",
+		foreignKey: "classification_id",
+	},
+	{
+		table:      "departments",
+		entityType: models.AuditEntityDepartment,
+		label:      "学部",
+		model:      func() interface{} { return &models.Department{} },
+		parent:     "universities",
+		foreignKey: "university_id",
+		nameColumn: "name",
+	},
+	{
+		table:      "majors",
+		entityType: models.AuditEntityMajor,
+		label:      "学科",
+		model:      func() interface{} { return &models.Major{} },
+		parent:     "departments",
+		foreignKey: "department_id",
+		nameColumn: "name",
+	},
+	{
+		table:      "academic_fields",
+		label:      "学問系統",
+		model:      func() interface{} { return &models.AcademicField{} },
+		parent:     "majors",
+		foreignKey: "major_id",
+	},
+	{
+		table:      "admission_schedules",
+		entityType: models.AuditEntityAdmissionSchedule,
+		label:      "入試日程",
+		model:      func() interface{} { return &models.AdmissionSchedule{} },
+		parent:     "majors",
+		foreignKey: "major_id",
+		nameColumn: "name",
+	},
+	{
+		table:      "schedule_events",
+		label:      "入試日程の日程",
+		model:      func() interface{} { return &models.ScheduleEvent{} },
+		parent:     "admission_schedules",
+		foreignKey: "admission_schedule_id",
+	},
+	{
+		table:      "admission_infos",
+		entityType: models.AuditEntityAdmissionInfo,
+		label:      "入試情報",
+		model:      func() interface{} { return &models.AdmissionInfo{} },
+		parent:     "admission_schedules",
+		foreignKey: "admission_schedule_id",
+		joinTables: map[string]string{"admission_info_test_types": "admission_info_id"},
+	},
+	{
+		table:      "admission_difficulties",
+		label:      "合格難易度",
+		model:      func() interface{} { return &models.AdmissionDifficulty{} },
+		parent:     "admission_infos",
+		foreignKey: "admission_info_id",
+	},
+	{
+		table:      "test_types",
+		entityType: models.AuditEntityTestType,
+		label:      "試験種別",
+		model:      func() interface{} { return &models.TestType{} },
+		parent:     "admission_schedules",
+		foreignKey: "admission_schedule_id",
+		nameColumn: "name",
+		joinTables: map[string]string{"admission_info_test_types": "test_type_id"},
+	},
+	{
+		table:      "subject_groups",
+		label:      "選択科目群",
+		model:      func() interface{} { return &models.SubjectGroup{} },
+		parent:     "test_types",
+		foreignKey: "test_type_id",
+	},
+	{
+		table:      "subjects",
+		entityType: models.AuditEntitySubject,
+		label:      "科目",
+		model:      func() interface{} { return &models.Subject{} },
+		parent:     "test_types",
+		foreignKey: "test_type_id",
+		nameColumn: "name",
+	},
+}
+
+// softDeleteTableOf はテーブル名から定義を取得します
+func softDeleteTableOf(table string) (softDeleteTable, bool) {
+	for _, t := range softDeleteTables {
+		if t.table == table {
+			return t, true
+		}
+	}
+
+	return softDeleteTable{}, false
+}
+
+// trashTableOf はゴミ箱で扱うエンティティ種別から定義を取得します
+func trashTableOf(entityType string) (softDeleteTable, error) {
+	for _, t := range softDeleteTables {
+		if t.entityType != "" && t.entityType == entityType {
+			return t, nil
+		}
+	}
+
+	return softDeleteTable{}, appErrors.NewInvalidInputError(
+		"entityType",
+		"ゴミ箱で扱えないエンティティ種別です",
+		map[string]string{"entityType": entityType},
+	)
+}
+
+// childTables は親テーブルを参照する子テーブルの定義を返します
+func childTables(table string) []softDeleteTable {
+	var children []softDeleteTable
+
+	for _, t := range softDeleteTables {
+		if t.parent == table {
+			children = append(children, t)
+		}
+	}
+
+	return children
+}
+
+// softDeleteCascade は要素と配下の未削除の要素を同じ削除日時でソフトデリートします。
+// 削除日時を揃えることで、復元時に一緒に削除された要素のみを復元できます。
+// 指定した要素のうち削除した件数を返します
+func softDeleteCascade(tx *gorm.DB, def softDeleteTable, ids []uint, deletedAt time.Time) (int64, error) {
+	result := tx.Session(&gorm.Session{NowFunc: func() time.Time { return deletedAt }}).
+		Where("id IN ?", ids).
+		Delete(def.model())
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return 0, nil
+	}
+
+	for _, child := range childTables(def.table) {
+		var childIDs []uint
+		if err := tx.Model(child.model()).Where(child.foreignKey+" IN ?", ids).Pluck("id", &childIDs).Error; err != nil {
+			return 0, err
+		}
+
+		if len(childIDs) == 0 {
+			continue
+		}
+
+		if _, err := softDeleteCascade(tx, child, childIDs, deletedAt); err != nil {
+			return 0, err
+		}
+	}
+
+	return result.RowsAffected, nil
+}
+
+// restoreCascade は要素と、同じ削除日時で削除された配下の要素を復元します
+func restoreCascade(tx *gorm.DB, def softDeleteTable, ids []uint, deletedAt time.Time) error {
+	err := tx.Session(&gorm.Session{SkipHooks: true}).Unscoped().
+		Model(def.model()).
+		Where("id IN ?", ids).
+		Updates(map[string]interface{}{"deleted_at": nil, "updated_at": time.Now()}).Error
+	if err != nil {
+		return err
+	}
+
+	for _, child := range childTables(def.table) {
+		var childIDs []uint
+
+		err := tx.Unscoped().Model(child.model()).
+			Where(child.foreignKey+" IN ? AND deleted_at = ?", ids, deletedAt).
+			Pluck("id", &childIDs).Error
+		if err != nil {
+			return err
+		}
+
+		if len(childIDs) == 0 {
+			continue
+		}
+
+		if err := restoreCascade(tx, child, childIDs, deletedAt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// purgeCascade は要素と配下の全ての要素を物理削除します。
+// 削除した件数（配下の要素を含む）を返します
+func purgeCascade(tx *gorm.DB, def softDeleteTable, ids []uint) (int64, error) {
+	var purged int64
+
+	for _, child := range childTables(def.table) {
+		var childIDs []uint
+		if err := tx.Unscoped().Model(child.model()).Where(child.foreignKey+" IN ?", ids).Pluck("id", &childIDs).Error; err != nil {
+			return 0, err
+		}
+
+		if len(childIDs) == 0 {
+			continue
+		}
+
+		n, err := purgeCascade(tx, child, childIDs)
+		if err != nil {
+			return 0, err
+		}
+
+		purged += n
+	}
+
+	for joinTable, column := range def.joinTables {
+		if err := tx.Exec("DELETE FROM "+joinTable+" WHERE "+column+" IN ?", ids).Error; err != nil {
+			return 0, err
+		}
+	}
+
+	result := tx.Unscoped().Where("id IN ?", ids).Delete(def.model())
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return purged + result.RowsAffected, nil
+}
+
+// owningUniversityID は要素が所属する大学のIDを削除済みの要素も含めて取得します。
+// 要素が存在しない場合は0を返します
+func owningUniversityID(tx *gorm.DB, def softDeleteTable, id uint) (uint, error) {
+	for def.parent != "" {
+		var parentID uint
+		if err := tx.Table(def.table).Select(def.foreignKey).Where("id = ?", id).Scan(&parentID).Error; err != nil {
+			return 0, err
+		}
+
+		parent, ok := softDeleteTableOf(def.parent)
+		if !ok || parentID == 0 {
+			return 0, nil
+		}
+
+		def, id = parent, parentID
+	}
+
+	return id, nil
+}
+
+// trashedAt はゴミ箱にある要素の削除日時を取得します。
+// 要素が存在しない場合や削除されていない場合は NotFound を返します
+func trashedAt(tx *gorm.DB, def softDeleteTable, id uint) (time.Time, error) {
+	var row struct {
+		DeletedAt *time.Time
+	}
+
+	err := tx.Table(def.table).Select("deleted_at").Where("id = ?", id).Take(&row).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return time.Time{}, appErrors.NewDatabaseError("ゴミ箱の"+def.label+"取得処理", err, nil)
+	}
+
+	if err != nil || row.DeletedAt == nil {
+		return time.Time{}, appErrors.NewNotFoundError("ゴミ箱の"+def.label, id, nil)
+	}
+
+	return *row.DeletedAt, nil
+}
+
+// softDelete は要素と配下の要素を同じ削除日時でソフトデリートします。
+// この関数は以下の処理を行います：
+// - 所属する大学の特定
+// - 配下の要素を含むソフトデリート
+// - 所属する大学のキャッシュのクリア
+// 削除した件数を返します（存在しない場合や削除済みの場合は0）
+func (r *universityRepository) softDelete(ctx context.Context, table string, id uint) (int64, error) {
+	def, ok := softDeleteTableOf(table)
+	if !ok {
+		return 0, fmt.Errorf("ソフトデリートの対象外のテーブルです: %s", table)
+	}
+
+	deletedAt := time.Now().UTC().Truncate(time.Microsecond)
+
+	var (
+		universityID uint
+		deleted      int64
+	)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		if universityID, err = owningUniversityID(tx, def, id); err != nil {
+			return err
+		}
+
+		deleted, err = softDeleteCascade(tx, def, []uint{id}, deletedAt)
+
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if deleted > 0 {
+		r.cache.ClearAllRelatedCache(universityID)
+		r.searchIndex.invalidate()
+	}
+
+	return deleted, nil
+}
+
+// FindTrash はゴミ箱の要素を削除日時の新しい順に取得します。
+// この関数は以下の処理を行います：
+// - エンティティ種別の検証
+// - 削除済みの要素の総件数の取得
+// - オフセット方式でのページ単位の取得
+func (r *universityRepository) FindTrash(
+	ctx context.Context,
+	entityType string,
+	params pagination.Params,
+) (*TrashPage, error) {
+	def, err := trashTableOf(entityType)
+	if err != nil {
+		return nil, err
+	}
+
+	query := r.db.WithContext(ctx).Table(def.table).Where("deleted_at IS NOT NULL")
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, appErrors.NewDatabaseError("ゴミ箱の件数取得処理", err, nil)
+	}
+
+	columns := []string{"id", "deleted_at", "'' AS name", "0 AS parent_id"}
+	if def.nameColumn != "" {
+		columns[2] = def.nameColumn + " AS name"
+	}
+
+	if def.foreignKey != "" {
+		columns[3] = def.foreignKey + " AS parent_id"
+	}
+
+	items := make([]models.TrashItem, 0, params.PerPage)
+
+	err = query.Select(columns).
+		Order("deleted_at DESC").Order("id DESC").
+		Offset(params.Offset()).
+		Limit(params.PerPage).
+		Scan(&items).Error
+	if err != nil {
+		return nil, appErrors.NewDatabaseError("ゴミ箱の取得処理", err, nil)
+	}
+
+	parentType := ""
+	if parent, ok := softDeleteTableOf(def.parent); ok {
+		parentType = parent.entityType
+	}
+
+	for i := range items {
+		items[i].EntityType = entityType
+		items[i].ParentType = parentType
+	}
+
+	return &TrashPage{
+		Items: items,
+		Page: pagination.Page{
+			Total:   total,
+			Page:    params.Page,
+			PerPage: params.PerPage,
+			HasNext: int64(params.Offset()+len(items)) < total,
+			HasPrev: params.Page > 1,
+		},
+	}, nil
+}
+
+// RestoreFromTrash はゴミ箱の要素を、一緒に削除された配下の要素とともに復元します。
+// この関数は以下の処理を行います：
+// - ゴミ箱にあることの確認
+// - 親の要素が削除されていないことの確認
+// - 同じ削除日時で削除された配下の要素を含む復元
+// - 所属する大学のキャッシュのクリア
+func (r *universityRepository) RestoreFromTrash(ctx context.Context, entityType string, id uint) error {
+	def, err := trashTableOf(entityType)
+	if err != nil {
+		return err
+	}
+
+	var universityID uint
+
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		deletedAt, err := trashedAt(tx, def, id)
+		if err != nil {
+			return err
+		}
+
+		if err := checkParentRestored(tx, def, id); err != nil {
+			return err
+		}
+
+		if universityID, err = owningUniversityID(tx, def, id); err != nil {
+			return appErrors.NewDatabaseError(def.label+"復元処理", err, nil)
+		}
+
+		if err := restoreCascade(tx, def, []uint{id}, deletedAt); err != nil {
+			return appErrors.NewDatabaseError(def.label+"復元処理", err, nil)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	r.cache.ClearAllRelatedCache(universityID)
+	r.searchIndex.invalidate()
+
+	return nil
+}
+
+// checkParentRestored は親の要素が削除されていないことを確認します
+func checkParentRestored(tx *gorm.DB, def softDeleteTable, id uint) error {
+	parent, ok := softDeleteTableOf(def.parent)
+	if !ok {
+		return nil
+	}
+
+	var parentID uint
+	if err := tx.Table(def.table).Select(def.foreignKey).Where("id = ?", id).Scan(&parentID).Error; err != nil {
+		return appErrors.NewDatabaseError(def.label+"復元処理", err, nil)
+	}
+
+	if _, err := trashedAt(tx, parent, parentID); err != nil {
+		var appErr *appErrors.Error
+		if errors.As(err, &appErr) && appErr.Code == appErrors.CodeNotFound {
+			return nil
+		}
+
+		return err
+	}
+
+	return appErrors.NewValidationError(
+		"entityID",
+		fmt.Sprintf("親の%sが削除されているため復元できません。先に%sを復元してください", parent.label, parent.label),
+		map[string]string{
+			"parent_type": parent.entityType,
+			"parent_id":   strconv.FormatUint(uint64(parentID), 10),
+		},
+	)
+}
+
+// PurgeFromTrash はゴミ箱の要素を配下の要素とともに物理削除します。
+// この関数は以下の処理を行います：
+// - ゴミ箱にあることの確認
+// - 配下の要素と中間テーブルの行を含む物理削除
+func (r *universityRepository) PurgeFromTrash(ctx context.Context, entityType string, id uint) error {
+	def, err := trashTableOf(entityType)
+	if err != nil {
+		return err
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := trashedAt(tx, def, id); err != nil {
+			return err
+		}
+
+		if _, err := purgeCascade(tx, def, []uint{id}); err != nil {
+			return appErrors.NewDatabaseError(def.label+"完全削除処理", err, nil)
+		}
+
+		return nil
+	})
+}
+
+// PurgeTrashBefore は指定日時より前に削除された要素を配下の要素とともに物理削除します。
+// この関数は以下の処理を行います：
+// - 親から子の順にテーブルごとの対象の取得
+// - 配下の要素と中間テーブルの行を含む物理削除
+// 物理削除した件数（配下の要素を含む）を返します
+func (r *universityRepository) PurgeTrashBefore(ctx context.Context, before time.Time) (int64, error) {
+	var purged int64
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, def := range softDeleteTables {
+			if err := checkContext(ctx, "ゴミ箱の一括完全削除処理"); err != nil {
+				return err
+			}
+
+			var ids []uint
+
+			err := tx.Unscoped().Model(def.model()).
+				Where("deleted_at IS NOT NULL AND deleted_at < ?", before.UTC()).
+				Pluck("id", &ids).Error
+			if err != nil {
+				return appErrors.NewDatabaseError("ゴミ箱の一括完全削除処理", err, nil)
+			}
+
+			if len(ids) == 0 {
+				continue
+			}
+
+			n, err := purgeCascade(tx, def, ids)
+			if err != nil {
+				return appErrors.NewDatabaseError("ゴミ箱の一括完全削除処理", err, nil)
+			}
+
+			purged += n
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,241 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/pkg/pagination"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// countTrashRows は削除済みを含めたテーブルの行数と、そのうち削除済みの行数を返します
+func countTrashRows(t *testing.T, db *gorm.DB, table string) (total, deleted int64) {
+	t.Helper()
+
+	require.NoError(t, db.Table(table).Count(&total).Error)
+	require.NoError(t, db.Table(table).Where("deleted_at IS NOT NULL").Count(&deleted).Error)
+
+	return total, deleted
+}
+
+// requireAppErrorCode はアプリケーションエラーのコードを検証して返します
+func requireAppErrorCode(t *testing.T, err error, code appErrors.Code) *appErrors.Error {
+	t.Helper()
+
+	var appErr *appErrors.Error
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, code, appErr.Code)
+
+	return appErr
+}
+
+func TestSoftDeleteCascade(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	path := setupAcademicYearTestData(t, db)
+
+	repo := NewUniversityRepository(db)
+	ctx := context.Background()
+
+	require.NoError(t, repo.Delete(ctx, path.UniversityID))
+
+	t.Run("通常の取得では削除済みの大学を返さない", func(t *testing.T) {
+		err := db.First(&models.University{}, path.UniversityID).Error
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+		var stored models.University
+		require.NoError(t, db.Unscoped().First(&stored, path.UniversityID).Error)
+		assert.True(t, stored.DeletedAt.Valid)
+	})
+
+	t.Run("配下の要素を同じ削除日時でソフトデリート", func(t *testing.T) {
+		var deletedAt []time.Time
+		for _, table := range []string{"universities", "departments", "majors", "admission_schedules",
+			"admission_infos", "test_types", "subjects"} {
+			total, deleted := countTrashRows(t, db, table)
+			assert.NotZero(t, total, table)
+			assert.Equal(t, total, deleted, table)
+
+			var times []time.Time
+			require.NoError(t, db.Table(table).Distinct("deleted_at").Pluck("deleted_at", &times).Error)
+			deletedAt = append(deletedAt, times...)
+		}
+
+		for _, at := range deletedAt {
+			assert.True(t, at.Equal(deletedAt[0]))
+		}
+	})
+
+	t.Run("削除済みの大学の削除はNotFound", func(t *testing.T) {
+		requireAppErrorCode(t, repo.Delete(ctx, path.UniversityID), appErrors.CodeNotFound)
+	})
+}
+
+func TestFindTrash(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	path := setupAcademicYearTestData(t, db)
+
+	repo := NewUniversityRepository(db)
+	ctx := context.Background()
+
+	require.NoError(t, repo.DeleteMajor(ctx, path.MajorID))
+
+	t.Run("親の情報を含めて取得", func(t *testing.T) {
+		page, err := repo.FindTrash(ctx, models.AuditEntityMajor, pagination.DefaultParams())
+		require.NoError(t, err)
+		require.Len(t, page.Items, 1)
+
+		item := page.Items[0]
+		assert.Equal(t, models.AuditEntityMajor, item.EntityType)
+		assert.Equal(t, path.MajorID, item.ID)
+		assert.Equal(t, "機械工学科", item.Name)
+		assert.Equal(t, models.AuditEntityDepartment, item.ParentType)
+		assert.Equal(t, path.DepartmentID, item.ParentID)
+		assert.False(t, item.DeletedAt.IsZero())
+		assert.Equal(t, int64(1), page.Total)
+	})
+
+	t.Run("配下の要素もページ単位で取得", func(t *testing.T) {
+		params := pagination.DefaultParams()
+		params.PerPage = 2
+
+		page, err := repo.FindTrash(ctx, models.AuditEntitySubject, params)
+		require.NoError(t, err)
+		assert.Len(t, page.Items, 2)
+		assert.Equal(t, int64(4), page.Total)
+		assert.True(t, page.HasNext)
+
+		page, err = repo.FindTrash(ctx, models.AuditEntityAdmissionInfo, params)
+		require.NoError(t, err)
+		assert.Empty(t, page.Items[0].Name, "入試情報は名称を持たない")
+	})
+
+	t.Run("削除されていない種別は空", func(t *testing.T) {
+		page, err := repo.FindTrash(ctx, models.AuditEntityUniversity, pagination.DefaultParams())
+		require.NoError(t, err)
+		assert.Empty(t, page.Items)
+		assert.Zero(t, page.Total)
+	})
+
+	t.Run("不正なエンティティ種別", func(t *testing.T) {
+		_, err := repo.FindTrash(ctx, "region", pagination.DefaultParams())
+		requireAppErrorCode(t, err, appErrors.CodeInvalidInput)
+	})
+}
+
+func TestRestoreFromTrash(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	path := setupAcademicYearTestData(t, db)
+
+	repo := NewUniversityRepository(db)
+	ctx := context.Background()
+
+	var subject models.Subject
+	require.NoError(t, db.Where("name = ?", "小論文").Take(&subject).Error)
+
+	// 先に個別に削除した科目は大学の復元では戻らない
+	require.NoError(t, repo.DeleteSubject(ctx, subject.ID))
+	require.NoError(t, repo.Delete(ctx, path.UniversityID))
+
+	t.Run("親が削除されている場合は復元できない", func(t *testing.T) {
+		err := repo.RestoreFromTrash(ctx, models.AuditEntityDepartment, path.DepartmentID)
+		appErr := requireAppErrorCode(t, err, appErrors.CodeValidationError)
+		assert.Equal(t, models.AuditEntityUniversity, appErr.Details.Extra["parent_type"])
+	})
+
+	t.Run("大学を学部・学科とともに復元", func(t *testing.T) {
+		require.NoError(t, repo.RestoreFromTrash(ctx, models.AuditEntityUniversity, path.UniversityID))
+
+		university, err := repo.FindByID(ctx, path.UniversityID)
+		require.NoError(t, err)
+		require.Len(t, university.Departments, 1)
+		require.Len(t, university.Departments[0].Majors, 1)
+
+		for _, table := range []string{"universities", "departments", "majors", "admission_schedules",
+			"admission_infos", "test_types"} {
+			_, deleted := countTrashRows(t, db, table)
+			assert.Zero(t, deleted, table)
+		}
+
+		_, deleted := countTrashRows(t, db, "subjects")
+		assert.Equal(t, int64(1), deleted)
+		assert.ErrorIs(t, db.First(&models.Subject{}, subject.ID).Error, gorm.ErrRecordNotFound)
+	})
+
+	t.Run("個別に削除した要素を復元", func(t *testing.T) {
+		require.NoError(t, repo.RestoreFromTrash(ctx, models.AuditEntitySubject, subject.ID))
+		require.NoError(t, db.First(&models.Subject{}, subject.ID).Error)
+	})
+
+	t.Run("ゴミ箱にない要素はNotFound", func(t *testing.T) {
+		err := repo.RestoreFromTrash(ctx, models.AuditEntityUniversity, path.UniversityID)
+		requireAppErrorCode(t, err, appErrors.CodeNotFound)
+
+		err = repo.RestoreFromTrash(ctx, models.AuditEntityMajor, 999)
+		requireAppErrorCode(t, err, appErrors.CodeNotFound)
+	})
+}
+
+func TestPurgeFromTrash(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	path := setupAcademicYearTestData(t, db)
+
+	repo := NewUniversityRepository(db)
+	ctx := context.Background()
+
+	t.Run("ゴミ箱にない要素は完全削除できない", func(t *testing.T) {
+		err := repo.PurgeFromTrash(ctx, models.AuditEntityMajor, path.MajorID)
+		requireAppErrorCode(t, err, appErrors.CodeNotFound)
+	})
+
+	t.Run("学科を配下の要素とともに物理削除", func(t *testing.T) {
+		require.NoError(t, repo.DeleteMajor(ctx, path.MajorID))
+		require.NoError(t, repo.PurgeFromTrash(ctx, models.AuditEntityMajor, path.MajorID))
+
+		for _, table := range []string{"majors", "admission_schedules", "admission_infos", "test_types", "subjects"} {
+			total, _ := countTrashRows(t, db, table)
+			assert.Zero(t, total, table)
+		}
+
+		var links int64
+		require.NoError(t, db.Table("admission_info_test_types").Count(&links).Error)
+		assert.Zero(t, links)
+
+		total, _ := countTrashRows(t, db, "departments")
+		assert.Equal(t, int64(1), total, "親の学部は残る")
+	})
+}
+
+func TestPurgeTrashBefore(t *testing.T) {
+	db := setupSQLiteTestDB(t)
+	path := setupAcademicYearTestData(t, db)
+
+	repo := NewUniversityRepository(db)
+	ctx := context.Background()
+
+	require.NoError(t, repo.Delete(ctx, path.UniversityID))
+
+	t.Run("保持期間内の要素は残す", func(t *testing.T) {
+		purged, err := repo.PurgeTrashBefore(ctx, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Zero(t, purged)
+
+		total, _ := countTrashRows(t, db, "universities")
+		assert.Equal(t, int64(1), total)
+	})
+
+	t.Run("保持期間を過ぎた要素を配下の要素とともに物理削除", func(t *testing.T) {
+		purged, err := repo.PurgeTrashBefore(ctx, time.Now().Add(time.Second))
+		require.NoError(t, err)
+		assert.Equal(t, int64(15), purged)
+
+		for _, table := range []string{"universities", "departments", "majors", "subjects"} {
+			total, _ := countTrashRows(t, db, table)
+			assert.Zero(t, total, table)
+		}
+	})
+}
@@ -29,6 +29,9 @@ const (
 	errMaxItems = "%sは%d以下である必要があります"
 	errNonNegative = "%sは0以上である必要があります"
 	errPercentageRange = "パーセンテージは0以上100以下である必要があります"
+	errConversionRatioRange = "換算比率は0より大きく10以下である必要があります"
+	errUnregisteredTestType = "試験種別「%s」は試験種別レジストリに登録されていません"
+	errUnregisteredSchedule = "日程「%s」は日程レジストリに登録されていません"
 )
 
 // ValidationRule はバリデーションルールを定義します。
@@ -317,7 +320,7 @@ func (r *universityRepository) validateAdmissionSchedule(
 		"departments[%d].majors[%d].admissionSchedules[%d].name",
 		deptIndex, majorIndex, scheduleIndex,
 	)
-	if err := validateName(schedule.Name, fieldName); err != nil {
+	if err := validateScheduleName(schedule.Name, fieldName); err != nil {
 		return err
 	}
 
@@ -358,6 +361,7 @@ func (r *universityRepository) validateAdmissionSchedule(
 // validateTestType はテストタイプのバリデーションを行います。
 // この関数は以下の処理を行います：
 // - 基本情報の検証
+// - 試験種別レジストリへの登録の確認
 // - 科目の検証
 // - エラーの返却
 func (r *universityRepository) validateTestType(
@@ -373,6 +377,10 @@ func (r *universityRepository) validateTestType(
 		return err
 	}
 
+	if err := validateTestTypeName(testType.Name, fieldName); err != nil {
+		return err
+	}
+
 	// バージョンチェック
 	if testType.Version < 1 {
 		return appErrors.NewInvalidInputError(
@@ -464,6 +472,18 @@ func (r *universityRepository) validateSubject(
 		)
 	}
 
+	// 換算比率のバリデーション（0は未指定として既定値の1を使用する）
+	if subject.ConversionRatio < 0 || subject.ConversionRatio > models.MaxConversionRatio {
+		return appErrors.NewInvalidInputError(
+			fmt.Sprintf(
+				"departments[%d].majors[%d].admissionSchedules[%d].testTypes[%d].subjects[%d].conversionRatio",
+				deptIndex, majorIndex, scheduleIndex, testTypeIndex, subjectIndex,
+			),
+			errConversionRatioRange,
+			nil,
+		)
+	}
+
 	// パーセンテージのバリデーション
 	if subject.Percentage < 0 || subject.Percentage > 100 {
 		return appErrors.NewInvalidInputError(
@@ -490,3 +510,29 @@ func (r *universityRepository) validateSubject(
 
 	return nil
 }
+
+// validateTestTypeName は試験種別名が試験種別レジストリに登録されていることを確認します
+func validateTestTypeName(name, fieldName string) error {
+	if _, ok := models.TestTypes().Lookup(name); !ok {
+		return appErrors.NewValidationError(
+			fieldName,
+			fmt.Sprintf(errUnregisteredTestType, name),
+			map[string]string{"registered": strings.Join(models.TestTypes().Names(), ",")},
+		)
+	}
+
+	return nil
+}
+
+// validateScheduleName は日程名が日程レジストリに登録されていることを確認します
+func validateScheduleName(name, fieldName string) error {
+	if _, ok := models.Schedules().Lookup(name); !ok {
+		return appErrors.NewValidationError(
+			fieldName,
+			fmt.Sprintf(errUnregisteredSchedule, name),
+			map[string]string{"registered": strings.Join(models.Schedules().Names(), ",")},
+		)
+	}
+
+	return nil
+}
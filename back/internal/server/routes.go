@@ -8,16 +8,38 @@
 package server
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
+	"os"
 	"regexp"
 	"time"
 	"university-exam-api/internal/config"
+	"university-exam-api/internal/domain/models"
+	academicyear "university-exam-api/internal/handlers/academic_year"
+	admissioninfo "university-exam-api/internal/handlers/admission_info"
+	admissionschedule "university-exam-api/internal/handlers/admission_schedule"
 	"university-exam-api/internal/handlers/department"
+	"university-exam-api/internal/handlers/history"
+	"university-exam-api/internal/handlers/importer"
+	"university-exam-api/internal/handlers/major"
+	masterdata "university-exam-api/internal/handlers/master_data"
+	"university-exam-api/internal/handlers/publication"
 	"university-exam-api/internal/handlers/search"
 	"university-exam-api/internal/handlers/subject"
+	scheduleevent "university-exam-api/internal/handlers/schedule_event"
+	testtype "university-exam-api/internal/handlers/test_type"
+	"university-exam-api/internal/handlers/trash"
 	"university-exam-api/internal/handlers/university"
+	"university-exam-api/internal/infrastructure/audit"
+	"university-exam-api/internal/infrastructure/jobs"
+	applogger "university-exam-api/internal/logger"
+	custom_middleware "university-exam-api/internal/middleware"
 	"university-exam-api/internal/repositories"
+	"university-exam-api/internal/usecases"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
@@ -30,6 +52,15 @@ const (
 	subjectPath   = "/:universityID/departments/:departmentID/subjects/:subjectID" // 科目関連のパス
 	departmentIDParam = "/:departmentID" // 学部IDパラメータ
 	subjectIDParam = "/:subjectID" // 科目IDパラメータ
+	majorPath = "/:universityID/departments/:departmentID/majors/:majorID" // 学科関連のパス
+	majorIDParam = "/:majorID" // 学科IDパラメータ
+	scheduleIDParam = "/:scheduleID" // 入試日程IDパラメータ
+	admissionInfoIDParam = "/:infoID" // 入試情報IDパラメータ
+	testTypeIDParam = "/:testTypeID" // 試験種別IDパラメータ
+	eventIDParam = "/:eventID" // 日程IDパラメータ
+	subjectGroupsPath = "/:testTypeID/subject-groups" // 選択科目群のパス
+	subjectGroupIDParam = "/:subjectGroupID" // 選択科目群IDパラメータ
+	admissionDifficultyPath = "/:infoID/difficulty" // 合格難易度のパス
 )
 
 // タイムアウト定数
@@ -42,6 +73,11 @@ var (
 	universityIDRegex = regexp.MustCompile(`^[0-9]+$`)
 	departmentIDRegex = regexp.MustCompile(`^[0-9]+$`)
 	subjectIDRegex   = regexp.MustCompile(`^[0-9]+$`)
+	majorIDRegex     = regexp.MustCompile(`^[0-9]+$`)
+	scheduleIDRegex  = regexp.MustCompile(`^[0-9]+$`)
+	infoIDRegex      = regexp.MustCompile(`^[0-9]+$`)
+	testTypeIDRegex  = regexp.MustCompile(`^[0-9]+$`)
+	subjectGroupIDRegex = regexp.MustCompile(`^[0-9]+$`)
 )
 
 // ErrorResponse はエラーレスポンスの構造体を定義します
@@ -56,10 +92,12 @@ type ErrorResponse struct {
 // - Echoインスタンス
 // - データベース接続
 // - アプリケーション設定
+// - サーバーの稼働中に実行するバックグラウンドジョブ
 type Routes struct {
 	echo *echo.Echo
 	db   *gorm.DB
 	cfg  *config.Config
+	jobs []func(ctx context.Context)
 }
 
 // NewRoutes は新しいルーティングインスタンスを作成します。
@@ -80,11 +118,17 @@ func NewRoutes(e *echo.Echo, db *gorm.DB, cfg *config.Config) *Routes {
 // - 大学IDの検証
 // - 学部IDの検証
 // - 科目IDの検証
+// - 学科ID・入試日程ID・入試情報ID・試験種別ID・選択科目群IDの検証
 func validatePathParams(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		universityID := c.Param("universityID")
 		departmentID := c.Param("departmentID")
 		subjectID := c.Param("subjectID")
+		majorID := c.Param("majorID")
+		scheduleID := c.Param("scheduleID")
+		infoID := c.Param("infoID")
+		testTypeID := c.Param("testTypeID")
+		subjectGroupID := c.Param("subjectGroupID")
 
 		if universityID != "" && !universityIDRegex.MatchString(universityID) {
 			return &echo.HTTPError{
@@ -107,6 +151,41 @@ func validatePathParams(next echo.HandlerFunc) echo.HandlerFunc {
 			}
 		}
 
+		if majorID != "" && !majorIDRegex.MatchString(majorID) {
+			return &echo.HTTPError{
+				Code:    http.StatusBadRequest,
+				Message: "学科IDは数値である必要があります",
+			}
+		}
+
+		if scheduleID != "" && !scheduleIDRegex.MatchString(scheduleID) {
+			return &echo.HTTPError{
+				Code:    http.StatusBadRequest,
+				Message: "入試日程IDは数値である必要があります",
+			}
+		}
+
+		if infoID != "" && !infoIDRegex.MatchString(infoID) {
+			return &echo.HTTPError{
+				Code:    http.StatusBadRequest,
+				Message: "入試情報IDは数値である必要があります",
+			}
+		}
+
+		if testTypeID != "" && !testTypeIDRegex.MatchString(testTypeID) {
+			return &echo.HTTPError{
+				Code:    http.StatusBadRequest,
+				Message: "試験種別IDは数値である必要があります",
+			}
+		}
+
+		if subjectGroupID != "" && !subjectGroupIDRegex.MatchString(subjectGroupID) {
+			return &echo.HTTPError{
+				Code:    http.StatusBadRequest,
+				Message: "選択科目群IDは数値である必要があります",
+			}
+		}
+
 		return next(c)
 	}
 }
@@ -116,6 +195,7 @@ func validatePathParams(next echo.HandlerFunc) echo.HandlerFunc {
 // - Content-Typeの検証
 // - リクエストボディのサイズチェック
 // - JSONバリデーション
+// - ハンドラーでバインドするためのリクエストボディの復元
 func validateRequestBody(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		if c.Request().ContentLength == 0 {
@@ -139,18 +219,77 @@ func validateRequestBody(next echo.HandlerFunc) echo.HandlerFunc {
 		}
 
 		// リクエストボディのJSONバリデーション
-		var body interface{}
-		if err := c.Bind(&body); err != nil {
+		body, err := io.ReadAll(c.Request().Body)
+		if err != nil || !json.Valid(body) {
 			return &echo.HTTPError{
 				Code:    http.StatusBadRequest,
 				Message: "JSONの形式が不正です",
 			}
 		}
 
+		// ハンドラーでバインドできるようにリクエストボディを戻す
+		c.Request().Body = io.NopCloser(bytes.NewReader(body))
+
 		return next(c)
 	}
 }
 
+// registerMasterData はマスタデータの種類ごとのエンドポイントを登録します
+func registerMasterData[T any, PT models.MasterDataModel[T]](g *echo.Group, h *masterdata.Handler[T, PT]) {
+	idParam := "/:" + masterdata.ParamID
+
+	g.GET("", h.List)
+	g.POST("", validateRequestBody(h.Create))
+	g.PUT("/order", validateRequestBody(h.Reorder))
+	g.GET(idParam, h.Get)
+	g.PUT(idParam, validateRequestBody(h.Update))
+	g.DELETE(idParam, h.Delete)
+}
+
+// loadTestTypeRegistry は設定された定義ファイルから試験種別レジストリを読み込みます
+func (r *Routes) loadTestTypeRegistry() error {
+	if r.cfg == nil || r.cfg.TestTypeRegistryFile == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(r.cfg.TestTypeRegistryFile)
+	if err != nil {
+		return fmt.Errorf("試験種別レジストリの定義ファイルの読み込みに失敗しました: %w", err)
+	}
+
+	registry, err := models.ParseTestTypeRegistry(data)
+	if err != nil {
+		return err
+	}
+
+	models.SetTestTypes(registry)
+	applogger.Info(context.Background(), "試験種別レジストリを読み込みました: %v", registry.Names())
+
+	return nil
+}
+
+// loadScheduleRegistry は設定された定義ファイルから日程レジストリを読み込みます
+func (r *Routes) loadScheduleRegistry() error {
+	if r.cfg == nil || r.cfg.ScheduleRegistryFile == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(r.cfg.ScheduleRegistryFile)
+	if err != nil {
+		return fmt.Errorf("日程レジストリの定義ファイルの読み込みに失敗しました: %w", err)
+	}
+
+	registry, err := models.ParseScheduleRegistry(data)
+	if err != nil {
+		return err
+	}
+
+	models.SetSchedules(registry)
+	applogger.Info(context.Background(), "日程レジストリを読み込みました: %v", registry.Names())
+
+	return nil
+}
+
 // Setup はルーティングを設定します。
 // この関数は以下の処理を行います：
 // - リポジトリの初期化
@@ -158,14 +297,91 @@ func validateRequestBody(next echo.HandlerFunc) echo.HandlerFunc {
 // - ミドルウェアの設定
 // - APIエンドポイントの定義
 func (r *Routes) Setup() error {
+	// 監査ログの記録を有効化
+	if err := audit.Register(r.db); err != nil {
+		return err
+	}
+
+	// 試験種別レジストリの読み込み（設定されていない場合は既定の定義を使用）
+	if err := r.loadTestTypeRegistry(); err != nil {
+		return err
+	}
+
+	// 日程レジストリの読み込み（設定されていない場合は既定の定義を使用）
+	if err := r.loadScheduleRegistry(); err != nil {
+		return err
+	}
+
 	// リポジトリの初期化
 	universityRepo := repositories.NewUniversityRepository(r.db)
+	facetSearchRepo := repositories.NewFacetSearchRepositoryWithSearcher(r.db, universityRepo)
+	academicYearRepo := repositories.NewAcademicYearRepository(r.db)
+	similarityRepo := repositories.NewSimilarityRepository(r.db)
+	simulationRepo := repositories.NewScoreSimulationRepository(r.db)
+	exportRepo := repositories.NewExportRepository(r.db)
+	auditRepo := repositories.NewAuditRepository(r.db)
+	ownershipRepo := repositories.NewOwnershipRepository(r.db)
+	filterOptionRepo := repositories.NewFilterOptionRepository(r.db)
+	scheduleEventRepo := repositories.NewScheduleEventRepository(r.db)
+
+	// 検索・入力補完のインデックスの事前構築（失敗時は最初のリクエストで再試行される）
+	if err := universityRepo.WarmSearchIndex(context.Background()); err != nil {
+		applogger.Error(context.Background(), "検索インデックスの構築に失敗しました: %v", err)
+	}
+
+	// ユースケースの初期化
+	facetSearchUsecase := usecases.NewFacetSearchUsecase(facetSearchRepo)
+	academicYearUsecase := usecases.NewAcademicYearUsecase(academicYearRepo)
+	similarityUsecase := usecases.NewSimilarityUsecase(similarityRepo)
+	simulationUsecase := usecases.NewScoreSimulationUsecase(simulationRepo)
+	importUsecase := usecases.NewImportUsecase(universityRepo)
+	exportUsecase := usecases.NewExportUsecase(exportRepo)
+	auditUsecase := usecases.NewAuditUsecase(auditRepo)
+	publicationUsecase := usecases.NewAdmissionPublicationUsecase(universityRepo)
+	trashUsecase := usecases.NewTrashUsecase(universityRepo)
+	scheduleEventUsecase := usecases.NewScheduleEventUsecase(scheduleEventRepo)
+	regionUsecase := usecases.NewMasterDataUsecase[models.Region](
+		repositories.NewMasterDataRepository[models.Region](r.db), filterOptionRepo)
+	prefectureUsecase := usecases.NewMasterDataUsecase[models.Prefecture](
+		repositories.NewMasterDataRepository[models.Prefecture](r.db), filterOptionRepo)
+	classificationUsecase := usecases.NewMasterDataUsecase[models.Classification](
+		repositories.NewMasterDataRepository[models.Classification](r.db), filterOptionRepo)
+	subClassificationUsecase := usecases.NewMasterDataUsecase[models.SubClassification](
+		repositories.NewMasterDataRepository[models.SubClassification](r.db), filterOptionRepo)
+	// 学問系統は入力補完の候補に含まれるため、変更時に検索インデックスを無効化する
+	academicFieldUsecase := usecases.NewMasterDataUsecase[models.AcademicField](
+		repositories.NewMasterDataRepositoryWithOnChange[models.AcademicField](r.db, universityRepo.InvalidateSearchIndex),
+		filterOptionRepo)
 
 	// ハンドラーの初期化
 	universityHandler := university.NewUniversityHandler(universityRepo, requestTimeout)
 	departmentHandler := department.NewDepartmentHandler(universityRepo, requestTimeout)
 	subjectHandler := subject.NewSubjectHandler(universityRepo, requestTimeout)
+	majorHandler := major.NewMajorHandler(universityRepo, requestTimeout)
+	scheduleHandler := admissionschedule.NewHandler(universityRepo, requestTimeout)
+	admissionInfoHandler := admissioninfo.NewHandler(universityRepo, requestTimeout)
+	testTypeHandler := testtype.NewTestTypeHandler(universityRepo, requestTimeout)
 	searchHandler := search.NewSearchHandler(universityRepo, requestTimeout)
+	facetHandler := search.NewFacetHandler(facetSearchUsecase, requestTimeout)
+	similarityHandler := search.NewSimilarityHandler(similarityUsecase, requestTimeout)
+	simulationHandler := search.NewSimulationHandler(simulationUsecase, requestTimeout)
+	exportHandler := search.NewExportHandler(exportUsecase, requestTimeout)
+	academicYearHandler := academicyear.NewAcademicYearHandler(academicYearUsecase, requestTimeout)
+	importHandler := importer.NewImportHandler(importUsecase, requestTimeout)
+	historyHandler := history.NewHistoryHandler(auditUsecase, requestTimeout)
+	publicationHandler := publication.NewPublicationHandler(publicationUsecase, requestTimeout)
+	trashHandler := trash.NewTrashHandler(trashUsecase, requestTimeout)
+	scheduleEventHandler := scheduleevent.NewScheduleEventHandler(scheduleEventUsecase, requestTimeout)
+	regionHandler := masterdata.NewHandler(regionUsecase, "地域", requestTimeout)
+	prefectureHandler := masterdata.NewHandler(prefectureUsecase, "都道府県", requestTimeout)
+	classificationHandler := masterdata.NewHandler(classificationUsecase, "設置区分", requestTimeout)
+	subClassificationHandler := masterdata.NewHandler(subClassificationUsecase, "小分類", requestTimeout)
+	academicFieldHandler := masterdata.NewHandler(academicFieldUsecase, "学問系統", requestTimeout)
+
+	// 保持期間を過ぎたゴミ箱の要素の定期的な完全削除
+	if r.cfg != nil {
+		r.jobs = append(r.jobs, jobs.NewTrashPurgeJob(trashUsecase, r.cfg.TrashRetention, r.cfg.TrashPurgeInterval).Run)
+	}
 
 	// グローバルミドルウェアの設定
 	r.echo.Use(middleware.Logger())
@@ -182,6 +398,10 @@ func (r *Routes) Setup() error {
 		HSTSMaxAge:            31536000,
 		ContentSecurityPolicy: "default-src 'self'",
 	}))
+	// 監査ログの操作者を記録するためのユーザー識別
+	r.echo.Use(custom_middleware.IdentifyUser())
+	// 管理者による下書きのプレビュー（?preview=true）
+	r.echo.Use(custom_middleware.PreviewMode(custom_middleware.RoleAdmin))
 
 	// データベースコンテキストの設定
 	r.echo.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
@@ -256,11 +476,70 @@ func (r *Routes) Setup() error {
 			})
 		})
 
+		// 入力補完エンドポイント
+		api.GET("/suggest", searchHandler.Suggest)
+
+		// 試験種別レジストリエンドポイント
+		api.GET("/test-types", testTypeHandler.ListDefinitions)
+
+		// 日程レジストリエンドポイント
+		api.GET("/schedule-types", scheduleHandler.ListDefinitions)
+
+		// 選択した学科の入試日程カレンダーエンドポイント（iCalendar形式）
+		api.GET("/calendar.ics", scheduleEventHandler.Calendar)
+
+		// 変更履歴エンドポイント（管理者のみ）
+		// 操作者や下書きを含む変更前後の値を返すため、公開しない
+		auditLogs := api.Group("/audit", custom_middleware.AuthorizeRole(custom_middleware.RoleAdmin))
+		{
+			auditLogs.GET("/:entityType/:entityID", historyHandler.GetHistory)
+		}
+
+		// 一括取り込みエンドポイント（管理者のみ）
+		imports := api.Group("/imports", custom_middleware.AuthorizeRole(custom_middleware.RoleAdmin))
+		{
+			imports.POST("/admissions", importHandler.ImportAdmissions)
+		}
+
+		// 入試情報の公開ワークフローエンドポイント（管理者のみ）
+		admissionInfos := api.Group("/admission-infos/:"+publication.ParamInfoID,
+			custom_middleware.AuthorizeRole(custom_middleware.RoleAdmin))
+		{
+			admissionInfos.POST("/publish", publicationHandler.Publish)
+			admissionInfos.POST("/unpublish", publicationHandler.Unpublish)
+			admissionInfos.POST("/archive", publicationHandler.Archive)
+		}
+
+		// ゴミ箱エンドポイント（管理者のみ）
+		trashGroup := api.Group("/trash/:"+trash.ParamEntityType,
+			custom_middleware.AuthorizeRole(custom_middleware.RoleAdmin))
+		{
+			trashGroup.GET("", trashHandler.ListTrash)
+			trashGroup.POST("/:"+trash.ParamEntityID+"/restore", trashHandler.RestoreTrash)
+			trashGroup.DELETE("/:"+trash.ParamEntityID, trashHandler.PurgeTrash)
+		}
+
+		// マスタデータエンドポイント（管理者のみ）
+		masterData := api.Group("/master-data", custom_middleware.AuthorizeRole(custom_middleware.RoleAdmin))
+		{
+			registerMasterData(masterData.Group("/regions"), regionHandler)
+			registerMasterData(masterData.Group("/prefectures"), prefectureHandler)
+			registerMasterData(masterData.Group("/classifications"), classificationHandler)
+			registerMasterData(masterData.Group("/sub-classifications"), subClassificationHandler)
+			registerMasterData(masterData.Group("/academic-fields"), academicFieldHandler)
+		}
+
 		// 大学関連エンドポイント
 		universities := api.Group("/universities")
 		{
 			// 検索エンドポイント
 			universities.GET("/search", searchHandler.SearchUniversities)
+			universities.GET("/search/facets", facetHandler.SearchWithFacets)
+			universities.GET("/search/similar", similarityHandler.FindSimilarMajors)
+			universities.POST("/search/simulate", validateRequestBody(simulationHandler.SimulateScores))
+
+			// エクスポートエンドポイント
+			universities.GET("/export", exportHandler.Export)
 
 			// 大学CRUDエンドポイント
 			universities.GET("", universityHandler.GetUniversities)
@@ -269,8 +548,9 @@ func (r *Routes) Setup() error {
 			universities.PUT("/:id", validatePathParams(validateRequestBody(universityHandler.UpdateUniversity)))
 			universities.DELETE("/:id", validatePathParams(universityHandler.DeleteUniversity))
 
-			// 学部関連エンドポイント
-			departments := universities.Group("/:universityID/departments")
+			// 学部関連エンドポイント（URLの親子関係を検証）
+			departments := universities.Group("/:universityID/departments",
+				custom_middleware.VerifyOwnership(ownershipRepo))
 			{
 				departments.GET(departmentIDParam, validatePathParams(departmentHandler.GetDepartment))
 				departments.POST("", validateRequestBody(departmentHandler.CreateDepartment))
@@ -286,7 +566,85 @@ func (r *Routes) Setup() error {
 					subjects.DELETE(subjectIDParam, validatePathParams(subjectHandler.DeleteSubject))
 					subjects.PUT("/batch", validateRequestBody(subjectHandler.UpdateSubjectsBatch))
 				}
+
+				// 学科関連エンドポイント
+				majors := departments.Group("/:departmentID/majors")
+				{
+					majors.GET(majorIDParam, validatePathParams(majorHandler.GetMajor))
+					majors.POST("", validateRequestBody(majorHandler.CreateMajor))
+					majors.PUT(majorIDParam, validatePathParams(validateRequestBody(majorHandler.UpdateMajor)))
+					majors.DELETE(majorIDParam, validatePathParams(majorHandler.DeleteMajor))
+
+					// 学科の入試日程カレンダーエンドポイント（iCalendar形式）
+					majors.GET(majorIDParam+"/calendar.ics", validatePathParams(scheduleEventHandler.MajorCalendar))
+
+					// 入試日程関連エンドポイント
+					schedules := majors.Group("/:majorID/schedules")
+					{
+						schedules.GET(scheduleIDParam, validatePathParams(scheduleHandler.GetAdmissionSchedule))
+						schedules.POST("", validateRequestBody(scheduleHandler.CreateAdmissionSchedule))
+						schedules.PUT(scheduleIDParam, validatePathParams(validateRequestBody(scheduleHandler.UpdateAdmissionSchedule)))
+						schedules.DELETE(scheduleIDParam, validatePathParams(scheduleHandler.DeleteAdmissionSchedule))
+
+						// 入試情報関連エンドポイント
+						admissionInfos := schedules.Group("/:scheduleID/admission-infos")
+						{
+							admissionInfos.GET(admissionInfoIDParam, validatePathParams(admissionInfoHandler.GetAdmissionInfo))
+							admissionInfos.POST("", validateRequestBody(admissionInfoHandler.CreateAdmissionInfo))
+							admissionInfos.PUT(admissionInfoIDParam, validatePathParams(validateRequestBody(admissionInfoHandler.UpdateAdmissionInfo)))
+							admissionInfos.DELETE(admissionInfoIDParam, validatePathParams(admissionInfoHandler.DeleteAdmissionInfo))
+
+							// 合格難易度（ボーダー得点・共通テスト得点率・偏差値帯）関連エンドポイント（登録・更新・削除は管理者のみ）
+							admissionInfos.GET(admissionDifficultyPath, validatePathParams(admissionInfoHandler.GetAdmissionDifficulty))
+
+							difficulty := admissionInfos.Group(admissionDifficultyPath,
+								custom_middleware.AuthorizeRole(custom_middleware.RoleAdmin))
+							{
+								difficulty.POST("", validatePathParams(validateRequestBody(admissionInfoHandler.CreateAdmissionDifficulty)))
+								difficulty.PUT("", validatePathParams(validateRequestBody(admissionInfoHandler.UpdateAdmissionDifficulty)))
+								difficulty.DELETE("", validatePathParams(admissionInfoHandler.DeleteAdmissionDifficulty))
+							}
+						}
+
+						// 試験種別関連エンドポイント
+						testTypes := schedules.Group("/:scheduleID/test-types")
+						{
+							testTypes.GET(testTypeIDParam, validatePathParams(testTypeHandler.GetTestType))
+							testTypes.POST("", validateRequestBody(testTypeHandler.CreateTestType))
+							testTypes.PUT(testTypeIDParam, validatePathParams(validateRequestBody(testTypeHandler.UpdateTestType)))
+							testTypes.DELETE(testTypeIDParam, validatePathParams(testTypeHandler.DeleteTestType))
+
+							// 選択科目群（「物理・化学・生物から2科目」など）関連エンドポイント
+							testTypes.GET(subjectGroupsPath, validatePathParams(testTypeHandler.ListSubjectGroups))
+							testTypes.POST(subjectGroupsPath, validatePathParams(validateRequestBody(testTypeHandler.CreateSubjectGroup)))
+							testTypes.PUT(subjectGroupsPath+subjectGroupIDParam, validatePathParams(validateRequestBody(testTypeHandler.UpdateSubjectGroup)))
+							testTypes.DELETE(subjectGroupsPath+subjectGroupIDParam, validatePathParams(testTypeHandler.DeleteSubjectGroup))
+						}
+
+						// 日程（出願期間・試験日・合格発表・入学手続締切）関連エンドポイント（管理者のみ）
+						events := schedules.Group("/:scheduleID/events",
+							custom_middleware.AuthorizeRole(custom_middleware.RoleAdmin))
+						{
+							events.GET("", scheduleEventHandler.List)
+							events.GET(eventIDParam, scheduleEventHandler.Get)
+							events.POST("", validateRequestBody(scheduleEventHandler.Create))
+							events.PUT(eventIDParam, validateRequestBody(scheduleEventHandler.Update))
+							events.DELETE(eventIDParam, scheduleEventHandler.Delete)
+						}
+					}
+				}
 			}
+
+			// 学科の年度一覧エンドポイント
+			universities.GET(majorPath+"/years", academicYearHandler.GetAvailableYears)
+			universities.GET(majorPath+"/years/compare", academicYearHandler.CompareYears)
+		}
+
+		// 年度別エンドポイント
+		years := api.Group("/years/:academicYear/universities" + majorPath)
+		{
+			years.GET("", academicYearHandler.GetMajorByYear)
+			years.GET("/schedules/:schedule", academicYearHandler.GetScheduleByYear)
 		}
 	}
 
@@ -9,12 +9,16 @@ package server
 import (
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"university-exam-api/internal/config"
+	"university-exam-api/internal/domain/models"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
@@ -44,6 +48,7 @@ func TestNewRoutes(t *testing.T) {
 // - 無効な大学ID
 // - 無効な学部ID
 // - 無効な科目ID
+// - 無効な学科ID・入試日程ID・試験種別ID
 func TestValidatePathParams(t *testing.T) {
 	t.Parallel()
 
@@ -52,6 +57,9 @@ func TestValidatePathParams(t *testing.T) {
 		universityID   string
 		departmentID   string
 		subjectID      string
+		majorID        string
+		scheduleID     string
+		testTypeID     string
 		expectedStatus int
 		expectedError  string
 	}{
@@ -80,6 +88,24 @@ func TestValidatePathParams(t *testing.T) {
 			expectedStatus: http.StatusBadRequest,
 			expectedError:  "科目IDは数値である必要があります",
 		},
+		{
+			name:           "無効な学科ID",
+			majorID:        "invalid",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "学科IDは数値である必要があります",
+		},
+		{
+			name:           "無効な入試日程ID",
+			scheduleID:     "invalid",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "入試日程IDは数値である必要があります",
+		},
+		{
+			name:           "無効な試験種別ID",
+			testTypeID:     "invalid",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "試験種別IDは数値である必要があります",
+		},
 	}
 
 	for _, tt := range tests {
@@ -107,6 +133,21 @@ func TestValidatePathParams(t *testing.T) {
 				c.SetParamValues(tt.subjectID)
 			}
 
+			if tt.majorID != "" {
+				c.SetParamNames("majorID")
+				c.SetParamValues(tt.majorID)
+			}
+
+			if tt.scheduleID != "" {
+				c.SetParamNames("scheduleID")
+				c.SetParamValues(tt.scheduleID)
+			}
+
+			if tt.testTypeID != "" {
+				c.SetParamNames("testTypeID")
+				c.SetParamValues(tt.testTypeID)
+			}
+
 			handler := validatePathParams(func(c echo.Context) error {
 				return c.String(http.StatusOK, "OK")
 			})
@@ -150,6 +191,14 @@ func TestValidateRequestBody(t *testing.T) {
 			body:          `{"name": "テスト大学"}`,
 			expectedStatus: http.StatusOK,
 		},
+		{
+			name:           "不正なJSON",
+			contentType:    "application/json",
+			contentLength:  100,
+			body:          `{"name": `,
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "JSONの形式が不正です",
+		},
 		{
 			name:           "無効なContent-Type",
 			contentType:    "text/plain",
@@ -181,7 +230,13 @@ func TestValidateRequestBody(t *testing.T) {
 			c := e.NewContext(req, rec)
 
 			handler := validateRequestBody(func(c echo.Context) error {
-				return c.String(http.StatusOK, "OK")
+				// 後続のハンドラーでもリクエストボディをバインドできる
+				var body map[string]interface{}
+				if err := c.Bind(&body); err != nil {
+					return err
+				}
+
+				return c.String(http.StatusOK, body["name"].(string))
 			})
 
 			err := handler(c)
@@ -189,6 +244,7 @@ func TestValidateRequestBody(t *testing.T) {
 			if tt.expectedStatus == http.StatusOK {
 				assert.NoError(t, err)
 				assert.Equal(t, http.StatusOK, rec.Code)
+				assert.Equal(t, "テスト大学", rec.Body.String())
 			} else {
 				assert.Error(t, err)
 				he, ok := err.(*echo.HTTPError)
@@ -227,4 +283,137 @@ func TestSetup(t *testing.T) {
 
 	// ミドルウェアが正しく設定されていることを確認
 	assert.NotNil(t, e.HTTPErrorHandler)
+
+	// マスタデータのエンドポイントが登録されていることを確認
+	registered := make(map[string]bool)
+	for _, route := range e.Routes() {
+		registered[route.Method+" "+route.Path] = true
+	}
+
+	for _, path := range []string{"regions", "prefectures", "classifications", "sub-classifications", "academic-fields"} {
+		assert.True(t, registered[http.MethodGet+" /api/master-data/"+path], path)
+		assert.True(t, registered[http.MethodPut+" /api/master-data/"+path+"/order"], path)
+		assert.True(t, registered[http.MethodDelete+" /api/master-data/"+path+"/:id"], path)
+	}
+
+	// 試験種別レジストリのエンドポイントが登録されていることを確認
+	assert.True(t, registered[http.MethodGet+" /api/test-types"])
+
+	// 日程レジストリのエンドポイントが登録されていることを確認
+	assert.True(t, registered[http.MethodGet+" /api/schedule-types"])
+
+	// 入試日程の日程とカレンダーのエンドポイントが登録されていることを確認
+	eventsPath := "/api/universities/:universityID/departments/:departmentID/majors/:majorID/schedules/:scheduleID/events"
+	assert.True(t, registered[http.MethodPost+" "+eventsPath])
+	assert.True(t, registered[http.MethodPut+" "+eventsPath+"/:eventID"])
+	assert.True(t, registered[http.MethodGet+" /api/universities/:universityID/departments/:departmentID/majors/:majorID/calendar.ics"])
+	assert.True(t, registered[http.MethodGet+" /api/calendar.ics"])
+
+	// 選択科目群のエンドポイントが登録されていることを確認
+	groupsPath := "/api/universities/:universityID/departments/:departmentID/majors/:majorID/schedules/:scheduleID/test-types/:testTypeID/subject-groups"
+	assert.True(t, registered[http.MethodGet+" "+groupsPath])
+	assert.True(t, registered[http.MethodPost+" "+groupsPath])
+	assert.True(t, registered[http.MethodPut+" "+groupsPath+"/:subjectGroupID"])
+	assert.True(t, registered[http.MethodDelete+" "+groupsPath+"/:subjectGroupID"])
+
+	// 合格難易度のエンドポイントが登録されていることを確認
+	difficultyPath := "/api/universities/:universityID/departments/:departmentID/majors/:majorID/schedules/:scheduleID/admission-infos/:infoID/difficulty"
+	assert.True(t, registered[http.MethodGet+" "+difficultyPath])
+	assert.True(t, registered[http.MethodPost+" "+difficultyPath])
+	assert.True(t, registered[http.MethodPut+" "+difficultyPath])
+	assert.True(t, registered[http.MethodDelete+" "+difficultyPath])
+
+	// 得点シミュレーションのエンドポイントが登録されていることを確認
+	assert.True(t, registered[http.MethodPost+" /api/universities/search/simulate"])
+}
+
+// TestAdminOnlyRoutes は管理者専用のエンドポイントを未認証で呼び出せないことをテストします
+func TestAdminOnlyRoutes(t *testing.T) {
+	t.Parallel()
+
+	e := echo.New()
+
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	require.NoError(t, NewRoutes(e, db, &config.Config{Env: "test"}).Setup())
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+	}{
+		{name: "変更履歴", method: http.MethodGet, path: "/api/audit/admission_info/1"},
+		{name: "一括取り込み", method: http.MethodPost, path: "/api/imports/admissions?dry_run=true"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, nil))
+			assert.Equal(t, http.StatusForbidden, rec.Code)
+		})
+	}
+}
+
+// TestLoadScheduleRegistry は定義ファイルからの日程レジストリの読み込みをテストします
+func TestLoadScheduleRegistry(t *testing.T) {
+	defaults := models.Schedules()
+	t.Cleanup(func() { models.SetSchedules(defaults) })
+
+	dir := t.TempDir()
+
+	t.Run("定義ファイルの日程に置き換える", func(t *testing.T) {
+		path := filepath.Join(dir, "schedules.json")
+		require.NoError(t, os.WriteFile(path, []byte(
+			`[{"code":"first","name":"前","label":"前期","kind":"national","display_order":1},`+
+				`{"code":"general","name":"一般方式","kind":"private","display_order":2}]`,
+		), 0o600))
+
+		routes := NewRoutes(echo.New(), nil, &config.Config{ScheduleRegistryFile: path})
+		require.NoError(t, routes.loadScheduleRegistry())
+		assert.Equal(t, []string{"前", "一般方式"}, models.Schedules().Names())
+	})
+
+	t.Run("不正な定義ファイルはエラー", func(t *testing.T) {
+		path := filepath.Join(dir, "invalid.json")
+		require.NoError(t, os.WriteFile(path, []byte(`[{"code":"first","name":"前","kind":"public"}]`), 0o600))
+
+		routes := NewRoutes(echo.New(), nil, &config.Config{ScheduleRegistryFile: path})
+		assert.Error(t, routes.loadScheduleRegistry())
+
+		routes = NewRoutes(echo.New(), nil, &config.Config{ScheduleRegistryFile: filepath.Join(dir, "missing.json")})
+		assert.Error(t, routes.loadScheduleRegistry())
+	})
+}
+
+// TestLoadTestTypeRegistry は定義ファイルからの試験種別レジストリの読み込みをテストします
+func TestLoadTestTypeRegistry(t *testing.T) {
+	defaults := models.TestTypes()
+	t.Cleanup(func() { models.SetTestTypes(defaults) })
+
+	dir := t.TempDir()
+
+	t.Run("定義ファイルの試験種別に置き換える", func(t *testing.T) {
+		path := filepath.Join(dir, "test_types.json")
+		require.NoError(t, os.WriteFile(path, []byte(
+			`[{"code":"common","name":"共通","counts_toward_total":true,"display_order":1},`+
+				`{"code":"interview","name":"面接","counts_toward_total":false,"display_order":2}]`,
+		), 0o600))
+
+		routes := NewRoutes(echo.New(), nil, &config.Config{TestTypeRegistryFile: path})
+		require.NoError(t, routes.loadTestTypeRegistry())
+		assert.Equal(t, []string{"共通", "面接"}, models.TestTypes().Names())
+	})
+
+	t.Run("不正な定義ファイルはエラー", func(t *testing.T) {
+		path := filepath.Join(dir, "invalid.json")
+		require.NoError(t, os.WriteFile(path, []byte(`[]`), 0o600))
+
+		routes := NewRoutes(echo.New(), nil, &config.Config{TestTypeRegistryFile: path})
+		assert.Error(t, routes.loadTestTypeRegistry())
+
+		routes = NewRoutes(echo.New(), nil, &config.Config{TestTypeRegistryFile: filepath.Join(dir, "missing.json")})
+		assert.Error(t, routes.loadTestTypeRegistry())
+	})
 }
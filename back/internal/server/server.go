@@ -33,11 +33,13 @@ const (
 // - アプリケーション設定
 // - 実際のリッスンアドレス
 // - リスナー
+// - バックグラウンドジョブ
 type Server struct {
 	echo     *echo.Echo
 	cfg      *config.Config
 	listener net.Listener // 追加: 実際のリッスンアドレスを取得するため
 	mu       sync.RWMutex // 追加: listenerへのアクセスを同期化
+	jobs     []func(ctx context.Context)
 }
 
 // New は新しいサーバーインスタンスを作成します。
@@ -74,6 +76,7 @@ func New(cfg *config.Config) *Server {
 // Start はサーバーを起動し、グレースフルシャットダウンを実装します。
 // この関数は以下の処理を行います：
 // - サーバーの起動
+// - バックグラウンドジョブの起動（コンテキストのキャンセルで停止）
 // - コンテキストの監視
 // - グレースフルシャットダウンの実行
 // ctx: コンテキスト
@@ -89,6 +92,10 @@ func (s *Server) Start(ctx context.Context) error {
 	s.listener = ln
 	s.mu.Unlock()
 
+	for _, job := range s.jobs {
+		go job(ctx)
+	}
+
 	errCh := make(chan error, 1)
 	go func() {
 		applogger.Info(context.Background(), "サーバーを起動しています。アドレス: %s", ln.Addr().String())
@@ -161,8 +168,13 @@ func (s *Server) SetupRoutes(db *gorm.DB) error {
 	}
 
 	routes := NewRoutes(s.echo, db, s.cfg)
+	if err := routes.Setup(); err != nil {
+		return err
+	}
 
-	return routes.Setup()
+	s.jobs = append(s.jobs, routes.jobs...)
+
+	return nil
 }
 
 // Shutdown はサーバーをシャットダウンします。
@@ -0,0 +1,273 @@
+package usecases
+
+import (
+	"context"
+	"math"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/repositories"
+)
+
+// 年度比較の差分種別
+const (
+	DiffStatusAdded     = "added"
+	DiffStatusRemoved   = "removed"
+	DiffStatusChanged   = "changed"
+	DiffStatusUnchanged = "unchanged"
+)
+
+// SubjectDiff は科目の年度間の配点差分を表現する構造体です
+// 換算比率が変わった場合は素点が同じでも換算後の配点の差分として表れます
+type SubjectDiff struct {
+	Name                string  `json:"name"`
+	FromScore           int     `json:"from_score"`
+	ToScore             int     `json:"to_score"`
+	ScoreDelta          int     `json:"score_delta"`
+	FromConversionRatio float64 `json:"from_conversion_ratio"`
+	ToConversionRatio   float64 `json:"to_conversion_ratio"`
+	FromEffectiveScore  float64 `json:"from_effective_score"`
+	ToEffectiveScore    float64 `json:"to_effective_score"`
+	EffectiveScoreDelta float64 `json:"effective_score_delta"`
+	FromPercentage      float64 `json:"from_percentage"`
+	ToPercentage        float64 `json:"to_percentage"`
+	PercentageDelta     float64 `json:"percentage_delta"`
+}
+
+// TestTypeDiff は試験種別ごとの年度間の差分を表現する構造体です
+// 合計は換算後の配点の合計で、選択科目群は選択数分の科目を一度だけ数えます
+type TestTypeDiff struct {
+	Name       string        `json:"name"`
+	Status     string        `json:"status"`
+	FromTotal  float64       `json:"from_total"`
+	ToTotal    float64       `json:"to_total"`
+	TotalDelta float64       `json:"total_delta"`
+	Added      []SubjectDiff `json:"added"`
+	Removed    []SubjectDiff `json:"removed"`
+	Changed    []SubjectDiff `json:"changed"`
+}
+
+// ScheduleDiff は入試日程ごとの年度間の差分を表現する構造体です
+type ScheduleDiff struct {
+	Name      string         `json:"name"`
+	Status    string         `json:"status"`
+	TestTypes []TestTypeDiff `json:"test_types"`
+}
+
+// YearComparison は学科の2つの年度間の配点差分を表現する構造体です
+type YearComparison struct {
+	FromYear   int                           `json:"from_year"`
+	ToYear     int                           `json:"to_year"`
+	University repositories.YearScopedEntity `json:"university"`
+	Department repositories.YearScopedEntity `json:"department"`
+	Major      repositories.YearScopedEntity `json:"major"`
+	Schedules  []ScheduleDiff                `json:"schedules"`
+}
+
+// CompareYears は学科の2つの年度間の配点を比較します。
+// この関数は以下の処理を行います：
+// - 年度の組み合わせの検証
+// - 各年度の配点内訳の取得
+// - 入試日程・試験種別・科目の名前による突き合わせ
+// - 追加・削除・変更された科目の抽出
+func (u *academicYearUsecase) CompareYears(
+	ctx context.Context,
+	path repositories.MajorPath,
+	fromYear, toYear int,
+) (*YearComparison, error) {
+	if fromYear == toYear {
+		return nil, appErrors.NewInvalidInputError("to", "比較する年度は異なる必要があります", nil)
+	}
+
+	from, err := u.repo.FindMajorByYear(ctx, path, fromYear)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := u.repo.FindMajorByYear(ctx, path, toYear)
+	if err != nil {
+		return nil, err
+	}
+
+	return &YearComparison{
+		FromYear:   fromYear,
+		ToYear:     toYear,
+		University: to.University,
+		Department: to.Department,
+		Major:      to.Major,
+		Schedules:  diffSchedules(from.Schedules, to.Schedules),
+	}, nil
+}
+
+// diffSchedules は入試日程を名前で突き合わせて差分を計算します
+// 並び順は比較先の年度を基準とし、削除された入試日程を末尾に追加します
+func diffSchedules(from, to []repositories.YearScopedSchedule) []ScheduleDiff {
+	fromByName := make(map[string]repositories.YearScopedSchedule, len(from))
+	for _, s := range from {
+		fromByName[s.Name] = s
+	}
+
+	diffs := make([]ScheduleDiff, 0, len(to))
+	matched := make(map[string]bool, len(to))
+
+	for _, s := range to {
+		prev, ok := fromByName[s.Name]
+		if !ok {
+			diffs = append(diffs, newScheduleDiff(s.Name, DiffStatusAdded, nil, s.TestTypes))
+			continue
+		}
+
+		matched[s.Name] = true
+		diffs = append(diffs, newScheduleDiff(s.Name, "", prev.TestTypes, s.TestTypes))
+	}
+
+	for _, s := range from {
+		if !matched[s.Name] {
+			diffs = append(diffs, newScheduleDiff(s.Name, DiffStatusRemoved, s.TestTypes, nil))
+		}
+	}
+
+	return diffs
+}
+
+// newScheduleDiff は入試日程の差分を生成します
+// statusが空の場合は試験種別の差分から変更の有無を判定します
+func newScheduleDiff(name, status string, from, to []models.TestType) ScheduleDiff {
+	testTypes := diffTestTypes(from, to)
+
+	if status == "" {
+		status = DiffStatusUnchanged
+
+		for _, tt := range testTypes {
+			if tt.Status != DiffStatusUnchanged {
+				status = DiffStatusChanged
+				break
+			}
+		}
+	}
+
+	return ScheduleDiff{Name: name, Status: status, TestTypes: testTypes}
+}
+
+// diffTestTypes は試験種別を名前で突き合わせて差分を計算します
+func diffTestTypes(from, to []models.TestType) []TestTypeDiff {
+	fromByName := make(map[string]models.TestType, len(from))
+	for _, tt := range from {
+		fromByName[tt.Name] = tt
+	}
+
+	diffs := make([]TestTypeDiff, 0, len(to))
+	matched := make(map[string]bool, len(to))
+
+	for _, tt := range to {
+		prev, ok := fromByName[tt.Name]
+		if !ok {
+			diffs = append(diffs, diffSubjects(tt.Name, DiffStatusAdded, nil, &tt))
+			continue
+		}
+
+		matched[tt.Name] = true
+		diffs = append(diffs, diffSubjects(tt.Name, "", &prev, &tt))
+	}
+
+	for _, tt := range from {
+		if !matched[tt.Name] {
+			diffs = append(diffs, diffSubjects(tt.Name, DiffStatusRemoved, &tt, nil))
+		}
+	}
+
+	return diffs
+}
+
+// diffSubjects は科目を名前で突き合わせて試験種別の差分を生成します
+// 存在しない側の試験種別は科目なしとして扱います
+func diffSubjects(name, status string, fromType, toType *models.TestType) TestTypeDiff {
+	diff := TestTypeDiff{
+		Name:    name,
+		Status:  status,
+		Added:   []SubjectDiff{},
+		Removed: []SubjectDiff{},
+		Changed: []SubjectDiff{},
+	}
+
+	var from, to []models.Subject
+
+	if fromType != nil {
+		from = fromType.Subjects
+		diff.FromTotal = models.SelectionTotal(from, fromType.SubjectGroups)
+	}
+
+	if toType != nil {
+		to = toType.Subjects
+		diff.ToTotal = models.SelectionTotal(to, toType.SubjectGroups)
+	}
+
+	fromByName := make(map[string]models.Subject, len(from))
+	for _, s := range from {
+		fromByName[s.Name] = s
+	}
+
+	matched := make(map[string]bool, len(to))
+
+	for _, s := range to {
+		prev, ok := fromByName[s.Name]
+		if !ok {
+			diff.Added = append(diff.Added, newSubjectDiff(s.Name, nil, &s))
+			continue
+		}
+
+		matched[s.Name] = true
+
+		if prev.Score != s.Score || prev.ConversionRatio != s.ConversionRatio || prev.Percentage != s.Percentage {
+			diff.Changed = append(diff.Changed, newSubjectDiff(s.Name, &prev, &s))
+		}
+	}
+
+	for _, s := range from {
+		if !matched[s.Name] {
+			diff.Removed = append(diff.Removed, newSubjectDiff(s.Name, &s, nil))
+		}
+	}
+
+	diff.TotalDelta = roundDelta(diff.ToTotal - diff.FromTotal)
+
+	if diff.Status == "" {
+		diff.Status = DiffStatusUnchanged
+		// 選択科目群の選択数のみが変わった場合も合計の差分として変更とみなします
+		if len(diff.Added)+len(diff.Removed)+len(diff.Changed) > 0 || diff.TotalDelta != 0 {
+			diff.Status = DiffStatusChanged
+		}
+	}
+
+	return diff
+}
+
+// newSubjectDiff は科目の配点差分を生成します
+// 存在しない側の科目は配点0・換算比率0として扱います
+func newSubjectDiff(name string, from, to *models.Subject) SubjectDiff {
+	diff := SubjectDiff{Name: name}
+
+	if from != nil {
+		diff.FromScore = from.Score
+		diff.FromConversionRatio = from.ConversionRatio
+		diff.FromEffectiveScore = from.EffectiveScore
+		diff.FromPercentage = from.Percentage
+	}
+
+	if to != nil {
+		diff.ToScore = to.Score
+		diff.ToConversionRatio = to.ConversionRatio
+		diff.ToEffectiveScore = to.EffectiveScore
+		diff.ToPercentage = to.Percentage
+	}
+
+	diff.ScoreDelta = diff.ToScore - diff.FromScore
+	diff.EffectiveScoreDelta = roundDelta(diff.ToEffectiveScore - diff.FromEffectiveScore)
+	diff.PercentageDelta = roundDelta(diff.ToPercentage - diff.FromPercentage)
+
+	return diff
+}
+
+// roundDelta は差分を小数点以下2桁に丸めます
+func roundDelta(v float64) float64 {
+	return math.Round(v*100) / 100
+}
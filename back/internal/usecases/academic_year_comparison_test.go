@@ -0,0 +1,194 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"university-exam-api/internal/domain/models"
+	"university-exam-api/internal/repositories"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newComparisonMajor はテスト用の年度別学科データを生成します
+func newComparisonMajor(year int, schedules ...repositories.YearScopedSchedule) *repositories.YearScopedMajor {
+	return &repositories.YearScopedMajor{
+		AcademicYear: year,
+		Major:        repositories.YearScopedEntity{ID: 3, Name: "機械工学科"},
+		Schedules:    schedules,
+	}
+}
+
+// newComparisonTestType はテスト用の試験種別を生成します
+func newComparisonTestType(name string, subjects ...models.Subject) models.TestType {
+	return models.TestType{Name: name, Subjects: subjects}
+}
+
+func TestAcademicYearUsecaseCompareYears(t *testing.T) {
+	path := repositories.MajorPath{UniversityID: 1, DepartmentID: 2, MajorID: 3}
+
+	from := newComparisonMajor(2024,
+		repositories.YearScopedSchedule{
+			Name: "前",
+			TestTypes: []models.TestType{
+				newComparisonTestType("共通",
+					models.Subject{Name: "英語", Score: 200, Percentage: 25},
+					models.Subject{Name: "国語", Score: 200, Percentage: 25},
+				),
+				newComparisonTestType("二次",
+					models.Subject{Name: "数学", Score: 200, Percentage: 25},
+					models.Subject{Name: "物理", Score: 200, Percentage: 25},
+				),
+			},
+		},
+		repositories.YearScopedSchedule{
+			Name:      "後",
+			TestTypes: []models.TestType{newComparisonTestType("二次", models.Subject{Name: "小論文", Score: 100})},
+		},
+	)
+	to := newComparisonMajor(2025,
+		repositories.YearScopedSchedule{
+			Name: "前",
+			TestTypes: []models.TestType{
+				newComparisonTestType("共通",
+					models.Subject{Name: "英語", Score: 200, Percentage: 25},
+					models.Subject{Name: "国語", Score: 200, Percentage: 25},
+				),
+				newComparisonTestType("二次",
+					models.Subject{Name: "数学", Score: 300, Percentage: 37.5},
+					models.Subject{Name: "情報", Score: 100, Percentage: 12.5},
+				),
+			},
+		},
+	)
+
+	t.Run("年度間の差分", func(t *testing.T) {
+		mockRepo := new(MockAcademicYearRepository)
+		mockRepo.On("FindMajorByYear", mock.Anything, path, 2024).Return(from, nil)
+		mockRepo.On("FindMajorByYear", mock.Anything, path, 2025).Return(to, nil)
+
+		result, err := NewAcademicYearUsecase(mockRepo).CompareYears(context.Background(), path, 2024, 2025)
+		require.NoError(t, err)
+
+		assert.Equal(t, 2024, result.FromYear)
+		assert.Equal(t, 2025, result.ToYear)
+		assert.Equal(t, "機械工学科", result.Major.Name)
+		require.Len(t, result.Schedules, 2)
+
+		early := result.Schedules[0]
+		assert.Equal(t, "前", early.Name)
+		assert.Equal(t, DiffStatusChanged, early.Status)
+		require.Len(t, early.TestTypes, 2)
+		assert.Equal(t, DiffStatusUnchanged, early.TestTypes[0].Status)
+
+		secondary := early.TestTypes[1]
+		assert.Equal(t, DiffStatusChanged, secondary.Status)
+		assert.Equal(t, 400.0, secondary.FromTotal)
+		assert.Equal(t, 400.0, secondary.ToTotal)
+		assert.Equal(t, 0.0, secondary.TotalDelta)
+		assert.Equal(t, []SubjectDiff{{
+			Name: "数学", FromScore: 200, ToScore: 300, ScoreDelta: 100,
+			FromConversionRatio: 1, ToConversionRatio: 1,
+			FromEffectiveScore: 200, ToEffectiveScore: 300, EffectiveScoreDelta: 100,
+			FromPercentage: 25, ToPercentage: 37.5, PercentageDelta: 12.5,
+		}}, secondary.Changed)
+		require.Len(t, secondary.Added, 1)
+		assert.Equal(t, "情報", secondary.Added[0].Name)
+		assert.Equal(t, 100, secondary.Added[0].ScoreDelta)
+		require.Len(t, secondary.Removed, 1)
+		assert.Equal(t, "物理", secondary.Removed[0].Name)
+		assert.Equal(t, -200, secondary.Removed[0].ScoreDelta)
+		assert.Equal(t, -25.0, secondary.Removed[0].PercentageDelta)
+
+		late := result.Schedules[1]
+		assert.Equal(t, "後", late.Name)
+		assert.Equal(t, DiffStatusRemoved, late.Status)
+		require.Len(t, late.TestTypes, 1)
+		assert.Equal(t, DiffStatusRemoved, late.TestTypes[0].Status)
+		assert.Equal(t, -100.0, late.TestTypes[0].TotalDelta)
+	})
+
+	t.Run("換算比率のみの変更", func(t *testing.T) {
+		schedule := func(ratio float64) repositories.YearScopedSchedule {
+			return repositories.YearScopedSchedule{
+				Name: "前",
+				TestTypes: []models.TestType{newComparisonTestType("共通",
+					models.Subject{Name: "英語", Score: 100, ConversionRatio: ratio, Percentage: 50},
+					models.Subject{Name: "国語", Score: 100, ConversionRatio: 1, Percentage: 50},
+				)},
+			}
+		}
+
+		mockRepo := new(MockAcademicYearRepository)
+		mockRepo.On("FindMajorByYear", mock.Anything, path, 2024).Return(newComparisonMajor(2024, schedule(1)), nil)
+		mockRepo.On("FindMajorByYear", mock.Anything, path, 2025).Return(newComparisonMajor(2025, schedule(1.5)), nil)
+
+		result, err := NewAcademicYearUsecase(mockRepo).CompareYears(context.Background(), path, 2024, 2025)
+		require.NoError(t, err)
+
+		require.Len(t, result.Schedules, 1)
+		assert.Equal(t, DiffStatusChanged, result.Schedules[0].Status)
+
+		common := result.Schedules[0].TestTypes[0]
+		assert.Equal(t, DiffStatusChanged, common.Status)
+		assert.Equal(t, 200.0, common.FromTotal)
+		assert.Equal(t, 250.0, common.ToTotal)
+		assert.Equal(t, 50.0, common.TotalDelta)
+		require.Len(t, common.Changed, 1)
+		assert.Equal(t, "英語", common.Changed[0].Name)
+		assert.Equal(t, 0, common.Changed[0].ScoreDelta)
+		assert.Equal(t, 1.0, common.Changed[0].FromConversionRatio)
+		assert.Equal(t, 1.5, common.Changed[0].ToConversionRatio)
+		assert.Equal(t, 50.0, common.Changed[0].EffectiveScoreDelta)
+	})
+
+	t.Run("選択科目群は一度だけ数える", func(t *testing.T) {
+		groupID := uint(10)
+		science := func(chooseCount int) models.TestType {
+			tt := newComparisonTestType("二次",
+				models.Subject{Name: "数学", Score: 200},
+				models.Subject{Name: "物理", Score: 100, SubjectGroupID: &groupID},
+				models.Subject{Name: "化学", Score: 100, SubjectGroupID: &groupID},
+			)
+			tt.SubjectGroups = []models.SubjectGroup{{BaseModel: models.BaseModel{ID: groupID}, ChooseCount: chooseCount}}
+
+			return tt
+		}
+
+		mockRepo := new(MockAcademicYearRepository)
+		mockRepo.On("FindMajorByYear", mock.Anything, path, 2024).Return(newComparisonMajor(2024,
+			repositories.YearScopedSchedule{Name: "前", TestTypes: []models.TestType{science(1)}}), nil)
+		mockRepo.On("FindMajorByYear", mock.Anything, path, 2025).Return(newComparisonMajor(2025,
+			repositories.YearScopedSchedule{Name: "前", TestTypes: []models.TestType{science(2)}}), nil)
+
+		result, err := NewAcademicYearUsecase(mockRepo).CompareYears(context.Background(), path, 2024, 2025)
+		require.NoError(t, err)
+
+		secondary := result.Schedules[0].TestTypes[0]
+		assert.Equal(t, DiffStatusChanged, secondary.Status)
+		assert.Empty(t, secondary.Changed)
+		assert.Equal(t, 300.0, secondary.FromTotal)
+		assert.Equal(t, 400.0, secondary.ToTotal)
+		assert.Equal(t, 100.0, secondary.TotalDelta)
+	})
+
+	t.Run("同一年度の比較", func(t *testing.T) {
+		mockRepo := new(MockAcademicYearRepository)
+
+		_, err := NewAcademicYearUsecase(mockRepo).CompareYears(context.Background(), path, 2025, 2025)
+
+		assert.Error(t, err)
+		mockRepo.AssertNotCalled(t, "FindMajorByYear", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("比較元の年度が存在しない", func(t *testing.T) {
+		mockRepo := new(MockAcademicYearRepository)
+		mockRepo.On("FindMajorByYear", mock.Anything, path, 2020).Return(nil, assert.AnError)
+
+		_, err := NewAcademicYearUsecase(mockRepo).CompareYears(context.Background(), path, 2020, 2025)
+
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
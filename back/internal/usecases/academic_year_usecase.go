@@ -0,0 +1,77 @@
+package usecases
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	appErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/repositories"
+)
+
+// AcademicYearUsecase は年度別の入試データ取得のユースケースインターフェースです
+type AcademicYearUsecase interface {
+	GetAvailableYears(ctx context.Context, path repositories.MajorPath) ([]int, error)
+	GetMajorByYear(ctx context.Context, path repositories.MajorPath, year int) (*repositories.YearScopedMajor, error)
+	GetScheduleByYear(
+		ctx context.Context,
+		path repositories.MajorPath,
+		year int,
+		schedule string,
+	) (*repositories.YearScopedMajor, error)
+	CompareYears(ctx context.Context, path repositories.MajorPath, fromYear, toYear int) (*YearComparison, error)
+}
+
+// academicYearUsecase はAcademicYearUsecaseの実装です
+type academicYearUsecase struct {
+	repo repositories.AcademicYearRepository
+}
+
+// NewAcademicYearUsecase は新しいAcademicYearUsecaseを作成します
+func NewAcademicYearUsecase(repo repositories.AcademicYearRepository) AcademicYearUsecase {
+	return &academicYearUsecase{repo: repo}
+}
+
+// GetAvailableYears は学科に登録されている年度の一覧を取得します
+func (u *academicYearUsecase) GetAvailableYears(ctx context.Context, path repositories.MajorPath) ([]int, error) {
+	return u.repo.FindYearsByMajor(ctx, path)
+}
+
+// GetMajorByYear は指定年度の学科の配点内訳を取得します
+func (u *academicYearUsecase) GetMajorByYear(
+	ctx context.Context,
+	path repositories.MajorPath,
+	year int,
+) (*repositories.YearScopedMajor, error) {
+	return u.repo.FindMajorByYear(ctx, path, year)
+}
+
+// GetScheduleByYear は指定年度・指定日程の学科の配点内訳を取得します
+// 日程名に一致する入試日程がない場合はNotFoundエラーを返します
+func (u *academicYearUsecase) GetScheduleByYear(
+	ctx context.Context,
+	path repositories.MajorPath,
+	year int,
+	schedule string,
+) (*repositories.YearScopedMajor, error) {
+	schedule = strings.TrimSpace(schedule)
+	if schedule == "" {
+		return nil, appErrors.NewInvalidInputError("schedule", "日程名は必須です", nil)
+	}
+
+	major, err := u.repo.FindMajorByYear(ctx, path, year)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range major.Schedules {
+		if s.Name == schedule {
+			major.Schedules = []repositories.YearScopedSchedule{s}
+			return major, nil
+		}
+	}
+
+	return nil, appErrors.NewNotFoundError("入試日程", path.MajorID, map[string]string{
+		"academic_year": strconv.Itoa(year),
+		"schedule":      schedule,
+	})
+}
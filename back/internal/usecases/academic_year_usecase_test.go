@@ -0,0 +1,115 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	appErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/repositories"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockAcademicYearRepository はAcademicYearRepositoryのモック実装です
+type MockAcademicYearRepository struct {
+	mock.Mock
+}
+
+// FindYearsByMajor は年度一覧取得のモック実装です
+func (m *MockAcademicYearRepository) FindYearsByMajor(
+	ctx context.Context,
+	path repositories.MajorPath,
+) ([]int, error) {
+	args := m.Called(ctx, path)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]int), args.Error(1)
+}
+
+// FindMajorByYear は年度別学科取得のモック実装です
+func (m *MockAcademicYearRepository) FindMajorByYear(
+	ctx context.Context,
+	path repositories.MajorPath,
+	year int,
+) (*repositories.YearScopedMajor, error) {
+	args := m.Called(ctx, path, year)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*repositories.YearScopedMajor), args.Error(1)
+}
+
+// newTestYearScopedMajor はテスト用の年度別学科データを生成します
+func newTestYearScopedMajor() *repositories.YearScopedMajor {
+	return &repositories.YearScopedMajor{
+		AcademicYear: 2025,
+		Major:        repositories.YearScopedEntity{ID: 3, Name: "機械工学科"},
+		Schedules: []repositories.YearScopedSchedule{
+			{ID: 1, Name: "前"},
+			{ID: 2, Name: "後"},
+		},
+	}
+}
+
+func TestAcademicYearUsecaseGetAvailableYears(t *testing.T) {
+	mockRepo := new(MockAcademicYearRepository)
+	usecase := NewAcademicYearUsecase(mockRepo)
+
+	path := repositories.MajorPath{UniversityID: 1, DepartmentID: 2, MajorID: 3}
+	mockRepo.On("FindYearsByMajor", mock.Anything, path).Return([]int{2025, 2024}, nil)
+
+	years, err := usecase.GetAvailableYears(context.Background(), path)
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{2025, 2024}, years)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestAcademicYearUsecaseGetScheduleByYear(t *testing.T) {
+	path := repositories.MajorPath{UniversityID: 1, DepartmentID: 2, MajorID: 3}
+
+	t.Run("日程で絞り込み", func(t *testing.T) {
+		mockRepo := new(MockAcademicYearRepository)
+		mockRepo.On("FindMajorByYear", mock.Anything, path, 2025).Return(newTestYearScopedMajor(), nil)
+
+		result, err := NewAcademicYearUsecase(mockRepo).GetScheduleByYear(context.Background(), path, 2025, "後")
+
+		require.NoError(t, err)
+		require.Len(t, result.Schedules, 1)
+		assert.Equal(t, "後", result.Schedules[0].Name)
+	})
+
+	t.Run("存在しない日程", func(t *testing.T) {
+		mockRepo := new(MockAcademicYearRepository)
+		mockRepo.On("FindMajorByYear", mock.Anything, path, 2025).Return(newTestYearScopedMajor(), nil)
+
+		_, err := NewAcademicYearUsecase(mockRepo).GetScheduleByYear(context.Background(), path, 2025, "中")
+
+		var appErr *appErrors.Error
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.CodeNotFound, appErr.Code)
+	})
+
+	t.Run("空の日程名", func(t *testing.T) {
+		mockRepo := new(MockAcademicYearRepository)
+
+		_, err := NewAcademicYearUsecase(mockRepo).GetScheduleByYear(context.Background(), path, 2025, " ")
+
+		assert.Error(t, err)
+		mockRepo.AssertNotCalled(t, "FindMajorByYear", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("リポジトリエラー", func(t *testing.T) {
+		mockRepo := new(MockAcademicYearRepository)
+		mockRepo.On("FindMajorByYear", mock.Anything, path, 2025).Return(nil, assert.AnError)
+
+		_, err := NewAcademicYearUsecase(mockRepo).GetScheduleByYear(context.Background(), path, 2025, "前")
+
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
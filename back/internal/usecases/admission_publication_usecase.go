@@ -0,0 +1,146 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/repositories"
+)
+
+// admissionInfoResource は入試情報のリソース名です
+const admissionInfoResource = "入試情報"
+
+// AdmissionPublicationUsecase は入試情報の公開ワークフローのユースケースインターフェースです
+type AdmissionPublicationUsecase interface {
+	Transition(ctx context.Context, infoID uint, action string, expectedVersion int) (*models.AdmissionInfo, error)
+}
+
+// admissionPublicationUsecase はAdmissionPublicationUsecaseの実装です
+type admissionPublicationUsecase struct {
+	repo repositories.IAdmissionPublisher
+}
+
+// NewAdmissionPublicationUsecase は新しいAdmissionPublicationUsecaseを作成します
+func NewAdmissionPublicationUsecase(repo repositories.IAdmissionPublisher) AdmissionPublicationUsecase {
+	return &admissionPublicationUsecase{repo: repo}
+}
+
+// Transition は入試情報のステータスを操作に応じて遷移させます。
+// この関数は以下の処理を行います：
+// - 操作の検証
+// - 入試情報と関連データの取得
+// - 期待するバージョンとの照合（0の場合は照合しない）
+// - 現在のステータスからの遷移可否の確認
+// - 公開時の入試日程・試験種別・科目の充足確認
+// - ステータスの更新
+func (u *admissionPublicationUsecase) Transition(
+	ctx context.Context,
+	infoID uint,
+	action string,
+	expectedVersion int,
+) (*models.AdmissionInfo, error) {
+	transition, ok := models.AdmissionTransitions[action]
+	if !ok {
+		actions := make([]string, 0, len(models.AdmissionTransitions))
+		for a := range models.AdmissionTransitions {
+			actions = append(actions, a)
+		}
+
+		sort.Strings(actions)
+
+		return nil, appErrors.NewInvalidInputError(
+			"action",
+			"操作は"+strings.Join(actions, ", ")+"のいずれかで指定してください",
+			map[string]string{"action": action},
+		)
+	}
+
+	info, err := u.repo.FindAdmissionInfoForPublication(ctx, infoID)
+	if err != nil {
+		return nil, err
+	}
+
+	if expectedVersion > 0 && expectedVersion != info.Version {
+		return nil, appErrors.NewConflictError(admissionInfoResource, info.ID, info.Version, info)
+	}
+
+	if !transition.Allows(info.Status) {
+		return nil, appErrors.NewValidationError(
+			"status",
+			fmt.Sprintf("ステータスが%sの入試情報に%sは実行できません", info.Status, action),
+			map[string]string{"status": info.Status, "action": action},
+		)
+	}
+
+	if transition.To == models.AdmissionStatusPublished {
+		if problems := publicationProblems(info); len(problems) > 0 {
+			return nil, appErrors.NewValidationError("status", "公開に必要な入試情報が揃っていません", problems)
+		}
+	}
+
+	if err := u.repo.UpdateAdmissionInfoStatus(ctx, info, transition.To); err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// publicationProblems は公開に必要な情報の不足を項目ごとに返します
+// 入試情報に試験種別が紐付いていない場合は、入試日程の試験種別を公開対象とします
+func publicationProblems(info *models.AdmissionInfo) map[string]string {
+	problems := make(map[string]string)
+
+	if err := info.Validate(); err != nil {
+		problems["admission_info"] = err.Error()
+	}
+
+	schedule := info.AdmissionSchedule
+	if schedule.ID == 0 {
+		problems["admission_schedule"] = "入試日程が見つかりません"
+		return problems
+	}
+
+	if schedule.Name == "" {
+		problems["admission_schedule.name"] = "入試日程名が登録されていません"
+	}
+
+	testTypes := info.TestTypes
+	if len(testTypes) == 0 {
+		testTypes = schedule.TestTypes
+	}
+
+	if len(testTypes) == 0 {
+		problems["test_types"] = "試験種別が登録されていません"
+		return problems
+	}
+
+	for i, testType := range testTypes {
+		field := fmt.Sprintf("test_types[%d]", i)
+
+		if testType.Name == "" {
+			problems[field+".name"] = "試験種別名が登録されていません"
+		}
+
+		if len(testType.Subjects) == 0 {
+			problems[field+".subjects"] = "科目が登録されていません"
+			continue
+		}
+
+		for j, subject := range testType.Subjects {
+			subjectField := fmt.Sprintf("%s.subjects[%d]", field, j)
+
+			if subject.Name == "" {
+				problems[subjectField+".name"] = "科目名が登録されていません"
+			}
+
+			if subject.Score <= 0 {
+				problems[subjectField+".score"] = "配点が登録されていません"
+			}
+		}
+	}
+
+	return problems
+}
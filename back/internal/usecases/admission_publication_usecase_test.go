@@ -0,0 +1,199 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockAdmissionPublisher はIAdmissionPublisherのモック実装です
+type MockAdmissionPublisher struct {
+	mock.Mock
+}
+
+// FindAdmissionInfoForPublication は公開確認用の入試情報取得のモック実装です
+func (m *MockAdmissionPublisher) FindAdmissionInfoForPublication(
+	ctx context.Context,
+	id uint,
+) (*models.AdmissionInfo, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*models.AdmissionInfo), args.Error(1)
+}
+
+// UpdateAdmissionInfoStatus はステータス変更のモック実装です
+func (m *MockAdmissionPublisher) UpdateAdmissionInfoStatus(
+	ctx context.Context,
+	info *models.AdmissionInfo,
+	status string,
+) error {
+	args := m.Called(ctx, info, status)
+	if args.Error(0) == nil {
+		info.Status = status
+		info.Version++
+	}
+
+	return args.Error(0)
+}
+
+// completeAdmissionInfo は公開に必要な情報が揃った入試情報を生成します
+func completeAdmissionInfo(status string) *models.AdmissionInfo {
+	return &models.AdmissionInfo{
+		BaseModel:           models.BaseModel{ID: 1, Version: 2},
+		AdmissionScheduleID: 10,
+		Enrollment:          100,
+		AcademicYear:        2025,
+		Status:              status,
+		AdmissionSchedule: models.AdmissionSchedule{
+			BaseModel: models.BaseModel{ID: 10, Version: 1},
+			Name:      "前",
+			TestTypes: []models.TestType{{
+				BaseModel: models.BaseModel{ID: 20, Version: 1},
+				Name:      "共通",
+				Subjects: []models.Subject{
+					{BaseModel: models.BaseModel{ID: 30, Version: 1}, Name: "英語", Score: 200},
+				},
+			}},
+		},
+	}
+}
+
+// requireAppError はアプリケーションエラーのコードを検証して返します
+func requireAppError(t *testing.T, err error, code appErrors.Code) *appErrors.Error {
+	t.Helper()
+
+	var appErr *appErrors.Error
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, code, appErr.Code)
+
+	return appErr
+}
+
+func TestAdmissionPublicationUsecaseTransition(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("下書きを公開", func(t *testing.T) {
+		mockRepo := new(MockAdmissionPublisher)
+		info := completeAdmissionInfo(models.AdmissionStatusDraft)
+		mockRepo.On("FindAdmissionInfoForPublication", ctx, uint(1)).Return(info, nil)
+		mockRepo.On("UpdateAdmissionInfoStatus", ctx, info, models.AdmissionStatusPublished).Return(nil)
+
+		result, err := NewAdmissionPublicationUsecase(mockRepo).Transition(ctx, 1, models.AdmissionActionPublish, 2)
+		require.NoError(t, err)
+		assert.Equal(t, models.AdmissionStatusPublished, result.Status)
+		assert.Equal(t, 3, result.Version)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("公開中を下書きに戻す", func(t *testing.T) {
+		mockRepo := new(MockAdmissionPublisher)
+		info := completeAdmissionInfo(models.AdmissionStatusPublished)
+		mockRepo.On("FindAdmissionInfoForPublication", ctx, uint(1)).Return(info, nil)
+		mockRepo.On("UpdateAdmissionInfoStatus", ctx, info, models.AdmissionStatusDraft).Return(nil)
+
+		result, err := NewAdmissionPublicationUsecase(mockRepo).Transition(ctx, 1, models.AdmissionActionUnpublish, 0)
+		require.NoError(t, err)
+		assert.Equal(t, models.AdmissionStatusDraft, result.Status)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("不完全な下書きもアーカイブは可能", func(t *testing.T) {
+		mockRepo := new(MockAdmissionPublisher)
+		info := completeAdmissionInfo(models.AdmissionStatusDraft)
+		info.AdmissionSchedule.TestTypes = nil
+		mockRepo.On("FindAdmissionInfoForPublication", ctx, uint(1)).Return(info, nil)
+		mockRepo.On("UpdateAdmissionInfoStatus", ctx, info, models.AdmissionStatusArchived).Return(nil)
+
+		result, err := NewAdmissionPublicationUsecase(mockRepo).Transition(ctx, 1, models.AdmissionActionArchive, 0)
+		require.NoError(t, err)
+		assert.Equal(t, models.AdmissionStatusArchived, result.Status)
+	})
+
+	t.Run("不正な操作", func(t *testing.T) {
+		mockRepo := new(MockAdmissionPublisher)
+
+		_, err := NewAdmissionPublicationUsecase(mockRepo).Transition(ctx, 1, "delete", 0)
+		appErr := requireAppError(t, err, appErrors.CodeInvalidInput)
+		assert.Equal(t, "delete", appErr.Details.Extra["action"])
+		mockRepo.AssertNotCalled(t, "FindAdmissionInfoForPublication", mock.Anything, mock.Anything)
+	})
+
+	t.Run("アーカイブ済みからの遷移は不可", func(t *testing.T) {
+		mockRepo := new(MockAdmissionPublisher)
+		info := completeAdmissionInfo(models.AdmissionStatusArchived)
+		mockRepo.On("FindAdmissionInfoForPublication", ctx, uint(1)).Return(info, nil)
+
+		_, err := NewAdmissionPublicationUsecase(mockRepo).Transition(ctx, 1, models.AdmissionActionPublish, 0)
+		appErr := requireAppError(t, err, appErrors.CodeValidationError)
+		assert.Equal(t, models.AdmissionStatusArchived, appErr.Details.Extra["status"])
+		mockRepo.AssertNotCalled(t, "UpdateAdmissionInfoStatus", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("バージョンの不一致", func(t *testing.T) {
+		mockRepo := new(MockAdmissionPublisher)
+		info := completeAdmissionInfo(models.AdmissionStatusDraft)
+		mockRepo.On("FindAdmissionInfoForPublication", ctx, uint(1)).Return(info, nil)
+
+		_, err := NewAdmissionPublicationUsecase(mockRepo).Transition(ctx, 1, models.AdmissionActionPublish, 1)
+		appErr := requireAppError(t, err, appErrors.CodeConflict)
+		assert.Equal(t, "2", appErr.Details.Extra["current_version"])
+		mockRepo.AssertNotCalled(t, "UpdateAdmissionInfoStatus", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("公開に必要な情報の不足", func(t *testing.T) {
+		mockRepo := new(MockAdmissionPublisher)
+		info := completeAdmissionInfo(models.AdmissionStatusDraft)
+		info.AdmissionSchedule.TestTypes = append(info.AdmissionSchedule.TestTypes,
+			models.TestType{Name: "二次"},
+			models.TestType{Name: "二次", Subjects: []models.Subject{{Name: "数学", Score: 0}}},
+		)
+		mockRepo.On("FindAdmissionInfoForPublication", ctx, uint(1)).Return(info, nil)
+
+		_, err := NewAdmissionPublicationUsecase(mockRepo).Transition(ctx, 1, models.AdmissionActionPublish, 0)
+		appErr := requireAppError(t, err, appErrors.CodeValidationError)
+		assert.Equal(t, map[string]string{
+			"test_types[1].subjects":          "科目が登録されていません",
+			"test_types[2].subjects[0].score": "配点が登録されていません",
+		}, appErr.Details.Extra)
+		mockRepo.AssertNotCalled(t, "UpdateAdmissionInfoStatus", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("入試情報に紐付く試験種別を優先して確認", func(t *testing.T) {
+		mockRepo := new(MockAdmissionPublisher)
+		info := completeAdmissionInfo(models.AdmissionStatusDraft)
+		info.TestTypes = []models.TestType{{Name: "二次"}}
+		mockRepo.On("FindAdmissionInfoForPublication", ctx, uint(1)).Return(info, nil)
+
+		_, err := NewAdmissionPublicationUsecase(mockRepo).Transition(ctx, 1, models.AdmissionActionPublish, 0)
+		appErr := requireAppError(t, err, appErrors.CodeValidationError)
+		assert.Contains(t, appErr.Details.Extra, "test_types[0].subjects")
+	})
+
+	t.Run("試験種別が未登録", func(t *testing.T) {
+		mockRepo := new(MockAdmissionPublisher)
+		info := completeAdmissionInfo(models.AdmissionStatusDraft)
+		info.AdmissionSchedule.TestTypes = nil
+		mockRepo.On("FindAdmissionInfoForPublication", ctx, uint(1)).Return(info, nil)
+
+		_, err := NewAdmissionPublicationUsecase(mockRepo).Transition(ctx, 1, models.AdmissionActionPublish, 0)
+		appErr := requireAppError(t, err, appErrors.CodeValidationError)
+		assert.Equal(t, "試験種別が登録されていません", appErr.Details.Extra["test_types"])
+	})
+
+	t.Run("入試情報が存在しない", func(t *testing.T) {
+		mockRepo := new(MockAdmissionPublisher)
+		mockRepo.On("FindAdmissionInfoForPublication", ctx, uint(9)).
+			Return(nil, appErrors.NewNotFoundError("入試情報", 9, nil))
+
+		_, err := NewAdmissionPublicationUsecase(mockRepo).Transition(ctx, 9, models.AdmissionActionPublish, 0)
+		requireAppError(t, err, appErrors.CodeNotFound)
+	})
+}
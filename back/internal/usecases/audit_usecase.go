@@ -0,0 +1,77 @@
+package usecases
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/pkg/pagination"
+	"university-exam-api/internal/repositories"
+)
+
+// AuditUsecase は変更履歴の閲覧のユースケースインターフェースです
+type AuditUsecase interface {
+	GetHistory(ctx context.Context, entityType string, entityID uint, params pagination.Params) (*repositories.AuditPage, error)
+}
+
+// auditUsecase はAuditUsecaseの実装です
+type auditUsecase struct {
+	repo repositories.AuditRepository
+}
+
+// NewAuditUsecase は新しいAuditUsecaseを作成します
+func NewAuditUsecase(repo repositories.AuditRepository) AuditUsecase {
+	return &auditUsecase{repo: repo}
+}
+
+// GetHistory はエンティティの変更履歴を新しい順に取得します。
+// この関数は以下の処理を行います：
+// - エンティティ種別の検証
+// - エンティティIDの検証
+// - 変更履歴の取得
+func (u *auditUsecase) GetHistory(
+	ctx context.Context,
+	entityType string,
+	entityID uint,
+	params pagination.Params,
+) (*repositories.AuditPage, error) {
+	if err := validateEntity(entityType, entityID); err != nil {
+		return nil, err
+	}
+
+	return u.repo.FindHistory(ctx, entityType, entityID, params)
+}
+
+// validateEntityType はエンティティ種別が監査対象の種別かどうかを検証します
+func validateEntityType(entityType string) error {
+	if _, ok := models.AuditEntities[entityType]; ok {
+		return nil
+	}
+
+	entityTypes := make([]string, 0, len(models.AuditEntities))
+	for t := range models.AuditEntities {
+		entityTypes = append(entityTypes, t)
+	}
+
+	sort.Strings(entityTypes)
+
+	return appErrors.NewInvalidInputError(
+		"entityType",
+		"エンティティ種別は"+strings.Join(entityTypes, ", ")+"のいずれかで指定してください",
+		map[string]string{"entityType": entityType},
+	)
+}
+
+// validateEntity はエンティティ種別とエンティティIDを検証します
+func validateEntity(entityType string, entityID uint) error {
+	if err := validateEntityType(entityType); err != nil {
+		return err
+	}
+
+	if entityID == 0 {
+		return appErrors.NewInvalidInputError("entityID", "エンティティIDは1以上で指定してください", nil)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/pkg/pagination"
+	"university-exam-api/internal/repositories"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockAuditRepository はAuditRepositoryのモック実装です
+type MockAuditRepository struct {
+	mock.Mock
+}
+
+// FindHistory は変更履歴取得のモック実装です
+func (m *MockAuditRepository) FindHistory(
+	ctx context.Context,
+	entityType string,
+	entityID uint,
+	params pagination.Params,
+) (*repositories.AuditPage, error) {
+	args := m.Called(ctx, entityType, entityID, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*repositories.AuditPage), args.Error(1)
+}
+
+func TestAuditUsecaseGetHistory(t *testing.T) {
+	ctx := context.Background()
+	params := pagination.DefaultParams()
+
+	t.Run("正常系", func(t *testing.T) {
+		mockRepo := new(MockAuditRepository)
+		expected := &repositories.AuditPage{
+			Logs: []models.AuditLog{{ID: 1, EntityType: models.AuditEntitySubject, EntityID: 5}},
+		}
+		mockRepo.On("FindHistory", ctx, models.AuditEntitySubject, uint(5), params).Return(expected, nil)
+
+		page, err := NewAuditUsecase(mockRepo).GetHistory(ctx, models.AuditEntitySubject, 5, params)
+		require.NoError(t, err)
+		assert.Equal(t, expected, page)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("不正なエンティティ種別", func(t *testing.T) {
+		mockRepo := new(MockAuditRepository)
+
+		_, err := NewAuditUsecase(mockRepo).GetHistory(ctx, "region", 1, params)
+		require.Error(t, err)
+
+		var appErr *appErrors.Error
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.CodeInvalidInput, appErr.Code)
+		mockRepo.AssertNotCalled(t, "FindHistory")
+	})
+
+	t.Run("不正なエンティティID", func(t *testing.T) {
+		mockRepo := new(MockAuditRepository)
+
+		_, err := NewAuditUsecase(mockRepo).GetHistory(ctx, models.AuditEntityUniversity, 0, params)
+		require.Error(t, err)
+		mockRepo.AssertNotCalled(t, "FindHistory")
+	})
+}
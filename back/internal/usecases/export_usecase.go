@@ -0,0 +1,261 @@
+package usecases
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	appErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/pkg/xlsx"
+	"university-exam-api/internal/repositories"
+)
+
+// エクスポートファイルの形式
+const (
+	ExportFormatCSV    = "csv"
+	ExportFormatNDJSON = "ndjson"
+	ExportFormatXLSX   = "xlsx"
+)
+
+const (
+	// exportFlushInterval は書き出し先にフラッシュする行数の間隔です
+	exportFlushInterval = 500
+	// exportFieldFormat はエクスポートファイルの形式を示すフィールド名です
+	exportFieldFormat = "format"
+	// exportSheetName はExcelブックのシート名です
+	exportSheetName = "universities"
+)
+
+// ExportContentTypes はエクスポートファイルの形式ごとのContent-Typeです
+var ExportContentTypes = map[string]string{
+	ExportFormatCSV:    "text/csv; charset=utf-8",
+	ExportFormatNDJSON: "application/x-ndjson",
+	ExportFormatXLSX:   "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+}
+
+// ExportUsecase はデータのエクスポートのユースケースインターフェースです
+type ExportUsecase interface {
+	Export(ctx context.Context, format string, criteria repositories.ExportCriteria, w io.Writer) error
+}
+
+// exportUsecase はExportUsecaseの実装です
+type exportUsecase struct {
+	repo repositories.ExportRepository
+}
+
+// NewExportUsecase は新しいExportUsecaseを作成します
+func NewExportUsecase(repo repositories.ExportRepository) ExportUsecase {
+	return &exportUsecase{repo: repo}
+}
+
+// Export は条件に一致するデータを指定の形式で書き出します。
+// この関数は以下の処理を行います：
+// - 形式・ファセット条件の検証と正規化
+// - リポジトリから読み出した行の逐次書き出し
+// - 一定行数ごとの書き出し先へのフラッシュ（http.ResponseWriterなどFlushを持つ場合）
+// 書き出し先には、最初の行を読み出すまで何も出力しません
+func (u *exportUsecase) Export(
+	ctx context.Context,
+	format string,
+	criteria repositories.ExportCriteria,
+	w io.Writer,
+) error {
+	format = strings.ToLower(format)
+	if _, ok := ExportContentTypes[format]; !ok {
+		return appErrors.NewInvalidInputError(exportFieldFormat, "エクスポートの形式はcsv・ndjson・xlsxのいずれかである必要があります", nil)
+	}
+
+	facets, err := normalizeFacetCriteria(criteria.FacetSearchCriteria)
+	if err != nil {
+		return err
+	}
+
+	criteria.FacetSearchCriteria = facets
+
+	flusher, _ := w.(interface{ Flush() })
+
+	var encoder exportEncoder
+
+	rows := 0
+
+	err = u.repo.StreamRows(ctx, criteria, func(row repositories.ExportRow) error {
+		if encoder == nil {
+			created, err := newExportEncoder(format, w)
+			if err != nil {
+				return err
+			}
+
+			encoder = created
+		}
+
+		if err := encoder.Write(row); err != nil {
+			return err
+		}
+
+		rows++
+		if rows%exportFlushInterval == 0 {
+			if err := encoder.Flush(); err != nil {
+				return err
+			}
+
+			if flusher != nil {
+				flusher.Flush()
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// 該当する行がない場合もヘッダーのみのファイルを書き出す
+	if encoder == nil {
+		if encoder, err = newExportEncoder(format, w); err != nil {
+			return err
+		}
+	}
+
+	return encoder.Close()
+}
+
+// exportEncoder はエクスポート行を形式に応じて書き出すインターフェースです
+type exportEncoder interface {
+	Write(row repositories.ExportRow) error
+	Flush() error
+	Close() error
+}
+
+// newExportEncoder は形式に応じたエンコーダーを生成し、ヘッダーを書き出します
+func newExportEncoder(format string, w io.Writer) (exportEncoder, error) {
+	switch format {
+	case ExportFormatCSV:
+		return newCSVExportEncoder(w)
+	case ExportFormatNDJSON:
+		return newNDJSONExportEncoder(w), nil
+	default:
+		return newXLSXExportEncoder(w)
+	}
+}
+
+// csvExportEncoder はCSV形式のエンコーダーです
+type csvExportEncoder struct {
+	w *csv.Writer
+}
+
+// newCSVExportEncoder はCSV形式のエンコーダーを生成します
+// Excelで文字化けしないように、先頭にバイト順マークを付与します
+func newCSVExportEncoder(w io.Writer) (*csvExportEncoder, error) {
+	cw := csv.NewWriter(w)
+
+	if _, err := io.WriteString(w, utf8BOM); err != nil {
+		return nil, err
+	}
+
+	if err := cw.Write(repositories.ExportColumns); err != nil {
+		return nil, err
+	}
+
+	return &csvExportEncoder{w: cw}, nil
+}
+
+// Write は1行を書き出します
+func (e *csvExportEncoder) Write(row repositories.ExportRow) error {
+	values := row.Values()
+	record := make([]string, len(values))
+
+	for i, v := range values {
+		switch value := v.(type) {
+		case nil:
+			record[i] = ""
+		case float64:
+			record[i] = strconv.FormatFloat(value, 'f', -1, 64)
+		default:
+			record[i] = fmt.Sprint(value)
+		}
+	}
+
+	return e.w.Write(record)
+}
+
+// Flush はバッファの内容を書き出します
+func (e *csvExportEncoder) Flush() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// Close は書き出しを完了します
+func (e *csvExportEncoder) Close() error {
+	return e.Flush()
+}
+
+// ndjsonExportEncoder はJSON Lines形式のエンコーダーです
+type ndjsonExportEncoder struct {
+	w   *bufio.Writer
+	enc *json.Encoder
+}
+
+// newNDJSONExportEncoder はJSON Lines形式のエンコーダーを生成します
+func newNDJSONExportEncoder(w io.Writer) *ndjsonExportEncoder {
+	bw := bufio.NewWriter(w)
+
+	return &ndjsonExportEncoder{w: bw, enc: json.NewEncoder(bw)}
+}
+
+// Write は1行を1つのJSONオブジェクトとして書き出します
+func (e *ndjsonExportEncoder) Write(row repositories.ExportRow) error {
+	return e.enc.Encode(row)
+}
+
+// Flush はバッファの内容を書き出します
+func (e *ndjsonExportEncoder) Flush() error {
+	return e.w.Flush()
+}
+
+// Close は書き出しを完了します
+func (e *ndjsonExportEncoder) Close() error {
+	return e.Flush()
+}
+
+// xlsxExportEncoder はExcelブック形式のエンコーダーです
+type xlsxExportEncoder struct {
+	w *xlsx.Writer
+}
+
+// newXLSXExportEncoder はExcelブック形式のエンコーダーを生成します
+func newXLSXExportEncoder(w io.Writer) (*xlsxExportEncoder, error) {
+	xw, err := xlsx.NewWriter(w, exportSheetName)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]interface{}, len(repositories.ExportColumns))
+	for i, column := range repositories.ExportColumns {
+		header[i] = column
+	}
+
+	if err := xw.WriteRow(header...); err != nil {
+		return nil, err
+	}
+
+	return &xlsxExportEncoder{w: xw}, nil
+}
+
+// Write は1行を書き出します
+func (e *xlsxExportEncoder) Write(row repositories.ExportRow) error {
+	return e.w.WriteRow(row.Values()...)
+}
+
+// Flush はバッファの内容を書き出します
+func (e *xlsxExportEncoder) Flush() error {
+	return e.w.Flush()
+}
+
+// Close は書き出しを完了します
+func (e *xlsxExportEncoder) Close() error {
+	return e.w.Close()
+}
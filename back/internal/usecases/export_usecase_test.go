@@ -0,0 +1,153 @@
+package usecases
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	appErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/pkg/xlsx"
+	"university-exam-api/internal/repositories"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockExportRepository はExportRepositoryのモック実装です
+type MockExportRepository struct {
+	mock.Mock
+}
+
+// StreamRows はモックに設定された行を順にfnへ渡します
+func (m *MockExportRepository) StreamRows(
+	ctx context.Context,
+	criteria repositories.ExportCriteria,
+	fn func(repositories.ExportRow) error,
+) error {
+	args := m.Called(ctx, criteria)
+
+	for _, row := range args.Get(0).([]repositories.ExportRow) {
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+
+	return args.Error(1)
+}
+
+// exportTestRows はエクスポートのテスト用の行を返します
+func exportTestRows() []repositories.ExportRow {
+	year := 2025
+	enrollment := 100
+	status := "published"
+
+	return []repositories.ExportRow{
+		{
+			UniversityID: 1, UniversityName: "東京大学", DepartmentID: 2, DepartmentName: "工学部",
+			MajorID: 3, MajorName: "機械工学科", AdmissionScheduleID: 4, AdmissionScheduleName: "前",
+			AcademicYear: &year, Enrollment: &enrollment, Status: &status,
+			TestTypeID: 5, TestTypeName: "共通", SubjectID: 6, SubjectName: "英語",
+			Score: 200, ConversionRatio: 0.5, EffectiveScore: 100, Percentage: 33.33, DisplayOrder: 1,
+		},
+		{
+			UniversityID: 1, UniversityName: "東京大学", DepartmentID: 2, DepartmentName: "工学部",
+			MajorID: 3, MajorName: "機械工学科", AdmissionScheduleID: 4, AdmissionScheduleName: "前",
+			TestTypeID: 7, TestTypeName: "二次", SubjectID: 8, SubjectName: "数学",
+			Score: 400, ConversionRatio: 1, EffectiveScore: 400, Percentage: 66.67, DisplayOrder: 2,
+		},
+	}
+}
+
+func TestExportUsecaseExport(t *testing.T) {
+	criteria := repositories.ExportCriteria{
+		FacetSearchCriteria: repositories.FacetSearchCriteria{Regions: []string{" 関東 ", "関東"}},
+		AcademicYear:        2025,
+	}
+	normalized := repositories.ExportCriteria{
+		FacetSearchCriteria: repositories.FacetSearchCriteria{Regions: []string{"関東"}},
+		AcademicYear:        2025,
+	}
+
+	t.Run("CSV", func(t *testing.T) {
+		mockRepo := new(MockExportRepository)
+		mockRepo.On("StreamRows", mock.Anything, normalized).Return(exportTestRows(), nil)
+
+		var buf bytes.Buffer
+		require.NoError(t, NewExportUsecase(mockRepo).Export(context.Background(), "CSV", criteria, &buf))
+
+		lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+		require.Len(t, lines, 3)
+		assert.Equal(t, utf8BOM+strings.Join(repositories.ExportColumns, ","), lines[0])
+		assert.Equal(t, "1,東京大学,2,工学部,3,機械工学科,4,前,2025,100,published,5,共通,6,英語,200,0.5,100,33.33,1", lines[1])
+		assert.Equal(t, "1,東京大学,2,工学部,3,機械工学科,4,前,,,,7,二次,8,数学,400,1,400,66.67,2", lines[2])
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("JSON Lines", func(t *testing.T) {
+		mockRepo := new(MockExportRepository)
+		mockRepo.On("StreamRows", mock.Anything, mock.Anything).Return(exportTestRows(), nil)
+
+		var buf bytes.Buffer
+		require.NoError(t, NewExportUsecase(mockRepo).Export(context.Background(), ExportFormatNDJSON, criteria, &buf))
+
+		lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+		require.Len(t, lines, 2)
+		assert.Contains(t, lines[0], `"university_name":"東京大学"`)
+		assert.Contains(t, lines[0], `"academic_year":2025`)
+		assert.Contains(t, lines[1], `"academic_year":null`)
+	})
+
+	t.Run("Excelブック", func(t *testing.T) {
+		mockRepo := new(MockExportRepository)
+		mockRepo.On("StreamRows", mock.Anything, mock.Anything).Return(exportTestRows(), nil)
+
+		var buf bytes.Buffer
+		require.NoError(t, NewExportUsecase(mockRepo).Export(context.Background(), ExportFormatXLSX, criteria, &buf))
+
+		records, err := xlsx.ReadRows(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		require.NoError(t, err)
+		require.Len(t, records, 3)
+		assert.Equal(t, repositories.ExportColumns, records[0])
+		assert.Equal(t, "東京大学", records[1][1])
+		assert.Equal(t, "0.5", records[1][16])
+		assert.Equal(t, "33.33", records[1][18])
+	})
+
+	t.Run("該当なしの場合はヘッダーのみ", func(t *testing.T) {
+		mockRepo := new(MockExportRepository)
+		mockRepo.On("StreamRows", mock.Anything, mock.Anything).Return([]repositories.ExportRow{}, nil)
+
+		var buf bytes.Buffer
+		require.NoError(t, NewExportUsecase(mockRepo).Export(context.Background(), ExportFormatCSV, criteria, &buf))
+		assert.Equal(t, utf8BOM+strings.Join(repositories.ExportColumns, ",")+"\n", buf.String())
+	})
+}
+
+func TestExportUsecaseExportErrors(t *testing.T) {
+	t.Run("未対応の形式", func(t *testing.T) {
+		mockRepo := new(MockExportRepository)
+
+		var buf bytes.Buffer
+		err := NewExportUsecase(mockRepo).Export(context.Background(), "json", repositories.ExportCriteria{}, &buf)
+
+		var appErr *appErrors.Error
+		require.True(t, errors.As(err, &appErr))
+		assert.Equal(t, appErrors.CodeInvalidInput, appErr.Code)
+		mockRepo.AssertNotCalled(t, "StreamRows", mock.Anything, mock.Anything)
+	})
+
+	t.Run("読み出し前のエラーでは何も書き出さない", func(t *testing.T) {
+		dbErr := appErrors.NewDatabaseError("エクスポート処理", errors.New("db error"), nil)
+
+		mockRepo := new(MockExportRepository)
+		mockRepo.On("StreamRows", mock.Anything, mock.Anything).Return([]repositories.ExportRow{}, dbErr)
+
+		var buf bytes.Buffer
+		err := NewExportUsecase(mockRepo).Export(context.Background(), ExportFormatCSV, repositories.ExportCriteria{}, &buf)
+		assert.Equal(t, dbErr, err)
+		assert.Zero(t, buf.Len())
+	})
+}
@@ -0,0 +1,132 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	appErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/pkg/pagination"
+	"university-exam-api/internal/repositories"
+)
+
+const (
+	// maxFacetValues は1カテゴリあたりに指定できる値の最大数です
+	maxFacetValues = 50
+)
+
+// FacetSearchUsecase はファセット検索のユースケースインターフェースです
+type FacetSearchUsecase interface {
+	SearchWithFacets(
+		ctx context.Context,
+		criteria repositories.FacetSearchCriteria,
+		params pagination.Params,
+	) (*repositories.FacetSearchResult, error)
+}
+
+// facetSearchUsecase はFacetSearchUsecaseの実装です
+type facetSearchUsecase struct {
+	repo repositories.FacetSearchRepository
+}
+
+// NewFacetSearchUsecase は新しいFacetSearchUsecaseを作成します
+func NewFacetSearchUsecase(repo repositories.FacetSearchRepository) FacetSearchUsecase {
+	return &facetSearchUsecase{repo: repo}
+}
+
+// SearchWithFacets は検索条件を正規化した上でファセット検索を実行します
+func (u *facetSearchUsecase) SearchWithFacets(
+	ctx context.Context,
+	criteria repositories.FacetSearchCriteria,
+	params pagination.Params,
+) (*repositories.FacetSearchResult, error) {
+	normalized, err := normalizeFacetCriteria(criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.repo.SearchWithFacets(ctx, normalized, params)
+}
+
+// normalizeFacetCriteria は検索条件の空白除去・重複排除・件数チェックと、合格難易度の範囲の検証を行います
+func normalizeFacetCriteria(criteria repositories.FacetSearchCriteria) (repositories.FacetSearchCriteria, error) {
+	normalized := repositories.FacetSearchCriteria{
+		Query: strings.TrimSpace(criteria.Query),
+	}
+
+	fields := []struct {
+		name string
+		src  []string
+		dst  *[]string
+	}{
+		{"region", criteria.Regions, &normalized.Regions},
+		{"prefecture", criteria.Prefectures, &normalized.Prefectures},
+		{"classification", criteria.Classifications, &normalized.Classifications},
+		{"sub_classification", criteria.SubClassifications, &normalized.SubClassifications},
+		{"schedule", criteria.Schedules, &normalized.Schedules},
+		{"academic_field", criteria.AcademicFields, &normalized.AcademicFields},
+	}
+
+	for _, f := range fields {
+		values := normalizeFacetValues(f.src)
+		if len(values) > maxFacetValues {
+			return repositories.FacetSearchCriteria{}, appErrors.NewInvalidInputError(
+				f.name,
+				fmt.Sprintf("指定できる値は%d件以下である必要があります", maxFacetValues),
+				nil,
+			)
+		}
+
+		*f.dst = values
+	}
+
+	ranges := []struct {
+		name string
+		src  repositories.DifficultyRange
+		dst  *repositories.DifficultyRange
+	}{
+		{"deviation", criteria.Deviation, &normalized.Deviation},
+		{"common_test_rate", criteria.CommonTestRate, &normalized.CommonTestRate},
+		{"border_score", criteria.BorderScore, &normalized.BorderScore},
+	}
+
+	for _, r := range ranges {
+		if r.src.Min != nil && r.src.Max != nil && *r.src.Min > *r.src.Max {
+			return repositories.FacetSearchCriteria{}, appErrors.NewInvalidInputError(
+				r.name,
+				"範囲の下限は上限以下である必要があります",
+				nil,
+			)
+		}
+
+		*r.dst = r.src
+	}
+
+	return normalized, nil
+}
+
+// normalizeFacetValues は値の空白除去と重複排除を行います
+func normalizeFacetValues(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]bool, len(values))
+	result := make([]string, 0, len(values))
+
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if v == "" || seen[v] {
+			continue
+		}
+
+		seen[v] = true
+
+		result = append(result, v)
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+
+	return result
+}
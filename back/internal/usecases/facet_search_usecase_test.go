@@ -0,0 +1,104 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"university-exam-api/internal/domain/models"
+	"university-exam-api/internal/pkg/pagination"
+	"university-exam-api/internal/repositories"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockFacetSearchRepository はFacetSearchRepositoryのモック実装です
+type MockFacetSearchRepository struct {
+	mock.Mock
+}
+
+// SearchWithFacets はファセット検索のモック実装です
+func (m *MockFacetSearchRepository) SearchWithFacets(
+	ctx context.Context,
+	criteria repositories.FacetSearchCriteria,
+	params pagination.Params,
+) (*repositories.FacetSearchResult, error) {
+	args := m.Called(ctx, criteria, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*repositories.FacetSearchResult), args.Error(1)
+}
+
+func TestFacetSearchUsecaseNormalizesCriteria(t *testing.T) {
+	mockRepo := new(MockFacetSearchRepository)
+	usecase := NewFacetSearchUsecase(mockRepo)
+
+	expected := &repositories.FacetSearchResult{
+		Universities: []models.University{{Name: "東京大学"}},
+		Facets:       map[string][]repositories.FacetCount{},
+	}
+
+	mockRepo.On("SearchWithFacets", mock.Anything, repositories.FacetSearchCriteria{
+		Query:   "東京",
+		Regions: []string{"関東", "関西"},
+	}, pagination.DefaultParams()).Return(expected, nil)
+
+	result, err := usecase.SearchWithFacets(context.Background(), repositories.FacetSearchCriteria{
+		Query:     "  東京 ",
+		Regions:   []string{" 関東", "関西", "関東", ""},
+		Schedules: []string{" "},
+	}, pagination.DefaultParams())
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestFacetSearchUsecaseTooManyValues(t *testing.T) {
+	mockRepo := new(MockFacetSearchRepository)
+	usecase := NewFacetSearchUsecase(mockRepo)
+
+	values := make([]string, 0, maxFacetValues+1)
+	for i := 0; i <= maxFacetValues; i++ {
+		values = append(values, fmt.Sprintf("分野%d", i))
+	}
+
+	result, err := usecase.SearchWithFacets(context.Background(), repositories.FacetSearchCriteria{
+		AcademicFields: values,
+	}, pagination.DefaultParams())
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	mockRepo.AssertNotCalled(t, "SearchWithFacets", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestFacetSearchUsecaseInvalidRange(t *testing.T) {
+	mockRepo := new(MockFacetSearchRepository)
+	usecase := NewFacetSearchUsecase(mockRepo)
+
+	low, high := 60.0, 55.0
+
+	result, err := usecase.SearchWithFacets(context.Background(), repositories.FacetSearchCriteria{
+		Deviation: repositories.DifficultyRange{Min: &low, Max: &high},
+	}, pagination.DefaultParams())
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	mockRepo.AssertNotCalled(t, "SearchWithFacets", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestFacetSearchUsecaseRepositoryError(t *testing.T) {
+	mockRepo := new(MockFacetSearchRepository)
+	usecase := NewFacetSearchUsecase(mockRepo)
+
+	mockRepo.On("SearchWithFacets", mock.Anything, mock.Anything, mock.Anything).Return(nil, assert.AnError)
+
+	result, err := usecase.SearchWithFacets(context.Background(), repositories.FacetSearchCriteria{}, pagination.DefaultParams())
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,350 @@
+package usecases
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	appErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/pkg/xlsx"
+	"university-exam-api/internal/repositories"
+)
+
+// 取り込みファイルの形式
+const (
+	ImportFormatCSV  = "csv"
+	ImportFormatXLSX = "xlsx"
+)
+
+const (
+	// MaxImportRows は1回の取り込みで扱える最大行数です
+	MaxImportRows = 5000
+	// importFieldFormat は取り込みファイルの形式を示すフィールド名です
+	importFieldFormat = "format"
+	// importFieldFile は取り込みファイルを示すフィールド名です
+	importFieldFile = "file"
+	// utf8BOM はExcelで保存したCSVの先頭に付与されるバイト順マークです
+	utf8BOM = "\ufeff"
+)
+
+// errInvalidImportNumber は整数に変換できないセル値のエラーです
+var errInvalidImportNumber = errors.New("整数に変換できません")
+
+// importColumnAliases は取り込みファイルのヘッダー名と列名の対応です
+var importColumnAliases = map[string]string{
+	"university":       repositories.ImportFieldUniversity,
+	"大学名":              repositories.ImportFieldUniversity,
+	"department":       repositories.ImportFieldDepartment,
+	"学部名":              repositories.ImportFieldDepartment,
+	"major":            repositories.ImportFieldMajor,
+	"学科名":              repositories.ImportFieldMajor,
+	"schedule":         repositories.ImportFieldSchedule,
+	"日程":               repositories.ImportFieldSchedule,
+	"test_type":        repositories.ImportFieldTestType,
+	"試験種別":             repositories.ImportFieldTestType,
+	"subject":          repositories.ImportFieldSubject,
+	"科目名":              repositories.ImportFieldSubject,
+	"score":            repositories.ImportFieldScore,
+	"配点":               repositories.ImportFieldScore,
+	"display_order":    repositories.ImportFieldDisplayOrder,
+	"表示順":              repositories.ImportFieldDisplayOrder,
+	"academic_year":    repositories.ImportFieldAcademicYear,
+	"年度":               repositories.ImportFieldAcademicYear,
+	"enrollment":       repositories.ImportFieldEnrollment,
+	"募集人員":             repositories.ImportFieldEnrollment,
+	"border_score":     repositories.ImportFieldBorderScore,
+	"ボーダー得点":           repositories.ImportFieldBorderScore,
+	"common_test_rate": repositories.ImportFieldCommonTestRate,
+	"共通テスト得点率":         repositories.ImportFieldCommonTestRate,
+	"deviation_low":    repositories.ImportFieldDeviationLow,
+	"偏差値下限":            repositories.ImportFieldDeviationLow,
+	"deviation_high":   repositories.ImportFieldDeviationHigh,
+	"偏差値上限":            repositories.ImportFieldDeviationHigh,
+}
+
+// requiredImportColumns は取り込みファイルに必須の列です
+var requiredImportColumns = []string{
+	repositories.ImportFieldUniversity,
+	repositories.ImportFieldDepartment,
+	repositories.ImportFieldMajor,
+	repositories.ImportFieldSchedule,
+	repositories.ImportFieldTestType,
+	repositories.ImportFieldSubject,
+	repositories.ImportFieldScore,
+}
+
+// ImportUsecase は入試データの一括取り込みのユースケースインターフェースです
+type ImportUsecase interface {
+	Import(ctx context.Context, format string, data []byte, dryRun bool) (*repositories.ImportResult, error)
+}
+
+// importUsecase はImportUsecaseの実装です
+type importUsecase struct {
+	repo repositories.IUniversityImporter
+}
+
+// NewImportUsecase は新しいImportUsecaseを作成します
+func NewImportUsecase(repo repositories.IUniversityImporter) ImportUsecase {
+	return &importUsecase{repo: repo}
+}
+
+// Import は取り込みファイルを解析し、入試データを一括登録します。
+// この関数は以下の処理を行います：
+// - CSV・Excelブックの読み込みとヘッダーの解釈
+// - 行ごとの数値項目（合格難易度の小数を含む）の変換と変換エラーの収集
+// - リポジトリによる検証・登録（変換エラーがある場合はドライランとして実行）
+func (u *importUsecase) Import(
+	ctx context.Context,
+	format string,
+	data []byte,
+	dryRun bool,
+) (*repositories.ImportResult, error) {
+	records, err := readImportRecords(format, data)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, parseErrors, err := parseImportRecords(records)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(parseErrors) > 0 {
+		dryRun = true
+	}
+
+	result := repositories.NewImportResult(dryRun)
+
+	if len(rows) > 0 {
+		if result, err = u.repo.ImportRows(ctx, rows, dryRun); err != nil {
+			return nil, err
+		}
+	}
+
+	failed := make(map[int]bool, len(parseErrors))
+	for _, e := range parseErrors {
+		failed[e.Line] = true
+	}
+
+	result.Rows = len(rows) + len(failed)
+	result.Errors = append(result.Errors, parseErrors...)
+	result.SortErrors()
+
+	return result, nil
+}
+
+// readImportRecords は取り込みファイルを形式に応じて行ごとのセル値に変換します
+func readImportRecords(format string, data []byte) ([][]string, error) {
+	switch strings.ToLower(format) {
+	case ImportFormatCSV:
+		reader := csv.NewReader(bytes.NewReader(data))
+		reader.FieldsPerRecord = -1
+		reader.TrimLeadingSpace = true
+
+		records, err := reader.ReadAll()
+		if err != nil {
+			return nil, appErrors.NewInvalidInputError(importFieldFile, fmt.Sprintf("CSVの形式が不正です: %v", err), nil)
+		}
+
+		return records, nil
+	case ImportFormatXLSX:
+		records, err := xlsx.ReadRows(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			return nil, appErrors.NewInvalidInputError(importFieldFile, fmt.Sprintf("Excelブックの形式が不正です: %v", err), nil)
+		}
+
+		return records, nil
+	default:
+		return nil, appErrors.NewInvalidInputError(importFieldFormat, "取り込みファイルの形式はcsvまたはxlsxである必要があります", nil)
+	}
+}
+
+// parseImportHeader はヘッダー行から列名と列位置の対応を生成します
+func parseImportHeader(header []string) (map[string]int, error) {
+	columns := make(map[string]int, len(header))
+
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, utf8BOM)))
+
+		column, ok := importColumnAliases[name]
+		if !ok {
+			continue
+		}
+
+		if _, dup := columns[column]; dup {
+			return nil, appErrors.NewInvalidInputError(importFieldFile, fmt.Sprintf("列「%s」が重複しています", name), nil)
+		}
+
+		columns[column] = i
+	}
+
+	var missing []string
+
+	for _, column := range requiredImportColumns {
+		if _, ok := columns[column]; !ok {
+			missing = append(missing, column)
+		}
+	}
+
+	if len(missing) > 0 {
+		return nil, appErrors.NewInvalidInputError(
+			importFieldFile,
+			fmt.Sprintf("必須の列がありません: %s", strings.Join(missing, ", ")),
+			nil,
+		)
+	}
+
+	return columns, nil
+}
+
+// parseImportRecords はヘッダー行に従ってセル値を取り込み行に変換します
+// 空行は読み飛ばし、数値に変換できない行は行エラーとして返します
+func parseImportRecords(records [][]string) ([]repositories.ImportRow, []repositories.ImportRowError, error) {
+	if len(records) == 0 {
+		return nil, nil, appErrors.NewInvalidInputError(importFieldFile, "取り込みファイルにヘッダー行がありません", nil)
+	}
+
+	if len(records)-1 > MaxImportRows {
+		return nil, nil, appErrors.NewInvalidInputError(
+			importFieldFile,
+			fmt.Sprintf("取り込める行数は%d行までです", MaxImportRows),
+			nil,
+		)
+	}
+
+	columns, err := parseImportHeader(records[0])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]repositories.ImportRow, 0, len(records)-1)
+
+	var parseErrors []repositories.ImportRowError
+
+	for i, record := range records[1:] {
+		if isBlankRecord(record) {
+			continue
+		}
+
+		row, errs := parseImportRecord(i+2, record, columns)
+		if len(errs) > 0 {
+			parseErrors = append(parseErrors, errs...)
+			continue
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, parseErrors, nil
+}
+
+// parseImportRecord は1行分のセル値を取り込み行に変換します
+func parseImportRecord(
+	line int,
+	record []string,
+	columns map[string]int,
+) (repositories.ImportRow, []repositories.ImportRowError) {
+	cell := func(column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+
+		return strings.TrimSpace(record[i])
+	}
+
+	row := repositories.ImportRow{
+		Line:       line,
+		University: cell(repositories.ImportFieldUniversity),
+		Department: cell(repositories.ImportFieldDepartment),
+		Major:      cell(repositories.ImportFieldMajor),
+		Schedule:   cell(repositories.ImportFieldSchedule),
+		TestType:   cell(repositories.ImportFieldTestType),
+		Subject:    cell(repositories.ImportFieldSubject),
+	}
+
+	var errs []repositories.ImportRowError
+
+	numbers := []struct {
+		column   string
+		required bool
+		target   *int
+	}{
+		{repositories.ImportFieldScore, true, &row.Score},
+		{repositories.ImportFieldDisplayOrder, false, &row.DisplayOrder},
+		{repositories.ImportFieldAcademicYear, false, &row.AcademicYear},
+		{repositories.ImportFieldEnrollment, false, &row.Enrollment},
+	}
+
+	for _, n := range numbers {
+		value := cell(n.column)
+		if value == "" && !n.required {
+			continue
+		}
+
+		v, err := parseImportNumber(value)
+		if err != nil {
+			errs = append(errs, repositories.ImportRowError{Line: line, Field: n.column, Message: "整数である必要があります"})
+			continue
+		}
+
+		*n.target = v
+	}
+
+	decimals := []struct {
+		column string
+		target **float64
+	}{
+		{repositories.ImportFieldBorderScore, &row.BorderScore},
+		{repositories.ImportFieldCommonTestRate, &row.CommonTestRate},
+		{repositories.ImportFieldDeviationLow, &row.DeviationLow},
+		{repositories.ImportFieldDeviationHigh, &row.DeviationHigh},
+	}
+
+	for _, d := range decimals {
+		value := cell(d.column)
+		if value == "" {
+			continue
+		}
+
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+			errs = append(errs, repositories.ImportRowError{Line: line, Field: d.column, Message: "数値である必要があります"})
+			continue
+		}
+
+		*d.target = &v
+	}
+
+	return row, errs
+}
+
+// parseImportNumber はセル値を整数に変換します
+// Excelブックでは整数も「200.0」のような小数表記で保存されることがあるため、小数部が0の値も受け付けます
+func parseImportNumber(value string) (int, error) {
+	if v, err := strconv.Atoi(value); err == nil {
+		return v, nil
+	}
+
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil || f != float64(int(f)) {
+		return 0, errInvalidImportNumber
+	}
+
+	return int(f), nil
+}
+
+// isBlankRecord は全てのセルが空の行かどうかを判定します
+func isBlankRecord(record []string) bool {
+	for _, v := range record {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+
+	return true
+}
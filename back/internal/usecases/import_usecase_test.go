@@ -0,0 +1,169 @@
+package usecases
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	appErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/repositories"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockUniversityImporter はIUniversityImporterのモック実装です
+type MockUniversityImporter struct {
+	mock.Mock
+}
+
+// ImportRows は一括取り込みのモック実装です
+func (m *MockUniversityImporter) ImportRows(
+	ctx context.Context,
+	rows []repositories.ImportRow,
+	dryRun bool,
+) (*repositories.ImportResult, error) {
+	args := m.Called(ctx, rows, dryRun)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*repositories.ImportResult), args.Error(1)
+}
+
+// importedResult はリポジトリが返す取り込み結果を生成します
+func importedResult(rows int, dryRun bool) *repositories.ImportResult {
+	result := repositories.NewImportResult(dryRun)
+	result.Rows = rows
+	result.Committed = !dryRun
+
+	return result
+}
+
+func TestImportUsecaseImportCSV(t *testing.T) {
+	csvData := "\ufeff大学名,学部名,学科名,日程,試験種別,科目名,配点,年度,募集人員\n" +
+		"一橋大学,経済学部,経済学科,前,共通,英語,200,2025,100\n" +
+		",,,,,,,,\n" +
+		"一橋大学,経済学部,経済学科,前,二次,数学,300.0,,\n"
+
+	mockRepo := new(MockUniversityImporter)
+	mockRepo.On("ImportRows", mock.Anything, []repositories.ImportRow{
+		{Line: 2, University: "一橋大学", Department: "経済学部", Major: "経済学科", Schedule: "前",
+			TestType: "共通", Subject: "英語", Score: 200, AcademicYear: 2025, Enrollment: 100},
+		{Line: 4, University: "一橋大学", Department: "経済学部", Major: "経済学科", Schedule: "前",
+			TestType: "二次", Subject: "数学", Score: 300},
+	}, false).Return(importedResult(2, false), nil)
+
+	result, err := NewImportUsecase(mockRepo).Import(context.Background(), "CSV", []byte(csvData), false)
+	require.NoError(t, err)
+	assert.True(t, result.Committed)
+	assert.Equal(t, 2, result.Rows)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestImportUsecaseParseErrorsForceDryRun(t *testing.T) {
+	csvData := "university,department,major,schedule,test_type,subject,score\n" +
+		"一橋大学,経済学部,経済学科,前,共通,英語,abc\n" +
+		"一橋大学,経済学部,経済学科,前,二次,数学,300\n"
+
+	repoResult := importedResult(1, true)
+	repoResult.AddError(3, repositories.ImportFieldSubject, "科目エラー")
+
+	mockRepo := new(MockUniversityImporter)
+	mockRepo.On("ImportRows", mock.Anything, mock.Anything, true).Return(repoResult, nil)
+
+	result, err := NewImportUsecase(mockRepo).Import(context.Background(), ImportFormatCSV, []byte(csvData), false)
+	require.NoError(t, err)
+	assert.False(t, result.Committed)
+	assert.Equal(t, 2, result.Rows)
+	assert.Equal(t, []repositories.ImportRowError{
+		{Line: 2, Field: repositories.ImportFieldScore, Message: "整数である必要があります"},
+		{Line: 3, Field: repositories.ImportFieldSubject, Message: "科目エラー"},
+	}, result.Errors)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestImportUsecaseDifficultyColumns(t *testing.T) {
+	csvData := "大学名,学部名,学科名,日程,試験種別,科目名,配点,年度,共通テスト得点率,偏差値下限,偏差値上限,ボーダー得点\n" +
+		"一橋大学,経済学部,経済学科,前,共通,英語,200,2025,82.5,62.5,65,\n" +
+		"一橋大学,経済学部,経済学科,前,二次,数学,300,2025,約80,,,\n"
+
+	rate, low, high := 82.5, 62.5, 65.0
+
+	mockRepo := new(MockUniversityImporter)
+	mockRepo.On("ImportRows", mock.Anything, []repositories.ImportRow{
+		{Line: 2, University: "一橋大学", Department: "経済学部", Major: "経済学科", Schedule: "前",
+			TestType: "共通", Subject: "英語", Score: 200, AcademicYear: 2025,
+			CommonTestRate: &rate, DeviationLow: &low, DeviationHigh: &high},
+	}, true).Return(importedResult(1, true), nil)
+
+	result, err := NewImportUsecase(mockRepo).Import(context.Background(), ImportFormatCSV, []byte(csvData), false)
+	require.NoError(t, err)
+	assert.Equal(t, []repositories.ImportRowError{
+		{Line: 3, Field: repositories.ImportFieldCommonTestRate, Message: "数値である必要があります"},
+	}, result.Errors)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestImportUsecaseImportXLSX(t *testing.T) {
+	var buf bytes.Buffer
+
+	zw := zip.NewWriter(&buf)
+	w, err := zw.Create("xl/worksheets/sheet1.xml")
+	require.NoError(t, err)
+	_, err = w.Write([]byte(`<worksheet><sheetData>` +
+		`<row><c t="inlineStr"><is><t>university</t></is></c><c t="inlineStr"><is><t>department</t></is></c>` +
+		`<c t="inlineStr"><is><t>major</t></is></c><c t="inlineStr"><is><t>schedule</t></is></c>` +
+		`<c t="inlineStr"><is><t>test_type</t></is></c><c t="inlineStr"><is><t>subject</t></is></c>` +
+		`<c t="inlineStr"><is><t>score</t></is></c></row>` +
+		`<row><c t="inlineStr"><is><t>一橋大学</t></is></c><c t="inlineStr"><is><t>経済学部</t></is></c>` +
+		`<c t="inlineStr"><is><t>経済学科</t></is></c><c t="inlineStr"><is><t>後</t></is></c>` +
+		`<c t="inlineStr"><is><t>二次</t></is></c><c t="inlineStr"><is><t>小論文</t></is></c>` +
+		`<c><v>100</v></c></row>` +
+		`</sheetData></worksheet>`))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	mockRepo := new(MockUniversityImporter)
+	mockRepo.On("ImportRows", mock.Anything, []repositories.ImportRow{
+		{Line: 2, University: "一橋大学", Department: "経済学部", Major: "経済学科", Schedule: "後",
+			TestType: "二次", Subject: "小論文", Score: 100},
+	}, true).Return(importedResult(1, true), nil)
+
+	result, err := NewImportUsecase(mockRepo).Import(context.Background(), ImportFormatXLSX, buf.Bytes(), true)
+	require.NoError(t, err)
+	assert.True(t, result.DryRun)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestImportUsecaseInvalidFile(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		data   string
+	}{
+		{name: "未対応の形式", format: "json", data: "[]"},
+		{name: "空のファイル", format: ImportFormatCSV, data: ""},
+		{name: "必須の列がない", format: ImportFormatCSV, data: "university,department\n東京大学,工学部\n"},
+		{name: "列の重複", format: ImportFormatCSV, data: "university,大学名,department,major,schedule,test_type,subject,score\n"},
+		{name: "CSVの形式が不正", format: ImportFormatCSV, data: "university,\"department\n"},
+		{name: "Excelブックでない", format: ImportFormatXLSX, data: "university"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockUniversityImporter)
+
+			_, err := NewImportUsecase(mockRepo).Import(context.Background(), tt.format, []byte(tt.data), false)
+			require.Error(t, err)
+
+			var appErr *appErrors.Error
+			require.True(t, errors.As(err, &appErr))
+			assert.Equal(t, appErrors.CodeInvalidInput, appErr.Code)
+			mockRepo.AssertNotCalled(t, "ImportRows", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/repositories"
+)
+
+// MasterDataUsecase は地域・都道府県・設置区分・小分類・学問系統のマスタデータ管理のユースケースインターフェースです
+type MasterDataUsecase[T any] interface {
+	List(ctx context.Context, ownerID uint) ([]T, error)
+	Get(ctx context.Context, id uint) (*T, error)
+	Create(ctx context.Context, item *T) error
+	Update(ctx context.Context, item *T) error
+	Reorder(ctx context.Context, ownerID uint, ids []uint) ([]T, error)
+	Delete(ctx context.Context, id uint) error
+}
+
+// masterDataUsecase はMasterDataUsecaseの実装です
+type masterDataUsecase[T any, PT models.MasterDataModel[T]] struct {
+	repo    repositories.MasterDataRepository[T]
+	options repositories.FilterOptionRepository
+}
+
+// NewMasterDataUsecase は新しいMasterDataUsecaseを作成します。
+// options に登録されたフィルターオプションは、マスタデータの名称の候補として検証に使用します
+func NewMasterDataUsecase[T any, PT models.MasterDataModel[T]](
+	repo repositories.MasterDataRepository[T],
+	options repositories.FilterOptionRepository,
+) MasterDataUsecase[T] {
+	return &masterDataUsecase[T, PT]{repo: repo, options: options}
+}
+
+// List は所属先のマスタデータを表示順に取得します
+func (u *masterDataUsecase[T, PT]) List(ctx context.Context, ownerID uint) ([]T, error) {
+	return u.repo.List(ctx, ownerID)
+}
+
+// Get は指定されたIDのマスタデータを取得します
+func (u *masterDataUsecase[T, PT]) Get(ctx context.Context, id uint) (*T, error) {
+	if id == 0 {
+		return nil, appErrors.NewValidationError("id", "IDは1以上である必要があります", nil)
+	}
+
+	return u.repo.Find(ctx, id)
+}
+
+// Create はマスタデータを作成します。
+// この関数は以下の処理を行います：
+// - 所属先の存在確認
+// - フィルターオプションの階層ルールによる検証
+// - マスタデータの作成
+func (u *masterDataUsecase[T, PT]) Create(ctx context.Context, item *T) error {
+	model := PT(item)
+
+	// 作成時のバージョンは初期値として検証します
+	model.Base().Version = 1
+
+	if err := u.validate(ctx, model); err != nil {
+		return err
+	}
+
+	return u.repo.Create(ctx, item)
+}
+
+// Update はマスタデータを更新します。
+// この関数は以下の処理を行います：
+// - 現在のマスタデータの取得（所属先・表示順が指定されていない場合は現在の値を引き継ぐ）
+// - 所属先の存在確認
+// - フィルターオプションの階層ルールによる検証
+// - 楽観的ロックによる更新
+func (u *masterDataUsecase[T, PT]) Update(ctx context.Context, item *T) error {
+	model := PT(item)
+
+	current, err := u.repo.Find(ctx, model.Base().ID)
+	if err != nil {
+		return err
+	}
+
+	if model.OwnerID() == 0 {
+		model.SetOwnerID(PT(current).OwnerID())
+	}
+
+	if model.ToFilterOption().DisplayOrder == 0 {
+		model.SetDisplayOrder(PT(current).ToFilterOption().DisplayOrder)
+	}
+
+	if err := u.validate(ctx, model); err != nil {
+		return err
+	}
+
+	return u.repo.Update(ctx, item)
+}
+
+// Reorder は所属先のマスタデータを指定されたIDの順に並び替えます
+func (u *masterDataUsecase[T, PT]) Reorder(ctx context.Context, ownerID uint, ids []uint) ([]T, error) {
+	if _, err := u.repo.OwnerName(ctx, ownerID); err != nil {
+		return nil, err
+	}
+
+	return u.repo.Reorder(ctx, ownerID, ids)
+}
+
+// Delete はマスタデータと配下の要素を削除します
+func (u *masterDataUsecase[T, PT]) Delete(ctx context.Context, id uint) error {
+	if id == 0 {
+		return appErrors.NewValidationError("id", "IDは1以上である必要があります", nil)
+	}
+
+	return u.repo.Delete(ctx, id)
+}
+
+// validate はマスタデータをフィルターオプションの階層ルールで検証します。
+// この関数は以下の処理を行います：
+// - 所属先の存在確認
+// - フィルターオプションとしての名称・表示順・親子カテゴリの検証
+// - 登録済みのフィルターオプションの候補との照合
+func (u *masterDataUsecase[T, PT]) validate(ctx context.Context, model PT) error {
+	if model.OwnerID() == 0 {
+		return appErrors.NewValidationError("parent_id", "所属先のIDを指定してください", nil)
+	}
+
+	ownerName, err := u.repo.OwnerName(ctx, model.OwnerID())
+	if err != nil {
+		return err
+	}
+
+	option := model.ToFilterOption()
+	if option.Parent != nil {
+		option.Parent.Name = ownerName
+	}
+
+	if err := option.Validate(); err != nil {
+		var validationErr *models.ValidationError
+		if errors.As(err, &validationErr) {
+			return appErrors.NewValidationError(validationErr.Field, validationErr.Message, map[string]string{
+				"code": validationErr.Code,
+			})
+		}
+
+		return err
+	}
+
+	return u.validateCatalog(ctx, option)
+}
+
+// validateCatalog はマスタデータの名称が登録済みのフィルターオプションの候補に含まれることを検証します。
+// カテゴリの候補が登録されていない場合は検証しません。
+// 親を持つカテゴリは、所属先と同じ名称の親の候補の子に含まれることを検証します
+func (u *masterDataUsecase[T, PT]) validateCatalog(ctx context.Context, option models.FilterOption) error {
+	category := option.Category
+	if option.Parent != nil {
+		category = option.Parent.Category
+	}
+
+	catalog, err := u.options.FindByCategory(ctx, category)
+	if err != nil {
+		return appErrors.NewDatabaseError("フィルターオプション取得処理", err, nil)
+	}
+
+	if len(catalog) == 0 {
+		return nil
+	}
+
+	candidates := catalog
+	if option.Parent != nil {
+		candidates = nil
+
+		for _, parent := range catalog {
+			if parent.Name == option.Parent.Name {
+				candidates = parent.Children
+				break
+			}
+		}
+	}
+
+	for _, candidate := range candidates {
+		if candidate.Category == option.Category && candidate.Name == option.Name {
+			return nil
+		}
+	}
+
+	return appErrors.NewValidationError("name", "フィルターオプションに登録されていない名称です", map[string]string{
+		"category": option.Category,
+		"name":     option.Name,
+	})
+}
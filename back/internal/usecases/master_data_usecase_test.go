@@ -0,0 +1,223 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockMasterDataRepository はMasterDataRepositoryのモック実装です
+type MockMasterDataRepository[T any] struct {
+	mock.Mock
+}
+
+// List は一覧取得のモック実装です
+func (m *MockMasterDataRepository[T]) List(ctx context.Context, ownerID uint) ([]T, error) {
+	args := m.Called(ctx, ownerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]T), args.Error(1)
+}
+
+// Find は取得のモック実装です
+func (m *MockMasterDataRepository[T]) Find(ctx context.Context, id uint) (*T, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*T), args.Error(1)
+}
+
+// OwnerName は所属先の名称取得のモック実装です
+func (m *MockMasterDataRepository[T]) OwnerName(ctx context.Context, ownerID uint) (string, error) {
+	args := m.Called(ctx, ownerID)
+	return args.String(0), args.Error(1)
+}
+
+// Create は作成のモック実装です
+func (m *MockMasterDataRepository[T]) Create(ctx context.Context, item *T) error {
+	return m.Called(ctx, item).Error(0)
+}
+
+// Update は更新のモック実装です
+func (m *MockMasterDataRepository[T]) Update(ctx context.Context, item *T) error {
+	return m.Called(ctx, item).Error(0)
+}
+
+// Reorder は並び替えのモック実装です
+func (m *MockMasterDataRepository[T]) Reorder(ctx context.Context, ownerID uint, ids []uint) ([]T, error) {
+	args := m.Called(ctx, ownerID, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]T), args.Error(1)
+}
+
+// Delete は削除のモック実装です
+func (m *MockMasterDataRepository[T]) Delete(ctx context.Context, id uint) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+// regionCatalog は地域と都道府県のフィルターオプションの候補です
+var regionCatalog = []models.FilterOption{
+	{
+		Category: models.FilterCategoryRegion,
+		Name:     "関東",
+		Children: []models.FilterOption{
+			{Category: models.FilterCategoryPrefecture, Name: "東京"},
+			{Category: models.FilterCategoryPrefecture, Name: "千葉"},
+		},
+	},
+}
+
+func TestMasterDataUsecaseCreate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("所属先の地域の候補に含まれる都道府県を作成できる", func(t *testing.T) {
+		repo := new(MockMasterDataRepository[models.Prefecture])
+		options := new(MockFilterOptionRepository)
+		prefecture := &models.Prefecture{RegionID: 1, Name: "千葉"}
+
+		repo.On("OwnerName", ctx, uint(1)).Return("関東", nil)
+		options.On("FindByCategory", ctx, models.FilterCategoryRegion).Return(regionCatalog, nil)
+		repo.On("Create", ctx, prefecture).Return(nil)
+
+		err := NewMasterDataUsecase[models.Prefecture](repo, options).Create(ctx, prefecture)
+		require.NoError(t, err)
+		assert.Equal(t, 1, prefecture.Version)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("所属先の地域の候補に含まれない都道府県は作成できない", func(t *testing.T) {
+		repo := new(MockMasterDataRepository[models.Prefecture])
+		options := new(MockFilterOptionRepository)
+
+		repo.On("OwnerName", ctx, uint(1)).Return("関東", nil)
+		options.On("FindByCategory", ctx, models.FilterCategoryRegion).Return(regionCatalog, nil)
+
+		err := NewMasterDataUsecase[models.Prefecture](repo, options).
+			Create(ctx, &models.Prefecture{RegionID: 1, Name: "京都"})
+		requireAppError(t, err, appErrors.CodeValidationError)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("フィルターオプションの名称の規則に違反する場合は作成できない", func(t *testing.T) {
+		repo := new(MockMasterDataRepository[models.Region])
+		options := new(MockFilterOptionRepository)
+
+		repo.On("OwnerName", ctx, uint(1)).Return("東京大学", nil)
+
+		err := NewMasterDataUsecase[models.Region](repo, options).
+			Create(ctx, &models.Region{UniversityID: 1, Name: "関東甲信越"})
+		appErr := requireAppError(t, err, appErrors.CodeValidationError)
+		assert.Equal(t, "Name", appErr.Details.Field)
+		assert.Equal(t, "INVALID_NAME", appErr.Details.Extra["code"])
+		options.AssertNotCalled(t, "FindByCategory", mock.Anything, mock.Anything)
+	})
+
+	t.Run("候補が登録されていないカテゴリは名称の規則のみ検証する", func(t *testing.T) {
+		repo := new(MockMasterDataRepository[models.AcademicField])
+		options := new(MockFilterOptionRepository)
+		field := &models.AcademicField{MajorID: 1, Name: "情報学"}
+
+		repo.On("OwnerName", ctx, uint(1)).Return("情報工学科", nil)
+		options.On("FindByCategory", ctx, models.FilterCategoryAcademicField).Return([]models.FilterOption{}, nil)
+		repo.On("Create", ctx, field).Return(nil)
+
+		require.NoError(t, NewMasterDataUsecase[models.AcademicField](repo, options).Create(ctx, field))
+	})
+
+	t.Run("所属先が指定されていない場合は作成できない", func(t *testing.T) {
+		repo := new(MockMasterDataRepository[models.Classification])
+		options := new(MockFilterOptionRepository)
+
+		err := NewMasterDataUsecase[models.Classification](repo, options).
+			Create(ctx, &models.Classification{Name: "国公立"})
+		requireAppError(t, err, appErrors.CodeValidationError)
+		repo.AssertNotCalled(t, "OwnerName", mock.Anything, mock.Anything)
+	})
+
+	t.Run("所属先が存在しない場合は作成できない", func(t *testing.T) {
+		repo := new(MockMasterDataRepository[models.SubClassification])
+		options := new(MockFilterOptionRepository)
+
+		repo.On("OwnerName", ctx, uint(9)).Return("", appErrors.NewNotFoundError("設置区分", 9, nil))
+
+		err := NewMasterDataUsecase[models.SubClassification](repo, options).
+			Create(ctx, &models.SubClassification{ClassificationID: 9, Name: "国立"})
+		requireAppError(t, err, appErrors.CodeNotFound)
+	})
+}
+
+func TestMasterDataUsecaseUpdate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("所属先と表示順を指定しない場合は現在の値を引き継ぐ", func(t *testing.T) {
+		repo := new(MockMasterDataRepository[models.Prefecture])
+		options := new(MockFilterOptionRepository)
+		current := &models.Prefecture{BaseModel: models.BaseModel{ID: 5, Version: 2}, RegionID: 1, Name: "東京", DisplayOrder: 3}
+		update := &models.Prefecture{BaseModel: models.BaseModel{ID: 5, Version: 2}, Name: "千葉"}
+
+		repo.On("Find", ctx, uint(5)).Return(current, nil)
+		repo.On("OwnerName", ctx, uint(1)).Return("関東", nil)
+		options.On("FindByCategory", ctx, models.FilterCategoryRegion).Return(regionCatalog, nil)
+		repo.On("Update", ctx, update).Return(nil)
+
+		require.NoError(t, NewMasterDataUsecase[models.Prefecture](repo, options).Update(ctx, update))
+		assert.Equal(t, uint(1), update.RegionID)
+		assert.Equal(t, 3, update.DisplayOrder)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("存在しないマスタデータは更新できない", func(t *testing.T) {
+		repo := new(MockMasterDataRepository[models.Region])
+		options := new(MockFilterOptionRepository)
+
+		repo.On("Find", ctx, uint(5)).Return(nil, appErrors.NewNotFoundError("地域", 5, nil))
+
+		err := NewMasterDataUsecase[models.Region](repo, options).
+			Update(ctx, &models.Region{BaseModel: models.BaseModel{ID: 5, Version: 1}, Name: "関東"})
+		requireAppError(t, err, appErrors.CodeNotFound)
+		repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestMasterDataUsecaseReorderAndDelete(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("所属先が存在する場合のみ並び替える", func(t *testing.T) {
+		repo := new(MockMasterDataRepository[models.Region])
+		expected := []models.Region{{Name: "近畿"}, {Name: "関東"}}
+
+		repo.On("OwnerName", ctx, uint(1)).Return("東京大学", nil)
+		repo.On("Reorder", ctx, uint(1), []uint{2, 1}).Return(expected, nil)
+		repo.On("OwnerName", ctx, uint(2)).Return("", appErrors.NewNotFoundError("大学", 2, nil))
+
+		usecase := NewMasterDataUsecase[models.Region](repo, new(MockFilterOptionRepository))
+
+		items, err := usecase.Reorder(ctx, 1, []uint{2, 1})
+		require.NoError(t, err)
+		assert.Equal(t, expected, items)
+
+		_, err = usecase.Reorder(ctx, 2, []uint{3})
+		requireAppError(t, err, appErrors.CodeNotFound)
+		repo.AssertNumberOfCalls(t, "Reorder", 1)
+	})
+
+	t.Run("IDが0の場合は削除しない", func(t *testing.T) {
+		repo := new(MockMasterDataRepository[models.Region])
+
+		err := NewMasterDataUsecase[models.Region](repo, new(MockFilterOptionRepository)).Delete(ctx, 0)
+		requireAppError(t, err, appErrors.CodeValidationError)
+		repo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,181 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/pkg/ical"
+	"university-exam-api/internal/repositories"
+)
+
+const (
+	// MaxCalendarMajors は1つのカレンダーにまとめられる学科の最大数です
+	MaxCalendarMajors = 20
+	// calendarProdID はカレンダーを作成した製品の識別子です
+	calendarProdID = "-//university-exam-api//schedule-events//JA"
+	// calendarUIDDomain は予定のUIDのドメイン部分です
+	calendarUIDDomain = "university-exam-api"
+	// defaultCalendarName は複数の学科をまとめたカレンダーの名前です
+	defaultCalendarName = "入試日程"
+)
+
+// ScheduleEventUsecase は入試日程の年度ごとの日程とカレンダー配信のユースケースインターフェースです
+type ScheduleEventUsecase interface {
+	List(ctx context.Context, scheduleID uint, year int) ([]models.ScheduleEvent, error)
+	Get(ctx context.Context, scheduleID, eventID uint) (*models.ScheduleEvent, error)
+	Create(ctx context.Context, event *models.ScheduleEvent) error
+	Update(ctx context.Context, event *models.ScheduleEvent) error
+	Delete(ctx context.Context, scheduleID, eventID uint) error
+	Calendar(ctx context.Context, majorIDs []uint, year int) (*ical.Calendar, error)
+}
+
+// scheduleEventUsecase はScheduleEventUsecaseの実装です
+type scheduleEventUsecase struct {
+	repo repositories.ScheduleEventRepository
+	now  func() time.Time
+}
+
+// NewScheduleEventUsecase は新しいScheduleEventUsecaseを作成します
+func NewScheduleEventUsecase(repo repositories.ScheduleEventRepository) ScheduleEventUsecase {
+	return &scheduleEventUsecase{repo: repo, now: time.Now}
+}
+
+// List は入試日程の日程を取得します（学年度に0を指定した場合は全ての年度）
+func (u *scheduleEventUsecase) List(ctx context.Context, scheduleID uint, year int) ([]models.ScheduleEvent, error) {
+	return u.repo.List(ctx, scheduleID, year)
+}
+
+// Get は入試日程に属する日程を取得します
+func (u *scheduleEventUsecase) Get(ctx context.Context, scheduleID, eventID uint) (*models.ScheduleEvent, error) {
+	return u.repo.Find(ctx, scheduleID, eventID)
+}
+
+// Create は入試日程の日程を検証して作成します
+func (u *scheduleEventUsecase) Create(ctx context.Context, event *models.ScheduleEvent) error {
+	// 作成時のバージョンは初期値として検証します
+	event.Version = 1
+
+	if err := validateScheduleEvent(event); err != nil {
+		return err
+	}
+
+	return u.repo.Create(ctx, event)
+}
+
+// Update は入試日程の日程を検証して、楽観的ロックにより更新します
+func (u *scheduleEventUsecase) Update(ctx context.Context, event *models.ScheduleEvent) error {
+	if err := validateScheduleEvent(event); err != nil {
+		return err
+	}
+
+	return u.repo.Update(ctx, event)
+}
+
+// Delete は入試日程に属する日程を削除します
+func (u *scheduleEventUsecase) Delete(ctx context.Context, scheduleID, eventID uint) error {
+	return u.repo.Delete(ctx, scheduleID, eventID)
+}
+
+// Calendar は指定された学科の日程をiCalendar形式のカレンダーとして生成します。
+// この関数は以下の処理を行います：
+// - 学科のIDの重複排除と件数の検証
+// - 閲覧可能な入試情報を持つ年度の日程の取得
+// - 日程ごとの終日の予定の生成（UIDは日程のID、改訂番号は日程のバージョンから算出）
+func (u *scheduleEventUsecase) Calendar(ctx context.Context, majorIDs []uint, year int) (*ical.Calendar, error) {
+	majorIDs = uniqueIDs(majorIDs)
+
+	if len(majorIDs) == 0 {
+		return nil, appErrors.NewValidationError("majors", "学科を1件以上指定してください", nil)
+	}
+
+	if len(majorIDs) > MaxCalendarMajors {
+		return nil, appErrors.NewValidationError("majors",
+			fmt.Sprintf("学科は%d件以下で指定してください", MaxCalendarMajors), nil)
+	}
+
+	events, err := u.repo.FindCalendarEvents(ctx, majorIDs, year)
+	if err != nil {
+		return nil, err
+	}
+
+	now := u.now()
+	calendar := &ical.Calendar{
+		ProdID: calendarProdID,
+		Name:   defaultCalendarName,
+		Events: make([]ical.Event, 0, len(events)),
+	}
+
+	if len(majorIDs) == 1 && len(events) > 0 {
+		calendar.Name = strings.Join([]string{
+			events[0].UniversityName, events[0].DepartmentName, events[0].MajorName, defaultCalendarName,
+		}, " ")
+	}
+
+	for _, e := range events {
+		calendar.Events = append(calendar.Events, calendarEvent(e, now))
+	}
+
+	return calendar, nil
+}
+
+// calendarEvent は日程をカレンダーの終日の予定に変換します
+func calendarEvent(e repositories.CalendarEvent, now time.Time) ical.Event {
+	label := models.ScheduleEventLabel(e.Type)
+
+	description := strconv.Itoa(e.AcademicYear) + "年度 " + label
+	if e.Note != "" {
+		description += "\n" + e.Note
+	}
+
+	return ical.Event{
+		UID:      fmt.Sprintf("schedule-event-%d@%s", e.ID, calendarUIDDomain),
+		Sequence: max(e.Version-1, 0),
+		Start:    e.StartDate.Time,
+		End:      e.LastDate().Time,
+		Summary: strings.Join([]string{
+			e.UniversityName, e.DepartmentName, e.MajorName, models.Schedules().Label(e.ScheduleName), label,
+		}, " "),
+		Description:  description,
+		Categories:   []string{label},
+		Stamp:        now,
+		LastModified: e.UpdatedAt,
+	}
+}
+
+// validateScheduleEvent は日程のモデルの検証エラーをバリデーションエラーに変換します
+func validateScheduleEvent(event *models.ScheduleEvent) error {
+	if err := event.Validate(); err != nil {
+		var validationErr *models.ValidationError
+		if errors.As(err, &validationErr) {
+			return appErrors.NewValidationError(validationErr.Field, validationErr.Message, map[string]string{
+				"code": validationErr.Code,
+			})
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// uniqueIDs は指定された順序を保ったまま重複するIDを除きます
+func uniqueIDs(ids []uint) []uint {
+	seen := make(map[uint]bool, len(ids))
+	result := make([]uint, 0, len(ids))
+
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+
+		seen[id] = true
+		result = append(result, id)
+	}
+
+	return result
+}
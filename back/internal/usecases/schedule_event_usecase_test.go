@@ -0,0 +1,186 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/repositories"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockScheduleEventRepository はScheduleEventRepositoryのモック実装です
+type MockScheduleEventRepository struct {
+	mock.Mock
+}
+
+// List は一覧取得のモック実装です
+func (m *MockScheduleEventRepository) List(ctx context.Context, scheduleID uint, year int) ([]models.ScheduleEvent, error) {
+	args := m.Called(ctx, scheduleID, year)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]models.ScheduleEvent), args.Error(1)
+}
+
+// Find は取得のモック実装です
+func (m *MockScheduleEventRepository) Find(ctx context.Context, scheduleID, eventID uint) (*models.ScheduleEvent, error) {
+	args := m.Called(ctx, scheduleID, eventID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*models.ScheduleEvent), args.Error(1)
+}
+
+// Create は作成のモック実装です
+func (m *MockScheduleEventRepository) Create(ctx context.Context, event *models.ScheduleEvent) error {
+	return m.Called(ctx, event).Error(0)
+}
+
+// Update は更新のモック実装です
+func (m *MockScheduleEventRepository) Update(ctx context.Context, event *models.ScheduleEvent) error {
+	return m.Called(ctx, event).Error(0)
+}
+
+// Delete は削除のモック実装です
+func (m *MockScheduleEventRepository) Delete(ctx context.Context, scheduleID, eventID uint) error {
+	return m.Called(ctx, scheduleID, eventID).Error(0)
+}
+
+// FindCalendarEvents はカレンダーの日程取得のモック実装です
+func (m *MockScheduleEventRepository) FindCalendarEvents(
+	ctx context.Context,
+	majorIDs []uint,
+	year int,
+) ([]repositories.CalendarEvent, error) {
+	args := m.Called(ctx, majorIDs, year)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]repositories.CalendarEvent), args.Error(1)
+}
+
+func TestScheduleEventUsecaseCreate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("初期バージョンで検証して作成する", func(t *testing.T) {
+		repo := new(MockScheduleEventRepository)
+		event := &models.ScheduleEvent{
+			AdmissionScheduleID: 1,
+			AcademicYear:        2026,
+			Type:                models.ScheduleEventExam,
+			StartDate:           models.NewDate(2026, time.February, 25),
+		}
+		repo.On("Create", ctx, event).Return(nil)
+
+		require.NoError(t, NewScheduleEventUsecase(repo).Create(ctx, event))
+		assert.Equal(t, 1, event.Version)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("終了日が開始日より前の場合は作成しない", func(t *testing.T) {
+		repo := new(MockScheduleEventRepository)
+		end := models.NewDate(2026, time.January, 20)
+		event := &models.ScheduleEvent{
+			AdmissionScheduleID: 1,
+			AcademicYear:        2026,
+			Type:                models.ScheduleEventApplication,
+			StartDate:           models.NewDate(2026, time.January, 26),
+			EndDate:             &end,
+		}
+
+		appErr := requireAppError(t, NewScheduleEventUsecase(repo).Create(ctx, event), appErrors.CodeValidationError)
+		assert.Equal(t, "EndDate", appErr.Details.Field)
+		assert.Equal(t, "INVALID_END_DATE", appErr.Details.Extra["code"])
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestScheduleEventUsecaseCalendar(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2025, 11, 20, 3, 0, 0, 0, time.UTC)
+	end := models.NewDate(2026, time.February, 4)
+
+	application := repositories.CalendarEvent{
+		ScheduleEvent: models.ScheduleEvent{
+			BaseModel:    models.BaseModel{ID: 7, Version: 3, UpdatedAt: updatedAt},
+			AcademicYear: 2026,
+			Type:         models.ScheduleEventApplication,
+			StartDate:    models.NewDate(2026, time.January, 26),
+			EndDate:      &end,
+			Note:         "郵送のみ",
+		},
+		UniversityName: "東京大学",
+		DepartmentName: "工学部",
+		MajorName:      "機械工学科",
+		ScheduleName:   "前",
+	}
+
+	t.Run("日程を終日の予定に変換する", func(t *testing.T) {
+		repo := new(MockScheduleEventRepository)
+		repo.On("FindCalendarEvents", ctx, []uint{3}, 2026).Return([]repositories.CalendarEvent{application}, nil)
+
+		usecase := &scheduleEventUsecase{repo: repo, now: func() time.Time { return now }}
+
+		calendar, err := usecase.Calendar(ctx, []uint{3, 3}, 2026)
+		require.NoError(t, err)
+		assert.Equal(t, "東京大学 工学部 機械工学科 入試日程", calendar.Name)
+		require.Len(t, calendar.Events, 1)
+
+		event := calendar.Events[0]
+		assert.Equal(t, "schedule-event-7@university-exam-api", event.UID)
+		assert.Equal(t, 2, event.Sequence)
+		assert.Equal(t, "東京大学 工学部 機械工学科 前期 出願期間", event.Summary)
+		assert.Equal(t, "2026年度 出願期間\n郵送のみ", event.Description)
+		assert.Equal(t, end.Time, event.End)
+		assert.Equal(t, now, event.Stamp)
+		assert.Equal(t, updatedAt, event.LastModified)
+	})
+
+	t.Run("私立の方式は日程名をそのまま表示する", func(t *testing.T) {
+		private := application
+		private.ScheduleName = "全学部日程"
+
+		repo := new(MockScheduleEventRepository)
+		repo.On("FindCalendarEvents", ctx, []uint{5}, 2026).Return([]repositories.CalendarEvent{private}, nil)
+
+		calendar, err := NewScheduleEventUsecase(repo).Calendar(ctx, []uint{5}, 2026)
+		require.NoError(t, err)
+		require.Len(t, calendar.Events, 1)
+		assert.Equal(t, "東京大学 工学部 機械工学科 全学部日程 出願期間", calendar.Events[0].Summary)
+	})
+
+	t.Run("複数の学科をまとめたカレンダー", func(t *testing.T) {
+		repo := new(MockScheduleEventRepository)
+		repo.On("FindCalendarEvents", ctx, []uint{3, 4}, 0).Return([]repositories.CalendarEvent{application}, nil)
+
+		calendar, err := NewScheduleEventUsecase(repo).Calendar(ctx, []uint{3, 4}, 0)
+		require.NoError(t, err)
+		assert.Equal(t, "入試日程", calendar.Name)
+	})
+
+	t.Run("学科の件数を検証する", func(t *testing.T) {
+		repo := new(MockScheduleEventRepository)
+		usecase := NewScheduleEventUsecase(repo)
+
+		_, err := usecase.Calendar(ctx, nil, 0)
+		requireAppError(t, err, appErrors.CodeValidationError)
+
+		tooMany := make([]uint, MaxCalendarMajors+1)
+		for i := range tooMany {
+			tooMany[i] = uint(i + 1)
+		}
+
+		_, err = usecase.Calendar(ctx, tooMany, 0)
+		requireAppError(t, err, appErrors.CodeValidationError)
+		repo.AssertNotCalled(t, "FindCalendarEvents", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,184 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/repositories"
+)
+
+const (
+	// DefaultSimulationLimit は得点シミュレーションのデフォルトの取得件数です
+	DefaultSimulationLimit = 20
+	// MaxSimulationLimit は得点シミュレーションの最大取得件数です
+	MaxSimulationLimit = 100
+	// MaxSimulationMajors は得点シミュレーションで指定できる学科数の上限です
+	MaxSimulationMajors = 100
+	// maxSimulationScores は得点シミュレーションで入力できる科目数の上限です
+	maxSimulationScores = 50
+	// maxSimulationFullScore は入力できる得点の満点の上限です
+	maxSimulationFullScore = 1000
+)
+
+// ScoreSimulationUsecase は得点シミュレーションのユースケースインターフェースです
+type ScoreSimulationUsecase interface {
+	SimulateScores(
+		ctx context.Context,
+		criteria repositories.SimulationCriteria,
+	) ([]repositories.SimulationResult, error)
+}
+
+// scoreSimulationUsecase はScoreSimulationUsecaseの実装です
+type scoreSimulationUsecase struct {
+	repo repositories.ScoreSimulationRepository
+}
+
+// NewScoreSimulationUsecase は新しいScoreSimulationUsecaseを作成します
+func NewScoreSimulationUsecase(repo repositories.ScoreSimulationRepository) ScoreSimulationUsecase {
+	return &scoreSimulationUsecase{repo: repo}
+}
+
+// SimulateScores は条件を検証・正規化した上で得点シミュレーションを実行します
+// 学科IDまたはファセット条件のいずれかの指定が必要です
+func (u *scoreSimulationUsecase) SimulateScores(
+	ctx context.Context,
+	criteria repositories.SimulationCriteria,
+) ([]repositories.SimulationResult, error) {
+	facets, err := normalizeFacetCriteria(criteria.FacetSearchCriteria)
+	if err != nil {
+		return nil, err
+	}
+
+	majorIDs, err := normalizeMajorIDs(criteria.MajorIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(majorIDs) == 0 && facets.IsEmpty() {
+		return nil, appErrors.NewInvalidInputError("major_ids", "学科IDまたは絞り込み条件のいずれかを指定してください", nil)
+	}
+
+	scores, err := normalizeSubjectScores(criteria.Scores)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := criteria.Limit
+	if limit == 0 {
+		limit = DefaultSimulationLimit
+	}
+
+	if limit < 1 || limit > MaxSimulationLimit {
+		return nil, appErrors.NewInvalidInputError(
+			"limit",
+			fmt.Sprintf("取得件数は1から%dの間である必要があります", MaxSimulationLimit),
+			nil,
+		)
+	}
+
+	return u.repo.SimulateScores(ctx, repositories.SimulationCriteria{
+		FacetSearchCriteria: facets,
+		MajorIDs:            majorIDs,
+		Scores:              scores,
+		AcademicYear:        criteria.AcademicYear,
+		Limit:               limit,
+	})
+}
+
+// normalizeMajorIDs は学科IDの重複排除と検証を行います
+func normalizeMajorIDs(ids []uint) ([]uint, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	seen := make(map[uint]bool, len(ids))
+	normalized := make([]uint, 0, len(ids))
+
+	for _, id := range ids {
+		if id == 0 {
+			return nil, appErrors.NewInvalidInputError("major_ids", "学科IDは1以上である必要があります", nil)
+		}
+
+		if seen[id] {
+			continue
+		}
+
+		seen[id] = true
+		normalized = append(normalized, id)
+	}
+
+	if len(normalized) > MaxSimulationMajors {
+		return nil, appErrors.NewInvalidInputError(
+			"major_ids",
+			fmt.Sprintf("学科IDは%d件以下である必要があります", MaxSimulationMajors),
+			nil,
+		)
+	}
+
+	return normalized, nil
+}
+
+// normalizeSubjectScores は入力した得点の空白除去と検証を行います
+// 試験種別は試験種別レジストリで配点比率の分母に含める試験種別のみ、得点は0以上満点以下である必要があります
+func normalizeSubjectScores(scores []repositories.SubjectScore) ([]repositories.SubjectScore, error) {
+	if len(scores) == 0 {
+		return nil, appErrors.NewInvalidInputError("scores", "得点は必須です", nil)
+	}
+
+	if len(scores) > maxSimulationScores {
+		return nil, appErrors.NewInvalidInputError(
+			"scores",
+			fmt.Sprintf("得点は%d科目以下である必要があります", maxSimulationScores),
+			nil,
+		)
+	}
+
+	normalized := make([]repositories.SubjectScore, 0, len(scores))
+	seen := make(map[string]bool, len(scores))
+
+	for _, s := range scores {
+		s.TestType = strings.TrimSpace(s.TestType)
+		s.Subject = strings.TrimSpace(s.Subject)
+
+		if !models.TestTypes().CountsTowardTotal(s.TestType) {
+			return nil, appErrors.NewInvalidInputError(
+				"scores",
+				fmt.Sprintf("試験種別は%sのいずれかである必要があります", strings.Join(models.TestTypes().CountedNames(), "、")),
+				nil,
+			)
+		}
+
+		if s.Subject == "" {
+			return nil, appErrors.NewInvalidInputError("scores", "科目名は必須です", nil)
+		}
+
+		key := s.TestType + "/" + s.Subject
+		if seen[key] {
+			return nil, appErrors.NewInvalidInputError(
+				"scores",
+				fmt.Sprintf("%sの%sの得点が重複しています", s.TestType, s.Subject),
+				nil,
+			)
+		}
+
+		seen[key] = true
+
+		if s.MaxScore <= 0 || s.MaxScore > maxSimulationFullScore {
+			return nil, appErrors.NewInvalidInputError(
+				"scores",
+				fmt.Sprintf("満点は0より大きく%d以下である必要があります", maxSimulationFullScore),
+				nil,
+			)
+		}
+
+		if s.Score < 0 || s.Score > s.MaxScore {
+			return nil, appErrors.NewInvalidInputError("scores", "得点は0以上満点以下である必要があります", nil)
+		}
+
+		normalized = append(normalized, s)
+	}
+
+	return normalized, nil
+}
@@ -0,0 +1,151 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	appErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/repositories"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockScoreSimulationRepository はScoreSimulationRepositoryのモック実装です
+type MockScoreSimulationRepository struct {
+	mock.Mock
+}
+
+// SimulateScores は得点シミュレーションのモック実装です
+func (m *MockScoreSimulationRepository) SimulateScores(
+	ctx context.Context,
+	criteria repositories.SimulationCriteria,
+) ([]repositories.SimulationResult, error) {
+	args := m.Called(ctx, criteria)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]repositories.SimulationResult), args.Error(1)
+}
+
+func TestScoreSimulationUsecaseSimulateScores(t *testing.T) {
+	english := repositories.SubjectScore{TestType: "共通", Subject: "英語", Score: 160, MaxScore: 200}
+
+	t.Run("条件の正規化とデフォルト件数", func(t *testing.T) {
+		mockRepo := new(MockScoreSimulationRepository)
+		expected := repositories.SimulationCriteria{
+			MajorIDs: []uint{3, 4},
+			Scores: []repositories.SubjectScore{
+				english,
+				{TestType: "二次", Subject: "数学", Score: 0, MaxScore: 150},
+			},
+			Limit: DefaultSimulationLimit,
+		}
+		mockRepo.On("SimulateScores", mock.Anything, expected).
+			Return([]repositories.SimulationResult{{Rank: 1, Percentage: 80}}, nil)
+
+		results, err := NewScoreSimulationUsecase(mockRepo).SimulateScores(context.Background(),
+			repositories.SimulationCriteria{
+				MajorIDs: []uint{3, 4, 3},
+				Scores: []repositories.SubjectScore{
+					{TestType: " 共通", Subject: "英語 ", Score: 160, MaxScore: 200},
+					{TestType: "二次", Subject: "数学", Score: 0, MaxScore: 150},
+				},
+			})
+
+		require.NoError(t, err)
+		assert.Len(t, results, 1)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("ファセット条件のみで絞り込み", func(t *testing.T) {
+		mockRepo := new(MockScoreSimulationRepository)
+		mockRepo.On("SimulateScores", mock.Anything, repositories.SimulationCriteria{
+			FacetSearchCriteria: repositories.FacetSearchCriteria{Regions: []string{"関東"}},
+			Scores:              []repositories.SubjectScore{english},
+			Limit:               5,
+		}).Return([]repositories.SimulationResult{}, nil)
+
+		_, err := NewScoreSimulationUsecase(mockRepo).SimulateScores(context.Background(),
+			repositories.SimulationCriteria{
+				FacetSearchCriteria: repositories.FacetSearchCriteria{Regions: []string{" 関東 "}},
+				Scores:              []repositories.SubjectScore{english},
+				Limit:               5,
+			})
+
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	invalid := []struct {
+		name     string
+		criteria repositories.SimulationCriteria
+	}{
+		{
+			name:     "学科IDも絞り込み条件もない",
+			criteria: repositories.SimulationCriteria{Scores: []repositories.SubjectScore{english}},
+		},
+		{
+			name:     "得点なし",
+			criteria: repositories.SimulationCriteria{MajorIDs: []uint{1}},
+		},
+		{
+			name: "不正な学科ID",
+			criteria: repositories.SimulationCriteria{
+				MajorIDs: []uint{0},
+				Scores:   []repositories.SubjectScore{english},
+			},
+		},
+		{
+			name: "配点比率の分母に含めない試験種別",
+			criteria: repositories.SimulationCriteria{
+				MajorIDs: []uint{1},
+				Scores:   []repositories.SubjectScore{{TestType: "英語外部試験", Subject: "英検", Score: 1, MaxScore: 1}},
+			},
+		},
+		{
+			name: "科目の重複",
+			criteria: repositories.SimulationCriteria{
+				MajorIDs: []uint{1},
+				Scores:   []repositories.SubjectScore{english, english},
+			},
+		},
+		{
+			name: "満点なし",
+			criteria: repositories.SimulationCriteria{
+				MajorIDs: []uint{1},
+				Scores:   []repositories.SubjectScore{{TestType: "共通", Subject: "英語", Score: 0}},
+			},
+		},
+		{
+			name: "満点を超える得点",
+			criteria: repositories.SimulationCriteria{
+				MajorIDs: []uint{1},
+				Scores:   []repositories.SubjectScore{{TestType: "共通", Subject: "英語", Score: 201, MaxScore: 200}},
+			},
+		},
+		{
+			name: "範囲外の取得件数",
+			criteria: repositories.SimulationCriteria{
+				MajorIDs: []uint{1},
+				Scores:   []repositories.SubjectScore{english},
+				Limit:    MaxSimulationLimit + 1,
+			},
+		},
+	}
+
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockScoreSimulationRepository)
+
+			_, err := NewScoreSimulationUsecase(mockRepo).SimulateScores(context.Background(), tt.criteria)
+
+			var appErr *appErrors.Error
+			require.ErrorAs(t, err, &appErr)
+			assert.Equal(t, appErrors.CodeInvalidInput, appErr.Code)
+			mockRepo.AssertNotCalled(t, "SimulateScores", mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/repositories"
+)
+
+const (
+	// DefaultSimilarityLimit は類似検索のデフォルトの取得件数です
+	DefaultSimilarityLimit = 10
+	// MaxSimilarityLimit は類似検索の最大取得件数です
+	MaxSimilarityLimit = 100
+	// maxTargetWeights は目標の配点比率に指定できる科目数の上限です
+	maxTargetWeights = 30
+	// percentageTolerance は配点比率の合計の許容誤差です
+	percentageTolerance = 0.01
+)
+
+// SimilarityUsecase は配点比率の類似検索のユースケースインターフェースです
+type SimilarityUsecase interface {
+	FindSimilarMajors(
+		ctx context.Context,
+		criteria repositories.SimilarityCriteria,
+	) ([]repositories.SimilarMajor, error)
+}
+
+// similarityUsecase はSimilarityUsecaseの実装です
+type similarityUsecase struct {
+	repo repositories.SimilarityRepository
+}
+
+// NewSimilarityUsecase は新しいSimilarityUsecaseを作成します
+func NewSimilarityUsecase(repo repositories.SimilarityRepository) SimilarityUsecase {
+	return &similarityUsecase{repo: repo}
+}
+
+// FindSimilarMajors は検索条件を検証・正規化した上で類似検索を実行します
+func (u *similarityUsecase) FindSimilarMajors(
+	ctx context.Context,
+	criteria repositories.SimilarityCriteria,
+) ([]repositories.SimilarMajor, error) {
+	facets, err := normalizeFacetCriteria(criteria.FacetSearchCriteria)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := normalizeTargetWeights(criteria.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := criteria.Limit
+	if limit == 0 {
+		limit = DefaultSimilarityLimit
+	}
+
+	if limit < 1 || limit > MaxSimilarityLimit {
+		return nil, appErrors.NewInvalidInputError(
+			"limit",
+			fmt.Sprintf("取得件数は1から%dの間である必要があります", MaxSimilarityLimit),
+			nil,
+		)
+	}
+
+	return u.repo.FindSimilarMajors(ctx, repositories.SimilarityCriteria{
+		FacetSearchCriteria: facets,
+		Target:              target,
+		AcademicYear:        criteria.AcademicYear,
+		Limit:               limit,
+	})
+}
+
+// normalizeTargetWeights は目標の配点比率の空白除去と検証を行います
+// 試験種別は試験種別レジストリで配点比率の分母に含める試験種別のみ、配点比率は0から100の範囲で合計100以下である必要があります
+func normalizeTargetWeights(weights []repositories.SubjectWeight) ([]repositories.SubjectWeight, error) {
+	if len(weights) == 0 {
+		return nil, appErrors.NewInvalidInputError("weight", "目標の配点比率は必須です", nil)
+	}
+
+	if len(weights) > maxTargetWeights {
+		return nil, appErrors.NewInvalidInputError(
+			"weight",
+			fmt.Sprintf("目標の配点比率は%d件以下である必要があります", maxTargetWeights),
+			nil,
+		)
+	}
+
+	normalized := make([]repositories.SubjectWeight, 0, len(weights))
+
+	var total float64
+
+	for _, w := range weights {
+		w.TestType = strings.TrimSpace(w.TestType)
+		w.Subject = strings.TrimSpace(w.Subject)
+
+		if !models.TestTypes().CountsTowardTotal(w.TestType) {
+			return nil, appErrors.NewInvalidInputError(
+				"weight",
+				fmt.Sprintf("試験種別は%sのいずれかである必要があります", strings.Join(models.TestTypes().CountedNames(), "、")),
+				nil,
+			)
+		}
+
+		if w.Subject == "" {
+			return nil, appErrors.NewInvalidInputError("weight", "科目名は必須です", nil)
+		}
+
+		if math.IsNaN(w.Percentage) || w.Percentage < 0 || w.Percentage > 100 {
+			return nil, appErrors.NewInvalidInputError("weight", "配点比率は0以上100以下である必要があります", nil)
+		}
+
+		total += w.Percentage
+
+		normalized = append(normalized, w)
+	}
+
+	if total > 100+percentageTolerance {
+		return nil, appErrors.NewInvalidInputError("weight", "配点比率の合計は100以下である必要があります", nil)
+	}
+
+	return normalized, nil
+}
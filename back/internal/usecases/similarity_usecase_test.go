@@ -0,0 +1,124 @@
+package usecases
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	appErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/repositories"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockSimilarityRepository はSimilarityRepositoryのモック実装です
+type MockSimilarityRepository struct {
+	mock.Mock
+}
+
+// FindSimilarMajors は類似検索のモック実装です
+func (m *MockSimilarityRepository) FindSimilarMajors(
+	ctx context.Context,
+	criteria repositories.SimilarityCriteria,
+) ([]repositories.SimilarMajor, error) {
+	args := m.Called(ctx, criteria)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]repositories.SimilarMajor), args.Error(1)
+}
+
+func TestSimilarityUsecaseFindSimilarMajors(t *testing.T) {
+	t.Run("条件の正規化とデフォルト件数", func(t *testing.T) {
+		mockRepo := new(MockSimilarityRepository)
+		expected := repositories.SimilarityCriteria{
+			FacetSearchCriteria: repositories.FacetSearchCriteria{Regions: []string{"関東"}},
+			Target: []repositories.SubjectWeight{
+				{TestType: "共通", Subject: "英語", Percentage: 40},
+				{TestType: "二次", Subject: "数学", Percentage: 60},
+			},
+			Limit: DefaultSimilarityLimit,
+		}
+		mockRepo.On("FindSimilarMajors", mock.Anything, expected).
+			Return([]repositories.SimilarMajor{{Distance: 1.5}}, nil)
+
+		results, err := NewSimilarityUsecase(mockRepo).FindSimilarMajors(context.Background(),
+			repositories.SimilarityCriteria{
+				FacetSearchCriteria: repositories.FacetSearchCriteria{Regions: []string{" 関東 ", "関東"}},
+				Target: []repositories.SubjectWeight{
+					{TestType: " 共通", Subject: "英語 ", Percentage: 40},
+					{TestType: "二次", Subject: "数学", Percentage: 60},
+				},
+			})
+
+		require.NoError(t, err)
+		assert.Len(t, results, 1)
+		mockRepo.AssertExpectations(t)
+	})
+
+	invalid := []struct {
+		name     string
+		criteria repositories.SimilarityCriteria
+	}{
+		{
+			name:     "目標の配点比率なし",
+			criteria: repositories.SimilarityCriteria{},
+		},
+		{
+			name: "不正な試験種別",
+			criteria: repositories.SimilarityCriteria{
+				Target: []repositories.SubjectWeight{{TestType: "一次", Subject: "英語", Percentage: 50}},
+			},
+		},
+		{
+			name: "配点比率の分母に含めない試験種別",
+			criteria: repositories.SimilarityCriteria{
+				Target: []repositories.SubjectWeight{{TestType: "英語外部試験", Subject: "英検", Percentage: 50}},
+			},
+		},
+		{
+			name: "範囲外の配点比率",
+			criteria: repositories.SimilarityCriteria{
+				Target: []repositories.SubjectWeight{{TestType: "共通", Subject: "英語", Percentage: -1}},
+			},
+		},
+		{
+			name: "数値でない配点比率",
+			criteria: repositories.SimilarityCriteria{
+				Target: []repositories.SubjectWeight{{TestType: "共通", Subject: "英語", Percentage: math.NaN()}},
+			},
+		},
+		{
+			name: "合計が100を超える",
+			criteria: repositories.SimilarityCriteria{
+				Target: []repositories.SubjectWeight{
+					{TestType: "共通", Subject: "英語", Percentage: 60},
+					{TestType: "二次", Subject: "数学", Percentage: 50},
+				},
+			},
+		},
+		{
+			name: "範囲外の取得件数",
+			criteria: repositories.SimilarityCriteria{
+				Target: []repositories.SubjectWeight{{TestType: "共通", Subject: "英語", Percentage: 50}},
+				Limit:  MaxSimilarityLimit + 1,
+			},
+		},
+	}
+
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockSimilarityRepository)
+
+			_, err := NewSimilarityUsecase(mockRepo).FindSimilarMajors(context.Background(), tt.criteria)
+
+			var appErr *appErrors.Error
+			require.ErrorAs(t, err, &appErr)
+			assert.Equal(t, appErrors.CodeInvalidInput, appErr.Code)
+			mockRepo.AssertNotCalled(t, "FindSimilarMajors", mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package usecases
+
+import (
+	"context"
+	"time"
+	appErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/pkg/pagination"
+	"university-exam-api/internal/repositories"
+)
+
+// TrashUsecase はゴミ箱（ソフトデリートした要素）の管理のユースケースインターフェースです
+type TrashUsecase interface {
+	List(ctx context.Context, entityType string, params pagination.Params) (*repositories.TrashPage, error)
+	Restore(ctx context.Context, entityType string, id uint) error
+	Purge(ctx context.Context, entityType string, id uint) error
+	PurgeExpired(ctx context.Context, retention time.Duration) (int64, error)
+}
+
+// trashUsecase はTrashUsecaseの実装です
+type trashUsecase struct {
+	repo repositories.ITrashManager
+	now  func() time.Time
+}
+
+// NewTrashUsecase は新しいTrashUsecaseを作成します
+func NewTrashUsecase(repo repositories.ITrashManager) TrashUsecase {
+	return &trashUsecase{repo: repo, now: time.Now}
+}
+
+// List はゴミ箱の要素を削除日時の新しい順に取得します。
+// この関数は以下の処理を行います：
+// - エンティティ種別の検証
+// - ゴミ箱の要素の取得
+func (u *trashUsecase) List(
+	ctx context.Context,
+	entityType string,
+	params pagination.Params,
+) (*repositories.TrashPage, error) {
+	if err := validateEntityType(entityType); err != nil {
+		return nil, err
+	}
+
+	return u.repo.FindTrash(ctx, entityType, params)
+}
+
+// Restore はゴミ箱の要素を、一緒に削除された配下の要素とともに復元します。
+// この関数は以下の処理を行います：
+// - エンティティ種別とIDの検証
+// - 配下の要素を含む復元
+func (u *trashUsecase) Restore(ctx context.Context, entityType string, id uint) error {
+	if err := validateEntity(entityType, id); err != nil {
+		return err
+	}
+
+	return u.repo.RestoreFromTrash(ctx, entityType, id)
+}
+
+// Purge はゴミ箱の要素を配下の要素とともに完全削除します。
+// この関数は以下の処理を行います：
+// - エンティティ種別とIDの検証
+// - 配下の要素を含む完全削除
+func (u *trashUsecase) Purge(ctx context.Context, entityType string, id uint) error {
+	if err := validateEntity(entityType, id); err != nil {
+		return err
+	}
+
+	return u.repo.PurgeFromTrash(ctx, entityType, id)
+}
+
+// PurgeExpired は保持期間を過ぎた要素を完全削除し、削除した件数を返します。
+// この関数は以下の処理を行います：
+// - 保持期間の検証
+// - 現在時刻から保持期間を引いた日時より前に削除された要素の完全削除
+func (u *trashUsecase) PurgeExpired(ctx context.Context, retention time.Duration) (int64, error) {
+	if retention <= 0 {
+		return 0, appErrors.NewInvalidInputError("retention", "保持期間は0より大きい値で指定してください", nil)
+	}
+
+	return u.repo.PurgeTrashBefore(ctx, u.now().Add(-retention))
+}
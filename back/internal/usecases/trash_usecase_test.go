@@ -0,0 +1,134 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+	"university-exam-api/internal/domain/models"
+	appErrors "university-exam-api/internal/errors"
+	"university-exam-api/internal/pkg/pagination"
+	"university-exam-api/internal/repositories"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockTrashManager はITrashManagerのモック実装です
+type MockTrashManager struct {
+	mock.Mock
+}
+
+// FindTrash はゴミ箱の一覧取得のモック実装です
+func (m *MockTrashManager) FindTrash(
+	ctx context.Context,
+	entityType string,
+	params pagination.Params,
+) (*repositories.TrashPage, error) {
+	args := m.Called(ctx, entityType, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*repositories.TrashPage), args.Error(1)
+}
+
+// RestoreFromTrash は復元のモック実装です
+func (m *MockTrashManager) RestoreFromTrash(ctx context.Context, entityType string, id uint) error {
+	return m.Called(ctx, entityType, id).Error(0)
+}
+
+// PurgeFromTrash は完全削除のモック実装です
+func (m *MockTrashManager) PurgeFromTrash(ctx context.Context, entityType string, id uint) error {
+	return m.Called(ctx, entityType, id).Error(0)
+}
+
+// PurgeTrashBefore は一括完全削除のモック実装です
+func (m *MockTrashManager) PurgeTrashBefore(ctx context.Context, before time.Time) (int64, error) {
+	args := m.Called(ctx, before)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func TestTrashUsecaseList(t *testing.T) {
+	ctx := context.Background()
+	params := pagination.DefaultParams()
+
+	t.Run("正常系", func(t *testing.T) {
+		mockRepo := new(MockTrashManager)
+		expected := &repositories.TrashPage{
+			Items: []models.TrashItem{{EntityType: models.AuditEntityMajor, ID: 3}},
+		}
+		mockRepo.On("FindTrash", ctx, models.AuditEntityMajor, params).Return(expected, nil)
+
+		page, err := NewTrashUsecase(mockRepo).List(ctx, models.AuditEntityMajor, params)
+		require.NoError(t, err)
+		assert.Equal(t, expected, page)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("不正なエンティティ種別", func(t *testing.T) {
+		mockRepo := new(MockTrashManager)
+
+		_, err := NewTrashUsecase(mockRepo).List(ctx, "region", params)
+		requireAppError(t, err, appErrors.CodeInvalidInput)
+		mockRepo.AssertNotCalled(t, "FindTrash", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestTrashUsecaseRestoreAndPurge(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("復元", func(t *testing.T) {
+		mockRepo := new(MockTrashManager)
+		mockRepo.On("RestoreFromTrash", ctx, models.AuditEntityUniversity, uint(1)).Return(nil)
+
+		require.NoError(t, NewTrashUsecase(mockRepo).Restore(ctx, models.AuditEntityUniversity, 1))
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("完全削除", func(t *testing.T) {
+		mockRepo := new(MockTrashManager)
+		mockRepo.On("PurgeFromTrash", ctx, models.AuditEntitySubject, uint(2)).
+			Return(appErrors.NewNotFoundError("ゴミ箱の科目", 2, nil))
+
+		err := NewTrashUsecase(mockRepo).Purge(ctx, models.AuditEntitySubject, 2)
+		requireAppError(t, err, appErrors.CodeNotFound)
+	})
+
+	t.Run("IDが0の場合は呼び出さない", func(t *testing.T) {
+		mockRepo := new(MockTrashManager)
+
+		err := NewTrashUsecase(mockRepo).Restore(ctx, models.AuditEntityUniversity, 0)
+		requireAppError(t, err, appErrors.CodeInvalidInput)
+
+		err = NewTrashUsecase(mockRepo).Purge(ctx, "unknown", 1)
+		requireAppError(t, err, appErrors.CodeInvalidInput)
+		mockRepo.AssertNotCalled(t, "RestoreFromTrash", mock.Anything, mock.Anything, mock.Anything)
+		mockRepo.AssertNotCalled(t, "PurgeFromTrash", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestTrashUsecasePurgeExpired(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("保持期間を過ぎた要素を完全削除", func(t *testing.T) {
+		mockRepo := new(MockTrashManager)
+		mockRepo.On("PurgeTrashBefore", ctx, now.Add(-30*24*time.Hour)).Return(int64(5), nil)
+
+		usecase := &trashUsecase{repo: mockRepo, now: func() time.Time { return now }}
+
+		purged, err := usecase.PurgeExpired(ctx, 30*24*time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), purged)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("保持期間が0以下", func(t *testing.T) {
+		mockRepo := new(MockTrashManager)
+
+		_, err := NewTrashUsecase(mockRepo).PurgeExpired(ctx, 0)
+		requireAppError(t, err, appErrors.CodeInvalidInput)
+		mockRepo.AssertNotCalled(t, "PurgeTrashBefore", mock.Anything, mock.Anything)
+	})
+}
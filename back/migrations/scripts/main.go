@@ -1,24 +1,47 @@
 // Package main はデータベースのマイグレーションスクリプトを提供します。
 // このスクリプトは以下の機能を提供します：
-// - データベースのテーブル再作成
-// - スキーマの適用
+// - バージョン管理されたマイグレーションの適用・取り消し
+// - schema_migrations テーブルによる適用状況の表示
+// - 新しいSQLマイグレーションファイルの作成
 // - 環境変数の検証
-// - エラーハンドリング
+//
+// 使い方:
+//
+//	go run migrations/scripts/main.go up          # 未適用のマイグレーションを全て適用
+//	go run migrations/scripts/main.go down [n]    # 最新の n 件（既定は1件）を取り消し
+//	go run migrations/scripts/main.go to <N>      # バージョン N の状態まで適用または取り消し（0で全て取り消し）
+//	go run migrations/scripts/main.go status      # 適用状況を表示
+//	go run migrations/scripts/main.go create <name>  # SQLマイグレーションファイルを作成
 package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"text/tabwriter"
 	"time"
-	"university-exam-api/internal/domain/models"
 	"university-exam-api/internal/infrastructure/database"
+	"university-exam-api/internal/infrastructure/migration"
 
 	"github.com/joho/godotenv"
 	"gorm.io/gorm"
 )
 
+const (
+	// migrationTimeout はマイグレーション全体のタイムアウト時間です
+	migrationTimeout = 5 * time.Minute
+	// usage はコマンドの使い方です
+	usage = "使い方: main.go up | down [n] | to <version> | status | create <name>"
+)
+
+// migrationNamePattern は作成するマイグレーション名の形式です
+var migrationNamePattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+
 // validateEnv は必要な環境変数が設定されているか確認します
 // この関数は以下の処理を行います：
 // - 必須環境変数の検証
@@ -41,18 +64,6 @@ func validateEnv() error {
 	return nil
 }
 
-// handleMigrationError はマイグレーションエラーを処理します
-// この関数は以下の処理を行います：
-// - トランザクションのロールバック
-// - エラーログの出力
-func handleMigrationError(_ context.Context, tx *gorm.DB, err error, message string) {
-	if err := tx.Rollback().Error; err != nil {
-		log.Printf("警告: ロールバックに失敗しました: %v", err)
-	}
-
-	log.Fatalf("エラー: %s: %v", message, err)
-}
-
 // setupEnvironment は環境変数を設定します
 // この関数は以下の処理を行います：
 // - .envファイルの読み込み
@@ -126,49 +137,179 @@ func connectToDatabase() (*gorm.DB, func()) {
 	return db, cleanup
 }
 
-// migrateDatabase はデータベースのテーブルを削除し、新たに作成します
+// parseArg は位置引数を整数として解釈します。引数がない場合は defaultValue を返します
+func parseArg(args []string, index int, defaultValue int) (int, error) {
+	if len(args) <= index {
+		return defaultValue, nil
+	}
+
+	value, err := strconv.Atoi(args[index])
+	if err != nil {
+		return 0, fmt.Errorf("%s は整数で指定してください", args[index])
+	}
+
+	return value, nil
+}
+
+// runMigrations はサブコマンドに応じてマイグレーションを実行します
 // この関数は以下の処理を行います：
-// - トランザクションの開始
-// - テーブルの削除
-// - スキーマの適用
-// - トランザクションのコミット
-func migrateDatabase(ctx context.Context, db *gorm.DB) {
-	// テーブルの作成
-	if err := db.AutoMigrate(
-		&models.University{},           // 親テーブル
-		&models.Department{},           // 大学の子テーブル
-		&models.Major{},                // 学部の子テーブル
-		&models.AdmissionSchedule{},    // 学科の子テーブル
-		&models.AdmissionInfo{},        // 入試日程の子テーブル
-		&models.TestType{},             // 入試情報の子テーブル
-		&models.Subject{},              // 試験種別の子テーブル
-		&models.Region{},               // 大学の子テーブル（地域）
-		&models.Prefecture{},           // 地域の子テーブル（都道府県）
-		&models.Classification{},       // 大学の子テーブル（設置区分）
-		&models.SubClassification{},    // 設置区分の子テーブル（小分類）
-		&models.AcademicField{},        // 学科の子テーブル（学問系統）
-	); err != nil {
-		handleMigrationError(ctx, db, err, "テーブルの作成に失敗しました")
-		return
+// - up・down・to による適用・取り消し
+// - status による適用状況の表示
+func runMigrations(ctx context.Context, m *migration.Migrator, args []string) error {
+	var (
+		executed []migration.Migration
+		err      error
+	)
+
+	switch args[0] {
+	case "up":
+		executed, err = m.Up(ctx)
+	case "down":
+		steps, parseErr := parseArg(args, 1, 1)
+		if parseErr != nil {
+			return parseErr
+		}
+
+		executed, err = m.Down(ctx, steps)
+	case "to":
+		if len(args) < 2 {
+			return errors.New(usage)
+		}
+
+		version, parseErr := parseArg(args, 1, 0)
+		if parseErr != nil {
+			return parseErr
+		}
+
+		executed, err = m.To(ctx, version)
+	case "status":
+		return printStatus(ctx, m)
+	default:
+		return errors.New(usage)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	current, err := m.Current(ctx)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("情報: %d 件のマイグレーションを実行しました（現在のバージョン: %d）", len(executed), current)
+
+	return nil
+}
+
+// printStatus はマイグレーションの適用状況を表形式で出力します
+func printStatus(ctx context.Context, m *migration.Migrator) error {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tSTATE\tAPPLIED AT")
+
+	for _, s := range statuses {
+		appliedAt := "-"
+		if s.AppliedAt != nil {
+			appliedAt = s.AppliedAt.Format(time.RFC3339)
+		}
+
+		fmt.Fprintf(w, "%04d\t%s\t%s\t%s\n", s.Version, s.Name, s.State, appliedAt)
 	}
 
-	log.Println("情報: データベースのマイグレーションが正常に完了しました")
+	return w.Flush()
+}
+
+// createMigration は次のバージョン番号でSQLマイグレーションファイルの雛形を作成します
+// この関数は以下の処理を行います：
+// - マイグレーション名の検証
+// - 登録済みのバージョンから次の番号の決定
+// - up・down ファイルの作成
+func createMigration(name string) error {
+	if !migrationNamePattern.MatchString(name) {
+		return fmt.Errorf("マイグレーション名は英小文字・数字・アンダースコアで指定してください: %s", name)
+	}
+
+	next := 1
+
+	for _, dialect := range []string{"postgres", "sqlite"} {
+		migrations, err := database.Migrations(dialect)
+		if err != nil {
+			return err
+		}
+
+		for _, m := range migrations {
+			if m.Version >= next {
+				next = m.Version + 1
+			}
+		}
+	}
+
+	for _, direction := range []string{"up", "down"} {
+		path := filepath.Join(database.SchemaDir, fmt.Sprintf("%04d_%s.%s.sql", next, name, direction))
+
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if err != nil {
+			return fmt.Errorf("%s の作成に失敗しました: %w", path, err)
+		}
+
+		if _, err := fmt.Fprintf(f, "-- %04d_%s (%s)\n", next, name, direction); err != nil {
+			f.Close()
+			return fmt.Errorf("%s の書き込みに失敗しました: %w", path, err)
+		}
+
+		if err := f.Close(); err != nil {
+			return fmt.Errorf("%s の書き込みに失敗しました: %w", path, err)
+		}
+
+		log.Printf("情報: %s を作成しました", path)
+	}
+
+	return nil
 }
 
 func main() {
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	args := os.Args[1:]
+	if len(args) == 0 {
+		log.Fatal(usage)
+	}
 
-	// 環境変数の設定
-	if err := setupEnvironment(); err != nil {
-		log.Printf("環境変数の設定に失敗しました: %v", err)
-		cancel()
+	if args[0] == "create" {
+		if len(args) < 2 {
+			log.Fatal(usage)
+		}
+
+		if err := createMigration(args[1]); err != nil {
+			log.Fatalf("エラー: %v", err)
+		}
 
 		return
 	}
 
+	// 環境変数の設定
+	if err := setupEnvironment(); err != nil {
+		log.Fatalf("環境変数の設定に失敗しました: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), migrationTimeout)
+	defer cancel()
+
 	db, cleanup := connectToDatabase()
 	defer cleanup()
 
-	migrateDatabase(ctx, db)
+	m, err := database.NewSchemaMigrator(db)
+	if err == nil {
+		err = runMigrations(ctx, m, args)
+	}
+
+	if err != nil {
+		log.Printf("エラー: マイグレーションに失敗しました: %v", err)
+		cleanup()
+		cancel()
+		os.Exit(1)
+	}
 }
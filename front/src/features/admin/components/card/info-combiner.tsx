@@ -64,6 +64,8 @@ export const InfoCombiner = ({
       test_type_id: testType.id,
       name: subject.name,
       score: subject.score ?? 0,
+      conversion_ratio: subject.conversionRatio ?? 1,
+      effective_score: subject.effectiveScore ?? subject.score ?? 0,
       percentage: subject.percentage ?? 0,
       display_order: subject.displayOrder,
       created_at: subject.createdAt,
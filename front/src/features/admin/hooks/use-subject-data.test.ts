@@ -19,6 +19,8 @@ describe('useSubjectData', () => {
       test_type_id: 1,
       name: '数学',
       score: 100,
+      conversion_ratio: 1,
+      effective_score: 100,
       percentage: 50,
       display_order: 1,
       created_at: '2024-01-01T00:00:00Z',
@@ -33,6 +35,8 @@ describe('useSubjectData', () => {
       test_type_id: 1,
       name: '英語',
       score: 100,
+      conversion_ratio: 1,
+      effective_score: 100,
       percentage: 50,
       display_order: 2,
       created_at: '2024-01-01T00:00:00Z',
@@ -60,6 +64,21 @@ describe('useSubjectData', () => {
       expect(updatedSubjects[1].percentage).toBe(33.33);
     });
 
+    it('換算比率を反映した換算後の配点からパーセンテージが計算されること', () => {
+      const compressedSubjects = [
+        { ...mockSubjects[0], conversion_ratio: 0.5, effective_score: 50 },
+        mockSubjects[1],
+      ];
+
+      const updatedSubjects = hook.calculateUpdatedSubjects(compressedSubjects, 1, 200);
+
+      expect(updatedSubjects[0].score).toBe(200);
+      expect(updatedSubjects[0].effective_score).toBe(100);
+      expect(updatedSubjects[1].effective_score).toBe(100);
+      expect(updatedSubjects[0].percentage).toBe(50);
+      expect(updatedSubjects[1].percentage).toBe(50);
+    });
+
     it('空の科目データの場合、空の配列を返すこと', () => {
       const updatedSubjects = hook.calculateUpdatedSubjects([], 1, 200);
       expect(updatedSubjects).toHaveLength(0);
@@ -79,6 +98,8 @@ describe('useSubjectData', () => {
         test_type_id: 1,
         name: '新規科目',
         score: 0,
+        conversion_ratio: 1,
+        effective_score: 0,
         percentage: 0,
         display_order: 1,
         version: 1,
@@ -107,6 +128,8 @@ describe('useSubjectData', () => {
         test_type_id: 1,
         name: '数学',
         score: 100,
+        conversion_ratio: 1,
+        effective_score: 100,
         percentage: 50,
         display_order: 1,
         created_at: '2024-01-01T00:00:00Z',
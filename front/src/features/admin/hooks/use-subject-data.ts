@@ -1,11 +1,20 @@
 import { useCallback } from 'react';
 import type { APISubject } from '@/types/api/types';
 
+/**
+ * 科目の換算後の配点を取得する関数
+ * 換算後の配点が未設定の場合は素点を使用
+ * @param subject - 科目データ
+ * @returns 換算後の配点
+ */
+const effectiveScoreOf = (subject: APISubject): number => subject.effective_score ?? subject.score;
+
 /**
  * 科目データの操作と検証機能を提供するカスタムフック
  *
  * @remarks
  * - 科目データの計算、検証、ソート機能を提供
+ * - パーセンテージは換算後の配点（effective_score）から計算
  * - テストタイプの判定機能
  * - パフォーマンス最適化のためのuseCallback使用
  * - データの整合性チェック
@@ -24,19 +33,27 @@ export const useSubjectData = () => {
       const targetSubject = subjects.find(subject => subject.id === subjectId);
       if (!targetSubject) return subjects;
 
-      // 科目のスコアを更新
-      const updatedSubjects = subjects.map(subject => ({
-        ...subject,
-        score: subject.id === subjectId ? value : subject.score,
-      }));
+      // 科目のスコアと換算後の配点を更新
+      const updatedSubjects = subjects.map(subject => {
+        if (subject.id !== subjectId) return subject;
+        return {
+          ...subject,
+          score: value,
+          effective_score: value * (subject.conversion_ratio ?? 1),
+        };
+      });
 
-      // 合計スコアを計算
-      const totalScore = updatedSubjects.reduce((sum, subject) => sum + subject.score, 0);
+      // 換算後の配点の合計を計算
+      const totalScore = updatedSubjects.reduce(
+        (sum, subject) => sum + effectiveScoreOf(subject),
+        0
+      );
 
-      // パーセンテージを更新
+      // 換算後の配点からパーセンテージを更新
       return updatedSubjects.map(subject => ({
         ...subject,
-        percentage: totalScore > 0 ? Number(((subject.score / totalScore) * 100).toFixed(2)) : 0,
+        percentage:
+          totalScore > 0 ? Number(((effectiveScoreOf(subject) / totalScore) * 100).toFixed(2)) : 0,
       }));
     },
     []
@@ -49,6 +66,8 @@ export const useSubjectData = () => {
       test_type_id: testTypeId,
       name: '新規科目',
       score: 0,
+      conversion_ratio: 1,
+      effective_score: 0,
       percentage: 0,
       display_order: displayOrder,
       created_at: now,
@@ -160,7 +160,20 @@ describe('useUniversityData', () => {
     // モックの設定
     vi.mocked(global.fetch).mockResolvedValueOnce({
       ok: true,
-      json: () => Promise.resolve(mockUniversityData),
+      json: () =>
+        Promise.resolve({
+          success: true,
+          timestamp: 0,
+          data: {
+            universities: mockUniversityData,
+            total: mockUniversityData.length,
+            page: 1,
+            perPage: 100,
+            hasNext: false,
+            hasPrev: false,
+          },
+          links: {},
+        }),
     } as Response);
 
     const { result } = renderHook(() => useUniversityData());
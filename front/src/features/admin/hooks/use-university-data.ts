@@ -12,6 +12,7 @@ import {
   transformTestTypeToAPI,
 } from '@/features/admin/utils/api-transformers';
 import { API_ENDPOINTS } from '@/constants/api';
+import { fetchAllUniversities } from '@/utils/fetch-all-universities';
 
 /**
  * 大学データの取得と更新機能を提供するカスタムフック
@@ -56,18 +57,12 @@ export const useUniversityData = () => {
       setIsLoading(true);
       setError(null);
 
-      const response = await fetch(API_ENDPOINTS.UNIVERSITIES, {
+      const data = await fetchAllUniversities(API_ENDPOINTS.UNIVERSITIES, {
         headers: {
           'Cache-Control': 'no-cache',
           Pragma: 'no-cache',
         },
       });
-
-      if (!response.ok) {
-        throw new Error(`APIエラー: ${response.status} ${response.statusText}`);
-      }
-
-      const data = await response.json();
       console.log('APIレスポンス:', data);
 
       const transformedData = transformAPIResponse(data);
@@ -283,6 +283,8 @@ export function useUniversityEditor() {
         testTypeId: testType.id,
         name: '' as SubjectName,
         score: 0,
+        conversionRatio: 1,
+        effectiveScore: 0,
         percentage: 0,
         displayOrder: subjects.length + 1,
         version: 1,
@@ -106,6 +106,10 @@ export interface Subject extends BaseModel {
   name: SubjectName;
   /** 科目の得点 */
   score: number;
+  /** 換算比率（未設定の場合は1） */
+  conversionRatio?: number;
+  /** 換算後の配点（未設定の場合は得点と同じ） */
+  effectiveScore?: number;
   /** 科目の得点率（0-100%） */
   percentage: number;
   /** UI表示時の順序 */
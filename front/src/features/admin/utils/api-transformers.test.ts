@@ -134,6 +134,8 @@ describe('APIトランスフォーマー', () => {
         testTypeId: 1,
         name: '数学' as SubjectName,
         score: 100,
+        conversionRatio: 0.5,
+        effectiveScore: 50,
         percentage: 50,
         displayOrder: 1,
         createdAt: '2024-01-01T00:00:00Z',
@@ -150,6 +152,8 @@ describe('APIトランスフォーマー', () => {
         test_type_id: 1,
         name: '数学',
         score: 100,
+        conversion_ratio: 0.5,
+        effective_score: 50,
         percentage: 50,
         display_order: 1,
         created_at: '2024-01-01T00:00:00Z',
@@ -181,6 +185,26 @@ describe('APIトランスフォーマー', () => {
       expect(result.percentage).toBe(0);
       expect(result.display_order).toBe(0);
     });
+
+    it('換算比率が未設定の場合は素点をそのまま換算後の配点とすること', () => {
+      const mockSubject: Subject = {
+        id: 1,
+        testTypeId: 1,
+        name: '数学' as SubjectName,
+        score: 200,
+        percentage: 0,
+        displayOrder: 0,
+        createdAt: '2024-01-01T00:00:00Z',
+        updatedAt: '2024-01-01T00:00:00Z',
+        version: 1,
+        createdBy: 'system',
+        updatedBy: 'system',
+      };
+
+      const result = transformSubjectToAPI(mockSubject);
+      expect(result.conversion_ratio).toBe(1);
+      expect(result.effective_score).toBe(200);
+    });
   });
 
   describe('transformSubjectFromAPI', () => {
@@ -190,6 +214,8 @@ describe('APIトランスフォーマー', () => {
         test_type_id: 1,
         name: '数学',
         score: 100,
+        conversion_ratio: 0.5,
+        effective_score: 50,
         percentage: 50,
         display_order: 1,
         created_at: '2024-01-01T00:00:00Z',
@@ -206,6 +232,8 @@ describe('APIトランスフォーマー', () => {
         testTypeId: 1,
         name: '数学',
         score: 100,
+        conversionRatio: 0.5,
+        effectiveScore: 50,
         percentage: 50,
         displayOrder: 0,
         createdAt: expect.any(String),
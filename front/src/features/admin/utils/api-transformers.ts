@@ -52,6 +52,8 @@ export const transformSubjectToAPI = (subject: Subject): APISubject => ({
   test_type_id: subject.testTypeId,
   name: subject.name,
   score: Number(subject.score) || 0,
+  conversion_ratio: subject.conversionRatio ?? 1,
+  effective_score: subject.effectiveScore ?? (Number(subject.score) || 0),
   percentage: Number(subject.percentage) || 0,
   display_order: subject.displayOrder,
   created_at: subject.createdAt,
@@ -73,6 +75,8 @@ export const transformSubjectFromAPI = (subject: APISubject): Subject => ({
   testTypeId: subject.test_type_id,
   name: subject.name as SubjectName,
   score: subject.score,
+  conversionRatio: subject.conversion_ratio,
+  effectiveScore: subject.effective_score,
   percentage: subject.percentage,
   displayOrder: 0,
   createdAt: subject.created_at ?? '',
@@ -111,6 +111,8 @@ describe('大学データ変換ユーティリティ', () => {
     test_type_id: 1,
     name: '数学' as SubjectName,
     score: 100,
+    conversion_ratio: 0.5,
+    effective_score: 50,
     percentage: 50,
     display_order: 1,
     created_at: '2024-01-01T00:00:00Z',
@@ -329,6 +331,8 @@ describe('大学データ変換ユーティリティ', () => {
         testTypeId: 1,
         name: '数学',
         score: 100,
+        conversionRatio: 0.5,
+        effectiveScore: 50,
         percentage: 50,
         displayOrder: 1,
         createdAt: expect.any(String),
@@ -166,6 +166,8 @@ export function transformSubject(apiSubject: APISubject): Subject {
     testTypeId: apiSubject.test_type_id,
     name: apiSubject.name as SubjectName,
     score: apiSubject.score,
+    conversionRatio: apiSubject.conversion_ratio,
+    effectiveScore: apiSubject.effective_score,
     percentage: apiSubject.percentage,
     displayOrder: apiSubject.display_order,
     createdAt: formatDate(apiSubject.created_at),
@@ -2,6 +2,7 @@
  * 科目チャートのデータを生成・管理するフック
  * 科目別チャートと試験別チャートそれぞれの詳細データとカテゴリデータを生成し、適切な順序でソート
  * チャートの表示に必要な全てのデータ構造とその加工処理を提供
+ * スコアと割合は換算後の配点（effective_score）を集計した科目スコアから計算
  */
 import { useMemo } from 'react';
 import type { UISubject } from '@/types/university-subject';
@@ -18,6 +19,7 @@ import {
 /**
  * チャート表示用のデータから部分的な合計点を計算する関数
  * 表示用に変換済みのスコア（DisplaySubjectScore）の合計を計算
+ * 選択科目群は科目データの変換時に1つの値へ集計済みのため、所属する科目を重複して数えない
  * チャートの各セクションやカテゴリの合計点を算出する際に使用
  * @param data - 表示用スコアデータの配列
  * @returns 合計スコア
@@ -17,8 +17,8 @@ import { createChartError } from '@/features/charts/utils/chart-error-factory';
 /**
  * スコアデータを円グラフ表示用に変換
  * @param params - 変換パラメータ
- * @param params.value - スコア値
- * @param params.totalScore - 合計スコア
+ * @param params.value - スコア値（換算後の配点）
+ * @param params.totalScore - 合計スコア（換算後の配点の合計）
  * @param params.name - データ名
  * @returns 円グラフ表示用のデータ
  * @throws {Error} スコア値が不正な場合
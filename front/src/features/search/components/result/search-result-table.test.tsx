@@ -27,15 +27,25 @@ describe('SearchResultTable', () => {
 
   // テストデータ
   const mockData = {
-    data: [
-      {
-        university: { id: 1, name: 'テスト大学' },
-        department: { id: 1, name: 'テスト学部' },
-        major: { id: 1, name: 'テスト学科' },
-        admissionSchedule: { id: 1, name: '前期' },
-        examInfo: { academicYear: 2024, enrollment: 100 },
-      },
-    ],
+    success: true,
+    timestamp: 0,
+    data: {
+      universities: [
+        {
+          university: { id: 1, name: 'テスト大学' },
+          department: { id: 1, name: 'テスト学部' },
+          major: { id: 1, name: 'テスト学科' },
+          admissionSchedule: { id: 1, name: '前期' },
+          examInfo: { academicYear: 2024, enrollment: 100 },
+        },
+      ],
+      total: 1,
+      page: 1,
+      perPage: 100,
+      hasNext: false,
+      hasPrev: false,
+    },
+    links: {},
   };
 
   // 変換後のデータ
@@ -110,7 +120,9 @@ describe('SearchResultTable', () => {
   describe('データ表示のテスト', () => {
     it('データが空の場合の表示が正しいこと', async () => {
       mockFetch.mockResolvedValueOnce({
-        json: () => Promise.resolve({ data: [] }),
+        ok: true,
+        json: () =>
+          Promise.resolve({ ...mockData, data: { ...mockData.data, universities: [], total: 0 } }),
       });
       (transformUniversityData as any).mockReturnValue([]);
 
@@ -128,6 +140,7 @@ describe('SearchResultTable', () => {
 
     it('データが正しく表示されること', async () => {
       mockFetch.mockResolvedValueOnce({
+        ok: true,
         json: () => Promise.resolve(mockData),
       });
 
@@ -148,6 +161,7 @@ describe('SearchResultTable', () => {
   describe('インタラクションのテスト', () => {
     it('行をクリックしたときに正しいURLが開かれること', async () => {
       mockFetch.mockResolvedValueOnce({
+        ok: true,
         json: () => Promise.resolve(mockData),
       });
       const mockOpen = vi.fn();
@@ -176,6 +190,7 @@ describe('SearchResultTable', () => {
 
     it('キーボード操作で行を選択したときに正しいURLが開かれること', async () => {
       mockFetch.mockResolvedValueOnce({
+        ok: true,
         json: () => Promise.resolve(mockData),
       });
       const mockOpen = vi.fn();
@@ -206,6 +221,7 @@ describe('SearchResultTable', () => {
   describe('アクセシビリティのテスト', () => {
     it('テーブルのアクセシビリティ属性が正しく設定されていること', async () => {
       mockFetch.mockResolvedValueOnce({
+        ok: true,
         json: () => Promise.resolve(mockData),
       });
 
@@ -229,6 +245,7 @@ describe('SearchResultTable', () => {
 
     it('行のアクセシビリティ属性が正しく設定されていること', async () => {
       mockFetch.mockResolvedValueOnce({
+        ok: true,
         json: () => Promise.resolve(mockData),
       });
 
@@ -250,6 +267,7 @@ describe('SearchResultTable', () => {
   describe('データ検証のテスト', () => {
     it('無効なデータ形式の場合にエラーが表示されること', async () => {
       mockFetch.mockResolvedValueOnce({
+        ok: true,
         json: () => Promise.resolve({ invalid: 'data' }),
       });
 
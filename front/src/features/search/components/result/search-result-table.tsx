@@ -7,6 +7,8 @@ import { Spinner } from '@/components/ui/feedback/spinner';
 import type { UISubject } from '@/types/university-subject';
 import { transformUniversityData } from '@/features/search/utils/university-data-transformer';
 import { SectionTitle } from '@/components/ui/section-title';
+import { API_ENDPOINTS } from '@/constants/api';
+import { fetchAllUniversities } from '@/utils/fetch-all-universities';
 import {
   Table,
   TableBody,
@@ -41,7 +43,7 @@ const SearchResultTable = () => {
   /**
    * 大学データの取得と変換
    *
-   * APIから大学データを取得し、表示用の形式に変換します。
+   * APIから大学データを全ページ取得し、表示用の形式に変換します。
    * エラーが発生した場合はエラーメッセージを表示します。
    */
   useEffect(() => {
@@ -50,15 +52,7 @@ const SearchResultTable = () => {
         setLoading(true);
         setError(null);
 
-        const response = await fetch(`${process.env.NEXT_PUBLIC_API_URL}/universities`);
-        const responseData = await response.json();
-        const data = responseData.data ?? responseData;
-
-        if (!Array.isArray(data)) {
-          console.error('データが配列ではありません:', typeof data, data);
-          throw new Error('無効なレスポンス形式です');
-        }
-
+        const data = await fetchAllUniversities(API_ENDPOINTS.UNIVERSITIES);
         const transformedData = transformUniversityData(data);
         setSubjects(transformedData);
       } catch (error) {
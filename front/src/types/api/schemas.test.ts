@@ -1,6 +1,7 @@
 import { describe, it, expect } from 'vitest';
 import {
   SubjectSchema,
+  SubjectGroupSchema,
   TestTypeSchema,
   AdmissionScheduleSchema,
   AdmissionInfoSchema,
@@ -21,6 +22,8 @@ describe('APIスキーマのバリデーション', () => {
         test_type_id: 1,
         name: '数学',
         score: 100,
+        conversion_ratio: 0.5,
+        effective_score: 50,
         percentage: 50,
         display_order: 1,
         created_at: '2024-01-01T00:00:00Z',
@@ -40,6 +43,8 @@ describe('APIスキーマのバリデーション', () => {
         test_type_id: 1,
         name: 'a'.repeat(51), // 51文字は制限を超える
         score: 100,
+        conversion_ratio: 0.5,
+        effective_score: 50,
         percentage: 50,
         display_order: 1,
         created_at: '2024-01-01T00:00:00Z',
@@ -59,6 +64,8 @@ describe('APIスキーマのバリデーション', () => {
         test_type_id: 1,
         name: '数学',
         score: 1001, // 1000を超える
+        conversion_ratio: 0.5,
+        effective_score: 500.5,
         percentage: 50,
         display_order: 1,
         created_at: '2024-01-01T00:00:00Z',
@@ -71,6 +78,54 @@ describe('APIスキーマのバリデーション', () => {
 
       expect(() => SubjectSchema.parse(invalidData)).toThrow();
     });
+
+    it('不正な換算比率を検出できる', () => {
+      const invalidData = {
+        id: 1,
+        test_type_id: 1,
+        name: '数学',
+        score: 100,
+        conversion_ratio: 0, // 0より大きい必要がある
+        effective_score: 0,
+        percentage: 50,
+        display_order: 1,
+        created_at: '2024-01-01T00:00:00Z',
+        updated_at: '2024-01-01T00:00:00Z',
+        deleted_at: null,
+        version: 1,
+        created_by: 'user1',
+        updated_by: 'user1',
+      };
+
+      expect(() => SubjectSchema.parse(invalidData)).toThrow();
+    });
+  });
+
+  describe('SubjectGroupSchema', () => {
+    const validGroup = {
+      id: 10,
+      test_type_id: 1,
+      name: '理科',
+      choose_count: 2,
+      display_order: 1,
+      subject_ids: [2, 3, 4],
+      effective_score: 200,
+      percentage: 50,
+      created_at: '2024-01-01T00:00:00Z',
+      updated_at: '2024-01-01T00:00:00Z',
+      deleted_at: null,
+      version: 1,
+      created_by: 'user1',
+      updated_by: 'user1',
+    };
+
+    it('正常な選択科目群データを検証できる', () => {
+      expect(() => SubjectGroupSchema.parse(validGroup)).not.toThrow();
+    });
+
+    it('不正な選択数を検出できる', () => {
+      expect(() => SubjectGroupSchema.parse({ ...validGroup, choose_count: 0 })).toThrow();
+    });
   });
 
   describe('TestTypeSchema', () => {
@@ -36,16 +36,44 @@ export const SubjectSchema: z.ZodType = z.object({
   ...commonValidationRules,
   /** 関連するテストタイプのID */
   test_type_id: z.number().min(1),
+  /** 所属する選択科目群のID（選択科目群に属さない場合は省略） */
+  subject_group_id: z.number().min(1).optional(),
   /** 科目名 */
   name: z.string().min(1).max(50),
-  /** 科目の得点 */
+  /** 科目の得点（素点の満点） */
   score: z.number().min(0).max(1000),
-  /** 科目の得点率（0-100%） */
+  /** 換算比率（900点を450点に圧縮する場合は0.5） */
+  conversion_ratio: z.number().positive().max(10),
+  /** 換算後の配点（score × conversion_ratio） */
+  effective_score: z.number().min(0),
+  /** 科目の得点率（換算後の配点に対する割合、0-100%。選択科目群に属する科目は参考値） */
   percentage: z.number().min(0).max(100),
   /** UI表示時の順序 */
   display_order: z.number().min(0),
 });
 
+/**
+ * 選択科目群のスキーマ
+ * 所属する科目から選択数の科目を選ぶため、配点の合計には選択科目群の換算後の配点を1回だけ数える
+ */
+export const SubjectGroupSchema: z.ZodType = z.object({
+  ...commonValidationRules,
+  /** 関連するテストタイプのID */
+  test_type_id: z.number().min(1),
+  /** 選択科目群名 */
+  name: z.string().min(1).max(20),
+  /** 選択する科目数 */
+  choose_count: z.number().min(1),
+  /** UI表示時の順序 */
+  display_order: z.number().min(0),
+  /** 所属する科目のID */
+  subject_ids: z.array(z.number().min(1)),
+  /** 選択科目群の換算後の配点（配点の高い順に選択数の科目を選んだ合計） */
+  effective_score: z.number().min(0),
+  /** 選択科目群の配点比率（0-100%） */
+  percentage: z.number().min(0).max(100),
+});
+
 /** 試験種別のスキーマ */
 export const TestTypeSchema: z.ZodType = z.object({
   ...commonValidationRules,
@@ -55,6 +83,8 @@ export const TestTypeSchema: z.ZodType = z.object({
   name: z.enum(['共通', '二次']),
   /** 関連する科目情報の配列 */
   subjects: z.array(SubjectSchema),
+  /** 選択科目群の配列（選択科目群がない場合は省略） */
+  subject_groups: z.array(SubjectGroupSchema).optional(),
 });
 
 /** 入試スケジュールのスキーマ */
@@ -224,6 +254,8 @@ export type AdmissionInfo = z.infer<typeof AdmissionInfoSchema>;
 export type AdmissionSchedule = z.infer<typeof AdmissionScheduleSchema>;
 /** 試験種別の型定義 */
 export type TestType = z.infer<typeof TestTypeSchema>;
+/** 選択科目群の型定義 */
+export type SubjectGroup = z.infer<typeof SubjectGroupSchema>;
 /** 科目情報の型定義 */
 export type Subject = z.infer<typeof SubjectSchema>;
 /** 検索フォームデータの型定義 */
@@ -29,6 +29,7 @@ import {
   AdmissionInfo,
   TestType,
   Subject,
+  SubjectGroup,
 } from './schemas';
 
 /**
@@ -43,7 +44,7 @@ export interface GetUniversitiesResponse
     /** 現在のページ番号 */
     page: number;
     /** 1ページあたりの件数 */
-    limit: number;
+    perPage: number;
   }> {}
 
 /** 大学情報のAPIレスポンス型 */
@@ -60,6 +61,8 @@ export type APIAdmissionInfo = AdmissionInfo & BaseModel;
 export type APITestType = TestType & BaseModel;
 /** 科目情報のAPIレスポンス型 */
 export type APISubject = Subject & BaseModel;
+/** 選択科目群のAPIレスポンス型 */
+export type APISubjectGroup = SubjectGroup & BaseModel;
 
 /** HTTPメソッドの型定義 */
 export type HttpMethod = 'GET' | 'POST' | 'PUT' | 'DELETE' | 'PATCH' | 'HEAD' | 'OPTIONS';
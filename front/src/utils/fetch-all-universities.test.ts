@@ -0,0 +1,106 @@
+import { describe, it, expect, vi, beforeEach } from 'vitest';
+import { fetchAllUniversities, UNIVERSITIES_PER_PAGE } from './fetch-all-universities';
+
+/**
+ * 大学一覧の全件取得のテスト
+ *
+ * 以下の項目をテストします：
+ * - 最後のページまでの取得
+ * - カーソルによる次ページの取得
+ * - APIエラーと不正なレスポンス形式の検出
+ */
+describe('fetchAllUniversities', () => {
+  const endpoint = 'http://localhost:8080/api/universities';
+
+  /**
+   * 大学一覧APIのレスポンスを生成
+   * @param universities - 大学情報の配列
+   * @param page - ページ番号
+   * @param hasNext - 次のページの有無
+   * @param nextCursor - 次のページのカーソル
+   * @returns fetchのレスポンス
+   */
+  const pageResponse = (
+    universities: { id: number; name: string }[],
+    page: number,
+    hasNext: boolean,
+    nextCursor?: string
+  ) =>
+    ({
+      ok: true,
+      json: () =>
+        Promise.resolve({
+          success: true,
+          timestamp: 0,
+          data: {
+            universities,
+            total: 3,
+            page,
+            perPage: UNIVERSITIES_PER_PAGE,
+            hasNext,
+            hasPrev: page > 1,
+            ...(nextCursor ? { nextCursor } : {}),
+          },
+          links: {},
+        }),
+    }) as Response;
+
+  beforeEach(() => {
+    global.fetch = vi.fn();
+  });
+
+  it('次のページがなくなるまでページ番号で取得すること', async () => {
+    vi.mocked(global.fetch)
+      .mockResolvedValueOnce(pageResponse([{ id: 1, name: '東京大学' }], 1, true))
+      .mockResolvedValueOnce(pageResponse([{ id: 2, name: '京都大学' }], 2, false));
+
+    const result = await fetchAllUniversities(endpoint);
+
+    expect(result.map(u => u.id)).toEqual([1, 2]);
+    expect(global.fetch).toHaveBeenNthCalledWith(
+      1,
+      `${endpoint}?perPage=${UNIVERSITIES_PER_PAGE}&page=1`,
+      undefined
+    );
+    expect(global.fetch).toHaveBeenNthCalledWith(
+      2,
+      `${endpoint}?perPage=${UNIVERSITIES_PER_PAGE}&page=2`,
+      undefined
+    );
+  });
+
+  it('次のページのカーソルがある場合はカーソルで取得すること', async () => {
+    vi.mocked(global.fetch)
+      .mockResolvedValueOnce(pageResponse([{ id: 1, name: '東京大学' }], 1, true, 'abc'))
+      .mockResolvedValueOnce(pageResponse([{ id: 3, name: '大阪大学' }], 2, false));
+
+    const result = await fetchAllUniversities(endpoint);
+
+    expect(result.map(u => u.id)).toEqual([1, 3]);
+    expect(global.fetch).toHaveBeenLastCalledWith(
+      `${endpoint}?perPage=${UNIVERSITIES_PER_PAGE}&cursor=abc`,
+      undefined
+    );
+  });
+
+  it('APIエラーの場合はエラーを投げること', async () => {
+    vi.mocked(global.fetch).mockResolvedValueOnce({
+      ok: false,
+      status: 500,
+      statusText: 'Internal Server Error',
+    } as Response);
+
+    await expect(fetchAllUniversities(endpoint)).rejects.toThrow(
+      'APIエラー: 500 Internal Server Error'
+    );
+  });
+
+  it('レスポンスに大学情報の配列がない場合はエラーを投げること', async () => {
+    vi.mocked(global.fetch).mockResolvedValueOnce({
+      ok: true,
+      json: () => Promise.resolve({ data: [] }),
+    } as Response);
+
+    await expect(fetchAllUniversities(endpoint)).rejects.toThrow('無効なレスポンス形式です');
+  });
+});
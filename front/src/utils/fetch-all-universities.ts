@@ -0,0 +1,80 @@
+/**
+ * 大学一覧の全件取得
+ * ページネーションされた大学一覧APIを最後のページまで辿って取得
+ * バックエンドのAPIレスポンスと同期を保つ必要があります
+ * @see back/internal/pkg/pagination/pagination.go
+ *
+ * @module fetch-all-universities
+ * @description
+ * - 1ページあたりの最大件数での取得
+ * - hasNext/nextCursorによる次ページの取得
+ * - レスポンス形式の検証
+ */
+
+import type { APIUniversity } from '@/types/api/types';
+
+/** 1ページあたりの取得件数（APIの最大件数） */
+export const UNIVERSITIES_PER_PAGE = 100;
+
+/** 大学一覧APIのレスポンスデータ */
+interface UniversitiesPageData {
+  /** 大学情報の配列 */
+  universities: APIUniversity[];
+  /** 次のページの有無 */
+  hasNext: boolean;
+  /** 次のページのカーソル（キーセットページネーション時のみ） */
+  nextCursor?: string;
+}
+
+/**
+ * 大学一覧APIのレスポンスデータかどうかを判定
+ * @param data - レスポンスのdata
+ * @returns 大学一覧のレスポンスデータの場合はtrue
+ */
+const isUniversitiesPageData = (data: unknown): data is UniversitiesPageData =>
+  typeof data === 'object' &&
+  data !== null &&
+  Array.isArray((data as UniversitiesPageData).universities);
+
+/**
+ * 大学一覧を全件取得
+ * @param endpoint - 大学一覧APIのURL
+ * @param init - fetchのオプション
+ * @returns 全ページの大学情報
+ * @throws {Error} APIエラーの場合やレスポンス形式が不正な場合
+ */
+export const fetchAllUniversities = async (
+  endpoint: string,
+  init?: RequestInit
+): Promise<APIUniversity[]> => {
+  const universities: APIUniversity[] = [];
+  let page = 1;
+  let cursor: string | undefined;
+
+  for (;;) {
+    const params = new URLSearchParams({ perPage: String(UNIVERSITIES_PER_PAGE) });
+    if (cursor) {
+      params.set('cursor', cursor);
+    } else {
+      params.set('page', String(page));
+    }
+
+    const response = await fetch(`${endpoint}?${params.toString()}`, init);
+    if (!response.ok) {
+      throw new Error(`APIエラー: ${response.status} ${response.statusText}`);
+    }
+
+    const data = (await response.json())?.data;
+    if (!isUniversitiesPageData(data)) {
+      throw new Error('無効なレスポンス形式です');
+    }
+
+    universities.push(...data.universities);
+    if (!data.hasNext) {
+      return universities;
+    }
+
+    page += 1;
+    cursor = data.nextCursor;
+  }
+};
@@ -103,6 +103,8 @@ describe('科目データ変換のテスト', () => {
     id: 1,
     name: '数学',
     score: 80,
+    conversion_ratio: 1,
+    effective_score: 80,
     percentage: 80,
     display_order: 1,
     test_type_id: 1,
@@ -137,6 +139,73 @@ describe('科目データ変換のテスト', () => {
       expect(result?.subjects['数学'].commonTest).toBe(80);
     });
 
+    it('科目スコアは換算後の配点で集計されること', () => {
+      const compressedSubject: APISubject = {
+        ...mockSubject,
+        score: 200,
+        conversion_ratio: 0.5,
+        effective_score: 100,
+      };
+
+      const result = transformSubjectData(
+        compressedSubject,
+        [compressedSubject],
+        mockUniversity,
+        mockDepartment,
+        mockMajor,
+        mockAdmissionInfo,
+        mockSchedule
+      );
+
+      expect(result?.score).toBe(200);
+      expect(result?.subjects['数学'].commonTest).toBe(100);
+    });
+
+    it('選択科目群は所属する科目ではなく選択科目群の換算後の配点で1回だけ集計されること', () => {
+      // 物理・化学・生物（各100点）から2科目
+      const members: APISubject[] = ['物理', '化学', '生物'].map((name, index) => ({
+        ...mockSubject,
+        id: index + 2,
+        name,
+        score: 100,
+        effective_score: 100,
+        subject_group_id: 10,
+      }));
+      const schedule: APIAdmissionSchedule = {
+        ...mockSchedule,
+        test_types: [
+          {
+            ...mockTestType,
+            subject_groups: [
+              {
+                id: 10,
+                test_type_id: 1,
+                name: '理科',
+                choose_count: 2,
+                display_order: 1,
+                subject_ids: [2, 3, 4],
+                effective_score: 200,
+                percentage: 71.43,
+              },
+            ],
+          },
+        ],
+      };
+
+      const result = transformSubjectData(
+        mockSubject,
+        [mockSubject, ...members],
+        mockUniversity,
+        mockDepartment,
+        mockMajor,
+        mockAdmissionInfo,
+        schedule
+      );
+
+      expect(result?.subjects['数学'].commonTest).toBe(80);
+      expect(result?.subjects['理科'].commonTest).toBe(200);
+    });
+
     it('必須パラメータが欠けている場合、nullを返すこと', () => {
       const result = transformSubjectData(
         { ...mockSubject, id: 0 },
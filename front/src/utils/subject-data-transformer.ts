@@ -4,7 +4,8 @@
  *
  * @module subject-data-transformer
  * @description
- * - 科目スコアの集計
+ * - 科目スコアの集計（換算後の配点を使用）
+ * - 選択科目群の集計（選択科目群の換算後の配点を1回だけ使用）
  * - テストタイプ別のスコア管理
  * - UI表示用データの生成
  */
@@ -17,37 +18,55 @@ import type {
   APIAdmissionInfo,
   APIAdmissionSchedule,
   APITestType,
+  APISubjectGroup,
 } from '@/types/api/types';
 import type { UISubject } from '@/types/university-subject';
 import type { BaseSubjectScore } from '@/types/score';
 
 /**
  * 科目スコアを更新
- * @param subject - 科目データ
+ * 圧縮・傾斜配点を反映するため、素点ではなく換算後の配点を集計
+ * @param name - 集計先の科目名
+ * @param score - 加算する換算後の配点
  * @param testType - テストタイプ
  * @param subjects - 科目スコアの記録
  */
 const updateSubjectScores = (
-  subject: APISubject,
+  name: string,
+  score: number,
   testType: { id: number; name: string },
   subjects: Record<string, BaseSubjectScore>
 ) => {
-  if (subject.name in subjects) {
+  if (name in subjects) {
     const isCommonTest = testType.name === '共通';
-    const currentScores = subjects[subject.name];
-    subjects[subject.name] = {
-      commonTest: isCommonTest
-        ? currentScores.commonTest + subject.score
-        : currentScores.commonTest,
-      secondTest: !isCommonTest
-        ? currentScores.secondTest + subject.score
-        : currentScores.secondTest,
+    const currentScores = subjects[name];
+    subjects[name] = {
+      commonTest: isCommonTest ? currentScores.commonTest + score : currentScores.commonTest,
+      secondTest: !isCommonTest ? currentScores.secondTest + score : currentScores.secondTest,
     };
   }
 };
 
+/**
+ * 選択科目群の集計先の科目名を取得
+ * 選択科目群名が集計対象の科目名の場合はその名前を、そうでない場合は所属する科目のうち最初に見つかった集計対象の科目名を使用
+ * @param group - 選択科目群
+ * @param members - 選択科目群に所属する科目
+ * @param subjects - 科目スコアの記録
+ * @returns 集計先の科目名（集計対象がない場合はundefined）
+ */
+const resolveGroupSubjectName = (
+  group: APISubjectGroup,
+  members: APISubject[],
+  subjects: Record<string, BaseSubjectScore>
+): string | undefined => {
+  if (group.name in subjects) return group.name;
+  return members.find(member => member.name in subjects)?.name;
+};
+
 /**
  * 全科目のスコアを計算
+ * 選択科目群に所属する科目は個別に集計せず、選択科目群の換算後の配点を1回だけ集計
  * @param allSubjects - 全科目データ
  * @param schedule - 入試日程
  * @returns 科目ごとのスコア記録
@@ -65,10 +84,22 @@ const calculateSubjectScores = (
     地歴公: { commonTest: 0, secondTest: 0 },
   };
 
-  for (const subject of allSubjects) {
-    const testType = schedule.test_types.find((tt: APITestType) => tt.id === subject.test_type_id);
-    if (testType) {
-      updateSubjectScores(subject, testType, subjects);
+  for (const testType of schedule.test_types as APITestType[]) {
+    const groups: APISubjectGroup[] = testType.subject_groups ?? [];
+    const groupIds = new Set(groups.map(group => group.id));
+    const testTypeSubjects = allSubjects.filter(subject => subject.test_type_id === testType.id);
+
+    for (const subject of testTypeSubjects) {
+      if (subject.subject_group_id && groupIds.has(subject.subject_group_id)) continue;
+      updateSubjectScores(subject.name, subject.effective_score, testType, subjects);
+    }
+
+    for (const group of groups) {
+      const members = testTypeSubjects.filter(subject => subject.subject_group_id === group.id);
+      const name = resolveGroupSubjectName(group, members, subjects);
+      if (members.length > 0 && name) {
+        updateSubjectScores(name, group.effective_score, testType, subjects);
+      }
     }
   }
 